@@ -44,6 +44,8 @@ import type {
     CloseChangesetsVariables,
     PublishChangesetsResult,
     PublishChangesetsVariables,
+    UpdateChangesetsMetadataResult,
+    UpdateChangesetsMetadataVariables,
     AvailableBulkOperationsVariables,
     AvailableBulkOperationsResult,
     BulkOperationType,
@@ -827,6 +829,36 @@ export async function publishChangesets(
     dataOrThrowErrors(result)
 }
 
+export async function updateChangesetsMetadata(
+    batchChange: Scalars['ID'],
+    changesets: Scalars['ID'][],
+    metadata: { title: string | null; body: string | null; branch: string | null }
+): Promise<void> {
+    const result = await requestGraphQL<UpdateChangesetsMetadataResult, UpdateChangesetsMetadataVariables>(
+        gql`
+            mutation UpdateChangesetsMetadata(
+                $batchChange: ID!
+                $changesets: [ID!]!
+                $title: String
+                $body: String
+                $branch: String
+            ) {
+                updateChangesetsMetadata(
+                    batchChange: $batchChange
+                    changesets: $changesets
+                    title: $title
+                    body: $body
+                    branch: $branch
+                ) {
+                    id
+                }
+            }
+        `,
+        { batchChange, changesets, ...metadata }
+    ).toPromise()
+    dataOrThrowErrors(result)
+}
+
 export const BULK_OPERATIONS = gql`
     query BatchChangeBulkOperations($batchChange: ID!, $first: Int, $after: String) {
         node(id: $batchChange) {
@@ -1,6 +1,14 @@
 import React from 'react'
 
-import { mdiCommentOutline, mdiLinkVariantRemove, mdiSync, mdiSourceBranch, mdiUpload, mdiOpenInNew } from '@mdi/js'
+import {
+    mdiCommentOutline,
+    mdiLinkVariantRemove,
+    mdiSync,
+    mdiSourceBranch,
+    mdiUpload,
+    mdiOpenInNew,
+    mdiPencilOutline,
+} from '@mdi/js'
 import classNames from 'classnames'
 
 import { Timestamp } from '@sourcegraph/branded/src/components/Timestamp'
@@ -44,6 +52,11 @@ const OPERATION_TITLES: Record<BulkOperationType, JSX.Element> = {
             <Icon aria-hidden={true} className="text-muted" svgPath={mdiUpload} /> Publish changesets
         </>
     ),
+    UPDATE_METADATA: (
+        <>
+            <Icon aria-hidden={true} className="text-muted" svgPath={mdiPencilOutline} /> Update changeset metadata
+        </>
+    ),
 }
 
 export interface BulkOperationNodeProps {
@@ -21,6 +21,7 @@ import { DetachChangesetsModal } from './DetachChangesetsModal'
 import { MergeChangesetsModal } from './MergeChangesetsModal'
 import { PublishChangesetsModal } from './PublishChangesetsModal'
 import { ReenqueueChangesetsModal } from './ReenqueueChangesetsModal'
+import { UpdateChangesetsMetadataModal } from './UpdateChangesetsMetadataModal'
 
 /**
  * Describes a possible action on the changeset list.
@@ -149,6 +150,25 @@ const AVAILABLE_ACTIONS: Record<BulkOperationType, ChangesetListAction> = {
             )
         },
     },
+    [BulkOperationType.UPDATE_METADATA]: {
+        type: 'update-metadata',
+        experimental: true,
+        buttonLabel: 'Update metadata',
+        dropdownTitle: 'Update metadata',
+        dropdownDescription:
+            'Re-render the title, body or branch of the selected changesets and update them on the code hosts without re-running the batch spec.',
+        onTrigger: (batchChangeID, changesetIDs, onDone, onCancel) => {
+            eventLogger.log('batch_change_details:bulk_action_update_metadata:clicked')
+            return (
+                <UpdateChangesetsMetadataModal
+                    batchChangeID={batchChangeID}
+                    changesetIDs={changesetIDs}
+                    afterCreate={onDone}
+                    onCancel={onCancel}
+                />
+            )
+        },
+    },
 }
 
 export interface ChangesetSelectRowProps {
@@ -0,0 +1,108 @@
+import React, { useCallback, useState } from 'react'
+
+import { asError, isErrorLike } from '@sourcegraph/common'
+import { Button, Input, TextArea, Modal, H3, Text, ErrorAlert, Form, Code } from '@sourcegraph/wildcard'
+
+import { LoaderButton } from '../../../../components/LoaderButton'
+import type { Scalars } from '../../../../graphql-operations'
+import { updateChangesetsMetadata as _updateChangesetsMetadata } from '../backend'
+
+export interface UpdateChangesetsMetadataModalProps {
+    onCancel: () => void
+    afterCreate: () => void
+    batchChangeID: Scalars['ID']
+    changesetIDs: Scalars['ID'][]
+
+    /** For testing only. */
+    updateChangesetsMetadata?: typeof _updateChangesetsMetadata
+}
+
+export const UpdateChangesetsMetadataModal: React.FunctionComponent<
+    React.PropsWithChildren<UpdateChangesetsMetadataModalProps>
+> = ({
+    onCancel,
+    afterCreate,
+    batchChangeID,
+    changesetIDs,
+    updateChangesetsMetadata = _updateChangesetsMetadata,
+}) => {
+    const [isLoading, setIsLoading] = useState<boolean | Error>(false)
+    const [title, setTitle] = useState<string>('')
+    const [body, setBody] = useState<string>('')
+    const [branch, setBranch] = useState<string>('')
+
+    const onChangeTitle = useCallback<React.ChangeEventHandler<HTMLInputElement>>(event => {
+        setTitle(event.target.value)
+    }, [])
+    const onChangeBody = useCallback<React.ChangeEventHandler<HTMLTextAreaElement>>(event => {
+        setBody(event.target.value)
+    }, [])
+    const onChangeBranch = useCallback<React.ChangeEventHandler<HTMLInputElement>>(event => {
+        setBranch(event.target.value)
+    }, [])
+
+    const onSubmit = useCallback<React.FormEventHandler>(
+        async event => {
+            event.preventDefault()
+            setIsLoading(true)
+            try {
+                await updateChangesetsMetadata(batchChangeID, changesetIDs, {
+                    title: title || null,
+                    body: body || null,
+                    branch: branch || null,
+                })
+                afterCreate()
+            } catch (error) {
+                setIsLoading(asError(error))
+            }
+        },
+        [afterCreate, batchChangeID, changesetIDs, title, body, branch, updateChangesetsMetadata]
+    )
+
+    const isEmpty = title.length === 0 && body.length === 0 && branch.length === 0
+
+    return (
+        <Modal onDismiss={onCancel} aria-labelledby={LABEL_ID}>
+            <H3 id={LABEL_ID}>Update changeset metadata</H3>
+            <Text className="mb-4">
+                Use this feature to update the title, body or branch of all the selected changesets without re-running
+                the batch spec. Fields can use the same templating as the <Code>changesetTemplate</Code>, except for
+                step outputs. Fields left empty are not changed. The branch can only be changed for unpublished
+                changesets.
+            </Text>
+            {isErrorLike(isLoading) && <ErrorAlert error={isLoading} />}
+            <Form onSubmit={onSubmit}>
+                <div className="form-group">
+                    <Input id="title" name="title" value={title} onChange={onChangeTitle} label="Title" />
+                </div>
+                <div className="form-group">
+                    <TextArea id="body" name="body" rows={8} value={body} onChange={onChangeBody} label="Body" />
+                </div>
+                <div className="form-group">
+                    <Input id="branch" name="branch" value={branch} onChange={onChangeBranch} label="Branch" />
+                </div>
+                <div className="d-flex justify-content-end">
+                    <Button
+                        disabled={isLoading === true}
+                        className="mr-2"
+                        onClick={onCancel}
+                        outline={true}
+                        variant="secondary"
+                    >
+                        Cancel
+                    </Button>
+                    <LoaderButton
+                        type="submit"
+                        disabled={isLoading === true || isEmpty}
+                        variant="primary"
+                        loading={isLoading === true}
+                        alwaysShowLabel={true}
+                        label="Update changesets"
+                    />
+                </div>
+            </Form>
+        </Modal>
+    )
+}
+
+const LABEL_ID = 'update-changesets-metadata-modal-id'
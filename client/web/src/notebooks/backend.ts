@@ -89,6 +89,33 @@ const notebooksFragment = gql`
                     symbolKind
                 }
             }
+            ... on ReferencesBlock {
+                __typename
+                id
+                referencesInput {
+                    __typename
+                    repositoryName
+                    filePath
+                    revision
+                    line
+                    character
+                    symbolName
+                }
+            }
+            ... on InsightBlock {
+                __typename
+                id
+                insightInput {
+                    __typename
+                    insightViewId
+                    seriesId
+                }
+            }
+            ... on ComputeBlock {
+                __typename
+                id
+                computeInput
+            }
         }
     }
 `
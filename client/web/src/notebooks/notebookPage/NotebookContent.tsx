@@ -53,6 +53,20 @@ export const NotebookContent: React.FunctionComponent<React.PropsWithChildren<No
         outlineContainerElement,
         isEmbedded,
     }) => {
+        // References, insight and compute blocks are only rendered on the server (in the Markdown
+        // export). The editor shows a placeholder for them, and the notebook can't be edited here so
+        // that saving it does not drop these blocks.
+        const hasServerRenderedBlocks = useMemo(
+            () =>
+                blocks.some(
+                    block =>
+                        block.__typename === 'ReferencesBlock' ||
+                        block.__typename === 'InsightBlock' ||
+                        block.__typename === 'ComputeBlock'
+                ),
+            [blocks]
+        )
+
         const initializerBlocks: BlockInit[] = useMemo(
             () =>
                 blocks.map(block => {
@@ -73,6 +87,30 @@ export const NotebookContent: React.FunctionComponent<React.PropsWithChildren<No
                                 type: 'symbol',
                                 input: { ...block.symbolInput, revision: block.symbolInput.revision ?? '' },
                             }
+                        case 'ReferencesBlock':
+                            return {
+                                id: block.id,
+                                type: 'md',
+                                input: {
+                                    text: `_References of \`${block.referencesInput.symbolName}\` are only available in the Markdown export of this notebook._`,
+                                },
+                            }
+                        case 'InsightBlock':
+                            return {
+                                id: block.id,
+                                type: 'md',
+                                input: {
+                                    text: '_This insight is only available in the Markdown export of this notebook._',
+                                },
+                            }
+                        case 'ComputeBlock':
+                            return {
+                                id: block.id,
+                                type: 'md',
+                                input: {
+                                    text: `\`\`\`sourcegraph-compute\n${block.computeInput}\n\`\`\`\n\n_The output of this compute block is only available in the Markdown export of this notebook._`,
+                                },
+                            }
                     }
                 }),
             [blocks]
@@ -89,9 +127,9 @@ export const NotebookContent: React.FunctionComponent<React.PropsWithChildren<No
                 authenticatedUser={authenticatedUser}
                 settingsCascade={settingsCascade}
                 platformContext={platformContext}
-                isReadOnly={!viewerCanManage}
+                isReadOnly={!viewerCanManage || hasServerRenderedBlocks}
                 blocks={initializerBlocks}
-                onSerializeBlocks={viewerCanManage ? onUpdateBlocks : noop}
+                onSerializeBlocks={viewerCanManage && !hasServerRenderedBlocks ? onUpdateBlocks : noop}
                 exportedFileName={exportedFileName}
                 onCopyNotebook={onCopyNotebook}
                 outlineContainerElement={outlineContainerElement}
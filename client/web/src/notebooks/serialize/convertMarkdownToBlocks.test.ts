@@ -132,4 +132,49 @@ https://sourcegraph.com/github.com/sourcegraph/sourcegraph@branch/-/blob/client/
             },
         ])
     })
+    it('should handle the Markdown export of the notebook API', () => {
+        const markdown = `# Title
+
+<!-- notebook-block -->
+
+Second paragraph
+
+\`\`\`sourcegraph file
+https://sourcegraph.com/github.com/sourcegraph/sourcegraph@feature/-/blob/client/web/index.ts?L101-123
+\`\`\`
+
+\`\`\`sourcegraph symbol
+https://sourcegraph.com/github.com/sourcegraph/sourcegraph/-/blob/client/web/index.ts#lineContext=3&symbolContainerName=class&symbolKind=FUNCTION&symbolName=func+a
+\`\`\`
+`
+
+        expect(convertMarkdownToBlocks(markdown)).toStrictEqual([
+            { type: 'md', input: { text: '# Title\n\n' } },
+            { type: 'md', input: { text: 'Second paragraph\n\n' } },
+            {
+                type: 'file',
+                input: {
+                    repositoryName: 'github.com/sourcegraph/sourcegraph',
+                    revision: 'feature',
+                    filePath: 'client/web/index.ts',
+                    lineRange: {
+                        startLine: 100,
+                        endLine: 123,
+                    },
+                },
+            },
+            {
+                type: 'symbol',
+                input: {
+                    repositoryName: 'github.com/sourcegraph/sourcegraph',
+                    revision: '',
+                    filePath: 'client/web/index.ts',
+                    symbolName: 'func a',
+                    symbolContainerName: 'class',
+                    symbolKind: SymbolKind.FUNCTION,
+                    lineContext: 3,
+                },
+            },
+        ])
+    })
 })
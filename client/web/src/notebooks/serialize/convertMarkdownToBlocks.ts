@@ -16,6 +16,9 @@ function isSymbolBlockURL(url: string): boolean {
     return symbolName !== null && symbolName.length > 0
 }
 
+// Separates consecutive Markdown blocks in the Markdown export of the notebook API.
+const MARKDOWN_BLOCK_SEPARATOR = '<!-- notebook-block -->'
+
 export function convertMarkdownToBlocks(markdown: string): BlockInput[] {
     const blocks: BlockInput[] = []
 
@@ -35,6 +38,13 @@ export function convertMarkdownToBlocks(markdown: string): BlockInput[] {
         if (token.type === 'code' && token.lang === 'sourcegraph') {
             addMarkdownBlock()
             blocks.push(deserializeBlockInput('query', token.text))
+        } else if (token.type === 'code' && (token.lang === 'sourcegraph file' || token.lang === 'sourcegraph symbol')) {
+            // File and symbol blocks in the Markdown export of the notebook API.
+            addMarkdownBlock()
+            const blockType = token.lang === 'sourcegraph file' ? 'file' : 'symbol'
+            blocks.push(deserializeBlockInput(blockType, token.text.trim()))
+        } else if (token.type === 'html' && token.text.trim() === MARKDOWN_BLOCK_SEPARATOR) {
+            addMarkdownBlock()
         } else if (
             token.type === 'paragraph' &&
             token.tokens.length === 1 &&
@@ -192,6 +192,20 @@ export function GQLBlockToGQLInput(block: NotebookFields['blocks'][number]): Cre
                 type: NotebookBlockType.SYMBOL,
                 symbolInput: block.symbolInput,
             }
+        case 'ReferencesBlock':
+            return {
+                id: block.id,
+                type: NotebookBlockType.REFERENCES,
+                referencesInput: block.referencesInput,
+            }
+        case 'InsightBlock':
+            return {
+                id: block.id,
+                type: NotebookBlockType.INSIGHT,
+                insightInput: block.insightInput,
+            }
+        case 'ComputeBlock':
+            return { id: block.id, type: NotebookBlockType.COMPUTE, computeInput: block.computeInput }
     }
 }
 
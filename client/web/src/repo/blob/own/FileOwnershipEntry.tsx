@@ -12,6 +12,7 @@ import type {
     CodeownersFileEntryFields,
     OwnerFields,
     RecentContributorOwnershipSignalFields,
+    RecentReviewerOwnershipSignalFields,
     RecentViewOwnershipSignalFields,
 } from '../../../graphql-operations'
 import { PersonLink } from '../../../person/PersonLink'
@@ -37,6 +38,7 @@ type OwnershipReason =
     | CodeownersFileEntryFields
     | RecentContributorOwnershipSignalFields
     | RecentViewOwnershipSignalFields
+    | RecentReviewerOwnershipSignalFields
     | AssignedOwnerFields
 
 export const FileOwnershipEntry: React.FunctionComponent<Props> = ({
@@ -151,10 +153,12 @@ export const FileOwnershipEntry: React.FunctionComponent<Props> = ({
 const getOwnershipReasonPriority = (reason: OwnershipReason): number => {
     switch (reason.__typename ?? '') {
         case 'CodeownersFileEntry':
-            return 4
+            return 5
         case 'AssignedOwner':
-            return 3
+            return 4
         case 'RecentContributorOwnershipSignal':
+            return 3
+        case 'RecentReviewerOwnershipSignal':
             return 2
         case 'RecentViewOwnershipSignal':
             return 1
@@ -6,6 +6,7 @@ import type {
     AssignedOwnerFields,
     CodeownersFileEntryFields,
     RecentContributorOwnershipSignalFields,
+    RecentReviewerOwnershipSignalFields,
     RecentViewOwnershipSignalFields,
 } from '../../../graphql-operations'
 
@@ -16,6 +17,7 @@ interface Props {
         | CodeownersFileEntryFields
         | RecentContributorOwnershipSignalFields
         | RecentViewOwnershipSignalFields
+        | RecentReviewerOwnershipSignalFields
         | AssignedOwnerFields
 }
 
@@ -44,6 +44,13 @@ export const RECENT_VIEW_FIELDS = gql`
     }
 `
 
+export const RECENT_REVIEWER_FIELDS = gql`
+    fragment RecentReviewerOwnershipSignalFields on RecentReviewerOwnershipSignal {
+        title
+        description
+    }
+`
+
 export const ASSIGNED_OWNER_FIELDS = gql`
     fragment AssignedOwnerFields on AssignedOwner {
         title
@@ -56,6 +63,7 @@ export const FETCH_OWNERS = gql`
     ${OWNER_FIELDS}
     ${RECENT_CONTRIBUTOR_FIELDS}
     ${RECENT_VIEW_FIELDS}
+    ${RECENT_REVIEWER_FIELDS}
     ${ASSIGNED_OWNER_FIELDS}
 
     fragment CodeownersFileEntryFields on CodeownersFileEntry {
@@ -79,6 +87,7 @@ export const FETCH_OWNERS = gql`
                         ...CodeownersFileEntryFields
                         ...RecentContributorOwnershipSignalFields
                         ...RecentViewOwnershipSignalFields
+                ...RecentReviewerOwnershipSignalFields
                         ...AssignedOwnerFields
                     }
                 }
@@ -113,6 +122,7 @@ export const FETCH_TREE_OWNERS = gql`
     ${OWNER_FIELDS}
     ${RECENT_CONTRIBUTOR_FIELDS}
     ${RECENT_VIEW_FIELDS}
+    ${RECENT_REVIEWER_FIELDS}
     ${ASSIGNED_OWNER_FIELDS}
 
     fragment CodeownersFileEntryFields on CodeownersFileEntry {
@@ -134,6 +144,7 @@ export const FETCH_TREE_OWNERS = gql`
                 ...CodeownersFileEntryFields
                 ...RecentContributorOwnershipSignalFields
                 ...RecentViewOwnershipSignalFields
+                ...RecentReviewerOwnershipSignalFields
                 ...AssignedOwnerFields
             }
         }
@@ -1,6 +1,6 @@
 import React, { useEffect, useMemo, useState } from 'react'
 
-import { mdiCog, mdiFileOutline, mdiGlasses, mdiInformationOutline } from '@mdi/js'
+import { mdiCheckCircleOutline, mdiCog, mdiFileOutline, mdiGlasses, mdiInformationOutline } from '@mdi/js'
 import classNames from 'classnames'
 import { formatISO, subYears } from 'date-fns'
 import { capitalize, escapeRegExp } from 'lodash'
@@ -51,7 +51,12 @@ import { PersonLink } from '../../person/PersonLink'
 import { quoteIfNeeded, searchQueryForRepoRevision } from '../../search'
 import { buildSearchURLQueryFromQueryState, useNavbarQueryState } from '../../stores'
 import { canWriteRepoMetadata } from '../../util/rbac'
-import { OWNER_FIELDS, RECENT_CONTRIBUTOR_FIELDS, RECENT_VIEW_FIELDS } from '../blob/own/grapqlQueries'
+import {
+    OWNER_FIELDS,
+    RECENT_CONTRIBUTOR_FIELDS,
+    RECENT_REVIEWER_FIELDS,
+    RECENT_VIEW_FIELDS,
+} from '../blob/own/grapqlQueries'
 import { GitCommitNodeTableRow } from '../commits/GitCommitNodeTableRow'
 import { gitCommitFragment } from '../commits/RepositoryCommitsPage'
 import { getRefType, isPerforceChangelistMappingEnabled } from '../utils'
@@ -500,6 +505,7 @@ const OWNERS_QUERY = gql`
     ${OWNER_FIELDS}
     ${RECENT_CONTRIBUTOR_FIELDS}
     ${RECENT_VIEW_FIELDS}
+    ${RECENT_REVIEWER_FIELDS}
 
     query TreePageOwnership($repo: ID!, $first: Int, $revision: String!, $filePath: String!) {
         node(id: $repo) {
@@ -534,6 +540,7 @@ const OWNERS_QUERY = gql`
         reasons {
             ...RecentContributorOwnershipSignalFields
             ...RecentViewOwnershipSignalFields
+            ...RecentReviewerOwnershipSignalFields
         }
     }
 `
@@ -642,6 +649,11 @@ const OwnerNode: React.FC<OwnerNodeProps> = ({ node }) => {
                         <Icon aria-label={primaryReason.title} svgPath={mdiFileOutline} /> changes
                     </Badge>
                 )}
+                {primaryReason?.__typename === 'RecentReviewerOwnershipSignal' && (
+                    <Badge tooltip={primaryReason.description} className={styles.badge} variant="secondary">
+                        <Icon aria-label={primaryReason.title} svgPath={mdiCheckCircleOutline} /> reviews
+                    </Badge>
+                )}
                 {primaryReason?.__typename === 'RecentViewOwnershipSignal' && (
                     <Badge tooltip={primaryReason.description} className={styles.badge} variant="secondary">
                         <Icon aria-label={primaryReason.title} svgPath={mdiGlasses} /> views
@@ -128,6 +128,72 @@ export const AggregationModeControls: FC<AggregationModeControlsProps> = props =
                     </Button>
                 </Tooltip>
             </div>
+
+            <div onMouseEnter={() => handleModeEnter(SearchAggregationMode.LANGUAGE)} onMouseLeave={handleMouseLeave}>
+                <Tooltip content={availabilityGroups[SearchAggregationMode.LANGUAGE]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.LANGUAGE}
+                        disabled={!isModeAvailable(SearchAggregationMode.LANGUAGE)}
+                        data-testid="language-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.LANGUAGE)}
+                    >
+                        Language
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div
+                onMouseEnter={() => handleModeEnter(SearchAggregationMode.FILE_EXTENSION)}
+                onMouseLeave={handleMouseLeave}
+            >
+                <Tooltip content={availabilityGroups[SearchAggregationMode.FILE_EXTENSION]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.FILE_EXTENSION}
+                        disabled={!isModeAvailable(SearchAggregationMode.FILE_EXTENSION)}
+                        data-testid="fileExtension-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.FILE_EXTENSION)}
+                    >
+                        File extension
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div
+                onMouseEnter={() => handleModeEnter(SearchAggregationMode.DATE_BUCKET)}
+                onMouseLeave={handleMouseLeave}
+            >
+                <Tooltip content={availabilityGroups[SearchAggregationMode.DATE_BUCKET]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.DATE_BUCKET}
+                        disabled={!isModeAvailable(SearchAggregationMode.DATE_BUCKET)}
+                        data-testid="dateBucket-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.DATE_BUCKET)}
+                    >
+                        Date
+                    </Button>
+                </Tooltip>
+            </div>
+
+            <div onMouseEnter={() => handleModeEnter(SearchAggregationMode.OWNER)} onMouseLeave={handleMouseLeave}>
+                <Tooltip content={availabilityGroups[SearchAggregationMode.OWNER]?.reasonUnavailable}>
+                    <Button
+                        variant="secondary"
+                        size={size}
+                        outline={mode !== SearchAggregationMode.OWNER}
+                        disabled={!isModeAvailable(SearchAggregationMode.OWNER)}
+                        data-testid="owner-aggregation-mode"
+                        onClick={() => onModeChange(SearchAggregationMode.OWNER)}
+                    >
+                        Owner
+                    </Button>
+                </Tooltip>
+            </div>
             {enableRepositoryMetadata && (
                 <div
                     onMouseEnter={() => handleModeEnter(SearchAggregationMode.REPO_METADATA)}
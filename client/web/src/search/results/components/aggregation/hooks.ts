@@ -64,7 +64,17 @@ function useSyncedWithURLState<State, SerializedState>(
     return [queryParameter, setNextState]
 }
 
-type SerializedAggregationMode = 'repo' | 'path' | 'author' | 'group' | 'repo-metadata' | ''
+type SerializedAggregationMode =
+    | 'repo'
+    | 'path'
+    | 'author'
+    | 'group'
+    | 'repo-metadata'
+    | 'language'
+    | 'file-extension'
+    | 'date'
+    | 'owner'
+    | ''
 
 const aggregationModeSerializer = (mode: SearchAggregationMode | null): SerializedAggregationMode => {
     switch (mode) {
@@ -78,6 +88,14 @@ const aggregationModeSerializer = (mode: SearchAggregationMode | null): Serializ
             return 'group'
         case SearchAggregationMode.REPO_METADATA:
             return 'repo-metadata'
+        case SearchAggregationMode.LANGUAGE:
+            return 'language'
+        case SearchAggregationMode.FILE_EXTENSION:
+            return 'file-extension'
+        case SearchAggregationMode.DATE_BUCKET:
+            return 'date'
+        case SearchAggregationMode.OWNER:
+            return 'owner'
         default:
             return ''
     }
@@ -97,6 +115,14 @@ const aggregationModeDeserializer = (
             return SearchAggregationMode.CAPTURE_GROUP
         case 'repo-metadata':
             return SearchAggregationMode.REPO_METADATA
+        case 'language':
+            return SearchAggregationMode.LANGUAGE
+        case 'file-extension':
+            return SearchAggregationMode.FILE_EXTENSION
+        case 'date':
+            return SearchAggregationMode.DATE_BUCKET
+        case 'owner':
+            return SearchAggregationMode.OWNER
 
         default:
             return null
@@ -20,14 +20,15 @@ import (
 // enterprise frontend setup hook.
 type Services struct {
 	// Batch Changes Services
-	BatchesGitHubWebhook            webhooks.Registerer
-	BatchesGitLabWebhook            webhooks.RegistererHandler
-	BatchesBitbucketServerWebhook   webhooks.RegistererHandler
-	BatchesBitbucketCloudWebhook    webhooks.RegistererHandler
-	BatchesAzureDevOpsWebhook       webhooks.Registerer
-	BatchesChangesFileGetHandler    http.Handler
-	BatchesChangesFileExistsHandler http.Handler
-	BatchesChangesFileUploadHandler http.Handler
+	BatchesGitHubWebhook             webhooks.Registerer
+	BatchesGitLabWebhook             webhooks.RegistererHandler
+	BatchesBitbucketServerWebhook    webhooks.RegistererHandler
+	BatchesBitbucketCloudWebhook     webhooks.RegistererHandler
+	BatchesAzureDevOpsWebhook        webhooks.Registerer
+	BatchesChangesFileGetHandler     http.Handler
+	BatchesChangesFileExistsHandler  http.Handler
+	BatchesChangesFileUploadHandler  http.Handler
+	BatchesImpactReportExportHandler http.Handler
 
 	// Repo related webhook handlers, currently only handle `push` events.
 	ReposGithubWebhook          webhooks.Registerer
@@ -40,9 +41,27 @@ type Services struct {
 	// Handler for exporting code insights data.
 	CodeInsightsDataExportHandler http.Handler
 
+	// Handler for streaming code insights data as CSV.
+	CodeInsightsCSVExportHandler http.Handler
+
+	// Handler for scraping the latest code insights values as metrics.
+	CodeInsightsMetricsHandler http.Handler
+
+	// The code insights database, which is only set if code insights are enabled.
+	CodeInsightsDB database.InsightsDB
+
 	// Handler for exporting search jobs data.
 	SearchJobsDataExportHandler http.Handler
 
+	// Handler for importing vulnerability databases.
+	VulnerabilityImportHandler http.Handler
+
+	// Handler for exporting SBOMs of repositories.
+	SBOMExportHandler http.Handler
+
+	// Handler for exporting precise code intelligence coverage.
+	CodeIntelCoverageExportHandler http.Handler
+
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
 
@@ -96,30 +115,36 @@ type NewDotcomLicenseCheckHandler func() http.Handler
 // DefaultServices creates a new Services value that has default implementations for all services.
 func DefaultServices() Services {
 	return Services{
-		ReposGithubWebhook:              &emptyWebhookHandler{name: "github sync webhook"},
-		ReposGitLabWebhook:              &emptyWebhookHandler{name: "gitlab sync webhook"},
-		ReposBitbucketServerWebhook:     &emptyWebhookHandler{name: "bitbucket server sync webhook"},
-		ReposBitbucketCloudWebhook:      &emptyWebhookHandler{name: "bitbucket cloud sync webhook"},
-		PermissionsGitHubWebhook:        &emptyWebhookHandler{name: "permissions github webhook"},
-		BatchesGitHubWebhook:            &emptyWebhookHandler{name: "batches github webhook"},
-		BatchesGitLabWebhook:            &emptyWebhookHandler{name: "batches gitlab webhook"},
-		BatchesBitbucketServerWebhook:   &emptyWebhookHandler{name: "batches bitbucket server webhook"},
-		BatchesBitbucketCloudWebhook:    &emptyWebhookHandler{name: "batches bitbucket cloud webhook"},
-		BatchesAzureDevOpsWebhook:       &emptyWebhookHandler{name: "batches azure devops webhook"},
-		BatchesChangesFileGetHandler:    makeNotFoundHandler("batches file get handler"),
-		BatchesChangesFileExistsHandler: makeNotFoundHandler("batches file exists handler"),
-		BatchesChangesFileUploadHandler: makeNotFoundHandler("batches file upload handler"),
-		SCIMHandler:                     makeNotFoundHandler("SCIM handler"),
-		NewCodeIntelUploadHandler:       func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
-		RankingService:                  stubRankingService{},
-		NewExecutorProxyHandler:         func() http.Handler { return makeNotFoundHandler("executor proxy") },
-		NewGitHubAppSetupHandler:        func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
-		NewComputeStreamHandler:         func() http.Handler { return makeNotFoundHandler("compute streaming endpoint") },
-		CodeInsightsDataExportHandler:   makeNotFoundHandler("code insights data export handler"),
-		NewDotcomLicenseCheckHandler:    func() http.Handler { return makeNotFoundHandler("dotcom license check handler") },
-		NewChatCompletionsStreamHandler: func() http.Handler { return makeNotFoundHandler("chat completions streaming endpoint") },
-		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
-		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
+		ReposGithubWebhook:               &emptyWebhookHandler{name: "github sync webhook"},
+		ReposGitLabWebhook:               &emptyWebhookHandler{name: "gitlab sync webhook"},
+		ReposBitbucketServerWebhook:      &emptyWebhookHandler{name: "bitbucket server sync webhook"},
+		ReposBitbucketCloudWebhook:       &emptyWebhookHandler{name: "bitbucket cloud sync webhook"},
+		PermissionsGitHubWebhook:         &emptyWebhookHandler{name: "permissions github webhook"},
+		BatchesGitHubWebhook:             &emptyWebhookHandler{name: "batches github webhook"},
+		BatchesGitLabWebhook:             &emptyWebhookHandler{name: "batches gitlab webhook"},
+		BatchesBitbucketServerWebhook:    &emptyWebhookHandler{name: "batches bitbucket server webhook"},
+		BatchesBitbucketCloudWebhook:     &emptyWebhookHandler{name: "batches bitbucket cloud webhook"},
+		BatchesAzureDevOpsWebhook:        &emptyWebhookHandler{name: "batches azure devops webhook"},
+		BatchesChangesFileGetHandler:     makeNotFoundHandler("batches file get handler"),
+		BatchesChangesFileExistsHandler:  makeNotFoundHandler("batches file exists handler"),
+		BatchesChangesFileUploadHandler:  makeNotFoundHandler("batches file upload handler"),
+		BatchesImpactReportExportHandler: makeNotFoundHandler("batches impact report export handler"),
+		SCIMHandler:                      makeNotFoundHandler("SCIM handler"),
+		NewCodeIntelUploadHandler:        func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		RankingService:                   stubRankingService{},
+		NewExecutorProxyHandler:          func() http.Handler { return makeNotFoundHandler("executor proxy") },
+		NewGitHubAppSetupHandler:         func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
+		NewComputeStreamHandler:          func() http.Handler { return makeNotFoundHandler("compute streaming endpoint") },
+		CodeInsightsDataExportHandler:    makeNotFoundHandler("code insights data export handler"),
+		CodeInsightsCSVExportHandler:     makeNotFoundHandler("code insights CSV export handler"),
+		CodeInsightsMetricsHandler:       makeNotFoundHandler("code insights metrics handler"),
+		NewDotcomLicenseCheckHandler:     func() http.Handler { return makeNotFoundHandler("dotcom license check handler") },
+		NewChatCompletionsStreamHandler:  func() http.Handler { return makeNotFoundHandler("chat completions streaming endpoint") },
+		NewCodeCompletionsHandler:        func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
+		SearchJobsDataExportHandler:      makeNotFoundHandler("search jobs data export handler"),
+		VulnerabilityImportHandler:       makeNotFoundHandler("vulnerability import handler"),
+		SBOMExportHandler:                makeNotFoundHandler("SBOM export handler"),
+		CodeIntelCoverageExportHandler:   makeNotFoundHandler("code intelligence coverage export handler"),
 	}
 }
 
@@ -13,14 +13,16 @@ import (
 )
 
 type CreateBatchChangeArgs struct {
-	BatchSpec         graphql.ID
-	PublicationStates *[]ChangesetSpecPublicationStateInput
+	BatchSpec                  graphql.ID
+	PublicationStates          *[]ChangesetSpecPublicationStateInput
+	BlockConflictingChangesets bool
 }
 
 type ApplyBatchChangeArgs struct {
-	BatchSpec         graphql.ID
-	EnsureBatchChange *graphql.ID
-	PublicationStates *[]ChangesetSpecPublicationStateInput
+	BatchSpec                  graphql.ID
+	EnsureBatchChange          *graphql.ID
+	PublicationStates          *[]ChangesetSpecPublicationStateInput
+	BlockConflictingChangesets bool
 }
 
 type ChangesetSpecPublicationStateInput struct {
@@ -182,6 +184,17 @@ type DeleteBatchChangesCredentialArgs struct {
 	BatchChangesCredential graphql.ID
 }
 
+type CreateBatchChangesCommitSigningKeyArgs struct {
+	User       *graphql.ID
+	Format     string
+	PrivateKey string
+	Passphrase *string
+}
+
+type DeleteBatchChangesCommitSigningKeyArgs struct {
+	BatchChangesCommitSigningKey graphql.ID
+}
+
 type ListBatchChangesCodeHostsArgs struct {
 	First  int32
 	After  *string
@@ -233,6 +246,54 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type UpdateChangesetsMetadataArgs struct {
+	BulkOperationBaseArgs
+	Title  *string
+	Body   *string
+	Branch *string
+}
+
+type BatchSpecTemplateInputDefinition struct {
+	Name        string
+	Description string
+	Type        string
+	Required    bool
+	Default     *string
+	Options     *[]string
+}
+
+type BatchSpecTemplateInputValue struct {
+	Name  string
+	Value string
+}
+
+type CreateBatchSpecTemplateArgs struct {
+	Namespace   graphql.ID
+	Name        string
+	Description string
+	Spec        string
+	Inputs      []BatchSpecTemplateInputDefinition
+}
+
+type UpdateBatchSpecTemplateArgs struct {
+	BatchSpecTemplate graphql.ID
+	Name              string
+	Description       string
+	Spec              string
+	Inputs            []BatchSpecTemplateInputDefinition
+}
+
+type DeleteBatchSpecTemplateArgs struct {
+	BatchSpecTemplate graphql.ID
+}
+
+type CreateBatchSpecFromTemplateArgs struct {
+	BatchSpecTemplate graphql.ID
+	Inputs            []BatchSpecTemplateInputValue
+	Namespace         graphql.ID
+	BatchChange       graphql.ID
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec string
 }
@@ -273,6 +334,8 @@ type BatchChangesResolver interface {
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
 	DeleteBatchChangesCredential(ctx context.Context, args *DeleteBatchChangesCredentialArgs) (*EmptyResponse, error)
+	CreateBatchChangesCommitSigningKey(ctx context.Context, args *CreateBatchChangesCommitSigningKeyArgs) (BatchChangesCommitSigningKeyResolver, error)
+	DeleteBatchChangesCommitSigningKey(ctx context.Context, args *DeleteBatchChangesCommitSigningKeyArgs) (*EmptyResponse, error)
 
 	CreateChangesetSpec(ctx context.Context, args *CreateChangesetSpecArgs) (ChangesetSpecResolver, error)
 	CreateChangesetSpecs(ctx context.Context, args *CreateChangesetSpecsArgs) ([]ChangesetSpecResolver, error)
@@ -284,6 +347,12 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	UpdateChangesetsMetadata(ctx context.Context, args *UpdateChangesetsMetadataArgs) (BulkOperationResolver, error)
+
+	CreateBatchSpecTemplate(ctx context.Context, args *CreateBatchSpecTemplateArgs) (BatchSpecTemplateResolver, error)
+	UpdateBatchSpecTemplate(ctx context.Context, args *UpdateBatchSpecTemplateArgs) (BatchSpecTemplateResolver, error)
+	DeleteBatchSpecTemplate(ctx context.Context, args *DeleteBatchSpecTemplateArgs) (*EmptyResponse, error)
+	CreateBatchSpecFromTemplate(ctx context.Context, args *CreateBatchSpecFromTemplateArgs) (BatchSpecResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -296,6 +365,7 @@ type BatchChangesResolver interface {
 	RepoDiffStat(ctx context.Context, repo *graphql.ID) (*DiffStat, error)
 
 	BatchSpecs(cx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
+	BatchSpecTemplates(ctx context.Context, args *ListBatchSpecTemplatesArgs) (BatchSpecTemplateConnectionResolver, error)
 	AvailableBulkOperations(ctx context.Context, args *AvailableBulkOperationsArgs) ([]string, error)
 
 	ResolveWorkspacesForBatchSpec(ctx context.Context, args *ResolveWorkspacesForBatchSpecArgs) ([]ResolvedBatchSpecWorkspaceResolver, error)
@@ -351,6 +421,8 @@ type BatchSpecResolver interface {
 	ViewerCanAdminister(context.Context) (bool, error)
 
 	DiffStat(ctx context.Context) (*DiffStat, error)
+	ImpactReport(ctx context.Context) (BatchSpecImpactReportResolver, error)
+	ChangesetConflicts(ctx context.Context) ([]ChangesetConflictResolver, error)
 
 	AppliesToBatchChange(ctx context.Context) (BatchChangeResolver, error)
 
@@ -382,6 +454,53 @@ type BatchChangeDescriptionResolver interface {
 	Description() string
 }
 
+type ChangesetConflictResolver interface {
+	ChangesetSpec() VisibleChangesetSpecResolver
+	ConflictingChangeset() ChangesetResolver
+	ConflictingBatchChange(ctx context.Context) (BatchChangeResolver, error)
+	Files() []ChangesetConflictFileResolver
+}
+
+type ChangesetConflictFileResolver interface {
+	Path() string
+	Hunks() []ChangesetConflictHunkResolver
+}
+
+type ChangesetConflictHunkResolver interface {
+	StartLine() int32
+	EndLine() int32
+}
+
+type BatchSpecImpactReportResolver interface {
+	Changesets() []ChangesetSpecImpactResolver
+	Owners() []BatchSpecImpactOwnerResolver
+	CsvURL() string
+}
+
+type ChangesetSpecImpactResolver interface {
+	ChangesetSpec() VisibleChangesetSpecResolver
+	Repository() *RepositoryResolver
+	Files() []ChangesetSpecFileImpactResolver
+	DiffStat() *DiffStat
+	Languages() []string
+	Owners() []string
+}
+
+type ChangesetSpecFileImpactResolver interface {
+	Path() string
+	DiffStat() *DiffStat
+	Language() *string
+	Owners() []string
+}
+
+type BatchSpecImpactOwnerResolver interface {
+	Owner() string
+	ChangesetCount() int32
+	FileCount() int32
+	DiffStat() *DiffStat
+	Repositories() []string
+}
+
 type ChangesetApplyPreviewResolver interface {
 	ToVisibleChangesetApplyPreview() (VisibleChangesetApplyPreviewResolver, bool)
 	ToHiddenChangesetApplyPreview() (HiddenChangesetApplyPreviewResolver, bool)
@@ -569,9 +688,19 @@ type BatchChangesCredentialResolver interface {
 	IsSiteCredential() bool
 }
 
-// Only GitHubApps are supported for commit signing for now.
 type CommitSigningConfigResolver interface {
 	ToGitHubApp() (GitHubAppResolver, bool)
+	ToBatchChangesCommitSigningKey() (BatchChangesCommitSigningKeyResolver, bool)
+}
+
+type BatchChangesCommitSigningKeyResolver interface {
+	ID() graphql.ID
+	Format() string
+	PublicKey() string
+	Fingerprint() string
+	User(ctx context.Context) (*UserResolver, error)
+	IsSiteKey() bool
+	CreatedAt() gqlutil.DateTime
 }
 
 type ChangesetCountsArgs struct {
@@ -611,6 +740,12 @@ type ListBatchSpecArgs struct {
 	ExcludeEmptySpecs           *bool
 }
 
+type ListBatchSpecTemplatesArgs struct {
+	Namespace *graphql.ID
+	First     int32
+	After     *string
+}
+
 type ListBatchSpecWorkspaceFilesArgs struct {
 	First int32
 	After *string
@@ -683,6 +818,34 @@ type BatchSpecConnectionResolver interface {
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
+type BatchSpecTemplateResolver interface {
+	ID() graphql.ID
+	Name() string
+	Description() string
+	Spec() string
+	Inputs() []BatchSpecTemplateInputResolver
+	Namespace(ctx context.Context) (NamespaceResolver, error)
+	Creator(ctx context.Context) (*UserResolver, error)
+	CreatedAt() gqlutil.DateTime
+	UpdatedAt() gqlutil.DateTime
+	ViewerCanAdminister(ctx context.Context) (bool, error)
+}
+
+type BatchSpecTemplateInputResolver interface {
+	Name() string
+	Description() string
+	Type() string
+	Required() bool
+	Default() *string
+	Options() []string
+}
+
+type BatchSpecTemplateConnectionResolver interface {
+	Nodes(ctx context.Context) ([]BatchSpecTemplateResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
 type BatchSpecWorkspaceFileConnectionResolver interface {
 	TotalCount(ctx context.Context) (int32, error)
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
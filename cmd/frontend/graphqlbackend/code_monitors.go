@@ -49,6 +49,7 @@ type MonitorResolver interface {
 	Description() string
 	Owner(ctx context.Context) (NamespaceResolver, error)
 	Enabled() bool
+	DeliverySchedule() string
 	Trigger(ctx context.Context) (MonitorTrigger, error)
 	Actions(ctx context.Context, args *ListActionArgs) (MonitorActionConnectionResolver, error)
 }
@@ -90,6 +91,7 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorIssue() (MonitorIssueResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -118,6 +120,21 @@ type MonitorSlackWebhookResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorIssueResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	Tracker() string
+	TitleTemplate() string
+	BodyTemplate() string
+	Labels() []string
+	JiraURL() *string
+	JiraProjectKey() *string
+	JiraIssueType() *string
+	JiraUsername() *string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -175,6 +192,7 @@ type CreateActionArgs struct {
 	Email        *CreateActionEmailArgs
 	Webhook      *CreateActionWebhookArgs
 	SlackWebhook *CreateActionSlackWebhookArgs
+	Issue        *CreateActionIssueArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -197,6 +215,20 @@ type CreateActionSlackWebhookArgs struct {
 	URL            string
 }
 
+type CreateActionIssueArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	Tracker        string
+	TitleTemplate  string
+	BodyTemplate   string
+	Labels         []string
+	JiraURL        *string
+	JiraProjectKey *string
+	JiraIssueType  *string
+	JiraUsername   *string
+	JiraToken      *string
+}
+
 type ToggleCodeMonitorArgs struct {
 	Id      graphql.ID
 	Enabled bool
@@ -229,9 +261,10 @@ type TriggerTestSlackWebhookActionArgs struct {
 }
 
 type CreateMonitorArgs struct {
-	Namespace   graphql.ID
-	Description string
-	Enabled     bool
+	Namespace        graphql.ID
+	Description      string
+	Enabled          bool
+	DeliverySchedule *string
 }
 
 type EditActionEmailArgs struct {
@@ -249,10 +282,16 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionIssueArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionIssueArgs
+}
+
 type EditActionArgs struct {
 	Email        *EditActionEmailArgs
 	Webhook      *EditActionWebhookArgs
 	SlackWebhook *EditActionSlackWebhookArgs
+	Issue        *EditActionIssueArgs
 }
 
 type EditTriggerArgs struct {
@@ -50,6 +50,12 @@ type InsightsResolver interface {
 	RetryInsightSeriesBackfill(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToFrontOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
+
+	// Alerts
+	InsightSeriesAlertRules(ctx context.Context, args *InsightSeriesAlertRulesArgs) ([]InsightSeriesAlertRuleResolver, error)
+	CreateInsightSeriesAlertRule(ctx context.Context, args *CreateInsightSeriesAlertRuleArgs) (InsightSeriesAlertRuleResolver, error)
+	SetInsightSeriesAlertRuleEnabled(ctx context.Context, args *SetInsightSeriesAlertRuleEnabledArgs) (InsightSeriesAlertRuleResolver, error)
+	DeleteInsightSeriesAlertRule(ctx context.Context, args *DeleteInsightSeriesAlertRuleArgs) (*EmptyResponse, error)
 }
 
 type SearchInsightLivePreviewArgs struct {
@@ -239,6 +245,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	GeneratedFromCaptureGroups() (bool, error)
 	IsCalculated() (bool, error)
 	GroupBy() (*string, error)
+	GeneratedFromDependencyVersions() (bool, error)
 }
 
 type InsightPresentation interface {
@@ -422,13 +429,14 @@ type InsightViewFiltersInput struct {
 }
 
 type LineChartSearchInsightDataSeriesInput struct {
-	SeriesId                   *string
-	Query                      string
-	TimeScope                  *TimeScopeInput
-	RepositoryScope            *RepositoryScopeInput
-	Options                    LineChartDataSeriesOptionsInput
-	GeneratedFromCaptureGroups *bool
-	GroupBy                    *string
+	SeriesId                        *string
+	Query                           string
+	TimeScope                       *TimeScopeInput
+	RepositoryScope                 *RepositoryScopeInput
+	Options                         LineChartDataSeriesOptionsInput
+	GeneratedFromCaptureGroups      *bool
+	GroupBy                         *string
+	GeneratedFromDependencyVersions *bool
 }
 
 type LineChartDataSeriesOptionsInput struct {
@@ -484,6 +492,65 @@ type DeleteInsightViewArgs struct {
 	Id graphql.ID
 }
 
+type InsightSeriesAlertRulesArgs struct {
+	InsightViewId graphql.ID
+	SeriesId      string
+}
+
+type CreateInsightSeriesAlertRuleArgs struct {
+	Input CreateInsightSeriesAlertRuleInput
+}
+
+type CreateInsightSeriesAlertRuleInput struct {
+	InsightViewId   graphql.ID
+	SeriesId        string
+	Kind            string
+	Direction       *string
+	Threshold       float64
+	Intervals       *int32
+	Email           bool
+	SlackWebhookURL *string
+	WebhookURL      *string
+}
+
+type SetInsightSeriesAlertRuleEnabledArgs struct {
+	Id      graphql.ID
+	Enabled bool
+}
+
+type DeleteInsightSeriesAlertRuleArgs struct {
+	Id graphql.ID
+}
+
+type InsightSeriesAlertRuleResolver interface {
+	ID() graphql.ID
+	SeriesId() string
+	Kind() string
+	Direction() string
+	Threshold() float64
+	Intervals() int32
+	Email() bool
+	SlackWebhookURL() *string
+	WebhookURL() *string
+	Enabled() bool
+	CreatedAt() gqlutil.DateTime
+	LastTriggeredAt() *gqlutil.DateTime
+	History(ctx context.Context, args *InsightSeriesAlertHistoryArgs) ([]InsightSeriesAlertEventResolver, error)
+}
+
+type InsightSeriesAlertHistoryArgs struct {
+	First int32
+}
+
+type InsightSeriesAlertEventResolver interface {
+	PointTime() gqlutil.DateTime
+	Value() float64
+	Baseline() float64
+	Message() string
+	DeliveryError() *string
+	TriggeredAt() gqlutil.DateTime
+}
+
 type SearchInsightLivePreviewSeriesResolver interface {
 	Points(ctx context.Context) ([]InsightsDataPointResolver, error)
 	Label(ctx context.Context) (string, error)
@@ -79,6 +79,11 @@ func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, boo
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorIssue() (MonitorIssueResolver, bool) {
+	n, ok := r.Node.(MonitorIssueResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -141,6 +146,11 @@ func (r *NodeResolver) ToBatchChangesCredential() (BatchChangesCredentialResolve
 	return n, ok
 }
 
+func (r *NodeResolver) ToBatchChangesCommitSigningKey() (BatchChangesCommitSigningKeyResolver, bool) {
+	n, ok := r.Node.(BatchChangesCommitSigningKeyResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToProductLicense() (ProductLicense, bool) {
 	n, ok := r.Node.(ProductLicense)
 	return n, ok
@@ -221,6 +231,11 @@ func (r *NodeResolver) ToNotebook() (NotebookResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToNotebookRevision() (NotebookRevisionResolver, bool) {
+	n, ok := r.Node.(NotebookRevisionResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToSite() (*siteResolver, bool) {
 	n, ok := r.Node.(*siteResolver)
 	return n, ok
@@ -256,6 +271,11 @@ func (r *NodeResolver) ToOutOfBandMigration() (*outOfBandMigrationResolver, bool
 	return n, ok
 }
 
+func (r *NodeResolver) ToBatchSpecTemplate() (BatchSpecTemplateResolver, bool) {
+	n, ok := r.Node.(BatchSpecTemplateResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToBulkOperation() (BulkOperationResolver, bool) {
 	n, ok := r.Node.(BulkOperationResolver)
 	return n, ok
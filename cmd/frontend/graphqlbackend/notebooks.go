@@ -27,6 +27,9 @@ type NotebooksResolver interface {
 	CreateNotebookStar(ctx context.Context, args CreateNotebookStarInputArgs) (NotebookStarResolver, error)
 	DeleteNotebookStar(ctx context.Context, args DeleteNotebookStarInputArgs) (*EmptyResponse, error)
 
+	RestoreNotebookRevision(ctx context.Context, args RestoreNotebookRevisionArgs) (NotebookResolver, error)
+	ImportNotebook(ctx context.Context, args ImportNotebookInputArgs) (NotebookResolver, error)
+
 	NodeResolvers() map[string]NodeByIDFunc
 }
 
@@ -47,10 +50,29 @@ type NotebookStarConnectionResolver interface {
 	PageInfo() *graphqlutil.PageInfo
 }
 
+type NotebookRevisionResolver interface {
+	ID() graphql.ID
+	Notebook(ctx context.Context) (NotebookResolver, error)
+	Title() string
+	Blocks() []NotebookBlockResolver
+	Markdown() string
+	Author(ctx context.Context) (*UserResolver, error)
+	Diff(ctx context.Context) (string, error)
+	CreatedAt() gqlutil.DateTime
+}
+
+type NotebookRevisionConnectionResolver interface {
+	Nodes() []NotebookRevisionResolver
+	TotalCount() int32
+	PageInfo() *graphqlutil.PageInfo
+}
+
 type NotebookResolver interface {
 	ID() graphql.ID
 	Title(ctx context.Context) string
 	Blocks(ctx context.Context) []NotebookBlockResolver
+	RenderedMarkdown(ctx context.Context) (string, error)
+	Markdown(ctx context.Context) string
 	Creator(ctx context.Context) (*UserResolver, error)
 	Updater(ctx context.Context) (*UserResolver, error)
 	Namespace(ctx context.Context) (*NamespaceResolver, error)
@@ -60,6 +82,7 @@ type NotebookResolver interface {
 	ViewerCanManage(ctx context.Context) (bool, error)
 	ViewerHasStarred(ctx context.Context) (bool, error)
 	Stars(ctx context.Context, args ListNotebookStarsArgs) (NotebookStarConnectionResolver, error)
+	Revisions(ctx context.Context, args ListNotebookRevisionsArgs) (NotebookRevisionConnectionResolver, error)
 }
 
 type NotebookBlockResolver interface {
@@ -67,6 +90,9 @@ type NotebookBlockResolver interface {
 	ToQueryBlock() (QueryBlockResolver, bool)
 	ToFileBlock() (FileBlockResolver, bool)
 	ToSymbolBlock() (SymbolBlockResolver, bool)
+	ToReferencesBlock() (ReferencesBlockResolver, bool)
+	ToInsightBlock() (InsightBlockResolver, bool)
+	ToComputeBlock() (ComputeBlockResolver, bool)
 }
 
 type MarkdownBlockResolver interface {
@@ -106,6 +132,35 @@ type SymbolBlockInputResolver interface {
 	SymbolKind() string
 }
 
+type ReferencesBlockResolver interface {
+	ID() string
+	ReferencesInput() ReferencesBlockInputResolver
+}
+
+type ReferencesBlockInputResolver interface {
+	RepositoryName() string
+	FilePath() string
+	Revision() *string
+	Line() int32
+	Character() int32
+	SymbolName() string
+}
+
+type InsightBlockResolver interface {
+	ID() string
+	InsightInput() InsightBlockInputResolver
+}
+
+type InsightBlockInputResolver interface {
+	InsightViewId() graphql.ID
+	SeriesId() string
+}
+
+type ComputeBlockResolver interface {
+	ID() string
+	ComputeInput() string
+}
+
 type FileBlockLineRangeResolver interface {
 	StartLine() int32
 	EndLine() int32
@@ -118,6 +173,10 @@ const (
 	NotebookQueryBlockType    NotebookBlockType = "QUERY"
 	NotebookFileBlockType     NotebookBlockType = "FILE"
 	NotebookSymbolBlockType   NotebookBlockType = "SYMBOL"
+
+	NotebookReferencesBlockType NotebookBlockType = "REFERENCES"
+	NotebookInsightBlockType    NotebookBlockType = "INSIGHT"
+	NotebookComputeBlockType    NotebookBlockType = "COMPUTE"
 )
 
 type CreateNotebookInputArgs struct {
@@ -147,6 +206,10 @@ type CreateNotebookBlockInputArgs struct {
 	QueryInput    *string                 `json:"queryInput"`
 	FileInput     *CreateFileBlockInput   `json:"fileInput"`
 	SymbolInput   *CreateSymbolBlockInput `json:"symbolInput"`
+
+	ReferencesInput *CreateReferencesBlockInput `json:"referencesInput"`
+	InsightInput    *CreateInsightBlockInput    `json:"insightInput"`
+	ComputeInput    *string                     `json:"computeInput"`
 }
 
 type CreateFileBlockInput struct {
@@ -166,6 +229,20 @@ type CreateSymbolBlockInput struct {
 	SymbolKind          string  `json:"symbolKind"`
 }
 
+type CreateReferencesBlockInput struct {
+	RepositoryName string  `json:"repositoryName"`
+	FilePath       string  `json:"filePath"`
+	Revision       *string `json:"revision"`
+	Line           int32   `json:"line"`
+	Character      int32   `json:"character"`
+	SymbolName     string  `json:"symbolName"`
+}
+
+type CreateInsightBlockInput struct {
+	InsightViewId graphql.ID `json:"insightViewId"`
+	SeriesId      string     `json:"seriesId"`
+}
+
 type CreateFileBlockLineRangeInput struct {
 	StartLine int32 `json:"startLine"`
 	EndLine   int32 `json:"endLine"`
@@ -187,6 +264,26 @@ type ListNotebookStarsArgs struct {
 	After *string `json:"after"`
 }
 
+type ListNotebookRevisionsArgs struct {
+	First int32   `json:"first"`
+	After *string `json:"after"`
+}
+
+type RestoreNotebookRevisionArgs struct {
+	ID graphql.ID `json:"id"`
+}
+
+type ImportNotebookInputArgs struct {
+	Notebook ImportNotebookInput `json:"notebook"`
+}
+
+type ImportNotebookInput struct {
+	Title     string     `json:"title"`
+	Markdown  string     `json:"markdown"`
+	Namespace graphql.ID `json:"namespace"`
+	Public    bool       `json:"public"`
+}
+
 type CreateNotebookStarInputArgs struct {
 	NotebookID graphql.ID
 }
@@ -23,6 +23,7 @@ const (
 	AssignedOwner                    OwnershipReasonType = "ASSIGNED_OWNER"
 	RecentContributorOwnershipSignal OwnershipReasonType = "RECENT_CONTRIBUTOR_OWNERSHIP_SIGNAL"
 	RecentViewOwnershipSignal        OwnershipReasonType = "RECENT_VIEW_OWNERSHIP_SIGNAL"
+	RecentReviewerOwnershipSignal    OwnershipReasonType = "RECENT_REVIEWER_OWNERSHIP_SIGNAL"
 )
 
 func (args *ListOwnershipArgs) IncludeReason(reason OwnershipReasonType) bool {
@@ -110,6 +111,7 @@ type OwnershipReasonResolver interface {
 	ToCodeownersFileEntry() (CodeownersFileEntryResolver, bool)
 	ToRecentContributorOwnershipSignal() (RecentContributorOwnershipSignalResolver, bool)
 	ToRecentViewOwnershipSignal() (RecentViewOwnershipSignalResolver, bool)
+	ToRecentReviewerOwnershipSignal() (RecentReviewerOwnershipSignalResolver, bool)
 	ToAssignedOwner() (AssignedOwnerResolver, bool)
 }
 
@@ -135,6 +137,11 @@ type RecentViewOwnershipSignalResolver interface {
 	Description() (string, error)
 }
 
+type RecentReviewerOwnershipSignalResolver interface {
+	Title() (string, error)
+	Description() (string, error)
+}
+
 type AssignedOwnerResolver interface {
 	Title() (string, error)
 	Description() (string, error)
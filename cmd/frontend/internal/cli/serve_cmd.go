@@ -274,27 +274,33 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 		schema,
 		rateLimiter,
 		&httpapi.Handlers{
-			GitHubSyncWebhook:               enterprise.ReposGithubWebhook,
-			GitLabSyncWebhook:               enterprise.ReposGitLabWebhook,
-			BitbucketServerSyncWebhook:      enterprise.ReposBitbucketServerWebhook,
-			BitbucketCloudSyncWebhook:       enterprise.ReposBitbucketCloudWebhook,
-			PermissionsGitHubWebhook:        enterprise.PermissionsGitHubWebhook,
-			BatchesGitHubWebhook:            enterprise.BatchesGitHubWebhook,
-			BatchesGitLabWebhook:            enterprise.BatchesGitLabWebhook,
-			BatchesBitbucketServerWebhook:   enterprise.BatchesBitbucketServerWebhook,
-			BatchesBitbucketCloudWebhook:    enterprise.BatchesBitbucketCloudWebhook,
-			BatchesAzureDevOpsWebhook:       enterprise.BatchesAzureDevOpsWebhook,
-			BatchesChangesFileGetHandler:    enterprise.BatchesChangesFileGetHandler,
-			BatchesChangesFileExistsHandler: enterprise.BatchesChangesFileExistsHandler,
-			BatchesChangesFileUploadHandler: enterprise.BatchesChangesFileUploadHandler,
-			SCIMHandler:                     enterprise.SCIMHandler,
-			NewCodeIntelUploadHandler:       enterprise.NewCodeIntelUploadHandler,
-			NewComputeStreamHandler:         enterprise.NewComputeStreamHandler,
-			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
-			SearchJobsDataExportHandler:     enterprise.SearchJobsDataExportHandler,
-			NewDotcomLicenseCheckHandler:    enterprise.NewDotcomLicenseCheckHandler,
-			NewChatCompletionsStreamHandler: enterprise.NewChatCompletionsStreamHandler,
-			NewCodeCompletionsHandler:       enterprise.NewCodeCompletionsHandler,
+			GitHubSyncWebhook:                enterprise.ReposGithubWebhook,
+			GitLabSyncWebhook:                enterprise.ReposGitLabWebhook,
+			BitbucketServerSyncWebhook:       enterprise.ReposBitbucketServerWebhook,
+			BitbucketCloudSyncWebhook:        enterprise.ReposBitbucketCloudWebhook,
+			PermissionsGitHubWebhook:         enterprise.PermissionsGitHubWebhook,
+			BatchesGitHubWebhook:             enterprise.BatchesGitHubWebhook,
+			BatchesGitLabWebhook:             enterprise.BatchesGitLabWebhook,
+			BatchesBitbucketServerWebhook:    enterprise.BatchesBitbucketServerWebhook,
+			BatchesBitbucketCloudWebhook:     enterprise.BatchesBitbucketCloudWebhook,
+			BatchesAzureDevOpsWebhook:        enterprise.BatchesAzureDevOpsWebhook,
+			BatchesChangesFileGetHandler:     enterprise.BatchesChangesFileGetHandler,
+			BatchesChangesFileExistsHandler:  enterprise.BatchesChangesFileExistsHandler,
+			BatchesChangesFileUploadHandler:  enterprise.BatchesChangesFileUploadHandler,
+			BatchesImpactReportExportHandler: enterprise.BatchesImpactReportExportHandler,
+			SCIMHandler:                      enterprise.SCIMHandler,
+			NewCodeIntelUploadHandler:        enterprise.NewCodeIntelUploadHandler,
+			VulnerabilityImportHandler:       enterprise.VulnerabilityImportHandler,
+			SBOMExportHandler:                enterprise.SBOMExportHandler,
+			CodeIntelCoverageExportHandler:   enterprise.CodeIntelCoverageExportHandler,
+			NewComputeStreamHandler:          enterprise.NewComputeStreamHandler,
+			CodeInsightsDataExportHandler:    enterprise.CodeInsightsDataExportHandler,
+			CodeInsightsCSVExportHandler:     enterprise.CodeInsightsCSVExportHandler,
+			CodeInsightsMetricsHandler:       enterprise.CodeInsightsMetricsHandler,
+			SearchJobsDataExportHandler:      enterprise.SearchJobsDataExportHandler,
+			NewDotcomLicenseCheckHandler:     enterprise.NewDotcomLicenseCheckHandler,
+			NewChatCompletionsStreamHandler:  enterprise.NewChatCompletionsStreamHandler,
+			NewCodeCompletionsHandler:        enterprise.NewCodeCompletionsHandler,
 		},
 		enterprise.NewExecutorProxyHandler,
 		enterprise.NewGitHubAppSetupHandler,
@@ -53,26 +53,32 @@ type Handlers struct {
 	PermissionsGitHubWebhook webhooks.Registerer
 
 	// Batch changes
-	BatchesGitHubWebhook            webhooks.Registerer
-	BatchesGitLabWebhook            webhooks.RegistererHandler
-	BatchesBitbucketServerWebhook   webhooks.RegistererHandler
-	BatchesBitbucketCloudWebhook    webhooks.RegistererHandler
-	BatchesAzureDevOpsWebhook       webhooks.Registerer
-	BatchesChangesFileGetHandler    http.Handler
-	BatchesChangesFileExistsHandler http.Handler
-	BatchesChangesFileUploadHandler http.Handler
+	BatchesGitHubWebhook             webhooks.Registerer
+	BatchesGitLabWebhook             webhooks.RegistererHandler
+	BatchesBitbucketServerWebhook    webhooks.RegistererHandler
+	BatchesBitbucketCloudWebhook     webhooks.RegistererHandler
+	BatchesAzureDevOpsWebhook        webhooks.Registerer
+	BatchesChangesFileGetHandler     http.Handler
+	BatchesChangesFileExistsHandler  http.Handler
+	BatchesChangesFileUploadHandler  http.Handler
+	BatchesImpactReportExportHandler http.Handler
 
 	// SCIM
 	SCIMHandler http.Handler
 
 	// Code intel
-	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	NewCodeIntelUploadHandler      enterprise.NewCodeIntelUploadHandler
+	VulnerabilityImportHandler     http.Handler
+	SBOMExportHandler              http.Handler
+	CodeIntelCoverageExportHandler http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
 
 	// Code Insights
 	CodeInsightsDataExportHandler http.Handler
+	CodeInsightsCSVExportHandler  http.Handler
+	CodeInsightsMetricsHandler    http.Handler
 
 	// Search jobs
 	SearchJobsDataExportHandler http.Handler
@@ -153,14 +159,20 @@ func NewHandler(
 	m.Get(apirouter.BatchesFileGet).Handler(trace.Route(handlers.BatchesChangesFileGetHandler))
 	m.Get(apirouter.BatchesFileExists).Handler(trace.Route(handlers.BatchesChangesFileExistsHandler))
 	m.Get(apirouter.BatchesFileUpload).Handler(trace.Route(handlers.BatchesChangesFileUploadHandler))
+	m.Get(apirouter.BatchesImpactReportExport).Handler(trace.Route(handlers.BatchesImpactReportExportHandler))
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
+	m.Get(apirouter.CodeIntelVulnerabilityImport).Handler(trace.Route(handlers.VulnerabilityImportHandler))
+	m.Get(apirouter.CodeIntelSBOMExport).Handler(trace.Route(handlers.SBOMExportHandler))
+	m.Get(apirouter.CodeIntelCoverageExport).Handler(trace.Route(handlers.CodeIntelCoverageExportHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Get(apirouter.ChatCompletionsStream).Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Get(apirouter.CodeCompletions).Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
 
 	m.Get(apirouter.CodeInsightsDataExport).Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
+	m.Get(apirouter.CodeInsightsCSVExport).Handler(trace.Route(handlers.CodeInsightsCSVExportHandler))
+	m.Get(apirouter.CodeInsightsMetrics).Handler(trace.Route(handlers.CodeInsightsMetricsHandler))
 
 	if envvar.SourcegraphDotComMode() {
 		m.Path("/app/check/update").Name(codyapp.RouteAppUpdateCheck).Handler(trace.Route(codyapp.AppUpdateHandler(logger)))
@@ -41,7 +41,15 @@ const (
 	BatchesFileExists = "batches.file.exists"
 	BatchesFileUpload = "batches.file.upload"
 
+	BatchesImpactReportExport = "batches.impact-report.export"
+
 	CodeInsightsDataExport = "insights.data.export"
+	CodeInsightsCSVExport  = "insights.data.export.csv"
+	CodeInsightsMetrics    = "insights.metrics"
+
+	CodeIntelVulnerabilityImport = "codeintel.vulnerabilities.import"
+	CodeIntelSBOMExport          = "codeintel.sbom.export"
+	CodeIntelCoverageExport      = "codeintel.coverage.export"
 
 	GitInfoRefs         = "internal.git.info-refs"
 	GitUploadPack       = "internal.git.upload-pack"
@@ -74,9 +82,13 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("GET").Name(BatchesFileGet)
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("HEAD").Name(BatchesFileExists)
 	base.Path("/files/batch-changes/{spec}").Methods("POST").Name(BatchesFileUpload)
+	base.Path("/batches/impact-report/{spec}").Methods("GET").Name(BatchesImpactReportExport)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
+	base.Path("/vulnerabilities/import").Methods("POST").Name(CodeIntelVulnerabilityImport)
+	base.Path("/sbom").Methods("GET").Name(CodeIntelSBOMExport)
+	base.Path("/codeintel/coverage/export").Methods("GET").Name(CodeIntelCoverageExport)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
 	base.Path("/search/export/{id}").Methods("GET").Name(SearchJob)
 	base.Path("/compute/stream").Methods("GET", "POST").Name(ComputeStream)
@@ -84,6 +96,8 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/src-cli/versions/{rest:.*}").Methods("GET", "POST").Name(SrcCliVersionCache)
 	base.Path("/src-cli/{rest:.*}").Methods("GET").Name(SrcCli)
 	base.Path("/insights/export/{id}").Methods("GET").Name(CodeInsightsDataExport)
+	base.Path("/insights/export/{id}/csv").Methods("GET").Name(CodeInsightsCSVExport)
+	base.Path("/insights/metrics").Methods("GET").Name(CodeInsightsMetrics)
 	base.Path("/completions/stream").Methods("POST").Name(ChatCompletionsStream)
 	base.Path("/completions/code").Methods("POST").Name(CodeCompletions)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/server/sshagent"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -266,6 +267,16 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 	}
 	cmtHash := strings.TrimSpace(string(out))
 
+	if req.CommitInfo.SigningKey != nil {
+		signedHash, err := signCommit(ctx, req.CommitInfo.SigningKey, cmtHash, tmpRepoDir, append(os.Environ(), tmpGitPathEnv, altObjectsEnv))
+		if err != nil {
+			logger.Error("Failed to sign commit", log.String("commit", cmtHash), log.Error(err))
+			resp.SetError(repo, "", "", errors.Wrap(err, "gitserver: signing commit"))
+			return http.StatusInternalServerError, resp
+		}
+		cmtHash = signedHash
+	}
+
 	// Move objects from tmpObjectsDir to repoObjectsDir.
 	err = filepath.Walk(tmpObjectsDir, func(path string, info fs.FileInfo, err error) error {
 		if err != nil {
@@ -355,6 +366,40 @@ func (s *Server) createCommitFromPatch(ctx context.Context, req protocol.CreateC
 	return http.StatusOK, resp
 }
 
+// signCommit signs the given commit with the given key, writes the signed
+// commit to the object database of the repository in dir and returns its hash.
+// The signed commit has the same tree, parents and metadata as the original
+// one, which is left behind as an unreferenced object.
+func signCommit(ctx context.Context, key *protocol.CommitSigningKey, commit, dir string, env []string) (string, error) {
+	signer, err := commitsigning.NewSigner(key.Format, key.PrivateKey, key.Passphrase)
+	if err != nil {
+		return "", errors.Wrap(err, "loading signing key")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "commit", commit)
+	cmd.Dir = dir
+	cmd.Env = env
+	raw, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrap(err, "reading commit object")
+	}
+
+	signed, err := commitsigning.SignCommit(signer, raw)
+	if err != nil {
+		return "", err
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "hash-object", "-t", "commit", "-w", "--stdin")
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdin = bytes.NewReader(signed)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrap(err, "writing signed commit object")
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 // repoRemoteRefs returns a map containing ref + commit pairs from the
 // remote Git repository starting with the specified prefix.
 //
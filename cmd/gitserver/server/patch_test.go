@@ -0,0 +1,71 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+)
+
+func TestSignCommit(t *testing.T) {
+	dir := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, dir, name, arg...)
+	}
+	commit := strings.TrimSpace(makeSingleCommitRepo(cmd))
+
+	entity, err := openpgp.NewEntity("a", "", "a@a.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.SerializePrivate(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := signCommit(context.Background(), &protocol.CommitSigningKey{
+		Format:     commitsigning.FormatGPG,
+		PrivateKey: key.String(),
+	}, commit, dir, os.Environ())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed == commit {
+		t.Fatal("signed commit has the same hash as the original commit")
+	}
+
+	raw := cmd("git", "cat-file", "commit", signed)
+	if !strings.Contains(raw, "\ngpgsig -----BEGIN PGP SIGNATURE-----\n") {
+		t.Fatalf("signed commit has no signature:\n%s", raw)
+	}
+
+	// The signed commit must point to the same tree as the original one.
+	if have, want := cmd("git", "rev-parse", signed+"^{tree}"), cmd("git", "rev-parse", commit+"^{tree}"); have != want {
+		t.Fatalf("signed commit has tree %q, want %q", have, want)
+	}
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := signCommit(context.Background(), &protocol.CommitSigningKey{
+			Format:     commitsigning.FormatSSH,
+			PrivateKey: "not a key",
+		}, commit, dir, os.Environ())
+		if err == nil {
+			t.Fatal("expected error for invalid key")
+		}
+	})
+}
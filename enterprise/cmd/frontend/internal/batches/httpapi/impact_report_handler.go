@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	sglog "github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ImpactReportHandler serves the impact report of a batch spec as CSV.
+type ImpactReportHandler struct {
+	logger          sglog.Logger
+	store           *store.Store
+	gitserverClient gitserver.Client
+	operations      *Operations
+}
+
+// NewImpactReportHandler creates a new ImpactReportHandler.
+func NewImpactReportHandler(store *store.Store, gitserverClient gitserver.Client, operations *Operations) *ImpactReportHandler {
+	return &ImpactReportHandler{
+		logger:          sglog.Scoped("ImpactReportHandler", "Batch Changes impact report REST API handler"),
+		store:           store,
+		gitserverClient: gitserverClient,
+		operations:      operations,
+	}
+}
+
+// Export writes the impact report of the batch spec as a CSV attachment.
+func (h *ImpactReportHandler) Export() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		responseBody, filename, statusCode, err := h.export(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(statusCode)
+
+		if _, err := w.Write(responseBody.Bytes()); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *ImpactReportHandler) export(r *http.Request) (_ *bytes.Buffer, filename string, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.exportImpactReport.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.Int("statusCode", statusCode),
+		}})
+	}()
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.store.DatabaseDB()); err != nil {
+		return nil, "", http.StatusUnauthorized, err
+	}
+
+	specID := mux.Vars(r)["spec"]
+	if specID == "" {
+		return nil, "", http.StatusBadRequest, errors.New("spec ID not provided")
+	}
+
+	// The spec ID is usually the marshalled GraphQL ID, since the URL is
+	// generated by the API. Try to unmarshal it, else use the regular value.
+	var randID string
+	if err := relay.UnmarshalSpec(graphql.ID(specID), &randID); err != nil {
+		randID = specID
+	}
+
+	// Everyone can see a batch spec, if they have the rand ID. Repositories the
+	// user doesn't have access to are omitted from the report by the service.
+	spec, err := h.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{RandID: randID})
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, "", http.StatusNotFound, errors.New("batch spec does not exist")
+		}
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "retrieving batch spec")
+	}
+
+	svc := service.New(h.store)
+	report, err := svc.GenerateImpactReport(ctx, spec.ID, own.NewService(h.gitserverClient, h.store.DatabaseDB()))
+	if err != nil {
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "generating impact report")
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		return nil, "", http.StatusInternalServerError, errors.Wrap(err, "writing impact report")
+	}
+
+	return &buf, fmt.Sprintf("%s-impact-report.csv", spec.Spec.Name), http.StatusOK, nil
+}
@@ -11,6 +11,8 @@ type Operations struct {
 	get    *observation.Operation
 	exists *observation.Operation
 	upload *observation.Operation
+
+	exportImpactReport *observation.Operation
 }
 
 func NewOperations(observationCtx *observation.Context) *Operations {
@@ -33,5 +35,7 @@ func NewOperations(observationCtx *observation.Context) *Operations {
 		get:    op("get"),
 		exists: op("exists"),
 		upload: op("upload"),
+
+		exportImpactReport: op("exportImpactReport"),
 	}
 }
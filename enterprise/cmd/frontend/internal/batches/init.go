@@ -58,6 +58,7 @@ func Init(
 	enterpriseServices.BatchesChangesFileGetHandler = fileHandler.Get()
 	enterpriseServices.BatchesChangesFileExistsHandler = fileHandler.Exists()
 	enterpriseServices.BatchesChangesFileUploadHandler = fileHandler.Upload()
+	enterpriseServices.BatchesImpactReportExportHandler = httpapi.NewImpactReportHandler(bstore, gitserverClient, operations).Export()
 
 	return nil
 }
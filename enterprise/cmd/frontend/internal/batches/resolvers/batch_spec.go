@@ -18,6 +18,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/batches/search"
 	"github.com/sourcegraph/sourcegraph/internal/batches/service"
@@ -26,6 +27,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/own"
 	"github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -230,6 +232,78 @@ func (r *batchSpecResolver) DiffStat(ctx context.Context) (*graphqlbackend.DiffS
 	}), nil
 }
 
+func (r *batchSpecResolver) ImpactReport(ctx context.Context) (graphqlbackend.BatchSpecImpactReportResolver, error) {
+	state, err := r.computeState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state != btypes.BatchSpecStateCompleted {
+		return nil, nil
+	}
+
+	gitserverClient := r.gitserverClient
+	if gitserverClient == nil {
+		gitserverClient = gitserver.NewClient()
+	}
+
+	svc := service.New(r.store)
+	report, err := svc.GenerateImpactReport(ctx, r.batchSpec.ID, own.NewService(gitserverClient, r.store.DatabaseDB()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchSpecImpactReportResolver{
+		store:           r.store,
+		gitserverClient: gitserverClient,
+		report:          report,
+		csvURL:          batchSpecImpactReportURL(r),
+	}, nil
+}
+
+func (r *batchSpecResolver) ChangesetConflicts(ctx context.Context) ([]graphqlbackend.ChangesetConflictResolver, error) {
+	state, err := r.computeState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if state != btypes.BatchSpecStateCompleted {
+		return []graphqlbackend.ChangesetConflictResolver{}, nil
+	}
+
+	svc := service.New(r.store)
+	conflicts, err := svc.DetectChangesetConflicts(ctx, r.batchSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	repoIDs := make([]api.RepoID, 0, len(conflicts))
+	for _, c := range conflicts {
+		repoIDs = append(repoIDs, c.ChangesetSpec.BaseRepoID)
+	}
+	// 🚨 SECURITY: database.Repos.GetReposSetByIDs uses the authzFilter under
+	// the hood and filters out repositories that the user doesn't have access
+	// to.
+	reposByID, err := r.store.Repos().GetReposSetByIDs(ctx, repoIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.ChangesetConflictResolver, 0, len(conflicts))
+	for _, c := range conflicts {
+		repo, ok := reposByID[c.ChangesetSpec.BaseRepoID]
+		if !ok {
+			continue
+		}
+		resolvers = append(resolvers, &changesetConflictResolver{
+			store:           r.store,
+			gitserverClient: r.gitserverClient,
+			logger:          r.logger,
+			conflict:        c,
+			repo:            repo,
+		})
+	}
+	return resolvers, nil
+}
+
 func (r *batchSpecResolver) AppliesToBatchChange(ctx context.Context) (graphqlbackend.BatchChangeResolver, error) {
 	svc := service.New(r.store)
 	batchChange, err := svc.GetBatchChangeMatchingBatchSpec(ctx, r.batchSpec)
@@ -0,0 +1,136 @@
+package resolvers
+
+import (
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+var _ graphqlbackend.BatchSpecImpactReportResolver = &batchSpecImpactReportResolver{}
+
+type batchSpecImpactReportResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+
+	report *service.ImpactReport
+	csvURL string
+}
+
+func (r *batchSpecImpactReportResolver) Changesets() []graphqlbackend.ChangesetSpecImpactResolver {
+	resolvers := make([]graphqlbackend.ChangesetSpecImpactResolver, 0, len(r.report.Changesets))
+	for _, c := range r.report.Changesets {
+		resolvers = append(resolvers, &changesetSpecImpactResolver{
+			store:           r.store,
+			gitserverClient: r.gitserverClient,
+			impact:          c,
+		})
+	}
+	return resolvers
+}
+
+func (r *batchSpecImpactReportResolver) Owners() []graphqlbackend.BatchSpecImpactOwnerResolver {
+	resolvers := make([]graphqlbackend.BatchSpecImpactOwnerResolver, 0, len(r.report.Owners))
+	for _, o := range r.report.Owners {
+		resolvers = append(resolvers, &batchSpecImpactOwnerResolver{impact: o})
+	}
+	return resolvers
+}
+
+func (r *batchSpecImpactReportResolver) CsvURL() string {
+	return r.csvURL
+}
+
+var _ graphqlbackend.ChangesetSpecImpactResolver = &changesetSpecImpactResolver{}
+
+type changesetSpecImpactResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+
+	impact *service.ChangesetImpact
+}
+
+func (r *changesetSpecImpactResolver) ChangesetSpec() graphqlbackend.VisibleChangesetSpecResolver {
+	return NewChangesetSpecResolverWithRepo(r.store, r.impact.Repo, r.impact.ChangesetSpec)
+}
+
+func (r *changesetSpecImpactResolver) Repository() *graphqlbackend.RepositoryResolver {
+	return graphqlbackend.NewRepositoryResolver(r.store.DatabaseDB(), r.gitserverClient, r.impact.Repo)
+}
+
+func (r *changesetSpecImpactResolver) Files() []graphqlbackend.ChangesetSpecFileImpactResolver {
+	resolvers := make([]graphqlbackend.ChangesetSpecFileImpactResolver, 0, len(r.impact.Files))
+	for _, f := range r.impact.Files {
+		resolvers = append(resolvers, &changesetSpecFileImpactResolver{impact: f})
+	}
+	return resolvers
+}
+
+func (r *changesetSpecImpactResolver) DiffStat() *graphqlbackend.DiffStat {
+	return graphqlbackend.NewDiffStat(diff.Stat{Added: r.impact.LinesAdded, Deleted: r.impact.LinesDeleted})
+}
+
+func (r *changesetSpecImpactResolver) Languages() []string {
+	return r.impact.Languages
+}
+
+func (r *changesetSpecImpactResolver) Owners() []string {
+	return r.impact.Owners
+}
+
+var _ graphqlbackend.ChangesetSpecFileImpactResolver = &changesetSpecFileImpactResolver{}
+
+type changesetSpecFileImpactResolver struct {
+	impact *service.FileImpact
+}
+
+func (r *changesetSpecFileImpactResolver) Path() string {
+	return r.impact.Path
+}
+
+func (r *changesetSpecFileImpactResolver) DiffStat() *graphqlbackend.DiffStat {
+	return graphqlbackend.NewDiffStat(diff.Stat{Added: r.impact.LinesAdded, Deleted: r.impact.LinesDeleted})
+}
+
+func (r *changesetSpecFileImpactResolver) Language() *string {
+	if r.impact.Language == "" {
+		return nil
+	}
+	return &r.impact.Language
+}
+
+func (r *changesetSpecFileImpactResolver) Owners() []string {
+	return r.impact.Owners
+}
+
+var _ graphqlbackend.BatchSpecImpactOwnerResolver = &batchSpecImpactOwnerResolver{}
+
+type batchSpecImpactOwnerResolver struct {
+	impact *service.OwnerImpact
+}
+
+func (r *batchSpecImpactOwnerResolver) Owner() string {
+	return r.impact.Owner
+}
+
+func (r *batchSpecImpactOwnerResolver) ChangesetCount() int32 {
+	return int32(r.impact.Changesets)
+}
+
+func (r *batchSpecImpactOwnerResolver) FileCount() int32 {
+	return int32(r.impact.Files)
+}
+
+func (r *batchSpecImpactOwnerResolver) DiffStat() *graphqlbackend.DiffStat {
+	return graphqlbackend.NewDiffStat(diff.Stat{Added: r.impact.LinesAdded, Deleted: r.impact.LinesDeleted})
+}
+
+func (r *batchSpecImpactOwnerResolver) Repositories() []string {
+	repos := make([]string, 0, len(r.impact.Repos))
+	for _, repo := range r.impact.Repos {
+		repos = append(repos, string(repo))
+	}
+	return repos
+}
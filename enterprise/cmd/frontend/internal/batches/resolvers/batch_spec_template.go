@@ -0,0 +1,154 @@
+package resolvers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const batchSpecTemplateIDKind = "BatchSpecTemplate"
+
+func marshalBatchSpecTemplateID(id int64) graphql.ID {
+	return relay.MarshalID(batchSpecTemplateIDKind, id)
+}
+
+func unmarshalBatchSpecTemplateID(id graphql.ID) (batchSpecTemplateID int64, err error) {
+	err = relay.UnmarshalSpec(id, &batchSpecTemplateID)
+	return
+}
+
+var _ graphqlbackend.BatchSpecTemplateResolver = &batchSpecTemplateResolver{}
+
+type batchSpecTemplateResolver struct {
+	store *store.Store
+
+	template *btypes.BatchSpecTemplate
+
+	// Cache the namespace on the resolver, since it's accessed more than once.
+	namespaceOnce sync.Once
+	namespace     graphqlbackend.NamespaceResolver
+	namespaceErr  error
+
+	canAdministerOnce sync.Once
+	canAdminister     bool
+	canAdministerErr  error
+}
+
+func (r *batchSpecTemplateResolver) ID() graphql.ID {
+	return marshalBatchSpecTemplateID(r.template.ID)
+}
+
+func (r *batchSpecTemplateResolver) Name() string {
+	return r.template.Name
+}
+
+func (r *batchSpecTemplateResolver) Description() string {
+	return r.template.Description
+}
+
+func (r *batchSpecTemplateResolver) Spec() string {
+	return r.template.Spec
+}
+
+func (r *batchSpecTemplateResolver) Inputs() []graphqlbackend.BatchSpecTemplateInputResolver {
+	resolvers := make([]graphqlbackend.BatchSpecTemplateInputResolver, 0, len(r.template.Inputs))
+	for _, input := range r.template.Inputs {
+		resolvers = append(resolvers, &batchSpecTemplateInputResolver{input: input})
+	}
+	return resolvers
+}
+
+func (r *batchSpecTemplateResolver) Namespace(ctx context.Context) (graphqlbackend.NamespaceResolver, error) {
+	r.namespaceOnce.Do(func() {
+		if r.template.NamespaceUserID != 0 {
+			r.namespace.Namespace, r.namespaceErr = graphqlbackend.UserByIDInt32(
+				ctx,
+				r.store.DatabaseDB(),
+				r.template.NamespaceUserID,
+			)
+		} else {
+			r.namespace.Namespace, r.namespaceErr = graphqlbackend.OrgByIDInt32(
+				ctx,
+				r.store.DatabaseDB(),
+				r.template.NamespaceOrgID,
+			)
+		}
+		if errcode.IsNotFound(r.namespaceErr) {
+			r.namespace.Namespace = nil
+			r.namespaceErr = errors.New("namespace of batch spec template has been deleted")
+		}
+	})
+
+	return r.namespace, r.namespaceErr
+}
+
+func (r *batchSpecTemplateResolver) Creator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	if r.template.CreatorID == 0 {
+		return nil, nil
+	}
+
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.template.CreatorID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *batchSpecTemplateResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.template.CreatedAt}
+}
+
+func (r *batchSpecTemplateResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.template.UpdatedAt}
+}
+
+func (r *batchSpecTemplateResolver) ViewerCanAdminister(ctx context.Context) (bool, error) {
+	r.canAdministerOnce.Do(func() {
+		svc := service.New(r.store)
+		r.canAdminister, r.canAdministerErr = svc.CheckViewerCanAdminister(ctx, r.template.NamespaceUserID, r.template.NamespaceOrgID)
+	})
+	return r.canAdminister, r.canAdministerErr
+}
+
+var _ graphqlbackend.BatchSpecTemplateInputResolver = &batchSpecTemplateInputResolver{}
+
+type batchSpecTemplateInputResolver struct {
+	input btypes.BatchSpecTemplateInput
+}
+
+func (r *batchSpecTemplateInputResolver) Name() string {
+	return r.input.Name
+}
+
+func (r *batchSpecTemplateInputResolver) Description() string {
+	return r.input.Description
+}
+
+func (r *batchSpecTemplateInputResolver) Type() string {
+	return string(r.input.Type)
+}
+
+func (r *batchSpecTemplateInputResolver) Required() bool {
+	return r.input.Required
+}
+
+func (r *batchSpecTemplateInputResolver) Default() *string {
+	return r.input.Default
+}
+
+func (r *batchSpecTemplateInputResolver) Options() []string {
+	if r.input.Options == nil {
+		return []string{}
+	}
+	return r.input.Options
+}
@@ -128,6 +128,8 @@ func changesetJobTypeToBulkOperationType(t btypes.ChangesetJobType) (string, err
 		return "CLOSE", nil
 	case btypes.ChangesetJobTypePublish:
 		return "PUBLISH", nil
+	case btypes.ChangesetJobTypeUpdateMetadata:
+		return "UPDATE_METADATA", nil
 	default:
 		return "", errors.Errorf("invalid job type %q", t)
 	}
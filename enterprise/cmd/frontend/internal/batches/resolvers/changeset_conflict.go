@@ -0,0 +1,83 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+var _ graphqlbackend.ChangesetConflictResolver = &changesetConflictResolver{}
+
+type changesetConflictResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+	logger          log.Logger
+
+	conflict *service.ChangesetConflict
+	repo     *types.Repo
+}
+
+func (r *changesetConflictResolver) ChangesetSpec() graphqlbackend.VisibleChangesetSpecResolver {
+	return NewChangesetSpecResolverWithRepo(r.store, r.repo, r.conflict.ChangesetSpec)
+}
+
+func (r *changesetConflictResolver) ConflictingChangeset() graphqlbackend.ChangesetResolver {
+	return NewChangesetResolver(r.store, r.gitserverClient, r.logger, r.conflict.Changeset, r.repo)
+}
+
+func (r *changesetConflictResolver) ConflictingBatchChange(ctx context.Context) (graphqlbackend.BatchChangeResolver, error) {
+	batchChange, err := r.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: r.conflict.Changeset.OwnedByBatchChangeID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &batchChangeResolver{store: r.store, gitserverClient: r.gitserverClient, batchChange: batchChange, logger: r.logger}, nil
+}
+
+func (r *changesetConflictResolver) Files() []graphqlbackend.ChangesetConflictFileResolver {
+	resolvers := make([]graphqlbackend.ChangesetConflictFileResolver, 0, len(r.conflict.Files))
+	for _, f := range r.conflict.Files {
+		resolvers = append(resolvers, &changesetConflictFileResolver{file: f})
+	}
+	return resolvers
+}
+
+var _ graphqlbackend.ChangesetConflictFileResolver = &changesetConflictFileResolver{}
+
+type changesetConflictFileResolver struct {
+	file *service.ConflictingFile
+}
+
+func (r *changesetConflictFileResolver) Path() string {
+	return r.file.Path
+}
+
+func (r *changesetConflictFileResolver) Hunks() []graphqlbackend.ChangesetConflictHunkResolver {
+	resolvers := make([]graphqlbackend.ChangesetConflictHunkResolver, 0, len(r.file.Hunks))
+	for _, h := range r.file.Hunks {
+		resolvers = append(resolvers, &changesetConflictHunkResolver{hunk: h})
+	}
+	return resolvers
+}
+
+var _ graphqlbackend.ChangesetConflictHunkResolver = &changesetConflictHunkResolver{}
+
+type changesetConflictHunkResolver struct {
+	hunk service.LineRange
+}
+
+func (r *changesetConflictHunkResolver) StartLine() int32 {
+	return r.hunk.StartLine
+}
+
+func (r *changesetConflictHunkResolver) EndLine() int32 {
+	return r.hunk.EndLine
+}
@@ -22,6 +22,10 @@ type batchChangesCodeHostResolver struct {
 	codeHost   *btypes.CodeHost
 	credential graphqlbackend.BatchChangesCredentialResolver
 	logger     log.Logger
+
+	// userID is the user the code host is listed for, if any. It is used to
+	// prefer the user's commit signing key over the site-wide key.
+	userID *int32
 }
 
 var _ graphqlbackend.BatchChangesCodeHostResolver = &batchChangesCodeHostResolver{}
@@ -57,9 +61,41 @@ func (c *batchChangesCodeHostResolver) CommitSigningConfiguration(ctx context.Co
 			logger:    c.logger,
 		}, nil
 	}
+
+	if btypes.SupportsGitserverCommitSigning(c.codeHost.ExternalServiceType) {
+		key, err := c.commitSigningKey(ctx)
+		if err != nil || key == nil {
+			return nil, err
+		}
+		return &commitSigningConfigResolver{
+			db:         c.db,
+			store:      c.store,
+			signingKey: key,
+			logger:     c.logger,
+		}, nil
+	}
 	return nil, nil
 }
 
+// commitSigningKey returns the key used to sign commits pushed to this code
+// host: the user's own key if they have one, and the site-wide key otherwise.
+func (c *batchChangesCodeHostResolver) commitSigningKey(ctx context.Context) (*btypes.CommitSigningKey, error) {
+	if c.userID != nil {
+		key, err := c.store.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{UserID: *c.userID})
+		if err == nil {
+			return key, nil
+		} else if err != store.ErrNoResults {
+			return nil, err
+		}
+	}
+
+	key, err := c.store.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{})
+	if err == store.ErrNoResults {
+		return nil, nil
+	}
+	return key, err
+}
+
 func (c *batchChangesCodeHostResolver) RequiresSSH() bool {
 	return c.codeHost.RequiresSSH
 }
@@ -74,7 +110,8 @@ func (c *batchChangesCodeHostResolver) RequiresUsername() bool {
 }
 
 func (c *batchChangesCodeHostResolver) SupportsCommitSigning() bool {
-	return c.codeHost.ExternalServiceType == extsvc.TypeGitHub
+	return c.codeHost.ExternalServiceType == extsvc.TypeGitHub ||
+		btypes.SupportsGitserverCommitSigning(c.codeHost.ExternalServiceType)
 }
 
 func (c *batchChangesCodeHostResolver) HasWebhooks() bool {
@@ -84,9 +121,11 @@ func (c *batchChangesCodeHostResolver) HasWebhooks() bool {
 var _ graphqlbackend.CommitSigningConfigResolver = &commitSigningConfigResolver{}
 
 type commitSigningConfigResolver struct {
-	logger    log.Logger
-	db        database.DB
-	githubApp *ghtypes.GitHubApp
+	logger     log.Logger
+	db         database.DB
+	store      *store.Store
+	githubApp  *ghtypes.GitHubApp
+	signingKey *btypes.CommitSigningKey
 }
 
 func (c *commitSigningConfigResolver) ToGitHubApp() (graphqlbackend.GitHubAppResolver, bool) {
@@ -96,3 +135,11 @@ func (c *commitSigningConfigResolver) ToGitHubApp() (graphqlbackend.GitHubAppRes
 
 	return nil, false
 }
+
+func (c *commitSigningConfigResolver) ToBatchChangesCommitSigningKey() (graphqlbackend.BatchChangesCommitSigningKeyResolver, bool) {
+	if c.signingKey != nil {
+		return &batchChangesCommitSigningKeyResolver{store: c.store, key: c.signingKey}, true
+	}
+
+	return nil, false
+}
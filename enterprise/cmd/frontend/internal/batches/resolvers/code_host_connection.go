@@ -67,7 +67,7 @@ func (c *batchChangesCodeHostConnectionResolver) Nodes(ctx context.Context) ([]g
 			externalServiceType: ch.ExternalServiceType,
 		}
 		cred := credsByIDType[t]
-		nodes[i] = &batchChangesCodeHostResolver{codeHost: ch, credential: cred, store: c.store, db: c.db, logger: c.logger}
+		nodes[i] = &batchChangesCodeHostResolver{codeHost: ch, credential: cred, store: c.store, db: c.db, logger: c.logger, userID: c.userID}
 	}
 
 	return nodes, nil
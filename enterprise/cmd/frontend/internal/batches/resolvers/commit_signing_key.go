@@ -0,0 +1,69 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+const batchChangesCommitSigningKeyIDKind = "BatchChangesCommitSigningKey"
+
+func marshalBatchChangesCommitSigningKeyID(id int64) graphql.ID {
+	return relay.MarshalID(batchChangesCommitSigningKeyIDKind, id)
+}
+
+func unmarshalBatchChangesCommitSigningKeyID(id graphql.ID) (keyID int64, err error) {
+	err = relay.UnmarshalSpec(id, &keyID)
+	return
+}
+
+var _ graphqlbackend.BatchChangesCommitSigningKeyResolver = &batchChangesCommitSigningKeyResolver{}
+
+type batchChangesCommitSigningKeyResolver struct {
+	store *store.Store
+	key   *btypes.CommitSigningKey
+}
+
+func (r *batchChangesCommitSigningKeyResolver) ID() graphql.ID {
+	return marshalBatchChangesCommitSigningKeyID(r.key.ID)
+}
+
+func (r *batchChangesCommitSigningKeyResolver) Format() string {
+	return strings.ToUpper(string(r.key.Format))
+}
+
+func (r *batchChangesCommitSigningKeyResolver) PublicKey() string {
+	return r.key.PublicKey
+}
+
+func (r *batchChangesCommitSigningKeyResolver) Fingerprint() string {
+	return r.key.Fingerprint
+}
+
+func (r *batchChangesCommitSigningKeyResolver) User(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	if r.key.IsSiteKey() {
+		return nil, nil
+	}
+
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.key.UserID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *batchChangesCommitSigningKeyResolver) IsSiteKey() bool {
+	return r.key.IsSiteKey()
+}
+
+func (r *batchChangesCommitSigningKeyResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.key.CreatedAt}
+}
@@ -96,6 +96,16 @@ func (e ErrMatchingBatchChangeExists) Extensions() map[string]any {
 	return map[string]any{"code": "ErrMatchingBatchChangeExists"}
 }
 
+type ErrConflictingChangesets struct{}
+
+func (e ErrConflictingChangesets) Error() string {
+	return "changesets that would be published conflict with open changesets of other batch changes"
+}
+
+func (e ErrConflictingChangesets) Extensions() map[string]any {
+	return map[string]any{"code": "ErrConflictingChangesets"}
+}
+
 type ErrDuplicateCredential struct{}
 
 func (e ErrDuplicateCredential) Error() string {
@@ -106,6 +116,28 @@ func (e ErrDuplicateCredential) Extensions() map[string]any {
 	return map[string]any{"code": "ErrDuplicateCredential"}
 }
 
+type ErrDuplicateCommitSigningKey struct{}
+
+func (e ErrDuplicateCommitSigningKey) Error() string {
+	return "a commit signing key is already configured"
+}
+
+func (e ErrDuplicateCommitSigningKey) Extensions() map[string]any {
+	return map[string]any{"code": "ErrDuplicateCommitSigningKey"}
+}
+
+type ErrInvalidCommitSigningKey struct {
+	SourceErr error
+}
+
+func (e ErrInvalidCommitSigningKey) Error() string {
+	return fmt.Sprintf("Invalid commit signing key: %s", e.SourceErr)
+}
+
+func (e ErrInvalidCommitSigningKey) Extensions() map[string]any {
+	return map[string]any{"code": "ErrInvalidCommitSigningKey"}
+}
+
 type ErrVerifyCredentialFailed struct {
 	SourceErr error
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
@@ -31,6 +32,7 @@ import (
 	extsvcauth "github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
 	"github.com/sourcegraph/sourcegraph/internal/licensing"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/usagestats"
@@ -125,6 +127,12 @@ func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 		workspaceFileIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
 			return r.batchSpecWorkspaceFileByID(ctx, id)
 		},
+		batchSpecTemplateIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.batchSpecTemplateByID(ctx, id)
+		},
+		batchChangesCommitSigningKeyIDKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.batchChangesCommitSigningKeyByID(ctx, id)
+		},
 	}
 }
 
@@ -299,6 +307,39 @@ type batchChangesCredentialResolver interface {
 	authenticator(ctx context.Context) (extsvcauth.Authenticator, error)
 }
 
+func (r *Resolver) batchChangesCommitSigningKeyByID(ctx context.Context, id graphql.ID) (graphqlbackend.BatchChangesCommitSigningKeyResolver, error) {
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	dbID, err := unmarshalBatchChangesCommitSigningKeyID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	key, err := r.store.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{ID: dbID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The site-wide key is used for the commits of all users, so everyone may
+	// see its public half. User keys are only visible to their owner.
+	if !key.IsSiteKey() {
+		if err := auth.CheckSiteAdminOrSameUser(ctx, r.store.DatabaseDB(), key.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &batchChangesCommitSigningKeyResolver{store: r.store, key: key}, nil
+}
+
 func (r *Resolver) batchChangesCredentialByID(ctx context.Context, id graphql.ID) (batchChangesCredentialResolver, error) {
 	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
 		return nil, err
@@ -381,6 +422,41 @@ func (r *Resolver) bulkOperationByIDString(ctx context.Context, id string) (grap
 	return &bulkOperationResolver{store: r.store, gitserverClient: r.gitserverClient, bulkOperation: bulkOperation, logger: r.logger}, nil
 }
 
+func (r *Resolver) batchSpecTemplateByID(ctx context.Context, id graphql.ID) (graphqlbackend.BatchSpecTemplateResolver, error) {
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	templateID, err := unmarshalBatchSpecTemplateID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	tmpl, err := r.store.GetBatchSpecTemplate(ctx, store.GetBatchSpecTemplateOpts{ID: templateID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Templates are only visible to users with access to their
+	// namespace.
+	svc := service.New(r.store)
+	if err := svc.CheckNamespaceAccess(ctx, tmpl.NamespaceUserID, tmpl.NamespaceOrgID); err != nil {
+		if err == auth.ErrNotAnOrgMember || errcode.IsUnauthorized(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchSpecTemplateResolver{store: r.store, template: tmpl}, nil
+}
+
 func (r *Resolver) batchSpecWorkspaceByID(ctx context.Context, gqlID graphql.ID) (graphqlbackend.BatchSpecWorkspaceResolver, error) {
 	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
 		return nil, err
@@ -429,9 +505,10 @@ func (r *Resolver) CreateBatchChange(ctx context.Context, args *graphqlbackend.C
 		FailIfBatchChangeExists: true,
 	}
 	batchChange, err := r.applyOrCreateBatchChange(ctx, &graphqlbackend.ApplyBatchChangeArgs{
-		BatchSpec:         args.BatchSpec,
-		EnsureBatchChange: nil,
-		PublicationStates: args.PublicationStates,
+		BatchSpec:                  args.BatchSpec,
+		EnsureBatchChange:          nil,
+		PublicationStates:          args.PublicationStates,
+		BlockConflictingChangesets: args.BlockConflictingChangesets,
 	}, opts)
 	if err != nil {
 		return nil, err
@@ -530,6 +607,7 @@ func (r *Resolver) applyOrCreateBatchChange(ctx context.Context, args *graphqlba
 	if err := addPublicationStatesToOptions(args.PublicationStates, &opts.PublicationStates); err != nil {
 		return nil, err
 	}
+	opts.BlockConflictingChangesets = args.BlockConflictingChangesets
 
 	svc := service.New(r.store)
 	// 🚨 SECURITY: ApplyBatchChange checks whether the user has permission to
@@ -542,6 +620,8 @@ func (r *Resolver) applyOrCreateBatchChange(ctx context.Context, args *graphqlba
 			return nil, ErrApplyClosedBatchChange{}
 		} else if err == service.ErrMatchingBatchChangeExists {
 			return nil, ErrMatchingBatchChangeExists{}
+		} else if err == service.ErrConflictingChangesets {
+			return nil, ErrConflictingChangesets{}
 		}
 		return nil, err
 	}
@@ -1314,6 +1394,102 @@ func (r *Resolver) deleteBatchChangesSiteCredential(ctx context.Context, credent
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) CreateBatchChangesCommitSigningKey(ctx context.Context, args *graphqlbackend.CreateBatchChangesCommitSigningKeyArgs) (_ graphqlbackend.BatchChangesCommitSigningKeyResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.CreateBatchChangesCommitSigningKey", attribute.String("format", args.Format))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	var userID int32
+	if args.User != nil {
+		userID, err = graphqlbackend.UnmarshalUserID(*args.User)
+		if err != nil {
+			return nil, err
+		}
+
+		if userID == 0 {
+			return nil, ErrIDIsZero{}
+		}
+
+		// 🚨 SECURITY: Check that the requesting user can create the key.
+		if err := auth.CheckSiteAdminOrSameUser(ctx, r.store.DatabaseDB(), userID); err != nil {
+			return nil, err
+		}
+	} else {
+		// 🚨 SECURITY: Check that the site-wide key can only be created by a
+		// site-admin.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.store.DatabaseDB()); err != nil {
+			return nil, err
+		}
+	}
+
+	format := commitsigning.Format(strings.ToLower(args.Format))
+	if !format.Valid() {
+		return nil, errors.Errorf("invalid commit signing key format %q", args.Format)
+	}
+
+	if args.PrivateKey == "" {
+		return nil, errors.New("empty private key not allowed")
+	}
+
+	var passphrase string
+	if args.Passphrase != nil {
+		passphrase = *args.Passphrase
+	}
+
+	key := &btypes.CommitSigningKey{UserID: userID}
+	if err := key.SetPrivateKey(format, args.PrivateKey, passphrase); err != nil {
+		return nil, &ErrInvalidCommitSigningKey{SourceErr: err}
+	}
+
+	if err := r.store.CreateCommitSigningKey(ctx, key); err != nil {
+		if err == store.ErrDuplicateCommitSigningKey {
+			return nil, ErrDuplicateCommitSigningKey{}
+		}
+		return nil, err
+	}
+
+	return &batchChangesCommitSigningKeyResolver{store: r.store, key: key}, nil
+}
+
+func (r *Resolver) DeleteBatchChangesCommitSigningKey(ctx context.Context, args *graphqlbackend.DeleteBatchChangesCommitSigningKeyArgs) (_ *graphqlbackend.EmptyResponse, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.DeleteBatchChangesCommitSigningKey", attribute.String("key", string(args.BatchChangesCommitSigningKey)))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	dbID, err := unmarshalBatchChangesCommitSigningKeyID(args.BatchChangesCommitSigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	key, err := r.store.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{ID: dbID})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Check that the requesting user may delete the key.
+	if key.IsSiteKey() {
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.store.DatabaseDB()); err != nil {
+			return nil, err
+		}
+	} else if err := auth.CheckSiteAdminOrSameUser(ctx, r.store.DatabaseDB(), key.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := r.store.DeleteCommitSigningKey(ctx, dbID); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func (r *Resolver) DetachChangesets(ctx context.Context, args *graphqlbackend.DetachChangesetsArgs) (_ graphqlbackend.BulkOperationResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.DetachChangesets",
 		attribute.String("batchChange", string(args.BatchChange)),
@@ -1553,6 +1729,51 @@ func (r *Resolver) PublishChangesets(ctx context.Context, args *graphqlbackend.P
 	return r.bulkOperationByIDString(ctx, bulkGroupID)
 }
 
+func (r *Resolver) UpdateChangesetsMetadata(ctx context.Context, args *graphqlbackend.UpdateChangesetsMetadataArgs) (_ graphqlbackend.BulkOperationResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.UpdateChangesetsMetadata",
+		attribute.String("batchChange", string(args.BatchChange)),
+		attribute.Int("changesets.len", len(args.Changesets)))
+	defer tr.EndWithErr(&err)
+	if err := enterprise.BatchChangesEnabledForUser(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	if args.Title == nil && args.Body == nil && args.Branch == nil {
+		return nil, errors.New("at least one of title, body or branch must be provided")
+	}
+
+	batchChangeID, changesetIDs, err := unmarshalBulkOperationBaseArgs(args.BulkOperationBaseArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: CreateChangesetJobs checks whether current user is authorized.
+	svc := service.New(r.store)
+	bulkGroupID, err := svc.CreateChangesetJobs(
+		ctx,
+		batchChangeID,
+		changesetIDs,
+		btypes.ChangesetJobTypeUpdateMetadata,
+		&btypes.ChangesetJobUpdateMetadataPayload{
+			Title:  args.Title,
+			Body:   args.Body,
+			Branch: args.Branch,
+		},
+		store.ListChangesetsOpts{
+			OwnedByBatchChangeID: batchChangeID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.bulkOperationByIDString(ctx, bulkGroupID)
+}
+
 func (r *Resolver) BatchSpecs(ctx context.Context, args *graphqlbackend.ListBatchSpecArgs) (_ graphqlbackend.BatchSpecConnectionResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.BatchSpecs",
 		attribute.Int("first", int(args.First)),
@@ -1600,6 +1821,54 @@ func (r *Resolver) BatchSpecs(ctx context.Context, args *graphqlbackend.ListBatc
 	return &batchSpecConnectionResolver{store: r.store, logger: r.logger, opts: opts}, nil
 }
 
+func (r *Resolver) BatchSpecTemplates(ctx context.Context, args *graphqlbackend.ListBatchSpecTemplatesArgs) (_ graphqlbackend.BatchSpecTemplateConnectionResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.BatchSpecTemplates",
+		attribute.Int("first", int(args.First)),
+		attribute.String("after", fmt.Sprintf("%v", args.After)))
+	defer tr.EndWithErr(&err)
+
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := validateFirstParamDefaults(args.First); err != nil {
+		return nil, err
+	}
+
+	opts := store.ListBatchSpecTemplatesOpts{
+		LimitOpts: store.LimitOpts{
+			Limit: int(args.First),
+		},
+	}
+
+	if args.Namespace != nil {
+		if err := graphqlbackend.UnmarshalNamespaceID(*args.Namespace, &opts.NamespaceUserID, &opts.NamespaceOrgID); err != nil {
+			return nil, err
+		}
+
+		// 🚨 SECURITY: Templates are only visible to users with access to
+		// their namespace.
+		svc := service.New(r.store)
+		if err := svc.CheckNamespaceAccess(ctx, opts.NamespaceUserID, opts.NamespaceOrgID); err != nil {
+			return nil, err
+		}
+	} else if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.store.DatabaseDB()); err != nil {
+		// 🚨 SECURITY: If the user is not an admin, only include templates in
+		// namespaces the user has access to.
+		opts.OnlyAccessibleByUserID = sgactor.FromContext(ctx).UID
+	}
+
+	if args.After != nil {
+		id, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cursor = int64(id)
+	}
+
+	return &batchSpecTemplateConnectionResolver{store: r.store, opts: opts}, nil
+}
+
 func (r *Resolver) CreateEmptyBatchChange(ctx context.Context, args *graphqlbackend.CreateEmptyBatchChangeArgs) (_ graphqlbackend.BatchChangeResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.CreateEmptyBatchChange",
 		attribute.String("namespace", string(args.Namespace)))
@@ -1709,6 +1978,181 @@ func (r *Resolver) CreateBatchSpecFromRaw(ctx context.Context, args *graphqlback
 	return &batchSpecResolver{store: r.store, logger: r.logger, batchSpec: batchSpec}, nil
 }
 
+func (r *Resolver) CreateBatchSpecTemplate(ctx context.Context, args *graphqlbackend.CreateBatchSpecTemplateArgs) (_ graphqlbackend.BatchSpecTemplateResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.CreateBatchSpecTemplate",
+		attribute.String("namespace", string(args.Namespace)))
+	defer tr.EndWithErr(&err)
+
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	var uid, oid int32
+	if err := graphqlbackend.UnmarshalNamespaceID(args.Namespace, &uid, &oid); err != nil {
+		return nil, err
+	}
+
+	svc := service.New(r.store)
+	tmpl, err := svc.CreateBatchSpecTemplate(ctx, service.CreateBatchSpecTemplateOpts{
+		NamespaceUserID: uid,
+		NamespaceOrgID:  oid,
+		Name:            args.Name,
+		Description:     args.Description,
+		Spec:            args.Spec,
+		Inputs:          unmarshalBatchSpecTemplateInputs(args.Inputs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchSpecTemplateResolver{store: r.store, template: tmpl}, nil
+}
+
+func (r *Resolver) UpdateBatchSpecTemplate(ctx context.Context, args *graphqlbackend.UpdateBatchSpecTemplateArgs) (_ graphqlbackend.BatchSpecTemplateResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.UpdateBatchSpecTemplate",
+		attribute.String("batchSpecTemplate", string(args.BatchSpecTemplate)))
+	defer tr.EndWithErr(&err)
+
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalBatchSpecTemplateID(args.BatchSpecTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	tmpl, err := svc.UpdateBatchSpecTemplate(ctx, service.UpdateBatchSpecTemplateOpts{
+		ID:          id,
+		Name:        args.Name,
+		Description: args.Description,
+		Spec:        args.Spec,
+		Inputs:      unmarshalBatchSpecTemplateInputs(args.Inputs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchSpecTemplateResolver{store: r.store, template: tmpl}, nil
+}
+
+func (r *Resolver) DeleteBatchSpecTemplate(ctx context.Context, args *graphqlbackend.DeleteBatchSpecTemplateArgs) (_ *graphqlbackend.EmptyResponse, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.DeleteBatchSpecTemplate",
+		attribute.String("batchSpecTemplate", string(args.BatchSpecTemplate)))
+	defer tr.EndWithErr(&err)
+
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalBatchSpecTemplateID(args.BatchSpecTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	svc := service.New(r.store)
+	if err := svc.DeleteBatchSpecTemplate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) CreateBatchSpecFromTemplate(ctx context.Context, args *graphqlbackend.CreateBatchSpecFromTemplateArgs) (_ graphqlbackend.BatchSpecResolver, err error) {
+	tr, ctx := trace.New(ctx, "Resolver.CreateBatchSpecFromTemplate",
+		attribute.String("batchSpecTemplate", string(args.BatchSpecTemplate)),
+		attribute.String("namespace", string(args.Namespace)))
+	defer tr.EndWithErr(&err)
+
+	if err := batchChangesCreateAccess(ctx, r.store.DatabaseDB()); err != nil {
+		return nil, err
+	}
+
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.store.DatabaseDB(), rbac.BatchChangesWritePermission); err != nil {
+		return nil, err
+	}
+
+	templateID, err := unmarshalBatchSpecTemplateID(args.BatchSpecTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateID == 0 {
+		return nil, ErrIDIsZero{}
+	}
+
+	var uid, oid int32
+	if err := graphqlbackend.UnmarshalNamespaceID(args.Namespace, &uid, &oid); err != nil {
+		return nil, err
+	}
+
+	bid, err := unmarshalBatchChangeID(args.BatchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[string]string, len(args.Inputs))
+	for _, input := range args.Inputs {
+		if _, ok := inputs[input.Name]; ok {
+			return nil, errors.Newf("duplicate value for input %q", input.Name)
+		}
+		inputs[input.Name] = input.Value
+	}
+
+	svc := service.New(r.store)
+	batchSpec, err := svc.CreateBatchSpecFromTemplate(ctx, service.CreateBatchSpecFromTemplateOpts{
+		BatchSpecTemplateID: templateID,
+		Inputs:              inputs,
+		NamespaceUserID:     uid,
+		NamespaceOrgID:      oid,
+		BatchChange:         bid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchSpecResolver{store: r.store, logger: r.logger, batchSpec: batchSpec}, nil
+}
+
+func unmarshalBatchSpecTemplateInputs(defs []graphqlbackend.BatchSpecTemplateInputDefinition) []btypes.BatchSpecTemplateInput {
+	inputs := make([]btypes.BatchSpecTemplateInput, 0, len(defs))
+	for _, def := range defs {
+		input := btypes.BatchSpecTemplateInput{
+			Name:        def.Name,
+			Description: def.Description,
+			Type:        btypes.BatchSpecTemplateInputType(def.Type),
+			Required:    def.Required,
+			Default:     def.Default,
+		}
+		if def.Options != nil {
+			input.Options = *def.Options
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
 func (r *Resolver) ExecuteBatchSpec(ctx context.Context, args *graphqlbackend.ExecuteBatchSpecArgs) (_ graphqlbackend.BatchSpecResolver, err error) {
 	tr, ctx := trace.New(ctx, "Resolver.ExecuteBatchSpec",
 		attribute.String("batchSpec", string(args.BatchSpec)))
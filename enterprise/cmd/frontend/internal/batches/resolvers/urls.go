@@ -12,3 +12,9 @@ func batchChangeURL(n graphqlbackend.Namespace, c graphqlbackend.BatchChangeReso
 	// This needs to be kept consistent with btypes.batchChangeURL().
 	return n.URL() + "/batch-changes/" + c.Name()
 }
+
+func batchSpecImpactReportURL(c graphqlbackend.BatchSpecResolver) string {
+	// This needs to be kept consistent with the route of the impact report
+	// export handler.
+	return "/.api/batches/impact-report/" + string(c.ID())
+}
@@ -15,6 +15,7 @@ import (
 	rankinggraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/ranking/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	sentinelgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/graphql"
+	sentinelhttp "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/transport/http"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/lsifuploadstore"
 	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
@@ -116,6 +117,7 @@ func Init(
 		indexLoaderFactory,
 		locationResolverFactory,
 		preciseIndexResolverFactory,
+		siteAdminChecker,
 	)
 
 	rankingRootResolver := rankinggraphql.NewRootResolver(
@@ -133,6 +135,9 @@ func Init(
 		rankingRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.VulnerabilityImportHandler = sentinelhttp.GetImportHandler(codeIntelServices.SentinelService, db)
+	enterpriseServices.SBOMExportHandler = sentinelhttp.GetSBOMHandler(codeIntelServices.SentinelService, db)
+	enterpriseServices.CodeIntelCoverageExportHandler = uploadshttp.GetCoverageExportHandler(codeIntelServices.UploadsService, db)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
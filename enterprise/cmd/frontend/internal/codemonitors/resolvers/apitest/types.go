@@ -59,6 +59,7 @@ type Action struct {
 	Email        *ActionEmail
 	Webhook      *ActionWebhook
 	SlackWebhook *ActionSlackWebhook
+	Issue        *ActionIssue
 }
 
 func (a *Action) UnmarshalJSON(b []byte) error {
@@ -80,6 +81,9 @@ func (a *Action) UnmarshalJSON(b []byte) error {
 	case "MonitorSlackWebhook":
 		a.SlackWebhook = &ActionSlackWebhook{}
 		return json.Unmarshal(b, &a.SlackWebhook)
+	case "MonitorIssue":
+		a.Issue = &ActionIssue{}
+		return json.Unmarshal(b, &a.Issue)
 	default:
 		return errors.Errorf("unexpected typename %q", t.TypeName)
 	}
@@ -108,6 +112,17 @@ type ActionSlackWebhook struct {
 	Events  ActionEventConnection
 }
 
+type ActionIssue struct {
+	Id             string
+	Enabled        bool
+	Tracker        string
+	TitleTemplate  string
+	Labels         []string
+	JiraURL        *string
+	JiraProjectKey *string
+	Events         ActionEventConnection
+}
+
 type RecipientsConnection struct {
 	Nodes      []UserOrg
 	TotalCount int
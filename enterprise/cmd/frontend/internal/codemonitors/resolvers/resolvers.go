@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -132,10 +133,11 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 
 		// Create monitor.
 		m, err := tx.db.CodeMonitors().CreateMonitor(ctx, database.MonitorArgs{
-			Description:     args.Monitor.Description,
-			Enabled:         args.Monitor.Enabled,
-			NamespaceUserID: userID,
-			NamespaceOrgID:  orgID,
+			Description:      args.Monitor.Description,
+			Enabled:          args.Monitor.Enabled,
+			NamespaceUserID:  userID,
+			NamespaceOrgID:   orgID,
+			DeliverySchedule: toDeliverySchedule(args.Monitor.DeliverySchedule),
 		})
 		if err != nil {
 			return err
@@ -292,15 +294,26 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err != nil {
 				return err
 			}
+		case a.Issue != nil:
+			issueArgs, err := toIssueActionArgs(a.Issue, true)
+			if err != nil {
+				return err
+			}
+			if err := r.checkIssueTrackerAllowed(ctx, issueArgs.Tracker); err != nil {
+				return err
+			}
+			if _, err := r.db.CodeMonitors().CreateIssueAction(ctx, monitorID, issueArgs); err != nil {
+				return err
+			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, or Issue must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, issue []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -315,8 +328,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionIssueKind:
+			issue = append(issue, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, or issue")
 		}
 	}
 
@@ -332,6 +347,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteIssueActions(ctx, monitorID, issue...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -443,7 +462,11 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	issueActions, err := r.db.CodeMonitors().ListIssueActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(issueActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -453,6 +476,9 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, issueAction := range issueActions {
+		ids = append(ids, (&monitorIssue{IssueAction: issueAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -497,6 +523,16 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.Issue != nil:
+			if a.Issue.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Issue: a.Issue.Update})
+				continue
+			}
+			if _, ok := aMap[*a.Issue.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.Issue.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.Issue.Id)
 		}
 	}
 
@@ -520,10 +556,11 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	mo, err := r.db.CodeMonitors().UpdateMonitor(ctx, monitorID, database.MonitorArgs{
-		Description:     args.Monitor.Update.Description,
-		Enabled:         args.Monitor.Update.Enabled,
-		NamespaceUserID: userID,
-		NamespaceOrgID:  orgID,
+		Description:      args.Monitor.Update.Description,
+		Enabled:          args.Monitor.Update.Enabled,
+		NamespaceUserID:  userID,
+		NamespaceOrgID:   orgID,
+		DeliverySchedule: toDeliverySchedule(args.Monitor.Update.DeliverySchedule),
 	})
 	if err != nil {
 		return nil, err
@@ -576,8 +613,10 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.Issue != nil:
+			err = r.updateIssueAction(ctx, *action.Issue)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, or issue")
 		}
 		if err != nil {
 			return nil, err
@@ -633,6 +672,35 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 	return err
 }
 
+func (r *Resolver) updateIssueAction(ctx context.Context, args graphqlbackend.EditActionIssueArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	// The token is write-only, so an update without a token keeps the stored one.
+	issueArgs, err := toIssueActionArgs(args.Update, false)
+	if err != nil {
+		return err
+	}
+	if err := r.checkIssueTrackerAllowed(ctx, issueArgs.Tracker); err != nil {
+		return err
+	}
+	_, err = r.db.CodeMonitors().UpdateIssueAction(ctx, id, issueArgs)
+	return err
+}
+
+// checkIssueTrackerAllowed checks whether the current user may configure an issue action
+// that files issues in the given tracker. Issues on the code host are opened with the
+// credentials of the code host connection, so only site admins may configure them.
+func (r *Resolver) checkIssueTrackerAllowed(ctx context.Context, tracker database.IssueTracker) error {
+	if tracker != database.IssueTrackerCodeHost {
+		return nil
+	}
+	return auth.CheckCurrentUserIsSiteAdmin(ctx, r.db)
+}
+
 func (r *Resolver) withTransact(ctx context.Context, f func(*Resolver) error) error {
 	return r.db.WithTransact(ctx, func(tx database.DB) error {
 		return f(&Resolver{
@@ -716,6 +784,7 @@ const (
 	monitorActionEmailKind             = "CodeMonitorActionEmail"
 	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
 	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
+	monitorActionIssueKind             = "CodeMonitorActionIssue"
 	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
 	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
 	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
@@ -781,6 +850,10 @@ func (m *monitor) Owner(ctx context.Context) (graphqlbackend.NamespaceResolver,
 	return graphqlbackend.NamespaceResolver{Namespace: n}, err
 }
 
+func (m *monitor) DeliverySchedule() string {
+	return strings.ToUpper(string(m.Monitor.DeliverySchedule))
+}
+
 func (m *monitor) Trigger(ctx context.Context) (graphqlbackend.MonitorTrigger, error) {
 	t, err := m.db.CodeMonitors().GetQueryTriggerForMonitor(ctx, m.Monitor.ID)
 	if err != nil {
@@ -811,7 +884,12 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	is, err := r.db.CodeMonitors().ListIssueActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(is))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -839,6 +917,15 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, i := range is {
+		actions = append(actions, &action{
+			issue: &monitorIssue{
+				Resolver:       r,
+				IssueAction:    i,
+				triggerEventID: triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -1015,6 +1102,7 @@ type action struct {
 	email        graphqlbackend.MonitorEmailResolver
 	webhook      graphqlbackend.MonitorWebhookResolver
 	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	issue        graphqlbackend.MonitorIssueResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1025,6 +1113,8 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.issue != nil:
+		return a.issue.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1042,6 +1132,10 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorIssue() (graphqlbackend.MonitorIssueResolver, bool) {
+	return a.issue, a.issue != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1257,6 +1351,102 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorIssue struct {
+	*Resolver
+	*database.IssueAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorIssue) ID() graphql.ID {
+	return relay.MarshalID(monitorActionIssueKind, m.IssueAction.ID)
+}
+
+func (m *monitorIssue) Enabled() bool {
+	return m.IssueAction.Enabled
+}
+
+func (m *monitorIssue) IncludeResults() bool {
+	return m.IssueAction.IncludeResults
+}
+
+func (m *monitorIssue) Tracker() string {
+	for enum, tracker := range issueTrackers {
+		if tracker == m.IssueAction.Tracker {
+			return enum
+		}
+	}
+	return string(m.IssueAction.Tracker)
+}
+
+func (m *monitorIssue) TitleTemplate() string {
+	return m.IssueAction.TitleTemplate
+}
+
+func (m *monitorIssue) BodyTemplate() string {
+	return m.IssueAction.BodyTemplate
+}
+
+func (m *monitorIssue) Labels() []string {
+	return m.IssueAction.Labels
+}
+
+func (m *monitorIssue) JiraURL() *string {
+	return nonEmpty(m.IssueAction.JiraURL)
+}
+
+func (m *monitorIssue) JiraProjectKey() *string {
+	return nonEmpty(m.IssueAction.JiraProjectKey)
+}
+
+func (m *monitorIssue) JiraIssueType() *string {
+	return nonEmpty(m.IssueAction.JiraIssueType)
+}
+
+func (m *monitorIssue) JiraUsername() *string {
+	return nonEmpty(m.IssueAction.JiraUsername)
+}
+
+func (m *monitorIssue) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, database.ListActionJobsOpts{
+		IssueActionID:  pointers.Ptr(int(m.IssueAction.ID)),
+		TriggerEventID: m.triggerEventID,
+		First:          pointers.Ptr(int(args.First)),
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, database.ListActionJobsOpts{
+		IssueActionID:  pointers.Ptr(int(m.IssueAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
 		return nil
@@ -1349,3 +1539,64 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+// toDeliverySchedule converts the MonitorDeliverySchedule GraphQL enum to the stored
+// schedule. The enum values are the upper-case stored values.
+func toDeliverySchedule(schedule *string) database.DeliverySchedule {
+	if schedule == nil {
+		return ""
+	}
+	return database.DeliverySchedule(strings.ToLower(*schedule))
+}
+
+// issueTrackers maps the MonitorIssueTracker GraphQL enum to the stored tracker.
+var issueTrackers = map[string]database.IssueTracker{
+	"CODE_HOST": database.IssueTrackerCodeHost,
+	"JIRA":      database.IssueTrackerJira,
+}
+
+// toIssueActionArgs validates the GraphQL input of an issue action. A Jira token is
+// only required when requireToken is set, because updates may keep the stored token.
+func toIssueActionArgs(args *graphqlbackend.CreateActionIssueArgs, requireToken bool) (*database.IssueActionArgs, error) {
+	tracker, ok := issueTrackers[args.Tracker]
+	if !ok {
+		return nil, errors.Errorf("unknown issue tracker %q", args.Tracker)
+	}
+	if err := background.ValidateIssueTemplates(args.TitleTemplate, args.BodyTemplate); err != nil {
+		return nil, err
+	}
+
+	issueArgs := &database.IssueActionArgs{
+		Enabled:        args.Enabled,
+		IncludeResults: args.IncludeResults,
+		Tracker:        tracker,
+		TitleTemplate:  args.TitleTemplate,
+		BodyTemplate:   args.BodyTemplate,
+		Labels:         args.Labels,
+	}
+	if tracker != database.IssueTrackerJira {
+		return issueArgs, nil
+	}
+
+	issueArgs.JiraURL = pointers.Deref(args.JiraURL, "")
+	issueArgs.JiraProjectKey = pointers.Deref(args.JiraProjectKey, "")
+	issueArgs.JiraIssueType = pointers.Deref(args.JiraIssueType, "")
+	issueArgs.JiraUsername = pointers.Deref(args.JiraUsername, "")
+	if args.JiraToken != nil && *args.JiraToken != "" {
+		issueArgs.JiraToken = args.JiraToken
+	}
+	if issueArgs.JiraURL == "" || issueArgs.JiraProjectKey == "" || issueArgs.JiraIssueType == "" {
+		return nil, errors.New("Jira issue actions require jiraURL, jiraProjectKey, and jiraIssueType")
+	}
+	u, err := url.Parse(issueArgs.JiraURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, errors.New("jiraURL must be an http or https URL")
+	}
+	if requireToken && issueArgs.JiraToken == nil {
+		return nil, errors.New("Jira issue actions require jiraToken")
+	}
+	return issueArgs, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -46,6 +47,8 @@ func TestCreateCodeMonitor(t *testing.T) {
 		Description: "test monitor",
 		Enabled:     true,
 		UserID:      user.ID,
+
+		DeliverySchedule: database.DeliveryImmediate,
 	}
 	ctx = actor.WithActor(ctx, actor.FromUser(user.ID))
 
@@ -101,6 +104,23 @@ func TestCreateCodeMonitor(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, monitors.Nodes(), 0) // the transaction should have been rolled back
 	})
+
+	t.Run("code host issue action requires site admin", func(t *testing.T) {
+		codeHostIssue := []*graphqlbackend.CreateActionArgs{{
+			Issue: &graphqlbackend.CreateActionIssueArgs{
+				Enabled: true,
+				Tracker: "CODE_HOST",
+			},
+		}}
+
+		nonAdmin := insertTestUser(t, db, "cm-user-non-admin", false)
+		nonAdminCtx := actor.WithActor(context.Background(), actor.FromUser(nonAdmin.ID))
+		_, err := r.insertTestMonitorWithOpts(nonAdminCtx, t, WithActions(codeHostIssue))
+		require.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+
+		_, err = r.insertTestMonitorWithOpts(ctx, t, WithActions(codeHostIssue))
+		require.NoError(t, err)
+	})
 }
 
 func TestListCodeMonitors(t *testing.T) {
@@ -1322,3 +1342,72 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestToIssueActionArgs(t *testing.T) {
+	jira := func() *graphqlbackend.CreateActionIssueArgs {
+		return &graphqlbackend.CreateActionIssueArgs{
+			Enabled:        true,
+			Tracker:        "JIRA",
+			Labels:         []string{"monitor"},
+			JiraURL:        pointers.Ptr("https://jira.example.com"),
+			JiraProjectKey: pointers.Ptr("SG"),
+			JiraIssueType:  pointers.Ptr("Task"),
+			JiraToken:      pointers.Ptr("secret"),
+		}
+	}
+
+	t.Run("code host", func(t *testing.T) {
+		args, err := toIssueActionArgs(&graphqlbackend.CreateActionIssueArgs{
+			Tracker:      "CODE_HOST",
+			JiraURL:      pointers.Ptr("https://jira.example.com"),
+			JiraToken:    pointers.Ptr("secret"),
+			BodyTemplate: "{{.Repository}}",
+		}, true)
+		require.NoError(t, err)
+		require.Equal(t, &database.IssueActionArgs{
+			Tracker:      database.IssueTrackerCodeHost,
+			BodyTemplate: "{{.Repository}}",
+		}, args)
+	})
+
+	t.Run("jira", func(t *testing.T) {
+		args, err := toIssueActionArgs(jira(), true)
+		require.NoError(t, err)
+		require.Equal(t, &database.IssueActionArgs{
+			Enabled:        true,
+			Tracker:        database.IssueTrackerJira,
+			Labels:         []string{"monitor"},
+			JiraURL:        "https://jira.example.com",
+			JiraProjectKey: "SG",
+			JiraIssueType:  "Task",
+			JiraToken:      pointers.Ptr("secret"),
+		}, args)
+	})
+
+	t.Run("jira update keeps token", func(t *testing.T) {
+		in := jira()
+		in.JiraToken = nil
+		_, err := toIssueActionArgs(in, true)
+		require.Error(t, err)
+
+		args, err := toIssueActionArgs(in, false)
+		require.NoError(t, err)
+		require.Nil(t, args.JiraToken)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		unknownTracker := jira()
+		unknownTracker.Tracker = "BUGZILLA"
+		missingProject := jira()
+		missingProject.JiraProjectKey = nil
+		badURL := jira()
+		badURL.JiraURL = pointers.Ptr("ftp://jira.example.com")
+		badTemplate := jira()
+		badTemplate.TitleTemplate = "{{.NoSuchField}}"
+
+		for _, in := range []*graphqlbackend.CreateActionIssueArgs{unknownTracker, missingProject, badURL, badTemplate} {
+			_, err := toIssueActionArgs(in, true)
+			require.Error(t, err)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -14,10 +15,13 @@ import (
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 
+	"github.com/sourcegraph/log"
+
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	edb "github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
 	"github.com/sourcegraph/sourcegraph/internal/licensing"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -25,6 +29,7 @@ import (
 // ExportHandler handles retrieving and exporting code insights data.
 type ExportHandler struct {
 	primaryDB database.DB
+	logger    log.Logger
 
 	seriesStore          *store.Store
 	permStore            *store.InsightPermStore
@@ -42,6 +47,7 @@ func NewExportHandler(db database.DB, insightsDB edb.InsightsDB) *ExportHandler
 
 	return &ExportHandler{
 		primaryDB:            db,
+		logger:               log.Scoped("insights.ExportHandler", "exports code insights data"),
 		seriesStore:          seriesStore,
 		permStore:            insightPermStore,
 		insightStore:         insightsStore,
@@ -55,15 +61,7 @@ func (h *ExportHandler) ExportFunc() http.HandlerFunc {
 
 		archive, err := h.exportCodeInsightData(r.Context(), id)
 		if err != nil {
-			if errors.Is(err, notFoundError) {
-				http.Error(w, err.Error(), http.StatusNotFound)
-			} else if errors.Is(err, authenticationError) {
-				http.Error(w, err.Error(), http.StatusUnauthorized)
-			} else if errors.Is(err, invalidLicenseError) {
-				http.Error(w, err.Error(), http.StatusForbidden)
-			} else {
-				http.Error(w, fmt.Sprintf("failed to export data: %v", err), http.StatusInternalServerError)
-			}
+			writeExportError(w, err)
 			return
 		}
 		w.Header().Set("Content-Type", "application/zip")
@@ -76,6 +74,51 @@ func (h *ExportHandler) ExportFunc() http.HandlerFunc {
 	}
 }
 
+// CSVExportFunc streams the data of an insight view as a CSV file. Unlike ExportFunc, the data is
+// not buffered into an archive, which makes it suitable for large insights and for tools that read
+// CSV over HTTP.
+func (h *ExportHandler) CSVExportFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		insightViewId, visibleViewSeries, err := h.visibleInsightView(r.Context(), id)
+		if err != nil {
+			writeExportError(w, err)
+			return
+		}
+		if err := h.logExportEvent(r.Context()); err != nil {
+			writeExportError(w, err)
+			return
+		}
+		opts, err := h.exportOpts(r.Context(), insightViewId, visibleViewSeries)
+		if err != nil {
+			writeExportError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", exportName(visibleViewSeries[0].Title)))
+
+		// The response has already started at this point, so errors can only be reported by
+		// truncating it.
+		if err := h.writeCSV(r.Context(), w, opts); err != nil {
+			h.logger.Error("failed to stream code insights data", log.String("insightViewID", insightViewId), log.Error(err))
+		}
+	}
+}
+
+func writeExportError(w http.ResponseWriter, err error) {
+	if errors.Is(err, notFoundError) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	} else if errors.Is(err, authenticationError) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	} else if errors.Is(err, invalidLicenseError) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+	} else {
+		http.Error(w, fmt.Sprintf("failed to export data: %v", err), http.StatusInternalServerError)
+	}
+}
+
 type codeInsightsDataArchive struct {
 	name string
 	data []byte
@@ -86,34 +129,51 @@ var authenticationError = errors.New("authentication error")
 var invalidLicenseError = errors.New("invalid license for code insights")
 
 func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*codeInsightsDataArchive, error) {
-	currentActor := actor.FromContext(ctx)
-	if !currentActor.IsAuthenticated() {
-		return nil, authenticationError
+	insightViewId, visibleViewSeries, err := h.visibleInsightView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.logExportEvent(ctx); err != nil {
+		return nil, err
 	}
-	userIDs, orgIDs, err := h.permStore.GetUserPermissions(ctx)
+	opts, err := h.exportOpts(ctx, insightViewId, visibleViewSeries)
 	if err != nil {
-		return nil, authenticationError
+		return nil, err
 	}
 
-	if err := h.primaryDB.EventLogs().Insert(ctx, &database.Event{
-		Name:            pingName,
-		UserID:          uint32(currentActor.UID),
-		AnonymousUserID: "",
-		Argument:        nil,
-		Timestamp:       time.Now(),
-		Source:          "BACKEND",
-	}); err != nil {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	name := exportName(visibleViewSeries[0].Title)
+
+	dataFile, err := zw.Create(fmt.Sprintf("%s.csv", name))
+	if err != nil {
+		return nil, err
+	}
+	if err := h.writeCSV(ctx, dataFile, opts); err != nil {
 		return nil, err
 	}
 
-	licenseError := licensing.Check(licensing.FeatureCodeInsights)
-	if licenseError != nil {
-		return nil, invalidLicenseError
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &codeInsightsDataArchive{
+		name: name,
+		data: buf.Bytes(),
+	}, nil
+}
+
+// visibleInsightView returns the unique ID and the series of the insight view with the given
+// GraphQL ID, if the current user can see it.
+func (h *ExportHandler) visibleInsightView(ctx context.Context, id string) (string, []types.InsightViewSeries, error) {
+	userIDs, orgIDs, err := h.checkAccess(ctx)
+	if err != nil {
+		return "", nil, err
 	}
 
 	var insightViewId string
 	if err := relay.UnmarshalSpec(graphql.ID(id), &insightViewId); err != nil {
-		return nil, errors.Wrap(err, "could not unmarshal insight view ID")
+		return "", nil, errors.Wrap(err, "could not unmarshal insight view ID")
 	}
 
 	visibleViewSeries, err := h.insightStore.GetAll(ctx, store.InsightQueryArgs{
@@ -123,48 +183,66 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 		WithoutAuthorization: false,
 	})
 	if err != nil {
-		return nil, errors.New("could not fetch insight information")
+		return "", nil, errors.New("could not fetch insight information")
 	}
 	// 🚨 SECURITY: if the user context doesn't get any response here that means they should not be able to access this insight.
 	if len(visibleViewSeries) == 0 {
-		return nil, notFoundError
+		return "", nil, notFoundError
 	}
+	return insightViewId, visibleViewSeries, nil
+}
 
-	opts := store.ExportOpts{}
-	includeRepo := func(regex ...string) {
-		opts.IncludeRepoRegex = append(opts.IncludeRepoRegex, regex...)
+// checkAccess checks that the current user can export code insights data, and returns the user
+// and organization IDs that insight views must be visible to.
+func (h *ExportHandler) checkAccess(ctx context.Context) (userIDs, orgIDs []int, err error) {
+	currentActor := actor.FromContext(ctx)
+	if !currentActor.IsAuthenticated() {
+		return nil, nil, authenticationError
 	}
-	excludeRepo := func(regex ...string) {
-		opts.ExcludeRepoRegex = append(opts.ExcludeRepoRegex, regex...)
+	userIDs, orgIDs, err = h.permStore.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, nil, authenticationError
 	}
 
-	if visibleViewSeries[0].DefaultFilterIncludeRepoRegex != nil {
-		includeRepo(*visibleViewSeries[0].DefaultFilterIncludeRepoRegex)
-	}
-	if visibleViewSeries[0].DefaultFilterExcludeRepoRegex != nil {
-		includeRepo(*visibleViewSeries[0].DefaultFilterExcludeRepoRegex)
+	licenseError := licensing.Check(licensing.FeatureCodeInsights)
+	if licenseError != nil {
+		return nil, nil, invalidLicenseError
 	}
+	return userIDs, orgIDs, nil
+}
+
+func (h *ExportHandler) logExportEvent(ctx context.Context) error {
+	return h.primaryDB.EventLogs().Insert(ctx, &database.Event{
+		Name:            pingName,
+		UserID:          uint32(actor.FromContext(ctx).UID),
+		AnonymousUserID: "",
+		Argument:        nil,
+		Timestamp:       time.Now(),
+		Source:          "BACKEND",
+	})
+}
 
-	inc, exc, err := h.searchContextHandler.UnwrapSearchContexts(ctx, visibleViewSeries[0].DefaultFilterSearchContexts)
+// exportOpts returns the options to export the data of an insight view with its default filters.
+func (h *ExportHandler) exportOpts(ctx context.Context, insightViewId string, visibleViewSeries []types.InsightViewSeries) (store.ExportOpts, error) {
+	include, exclude, err := h.searchContextHandler.DefaultRepoFilters(ctx, visibleViewSeries[0])
 	if err != nil {
-		return nil, errors.Wrap(err, "search context error")
+		return store.ExportOpts{}, err
 	}
-	includeRepo(inc...)
-	excludeRepo(exc...)
-
-	var buf bytes.Buffer
-	zw := zip.NewWriter(&buf)
+	return store.ExportOpts{
+		InsightViewUniqueID: insightViewId,
+		IncludeRepoRegex:    include,
+		ExcludeRepoRegex:    exclude,
+	}, nil
+}
 
+func exportName(title string) string {
 	timestamp := time.Now().Format(time.RFC3339)
-	escapedInsightViewTitle := regexp.MustCompile(`\W+`).ReplaceAllString(visibleViewSeries[0].Title, "-")
-	name := fmt.Sprintf("%s-%s", escapedInsightViewTitle, timestamp)
-
-	dataFile, err := zw.Create(fmt.Sprintf("%s.csv", name))
-	if err != nil {
-		return nil, err
-	}
+	escapedInsightViewTitle := regexp.MustCompile(`\W+`).ReplaceAllString(title, "-")
+	return fmt.Sprintf("%s-%s", escapedInsightViewTitle, timestamp)
+}
 
-	dataWriter := csv.NewWriter(dataFile)
+func (h *ExportHandler) writeCSV(ctx context.Context, w io.Writer, opts store.ExportOpts) error {
+	dataWriter := csv.NewWriter(w)
 
 	// this needs to be the same number of elements as the number of columns in store.GetAllDataForInsightViewID
 	dataPoint := []string{
@@ -178,16 +256,10 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 	}
 
 	if err := dataWriter.Write(dataPoint); err != nil {
-		return nil, errors.Wrap(err, "failed to write csv header")
-	}
-
-	opts.InsightViewUniqueID = insightViewId
-	dataPoints, err := h.seriesStore.GetAllDataForInsightViewID(ctx, opts)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch all data for insight")
+		return errors.Wrap(err, "failed to write csv header")
 	}
 
-	for _, d := range dataPoints {
+	err := h.seriesStore.StreamAllDataForInsightViewID(ctx, opts, func(d store.SeriesPointForExport) error {
 		dataPoint[0] = d.InsightViewTitle
 		dataPoint[1] = d.SeriesLabel
 		dataPoint[2] = d.SeriesQuery
@@ -196,19 +268,13 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 		dataPoint[5] = fmt.Sprintf("%d", d.Value)
 		dataPoint[6] = emptyStringIfNil(d.Capture)
 
-		if err := dataWriter.Write(dataPoint); err != nil {
-			return nil, err
-		}
+		return dataWriter.Write(dataPoint)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch all data for insight")
 	}
 	dataWriter.Flush()
-
-	if err := zw.Close(); err != nil {
-		return nil, err
-	}
-	return &codeInsightsDataArchive{
-		name: name,
-		data: buf.Bytes(),
-	}, nil
+	return dataWriter.Error()
 }
 
 func emptyStringIfNil(s *string) string {
@@ -0,0 +1,167 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	seriesValueDesc = prometheus.NewDesc(
+		"src_insights_series_value",
+		"The latest recorded value of a code insights series.",
+		[]string{"insight_view_id", "insight_title", "series_id", "series_label", "capture"},
+		nil,
+	)
+	seriesLastRecordedDesc = prometheus.NewDesc(
+		"src_insights_series_last_recorded_timestamp_seconds",
+		"The time of the latest recorded value of a code insights series.",
+		[]string{"insight_view_id", "insight_title", "series_id", "series_label", "capture"},
+		nil,
+	)
+)
+
+// MetricsFunc serves the latest value of every series of the insight views visible to the
+// current user as gauges in the Prometheus text or OpenMetrics format, so that code insights can
+// be scraped into the same monitoring stack as production metrics. The views can be limited to
+// the GraphQL IDs given in the id query parameters.
+//
+// Samples are served without timestamps, as Prometheus rejects samples that are older than its
+// head block and series are only recorded periodically. The time of the latest value is exposed
+// as a separate gauge instead.
+func (h *ExportHandler) MetricsFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		metrics, err := h.seriesMetrics(r.Context(), r.URL.Query()["id"])
+		if err != nil {
+			writeExportError(w, err)
+			return
+		}
+
+		registry := prometheus.NewRegistry()
+		if err := registry.Register(metrics); err != nil {
+			writeExportError(w, err)
+			return
+		}
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+			ErrorLog:          promhttpLogger{logger: h.logger},
+			ErrorHandling:     promhttp.HTTPErrorOnError,
+			EnableOpenMetrics: true,
+		}).ServeHTTP(w, r)
+	}
+}
+
+// promhttpLogger reports the errors of promhttp handlers.
+type promhttpLogger struct {
+	logger log.Logger
+}
+
+func (l promhttpLogger) Println(v ...any) {
+	l.logger.Error("failed to serve code insights metrics", log.String("error", fmt.Sprint(v...)))
+}
+
+// constMetrics is an unchecked collector of precomputed metrics.
+type constMetrics []prometheus.Metric
+
+func (m constMetrics) Describe(chan<- *prometheus.Desc) {}
+
+func (m constMetrics) Collect(ch chan<- prometheus.Metric) {
+	for _, metric := range m {
+		ch <- metric
+	}
+}
+
+func (h *ExportHandler) seriesMetrics(ctx context.Context, ids []string) (constMetrics, error) {
+	userIDs, orgIDs, err := h.checkAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		var insightViewId string
+		if err := relay.UnmarshalSpec(graphql.ID(id), &insightViewId); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal insight view ID")
+		}
+		uniqueIDs = append(uniqueIDs, insightViewId)
+	}
+
+	// 🚨 SECURITY: only the insight views visible to the current user are returned here, and the
+	// series points below only include the repositories they can see.
+	visibleViewSeries, err := h.insightStore.GetAll(ctx, store.InsightQueryArgs{
+		UniqueIDs: uniqueIDs,
+		UserIDs:   userIDs,
+		OrgIDs:    orgIDs,
+	})
+	if err != nil {
+		return nil, errors.New("could not fetch insight information")
+	}
+	if len(ids) > 0 && len(visibleViewSeries) == 0 {
+		return nil, notFoundError
+	}
+
+	var metrics constMetrics
+	for _, viewSeries := range visibleViewSeries {
+		include, exclude, err := h.searchContextHandler.DefaultRepoFilters(ctx, viewSeries)
+		if err != nil {
+			return nil, err
+		}
+		points, err := h.seriesStore.SeriesPoints(ctx, store.SeriesPointsOpts{
+			SeriesID:         &viewSeries.SeriesID,
+			IncludeRepoRegex: include,
+			ExcludeRepoRegex: exclude,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "SeriesPoints for series %s", viewSeries.SeriesID)
+		}
+
+		for _, point := range latestPoints(points) {
+			labels := seriesLabels(viewSeries, point)
+			metrics = append(metrics,
+				prometheus.MustNewConstMetric(seriesValueDesc, prometheus.GaugeValue, point.Value, labels...),
+				prometheus.MustNewConstMetric(seriesLastRecordedDesc, prometheus.GaugeValue, float64(point.Time.Unix()), labels...),
+			)
+		}
+	}
+	return metrics, nil
+}
+
+// latestPoints returns the points of a series that were recorded at its most recent time, which
+// are one point per capture for capture group series.
+func latestPoints(points []store.SeriesPoint) []store.SeriesPoint {
+	var latest time.Time
+	for _, point := range points {
+		if point.Time.After(latest) {
+			latest = point.Time
+		}
+	}
+
+	var result []store.SeriesPoint
+	for _, point := range points {
+		if point.Time.Equal(latest) {
+			result = append(result, point)
+		}
+	}
+	return result
+}
+
+func seriesLabels(viewSeries types.InsightViewSeries, point store.SeriesPoint) []string {
+	return []string{
+		string(relay.MarshalID("insight_view", viewSeries.UniqueID)),
+		viewSeries.Title,
+		viewSeries.SeriesID,
+		viewSeries.Label,
+		emptyStringIfNil(point.Capture),
+	}
+}
@@ -0,0 +1,38 @@
+package httpapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestLatestPoints(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 1, 0)
+
+	t.Run("no points", func(t *testing.T) {
+		if got := latestPoints(nil); len(got) != 0 {
+			t.Errorf("unexpected points: %v", got)
+		}
+	})
+
+	t.Run("one point per capture", func(t *testing.T) {
+		got := latestPoints([]store.SeriesPoint{
+			{SeriesID: "s", Time: t1, Value: 1, Capture: pointers.Ptr("1.19")},
+			{SeriesID: "s", Time: t1, Value: 2, Capture: pointers.Ptr("1.20")},
+			{SeriesID: "s", Time: t2, Value: 3, Capture: pointers.Ptr("1.20")},
+			{SeriesID: "s", Time: t2, Value: 4, Capture: pointers.Ptr("1.21")},
+		})
+		want := []store.SeriesPoint{
+			{SeriesID: "s", Time: t2, Value: 3, Capture: pointers.Ptr("1.20")},
+			{SeriesID: "s", Time: t2, Value: 4, Capture: pointers.Ptr("1.21")},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected points (-want +got):\n%s", diff)
+		}
+	})
+}
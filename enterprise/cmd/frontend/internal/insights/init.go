@@ -38,8 +38,12 @@ func Init(
 	if err != nil {
 		return err
 	}
+	enterpriseServices.CodeInsightsDB = rawInsightsDB
 	enterpriseServices.InsightsResolver = resolvers.New(rawInsightsDB, db)
-	enterpriseServices.CodeInsightsDataExportHandler = httpapi.NewExportHandler(db, rawInsightsDB).ExportFunc()
+	exportHandler := httpapi.NewExportHandler(db, rawInsightsDB)
+	enterpriseServices.CodeInsightsDataExportHandler = exportHandler.ExportFunc()
+	enterpriseServices.CodeInsightsCSVExportHandler = exportHandler.CSVExportFunc()
+	enterpriseServices.CodeInsightsMetricsHandler = exportHandler.MetricsFunc()
 
 	return nil
 }
@@ -189,6 +189,61 @@ func Test_canAggregateByAuthor(t *testing.T) {
 	suite.Test_canAggregateBy()
 }
 
+func Test_canAggregateByDateBucket(t *testing.T) {
+	testCases := []canAggregateTestCase{
+		{
+			name:         "cannot aggregate for query without parameters",
+			query:        "func(t *testing.T)",
+			reason:       dateBucketNotCommitDiffMsg,
+			canAggregate: false,
+		},
+		{
+			name:         "can aggregate for query with type:commit parameter",
+			query:        "type:commit fix",
+			canAggregate: true,
+		},
+		{
+			name:         "can aggregate for query with type:diff parameter",
+			query:        "type:diff fix",
+			canAggregate: true,
+		},
+	}
+	suite := canAggregateBySuite{
+		canAggregateByFunc: canAggregateByDateBucket,
+		testCases:          testCases,
+		t:                  t,
+	}
+	suite.Test_canAggregateBy()
+}
+
+func Test_canAggregateByOwner(t *testing.T) {
+	testCases := []canAggregateTestCase{
+		{
+			name:         "can aggregate for query without parameters",
+			query:        "func(t *testing.T)",
+			canAggregate: true,
+		},
+		{
+			name:         "cannot aggregate for query with type:diff parameter",
+			query:        "type:diff fix",
+			reason:       fmt.Sprintf(ownerUnsupportedFieldValueFmt, "type", "diff"),
+			canAggregate: false,
+		},
+		{
+			name:         "cannot aggregate for query with select:repo parameter",
+			query:        "repo:contains.path(README) select:repo",
+			reason:       fmt.Sprintf(ownerUnsupportedFieldValueFmt, "select", "repo"),
+			canAggregate: false,
+		},
+	}
+	suite := canAggregateBySuite{
+		canAggregateByFunc: canAggregateByOwner,
+		testCases:          testCases,
+		t:                  t,
+	}
+	suite.Test_canAggregateBy()
+}
+
 func Test_canAggregateByCaptureGroup(t *testing.T) {
 	testCases := []canAggregateTestCase{
 		{
@@ -456,6 +511,34 @@ func Test_buildDrilldownQuery(t *testing.T) {
 			patternType: "standard",
 			mode:        types.PATH_AGGREGATION_MODE,
 		},
+		{
+			want:        autogold.Expect("lang:Go findme"),
+			query:       "findme",
+			drilldown:   "Go",
+			patternType: "standard",
+			mode:        types.LANGUAGE_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:\\.go$ findme"),
+			query:       "findme",
+			drilldown:   ".go",
+			patternType: "standard",
+			mode:        types.FILE_EXTENSION_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("type:commit after:2023-05-01 before:2023-06-01 findme"),
+			query:       "findme type:commit",
+			drilldown:   "2023-05",
+			patternType: "standard",
+			mode:        types.DATE_BUCKET_AGGREGATION_MODE,
+		},
+		{
+			want:        autogold.Expect("file:has.owner(@sourcegraph/search) findme"),
+			query:       "findme",
+			drilldown:   "@sourcegraph/search",
+			patternType: "standard",
+			mode:        types.OWNER_AGGREGATION_MODE,
+		},
 		{
 			want:        autogold.Expect("case:yes /fin(?:d m)e/"),
 			query:       "/fin(.*)e/",
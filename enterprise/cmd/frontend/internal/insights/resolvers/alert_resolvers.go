@@ -0,0 +1,272 @@
+package resolvers
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/alerts"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const alertRuleKind = "InsightSeriesAlertRule"
+
+// defaultAnomalyIntervals is the size of the rolling baseline of anomaly rules that do not
+// configure one.
+const defaultAnomalyIntervals = 12
+
+func (r *Resolver) InsightSeriesAlertRules(ctx context.Context, args *graphqlbackend.InsightSeriesAlertRulesArgs) ([]graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	series, err := r.seriesOfView(ctx, args.InsightViewId, args.SeriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := r.alertStore.GetAlertRules(ctx, store.AlertRuleQueryArgs{InsightSeriesID: series.InsightSeriesID})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlertRules")
+	}
+	resolvers := make([]graphqlbackend.InsightSeriesAlertRuleResolver, 0, len(rules))
+	for _, rule := range rules {
+		// Alert rules are personal, as they notify the channels of their creator.
+		if rule.CreatedBy != uid {
+			continue
+		}
+		resolvers = append(resolvers, &alertRuleResolver{rule: rule, seriesID: series.SeriesID, alertStore: r.alertStore})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertRuleArgs) (graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	input := args.Input
+	series, err := r.seriesOfView(ctx, input.InsightViewId, input.SeriesId)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := types.InsightSeriesAlertRule{
+		InsightSeriesID: series.InsightSeriesID,
+		Kind:            types.AlertKind(strings.ToLower(input.Kind)),
+		Direction:       types.AlertAbove,
+		Threshold:       input.Threshold,
+		Intervals:       1,
+		CreatedBy:       uid,
+		Email:           input.Email,
+		SlackWebhookURL: input.SlackWebhookURL,
+		WebhookURL:      input.WebhookURL,
+		Enabled:         true,
+	}
+	if input.Direction != nil {
+		rule.Direction = types.AlertDirection(strings.ToLower(*input.Direction))
+	}
+	if input.Intervals != nil {
+		rule.Intervals = int(*input.Intervals)
+	} else if rule.Kind == types.AlertAnomaly {
+		rule.Intervals = defaultAnomalyIntervals
+	}
+	if err := alerts.Validate(rule); err != nil {
+		return nil, err
+	}
+	if !rule.Email && rule.SlackWebhookURL == nil && rule.WebhookURL == nil {
+		return nil, errors.New("alert rules require at least one notification channel")
+	}
+	for _, u := range []*string{rule.SlackWebhookURL, rule.WebhookURL} {
+		if u == nil {
+			continue
+		}
+		if err := validateWebhookURL(*u); err != nil {
+			return nil, err
+		}
+	}
+
+	rule, err = r.alertStore.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateAlertRule")
+	}
+	return &alertRuleResolver{rule: rule, seriesID: series.SeriesID, alertStore: r.alertStore}, nil
+}
+
+func (r *Resolver) SetInsightSeriesAlertRuleEnabled(ctx context.Context, args *graphqlbackend.SetInsightSeriesAlertRuleEnabledArgs) (graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	rule, err := r.ownAlertRule(ctx, args.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.alertStore.SetAlertRuleEnabled(ctx, rule.ID, args.Enabled); err != nil {
+		return nil, errors.Wrap(err, "SetAlertRuleEnabled")
+	}
+	rule.Enabled = args.Enabled
+
+	series, err := r.insightStore.GetDataSeriesByID(ctx, rule.InsightSeriesID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetDataSeriesByID")
+	}
+	if series == nil {
+		return nil, errors.New("series not found")
+	}
+	return &alertRuleResolver{rule: *rule, seriesID: series.SeriesID, alertStore: r.alertStore}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertRuleArgs) (*graphqlbackend.EmptyResponse, error) {
+	rule, err := r.ownAlertRule(ctx, args.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.alertStore.DeleteAlertRule(ctx, rule.ID); err != nil {
+		return nil, errors.Wrap(err, "DeleteAlertRule")
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+// seriesOfView returns the series of the insight view with the given unique series ID, if the
+// current user can see the view.
+func (r *Resolver) seriesOfView(ctx context.Context, insightViewID graphql.ID, seriesID string) (*types.InsightViewSeries, error) {
+	var viewID string
+	if err := relay.UnmarshalSpec(insightViewID, &viewID); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
+	}
+	permissionsValidator := PermissionsValidatorFromBase(&r.baseInsightResolver)
+	if err := permissionsValidator.validateUserAccessForView(ctx, viewID); err != nil {
+		return nil, err
+	}
+
+	viewSeries, err := r.insightStore.Get(ctx, store.InsightQueryArgs{WithoutAuthorization: true, UniqueID: viewID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Get")
+	}
+	for _, series := range viewSeries {
+		if series.SeriesID == seriesID {
+			return &series, nil
+		}
+	}
+	return nil, errors.New("series not found")
+}
+
+// ownAlertRule returns the alert rule with the given ID if it was created by the current user.
+func (r *Resolver) ownAlertRule(ctx context.Context, id graphql.ID) (*types.InsightSeriesAlertRule, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ruleID int
+	if err := relay.UnmarshalSpec(id, &ruleID); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert rule id")
+	}
+
+	rules, err := r.alertStore.GetAlertRules(ctx, store.AlertRuleQueryArgs{IDs: []int{ruleID}})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlertRules")
+	}
+	// 🚨 SECURITY: Users can only manage their own alert rules. We return a generic not found error
+	// to prevent leaking the existence of the rules of other users.
+	if len(rules) == 0 || rules[0].CreatedBy != uid {
+		return nil, errors.New("alert rule not found")
+	}
+	return &rules[0], nil
+}
+
+func currentUserID(ctx context.Context) (int32, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return 0, auth.ErrNotAuthenticated
+	}
+	return a.UID, nil
+}
+
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return errors.Wrap(err, "invalid webhook URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Newf("invalid webhook URL %q: only http and https URLs are supported", raw)
+	}
+	return nil
+}
+
+var _ graphqlbackend.InsightSeriesAlertRuleResolver = &alertRuleResolver{}
+
+type alertRuleResolver struct {
+	rule       types.InsightSeriesAlertRule
+	seriesID   string
+	alertStore store.AlertStore
+}
+
+func (a *alertRuleResolver) ID() graphql.ID {
+	return relay.MarshalID(alertRuleKind, a.rule.ID)
+}
+
+func (a *alertRuleResolver) SeriesId() string { return a.seriesID }
+
+func (a *alertRuleResolver) Kind() string { return strings.ToUpper(string(a.rule.Kind)) }
+
+func (a *alertRuleResolver) Direction() string { return strings.ToUpper(string(a.rule.Direction)) }
+
+func (a *alertRuleResolver) Threshold() float64 { return a.rule.Threshold }
+
+func (a *alertRuleResolver) Intervals() int32 { return int32(a.rule.Intervals) }
+
+func (a *alertRuleResolver) Email() bool { return a.rule.Email }
+
+func (a *alertRuleResolver) SlackWebhookURL() *string { return a.rule.SlackWebhookURL }
+
+func (a *alertRuleResolver) WebhookURL() *string { return a.rule.WebhookURL }
+
+func (a *alertRuleResolver) Enabled() bool { return a.rule.Enabled }
+
+func (a *alertRuleResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: a.rule.CreatedAt}
+}
+
+func (a *alertRuleResolver) LastTriggeredAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(a.rule.LastTriggeredAt)
+}
+
+func (a *alertRuleResolver) History(ctx context.Context, args *graphqlbackend.InsightSeriesAlertHistoryArgs) ([]graphqlbackend.InsightSeriesAlertEventResolver, error) {
+	events, err := a.alertStore.GetAlertEvents(ctx, store.AlertEventQueryArgs{AlertRuleID: a.rule.ID, Limit: int(args.First)})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlertEvents")
+	}
+	resolvers := make([]graphqlbackend.InsightSeriesAlertEventResolver, 0, len(events))
+	for _, event := range events {
+		resolvers = append(resolvers, &alertEventResolver{event: event})
+	}
+	return resolvers, nil
+}
+
+var _ graphqlbackend.InsightSeriesAlertEventResolver = &alertEventResolver{}
+
+type alertEventResolver struct {
+	event types.InsightSeriesAlertEvent
+}
+
+func (e *alertEventResolver) PointTime() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: e.event.PointTime}
+}
+
+func (e *alertEventResolver) Value() float64 { return e.event.Value }
+
+func (e *alertEventResolver) Baseline() float64 { return e.event.Baseline }
+
+func (e *alertEventResolver) Message() string { return e.event.Message }
+
+func (e *alertEventResolver) DeliveryError() *string { return e.event.DeliveryError }
+
+func (e *alertEventResolver) TriggeredAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: e.event.TriggeredAt}
+}
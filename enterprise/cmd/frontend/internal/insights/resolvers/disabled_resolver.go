@@ -114,3 +114,19 @@ func (r *disabledResolver) MoveInsightSeriesBackfillToFrontOfQueue(ctx context.C
 func (r *disabledResolver) MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *graphqlbackend.BackfillArgs) (*graphqlbackend.BackfillQueueItemResolver, error) {
 	return nil, errors.New(r.reason)
 }
+
+func (r *disabledResolver) InsightSeriesAlertRules(ctx context.Context, args *graphqlbackend.InsightSeriesAlertRulesArgs) ([]graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) CreateInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertRuleArgs) (graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) SetInsightSeriesAlertRuleEnabled(ctx context.Context, args *graphqlbackend.SetInsightSeriesAlertRuleEnabledArgs) (graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) DeleteInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertRuleArgs) (*graphqlbackend.EmptyResponse, error) {
+	return nil, errors.New(r.reason)
+}
@@ -340,6 +340,10 @@ func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromCaptureGroups()
 	return s.series.GeneratedFromCaptureGroups, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromDependencyVersions() (bool, error) {
+	return s.series.GenerationMethod == types.DependencyVersions, nil
+}
+
 func (s *searchInsightDataSeriesDefinitionResolver) GroupBy() (*string, error) {
 	if s.series.GroupBy != nil {
 		groupBy := strings.ToUpper(*s.series.GroupBy)
@@ -609,10 +613,10 @@ func (r *Resolver) UpdateLineChartSearchInsight(ctx context.Context, args *graph
 		return nil, errors.Wrap(err, "UpdateView")
 	}
 
-	// Capture group insight only have 1 associated insight series at most.
+	// Capture group and dependency versions insights only have 1 associated insight series at most.
 	captureGroupInsight := false
 	for _, newSeries := range args.Input.DataSeries {
-		if isCaptureGroupSeries(newSeries.GeneratedFromCaptureGroups) {
+		if isCaptureGroupSeries(newSeries.GeneratedFromCaptureGroups) || isDependencyVersionsSeries(newSeries.GeneratedFromDependencyVersions) {
 			captureGroupInsight = true
 			break
 		}
@@ -666,6 +670,10 @@ func isCaptureGroupSeries(generatedFromCaptureGroups *bool) bool {
 	return *generatedFromCaptureGroups
 }
 
+func isDependencyVersionsSeries(generatedFromDependencyVersions *bool) bool {
+	return generatedFromDependencyVersions != nil && *generatedFromDependencyVersions
+}
+
 func updateCaptureGroupInsight(ctx context.Context, input graphqlbackend.LineChartSearchInsightDataSeriesInput, existingSeries []types.InsightViewSeries, view types.InsightView, tx *store.InsightStore, seriesFillStrategy fillSeriesStrategy) error {
 	if len(existingSeries) == 0 {
 		// This should not happen, but if we somehow have no existing series for an insight, create one.
@@ -744,6 +752,9 @@ func existingSeriesHasChanged(new graphqlbackend.LineChartSearchInsightDataSerie
 	if new.Query != existing.Query {
 		return true
 	}
+	if isDependencyVersionsSeries(new.GeneratedFromDependencyVersions) != (existing.GenerationMethod == types.DependencyVersions) {
+		return true
+	}
 	if new.TimeScope.StepInterval.Unit != existing.SampleIntervalUnit {
 		return true
 	}
@@ -1315,8 +1326,16 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	var foundSeries bool
 	var err error
 	var dynamic bool
+	dependencyVersions := isDependencyVersionsSeries(series.GeneratedFromDependencyVersions)
 	// Validate the query before creating anything; we don't want faulty insights running pointlessly.
-	if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
+	if dependencyVersions {
+		if series.GroupBy != nil {
+			return errors.New("query validation: dependency versions series cannot be grouped")
+		}
+		if _, err := querybuilder.ParseDependencyQuery(series.Query); err != nil {
+			return errors.Wrap(err, "query validation")
+		}
+	} else if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
 		if _, err := querybuilder.ParseComputeQuery(series.Query, gitserver.NewClient()); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
@@ -1329,6 +1348,10 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	if series.GeneratedFromCaptureGroups != nil {
 		dynamic = *series.GeneratedFromCaptureGroups
 	}
+	if dependencyVersions {
+		// Every version of the package is its own series, just like every value of a capture group.
+		dynamic = true
+	}
 
 	groupBy := lowercaseGroupBy(series.GroupBy)
 	var nextRecordingAfter time.Time
@@ -1350,6 +1373,7 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 			StepIntervalValue:         int(series.TimeScope.StepInterval.Value),
 			GenerateFromCaptureGroups: dynamic,
 			GroupBy:                   groupBy,
+			GenerationMethod:          searchGenerationMethod(series),
 		})
 		if err != nil {
 			return errors.Wrap(err, "FindMatchingSeries")
@@ -1399,6 +1423,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 }
 
 func searchGenerationMethod(series graphqlbackend.LineChartSearchInsightDataSeriesInput) types.GenerationMethod {
+	if isDependencyVersionsSeries(series.GeneratedFromDependencyVersions) {
+		return types.DependencyVersions
+	}
 	if series.GeneratedFromCaptureGroups != nil && *series.GeneratedFromCaptureGroups {
 		if series.GroupBy != nil {
 			return types.MappingCompute
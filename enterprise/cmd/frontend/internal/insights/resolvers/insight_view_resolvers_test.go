@@ -485,3 +485,32 @@ func TestInsightRepoScopeResolver(t *testing.T) {
 	}
 
 }
+
+func TestDependencyVersionsSeriesInput(t *testing.T) {
+	yes := true
+	input := graphqlbackend.LineChartSearchInsightDataSeriesInput{
+		Query:                           "npm:react",
+		TimeScope:                       &graphqlbackend.TimeScopeInput{StepInterval: &graphqlbackend.TimeIntervalStepInput{Unit: string(types.Month), Value: 1}},
+		RepositoryScope:                 &graphqlbackend.RepositoryScopeInput{},
+		GeneratedFromDependencyVersions: &yes,
+	}
+
+	if got := searchGenerationMethod(input); got != types.DependencyVersions {
+		t.Errorf("unexpected generation method %q", got)
+	}
+
+	existing := types.InsightViewSeries{
+		Query:               "npm:react",
+		SampleIntervalUnit:  string(types.Month),
+		SampleIntervalValue: 1,
+		GenerationMethod:    types.DependencyVersions,
+	}
+	if existingSeriesHasChanged(input, existing) {
+		t.Error("expected an unchanged dependency versions series")
+	}
+
+	existing.GenerationMethod = types.Search
+	if !existingSeriesHasChanged(input, existing) {
+		t.Error("expected switching to dependency versions to change the series")
+	}
+}
@@ -16,10 +16,16 @@ func Init(
 	ctx context.Context,
 	observationCtx *observation.Context,
 	db database.DB,
-	_ codeintel.Services,
+	codeIntelServices codeintel.Services,
 	_ conftypes.UnifiedWatchable,
 	enterpriseServices *enterprise.Services,
 ) error {
-	enterpriseServices.NotebooksResolver = resolvers.NewResolver(db)
+	// The code insights database is initialized by the insights initializer, which must run
+	// before this one.
+	blockOutputs, err := resolvers.NewBlockOutputs(observationCtx, db, enterpriseServices.CodeInsightsDB, codeIntelServices)
+	if err != nil {
+		return err
+	}
+	enterpriseServices.NotebooksResolver = resolvers.NewResolver(db, blockOutputs)
 	return nil
 }
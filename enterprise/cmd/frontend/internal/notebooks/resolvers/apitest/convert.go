@@ -30,6 +30,22 @@ func BlockToAPIResponse(block notebooks.NotebookBlock) NotebookBlock {
 			SymbolContainerName: block.SymbolInput.SymbolContainerName,
 			SymbolKind:          block.SymbolInput.SymbolKind,
 		}}
+	case notebooks.NotebookReferencesBlockType:
+		return NotebookBlock{Typename: "ReferencesBlock", ID: block.ID, ReferencesInput: ReferencesInput{
+			RepositoryName: block.ReferencesInput.RepositoryName,
+			FilePath:       block.ReferencesInput.FilePath,
+			Revision:       block.ReferencesInput.Revision,
+			Line:           block.ReferencesInput.Line,
+			Character:      block.ReferencesInput.Character,
+			SymbolName:     block.ReferencesInput.SymbolName,
+		}}
+	case notebooks.NotebookInsightBlockType:
+		return NotebookBlock{Typename: "InsightBlock", ID: block.ID, InsightInput: InsightInput{
+			InsightViewID: block.InsightInput.InsightViewID,
+			SeriesID:      block.InsightInput.SeriesID,
+		}}
+	case notebooks.NotebookComputeBlockType:
+		return NotebookBlock{Typename: "ComputeBlock", ID: block.ID, ComputeInput: block.ComputeInput.Text}
 	}
 	panic("unknown block type")
 }
@@ -75,6 +91,22 @@ func BlockToAPIInput(block notebooks.NotebookBlock) graphqlbackend.CreateNoteboo
 			SymbolContainerName: block.SymbolInput.SymbolContainerName,
 			SymbolKind:          block.SymbolInput.SymbolKind,
 		}}
+	case notebooks.NotebookReferencesBlockType:
+		return graphqlbackend.CreateNotebookBlockInputArgs{ID: block.ID, Type: graphqlbackend.NotebookReferencesBlockType, ReferencesInput: &graphqlbackend.CreateReferencesBlockInput{
+			RepositoryName: block.ReferencesInput.RepositoryName,
+			FilePath:       block.ReferencesInput.FilePath,
+			Revision:       block.ReferencesInput.Revision,
+			Line:           block.ReferencesInput.Line,
+			Character:      block.ReferencesInput.Character,
+			SymbolName:     block.ReferencesInput.SymbolName,
+		}}
+	case notebooks.NotebookInsightBlockType:
+		return graphqlbackend.CreateNotebookBlockInputArgs{ID: block.ID, Type: graphqlbackend.NotebookInsightBlockType, InsightInput: &graphqlbackend.CreateInsightBlockInput{
+			InsightViewId: graphql.ID(block.InsightInput.InsightViewID),
+			SeriesId:      block.InsightInput.SeriesID,
+		}}
+	case notebooks.NotebookComputeBlockType:
+		return graphqlbackend.CreateNotebookBlockInputArgs{ID: block.ID, Type: graphqlbackend.NotebookComputeBlockType, ComputeInput: &block.ComputeInput.Text}
 	}
 	panic("unknown block type")
 }
@@ -23,6 +23,10 @@ type NotebookBlock struct {
 	QueryInput    string
 	FileInput     FileInput
 	SymbolInput   SymbolInput
+
+	ReferencesInput ReferencesInput
+	InsightInput    InsightInput
+	ComputeInput    string
 }
 
 type FileInput struct {
@@ -42,6 +46,20 @@ type SymbolInput struct {
 	SymbolKind          string
 }
 
+type ReferencesInput struct {
+	RepositoryName string
+	FilePath       string
+	Revision       *string
+	Line           int32
+	Character      int32
+	SymbolName     string
+}
+
+type InsightInput struct {
+	InsightViewID string
+	SeriesID      string
+}
+
 type LineRange struct {
 	StartLine int32
 	EndLine   int32
@@ -0,0 +1,219 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	computeresolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/compute/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/insights"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/notebooks"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// maxReferences is the maximum number of references included in the output of a references block.
+	maxReferences = 100
+	// maxComputeOutputs is the maximum number of outputs included in the output of a compute block.
+	maxComputeOutputs = 100
+
+	hunkCacheSize                  = 1000
+	maximumIndexesPerMonikerSearch = 500
+)
+
+type blockOutputs struct {
+	db              database.DB
+	insightsDB      database.InsightsDB
+	logger          log.Logger
+	codenavSvc      *codenav.Service
+	gitserverClient gitserver.Client
+	hunkCache       codenav.HunkCache
+}
+
+// NewBlockOutputs returns the block outputs used to render references blocks with precise code
+// navigation, insight blocks with the series points of code insights and compute blocks with the
+// compute API. The code insights database is nil if code insights are disabled.
+func NewBlockOutputs(observationCtx *observation.Context, db database.DB, insightsDB database.InsightsDB, codeIntelServices codeintel.Services) (notebooks.BlockOutputs, error) {
+	hunkCache, err := codenav.NewHunkCache(hunkCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &blockOutputs{
+		db:              db,
+		insightsDB:      insightsDB,
+		logger:          observationCtx.Logger.Scoped("notebookBlockOutputs", "computes the output of notebook blocks"),
+		codenavSvc:      codeIntelServices.CodenavService,
+		gitserverClient: codeIntelServices.GitserverClient,
+		hunkCache:       hunkCache,
+	}, nil
+}
+
+func (o *blockOutputs) References(ctx context.Context, input notebooks.NotebookReferencesBlockInput) ([]notebooks.ReferenceLocation, error) {
+	// 🚨 SECURITY: The repository store only returns repositories the current user has access to.
+	repo, err := o.db.Repos().GetByName(ctx, api.RepoName(input.RepositoryName))
+	if err != nil {
+		return nil, err
+	}
+
+	revision := ""
+	if input.Revision != nil {
+		revision = *input.Revision
+	}
+	commit, err := o.gitserverClient.ResolveRevision(ctx, repo.Name, revision, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	uploads, err := o.codenavSvc.GetClosestDumpsForBlob(ctx, int(repo.ID), string(commit), input.FilePath, true, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(uploads) == 0 {
+		return nil, errors.New("no precise code navigation data is available for the file")
+	}
+
+	requestState := codenav.NewRequestState(
+		uploads,
+		o.db.Repos(),
+		authz.DefaultSubRepoPermsChecker,
+		o.gitserverClient,
+		repo,
+		string(commit),
+		input.FilePath,
+		maximumIndexesPerMonikerSearch,
+		o.hunkCache,
+	)
+	args := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: int(repo.ID),
+			Commit:       string(commit),
+		},
+		Path:      input.FilePath,
+		Line:      int(input.Line),
+		Character: int(input.Character),
+	}
+
+	// Page through the local references of the uploads and then the remote references found
+	// via moniker search, as the references resolver does, until the output is full.
+	var locations []notebooks.ReferenceLocation
+	cursor := codenav.ReferencesCursor{Phase: "local"}
+	for len(locations) < maxReferences && cursor.Phase != "done" {
+		args.Limit = maxReferences - len(locations)
+		uploadLocations, nextCursor, err := o.codenavSvc.GetReferences(ctx, args, requestState, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if len(uploadLocations) == 0 {
+			break
+		}
+
+		for _, location := range uploadLocations {
+			locations = append(locations, notebooks.ReferenceLocation{
+				RepositoryName: location.Dump.RepositoryName,
+				Commit:         location.TargetCommit,
+				FilePath:       location.Path,
+				Line:           int32(location.TargetRange.Start.Line),
+			})
+		}
+		cursor = nextCursor
+	}
+	if len(locations) > maxReferences {
+		locations = locations[:maxReferences]
+	}
+	return locations, nil
+}
+
+func (o *blockOutputs) InsightSeries(ctx context.Context, input notebooks.NotebookInsightBlockInput) (*notebooks.InsightSeries, error) {
+	if !insights.IsEnabled() {
+		return nil, errors.New("code insights are disabled")
+	}
+	if err := licensing.Check(licensing.FeatureCodeInsights); err != nil {
+		return nil, err
+	}
+	if o.insightsDB == nil {
+		return nil, errors.New("the code insights database is not available")
+	}
+
+	var insightViewID string
+	if err := relay.UnmarshalSpec(graphql.ID(input.InsightViewID), &insightViewID); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal insight view ID")
+	}
+
+	permStore := store.NewInsightPermissionStore(o.db)
+	userIDs, orgIDs, err := permStore.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 🚨 SECURITY: Only the insight views visible to the current user are returned here, and the
+	// series points below only include the repositories they can see.
+	viewSeries, err := store.NewInsightStore(o.insightsDB).GetAll(ctx, store.InsightQueryArgs{
+		UniqueIDs: []string{insightViewID},
+		UserIDs:   userIDs,
+		OrgIDs:    orgIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, series := range viewSeries {
+		if series.SeriesID != input.SeriesID {
+			continue
+		}
+
+		include, exclude, err := store.NewSearchContextHandler(o.db).DefaultRepoFilters(ctx, series)
+		if err != nil {
+			return nil, err
+		}
+		points, err := store.New(o.insightsDB, permStore).SeriesPoints(ctx, store.SeriesPointsOpts{
+			SeriesID:         &series.SeriesID,
+			IncludeRepoRegex: include,
+			ExcludeRepoRegex: exclude,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		seriesPoints := make([]notebooks.InsightSeriesPoint, 0, len(points))
+		for _, point := range points {
+			seriesPoints = append(seriesPoints, notebooks.InsightSeriesPoint{Time: point.Time, Value: point.Value, Capture: point.Capture})
+		}
+		return &notebooks.InsightSeries{InsightTitle: series.Title, SeriesLabel: series.Label, Points: seriesPoints}, nil
+	}
+	return nil, errors.New("insight series not found")
+}
+
+func (o *blockOutputs) ComputeOutput(ctx context.Context, input notebooks.NotebookComputeBlockInput) ([]string, error) {
+	results, err := computeresolvers.NewBatchComputeImplementer(ctx, o.logger, o.db, &graphqlbackend.ComputeArgs{Query: input.Text})
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []string
+	for _, result := range results {
+		if matchContext, ok := result.ToComputeMatchContext(); ok {
+			for _, match := range matchContext.Matches() {
+				outputs = append(outputs, match.Value())
+			}
+		}
+		if text, ok := result.ToComputeText(); ok {
+			outputs = append(outputs, text.Value())
+		}
+		if len(outputs) >= maxComputeOutputs {
+			return outputs[:maxComputeOutputs], nil
+		}
+	}
+	return outputs, nil
+}
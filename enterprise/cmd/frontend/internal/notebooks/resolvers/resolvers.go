@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
@@ -16,12 +17,15 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func NewResolver(db database.DB) graphqlbackend.NotebooksResolver {
-	return &Resolver{db: db}
+// NewResolver returns a new notebooks resolver. The block outputs are used to include the output of
+// references, insight and compute blocks in the rendered Markdown of notebooks, and may be nil.
+func NewResolver(db database.DB, blockOutputs notebooks.BlockOutputs) graphqlbackend.NotebooksResolver {
+	return &Resolver{db: db, blockOutputs: blockOutputs}
 }
 
 type Resolver struct {
-	db database.DB
+	db           database.DB
+	blockOutputs notebooks.BlockOutputs
 }
 
 func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
@@ -29,6 +33,9 @@ func (r *Resolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 		"Notebook": func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
 			return r.NotebookByID(ctx, id)
 		},
+		"NotebookRevision": func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			return r.NotebookRevisionByID(ctx, id)
+		},
 	}
 }
 
@@ -58,7 +65,7 @@ func (r *Resolver) NotebookByID(ctx context.Context, id graphql.ID) (graphqlback
 		return nil, err
 	}
 
-	return &notebookResolver{notebook, r.db}, nil
+	return &notebookResolver{notebook, r.db, r.blockOutputs}, nil
 }
 
 func convertLineRangeInput(inputLineRage *graphqlbackend.CreateFileBlockLineRangeInput) *notebooks.LineRange {
@@ -108,6 +115,34 @@ func convertNotebookBlockInput(inputBlock graphqlbackend.CreateNotebookBlockInpu
 			SymbolContainerName: inputBlock.SymbolInput.SymbolContainerName,
 			SymbolKind:          inputBlock.SymbolInput.SymbolKind,
 		}
+	case graphqlbackend.NotebookReferencesBlockType:
+		if inputBlock.ReferencesInput == nil {
+			return nil, errors.Errorf("references block with id %s is missing input", inputBlock.ID)
+		}
+		block.Type = notebooks.NotebookReferencesBlockType
+		block.ReferencesInput = &notebooks.NotebookReferencesBlockInput{
+			RepositoryName: inputBlock.ReferencesInput.RepositoryName,
+			FilePath:       inputBlock.ReferencesInput.FilePath,
+			Revision:       inputBlock.ReferencesInput.Revision,
+			Line:           inputBlock.ReferencesInput.Line,
+			Character:      inputBlock.ReferencesInput.Character,
+			SymbolName:     inputBlock.ReferencesInput.SymbolName,
+		}
+	case graphqlbackend.NotebookInsightBlockType:
+		if inputBlock.InsightInput == nil {
+			return nil, errors.Errorf("insight block with id %s is missing input", inputBlock.ID)
+		}
+		block.Type = notebooks.NotebookInsightBlockType
+		block.InsightInput = &notebooks.NotebookInsightBlockInput{
+			InsightViewID: string(inputBlock.InsightInput.InsightViewId),
+			SeriesID:      inputBlock.InsightInput.SeriesId,
+		}
+	case graphqlbackend.NotebookComputeBlockType:
+		if inputBlock.ComputeInput == nil {
+			return nil, errors.Errorf("compute block with id %s is missing input", inputBlock.ID)
+		}
+		block.Type = notebooks.NotebookComputeBlockType
+		block.ComputeInput = &notebooks.NotebookComputeBlockInput{Text: *inputBlock.ComputeInput}
 	default:
 		return nil, errors.Newf("invalid block type: %s", inputBlock.Type)
 	}
@@ -150,7 +185,41 @@ func (r *Resolver) CreateNotebook(ctx context.Context, args graphqlbackend.Creat
 	if err != nil {
 		return nil, err
 	}
-	return &notebookResolver{createdNotebook, r.db}, nil
+	return &notebookResolver{createdNotebook, r.db, r.blockOutputs}, nil
+}
+
+func (r *Resolver) ImportNotebook(ctx context.Context, args graphqlbackend.ImportNotebookInputArgs) (graphqlbackend.NotebookResolver, error) {
+	user, err := r.db.Users().GetByCurrentAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := notebooks.ImportMarkdown(args.Notebook.Markdown)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook := &notebooks.Notebook{
+		Title:         args.Notebook.Title,
+		Public:        args.Notebook.Public,
+		CreatorUserID: user.ID,
+		UpdaterUserID: user.ID,
+		Blocks:        blocks,
+	}
+	err = graphqlbackend.UnmarshalNamespaceID(args.Notebook.Namespace, &notebook.NamespaceUserID, &notebook.NamespaceOrgID)
+	if err != nil {
+		return nil, err
+	}
+	err = validateNotebookWritePermissionsForUser(ctx, r.db, notebook, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdNotebook, err := notebooks.Notebooks(r.db).CreateNotebook(ctx, notebook)
+	if err != nil {
+		return nil, err
+	}
+	return &notebookResolver{createdNotebook, r.db, r.blockOutputs}, nil
 }
 
 func (r *Resolver) UpdateNotebook(ctx context.Context, args graphqlbackend.UpdateNotebookInputArgs) (graphqlbackend.NotebookResolver, error) {
@@ -206,7 +275,7 @@ func (r *Resolver) UpdateNotebook(ctx context.Context, args graphqlbackend.Updat
 	if err != nil {
 		return nil, err
 	}
-	return &notebookResolver{updatedNotebook, r.db}, nil
+	return &notebookResolver{updatedNotebook, r.db, r.blockOutputs}, nil
 }
 
 func (r *Resolver) DeleteNotebook(ctx context.Context, args graphqlbackend.DeleteNotebookArgs) (*graphqlbackend.EmptyResponse, error) {
@@ -334,7 +403,7 @@ func (r *Resolver) Notebooks(ctx context.Context, args graphqlbackend.ListNotebo
 func (r *Resolver) notebooksToResolvers(notebooks []*notebooks.Notebook) []graphqlbackend.NotebookResolver {
 	notebookResolvers := make([]graphqlbackend.NotebookResolver, len(notebooks))
 	for idx, notebook := range notebooks {
-		notebookResolvers[idx] = &notebookResolver{notebook, r.db}
+		notebookResolvers[idx] = &notebookResolver{notebook, r.db, r.blockOutputs}
 	}
 	return notebookResolvers
 }
@@ -363,8 +432,9 @@ func (n *notebookConnectionResolver) PageInfo(ctx context.Context) *graphqlutil.
 }
 
 type notebookResolver struct {
-	notebook *notebooks.Notebook
-	db       database.DB
+	notebook     *notebooks.Notebook
+	db           database.DB
+	blockOutputs notebooks.BlockOutputs
 }
 
 func (r *notebookResolver) ID() graphql.ID {
@@ -383,6 +453,14 @@ func (r *notebookResolver) Blocks(ctx context.Context) []graphqlbackend.Notebook
 	return blockResolvers
 }
 
+func (r *notebookResolver) RenderedMarkdown(ctx context.Context) (string, error) {
+	return notebooks.RenderMarkdown(ctx, r.notebook.Blocks, conf.ExternalURL(), r.blockOutputs), nil
+}
+
+func (r *notebookResolver) Markdown(ctx context.Context) string {
+	return notebooks.ExportMarkdown(r.notebook.Blocks, conf.ExternalURL())
+}
+
 func (r *notebookResolver) Creator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
 	if r.notebook.CreatorUserID == 0 {
 		return nil, nil
@@ -513,6 +591,27 @@ func (r *notebookBlockResolver) ToSymbolBlock() (graphqlbackend.SymbolBlockResol
 	return nil, false
 }
 
+func (r *notebookBlockResolver) ToReferencesBlock() (graphqlbackend.ReferencesBlockResolver, bool) {
+	if r.block.Type == notebooks.NotebookReferencesBlockType {
+		return &referencesBlockResolver{r.block}, true
+	}
+	return nil, false
+}
+
+func (r *notebookBlockResolver) ToInsightBlock() (graphqlbackend.InsightBlockResolver, bool) {
+	if r.block.Type == notebooks.NotebookInsightBlockType {
+		return &insightBlockResolver{r.block}, true
+	}
+	return nil, false
+}
+
+func (r *notebookBlockResolver) ToComputeBlock() (graphqlbackend.ComputeBlockResolver, bool) {
+	if r.block.Type == notebooks.NotebookComputeBlockType {
+		return &computeBlockResolver{r.block}, true
+	}
+	return nil, false
+}
+
 type markdownBlockResolver struct {
 	// block.type == NotebookMarkdownBlockType
 	block notebooks.NotebookBlock
@@ -631,3 +730,82 @@ func (r *symbolBlockInputResolver) SymbolContainerName() string {
 func (r *symbolBlockInputResolver) SymbolKind() string {
 	return r.input.SymbolKind
 }
+
+type referencesBlockResolver struct {
+	// block.type == NotebookReferencesBlockType
+	block notebooks.NotebookBlock
+}
+
+func (r *referencesBlockResolver) ID() string {
+	return r.block.ID
+}
+
+func (r *referencesBlockResolver) ReferencesInput() graphqlbackend.ReferencesBlockInputResolver {
+	return &referencesBlockInputResolver{*r.block.ReferencesInput}
+}
+
+type referencesBlockInputResolver struct {
+	input notebooks.NotebookReferencesBlockInput
+}
+
+func (r *referencesBlockInputResolver) RepositoryName() string {
+	return r.input.RepositoryName
+}
+
+func (r *referencesBlockInputResolver) FilePath() string {
+	return r.input.FilePath
+}
+
+func (r *referencesBlockInputResolver) Revision() *string {
+	return r.input.Revision
+}
+
+func (r *referencesBlockInputResolver) Line() int32 {
+	return r.input.Line
+}
+
+func (r *referencesBlockInputResolver) Character() int32 {
+	return r.input.Character
+}
+
+func (r *referencesBlockInputResolver) SymbolName() string {
+	return r.input.SymbolName
+}
+
+type insightBlockResolver struct {
+	// block.type == NotebookInsightBlockType
+	block notebooks.NotebookBlock
+}
+
+func (r *insightBlockResolver) ID() string {
+	return r.block.ID
+}
+
+func (r *insightBlockResolver) InsightInput() graphqlbackend.InsightBlockInputResolver {
+	return &insightBlockInputResolver{*r.block.InsightInput}
+}
+
+type insightBlockInputResolver struct {
+	input notebooks.NotebookInsightBlockInput
+}
+
+func (r *insightBlockInputResolver) InsightViewId() graphql.ID {
+	return graphql.ID(r.input.InsightViewID)
+}
+
+func (r *insightBlockInputResolver) SeriesId() string {
+	return r.input.SeriesID
+}
+
+type computeBlockResolver struct {
+	// block.type == NotebookComputeBlockType
+	block notebooks.NotebookBlock
+}
+
+func (r *computeBlockResolver) ID() string {
+	return r.block.ID
+}
+
+func (r *computeBlockResolver) ComputeInput() string {
+	return r.block.ComputeInput.Text
+}
@@ -73,6 +73,31 @@ const notebookFields = `
 				symbolKind
 			}
 		}
+		... on ReferencesBlock {
+			__typename
+			id
+			referencesInput {
+				repositoryName
+				filePath
+				revision
+				line
+				character
+				symbolName
+			}
+		}
+		... on InsightBlock {
+			__typename
+			id
+			insightInput {
+				insightViewId
+				seriesId
+			}
+		}
+		... on ComputeBlock {
+			__typename
+			id
+			computeInput
+		}
 	}
 `
 
@@ -145,6 +170,19 @@ func notebookFixture(creatorID int32, namespaceUserID int32, namespaceOrgID int3
 			SymbolContainerName: "container",
 			SymbolKind:          "FUNCTION",
 		}},
+		{ID: "5", Type: notebooks.NotebookReferencesBlockType, ReferencesInput: &notebooks.NotebookReferencesBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph",
+			FilePath:       "client/web/file.tsx",
+			Revision:       &revision,
+			Line:           10,
+			Character:      4,
+			SymbolName:     "function",
+		}},
+		{ID: "6", Type: notebooks.NotebookInsightBlockType, InsightInput: &notebooks.NotebookInsightBlockInput{
+			InsightViewID: "aW5zaWdodF92aWV3OiIyN2I3ZDcyZCI=",
+			SeriesID:      "series-1",
+		}},
+		{ID: "7", Type: notebooks.NotebookComputeBlockType, ComputeInput: &notebooks.NotebookComputeBlockInput{Text: "content:output(func (\\w+) -> $1)"}},
 	}
 	return &notebooks.Notebook{Title: "Notebook Title", Blocks: blocks, Public: public, CreatorUserID: creatorID, UpdaterUserID: creatorID, NamespaceUserID: namespaceUserID, NamespaceOrgID: namespaceOrgID}
 }
@@ -199,7 +237,7 @@ func TestSingleNotebookCRUD(t *testing.T) {
 		t.Fatalf("Expected no error, got %s", err)
 	}
 
-	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db))
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db, nil))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -592,7 +630,7 @@ func TestListNotebooks(t *testing.T) {
 		return ids
 	}
 
-	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db))
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db, nil))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -729,7 +767,7 @@ func TestGetNotebookWithSoftDeletedUserColumns(t *testing.T) {
 		t.Fatalf("Expected no error, got %s", err)
 	}
 
-	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db))
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db, nil))
 	if err != nil {
 		t.Fatal(err)
 	}
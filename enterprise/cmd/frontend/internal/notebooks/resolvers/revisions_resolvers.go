@@ -0,0 +1,244 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/notebooks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const notebookRevisionIDKind = "NotebookRevision"
+
+func marshalNotebookRevisionID(revisionID int64) graphql.ID {
+	return relay.MarshalID(notebookRevisionIDKind, revisionID)
+}
+
+func unmarshalNotebookRevisionID(id graphql.ID) (revisionID int64, err error) {
+	if kind := relay.UnmarshalKind(id); kind != notebookRevisionIDKind {
+		err = errors.Errorf("expected graphql ID to have kind %q; got %q", notebookRevisionIDKind, kind)
+		return
+	}
+	err = relay.UnmarshalSpec(id, &revisionID)
+	return
+}
+
+func marshalNotebookRevisionCursor(cursor int64) string {
+	return string(relay.MarshalID("NotebookRevisionCursor", cursor))
+}
+
+func unmarshalNotebookRevisionCursor(cursor *string) (int64, error) {
+	if cursor == nil {
+		return 0, nil
+	}
+	var after int64
+	err := relay.UnmarshalSpec(graphql.ID(*cursor), &after)
+	if err != nil {
+		return -1, err
+	}
+	return after, nil
+}
+
+// notebookRevisionByID returns the notebook revision with the given ID, along with its notebook.
+func (r *Resolver) notebookRevisionByID(ctx context.Context, id graphql.ID) (*notebooks.NotebookRevision, *notebooks.Notebook, error) {
+	revisionID, err := unmarshalNotebookRevisionID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store := notebooks.Notebooks(r.db)
+	revision, err := store.GetNotebookRevision(ctx, revisionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 🚨 SECURITY: Ensure the user has access to the notebook of the revision.
+	notebook, err := store.GetNotebook(ctx, revision.NotebookID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return revision, notebook, nil
+}
+
+func (r *Resolver) NotebookRevisionByID(ctx context.Context, id graphql.ID) (graphqlbackend.NotebookRevisionResolver, error) {
+	revision, notebook, err := r.notebookRevisionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &notebookRevisionResolver{revision, &notebookResolver{notebook, r.db, r.blockOutputs}}, nil
+}
+
+func (r *Resolver) RestoreNotebookRevision(ctx context.Context, args graphqlbackend.RestoreNotebookRevisionArgs) (graphqlbackend.NotebookResolver, error) {
+	user, err := r.db.Users().GetByCurrentAuthUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, notebook, err := r.notebookRevisionByID(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateNotebookWritePermissionsForUser(ctx, r.db, notebook, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook.Title = revision.Title
+	notebook.Blocks = revision.Blocks
+	notebook.UpdaterUserID = user.ID
+	updatedNotebook, err := notebooks.Notebooks(r.db).UpdateNotebook(ctx, notebook)
+	if err != nil {
+		return nil, err
+	}
+	return &notebookResolver{updatedNotebook, r.db, r.blockOutputs}, nil
+}
+
+type notebookRevisionConnectionResolver struct {
+	afterCursor int64
+	revisions   []graphqlbackend.NotebookRevisionResolver
+	totalCount  int32
+	hasNextPage bool
+}
+
+func (n *notebookRevisionConnectionResolver) Nodes() []graphqlbackend.NotebookRevisionResolver {
+	return n.revisions
+}
+
+func (n *notebookRevisionConnectionResolver) TotalCount() int32 {
+	return n.totalCount
+}
+
+func (n *notebookRevisionConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	if len(n.revisions) == 0 || !n.hasNextPage {
+		return graphqlutil.HasNextPage(false)
+	}
+	// The after value (offset) for the next page is computed from the current after value + the number of retrieved notebook revisions
+	return graphqlutil.NextPageCursor(marshalNotebookRevisionCursor(n.afterCursor + int64(len(n.revisions))))
+}
+
+type notebookRevisionResolver struct {
+	revision *notebooks.NotebookRevision
+	notebook *notebookResolver
+}
+
+func (r *notebookRevisionResolver) ID() graphql.ID {
+	return marshalNotebookRevisionID(r.revision.ID)
+}
+
+func (r *notebookRevisionResolver) Notebook(ctx context.Context) (graphqlbackend.NotebookResolver, error) {
+	return r.notebook, nil
+}
+
+func (r *notebookRevisionResolver) Title() string {
+	return r.revision.Title
+}
+
+func (r *notebookRevisionResolver) Blocks() []graphqlbackend.NotebookBlockResolver {
+	blockResolvers := make([]graphqlbackend.NotebookBlockResolver, 0, len(r.revision.Blocks))
+	for _, block := range r.revision.Blocks {
+		blockResolvers = append(blockResolvers, &notebookBlockResolver{block})
+	}
+	return blockResolvers
+}
+
+func (r *notebookRevisionResolver) Markdown() string {
+	return notebooks.ExportMarkdown(r.revision.Blocks, conf.ExternalURL())
+}
+
+func (r *notebookRevisionResolver) Author(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	if r.revision.AuthorUserID == 0 {
+		return nil, nil
+	}
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.notebook.db, r.revision.AuthorUserID)
+	if err != nil {
+		// Handle soft-deleted users
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *notebookRevisionResolver) Diff(ctx context.Context) (string, error) {
+	prevName, prevMarkdown := "/dev/null", ""
+	previous, err := notebooks.Notebooks(r.notebook.db).GetPreviousNotebookRevision(ctx, r.revision)
+	if err != nil && !errors.Is(err, notebooks.ErrNotebookRevisionNotFound) {
+		return "", err
+	}
+	if previous != nil {
+		prevName, prevMarkdown = revisionFileName(previous), exportRevisionMarkdown(previous)
+	}
+
+	markdown := exportRevisionMarkdown(r.revision)
+	edits := myers.ComputeEdits("", prevMarkdown, markdown)
+	return fmt.Sprint(gotextdiff.ToUnified(prevName, revisionFileName(r.revision), prevMarkdown, edits)), nil
+}
+
+func (r *notebookRevisionResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.revision.CreatedAt}
+}
+
+// exportRevisionMarkdown exports a revision as Markdown for diffing. The title is included as a
+// heading, so that renaming the notebook shows up in the diff.
+func exportRevisionMarkdown(revision *notebooks.NotebookRevision) string {
+	return "# " + revision.Title + "\n\n" + notebooks.ExportMarkdown(revision.Blocks, conf.ExternalURL())
+}
+
+func revisionFileName(revision *notebooks.NotebookRevision) string {
+	return fmt.Sprintf("notebook-revision-%d.md", revision.ID)
+}
+
+func (r *notebookResolver) notebookRevisionsToResolvers(revisions []*notebooks.NotebookRevision) []graphqlbackend.NotebookRevisionResolver {
+	revisionResolvers := make([]graphqlbackend.NotebookRevisionResolver, len(revisions))
+	for idx, revision := range revisions {
+		revisionResolvers[idx] = &notebookRevisionResolver{revision, r}
+	}
+	return revisionResolvers
+}
+
+func (r *notebookResolver) Revisions(ctx context.Context, args graphqlbackend.ListNotebookRevisionsArgs) (graphqlbackend.NotebookRevisionConnectionResolver, error) {
+	// Request one extra to determine if there are more pages
+	newArgs := args
+	newArgs.First += 1
+
+	afterCursor, err := unmarshalNotebookRevisionCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	pageOpts := notebooks.ListNotebookRevisionsPageOptions{First: newArgs.First, After: afterCursor}
+	store := notebooks.Notebooks(r.db)
+	revisions, err := store.ListNotebookRevisions(ctx, pageOpts, r.notebook.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := store.CountNotebookRevisions(ctx, r.notebook.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := false
+	if len(revisions) == int(args.First)+1 {
+		hasNextPage = true
+		revisions = revisions[:len(revisions)-1]
+	}
+
+	return &notebookRevisionConnectionResolver{
+		afterCursor: afterCursor,
+		revisions:   r.notebookRevisionsToResolvers(revisions),
+		totalCount:  int32(count),
+		hasNextPage: hasNextPage,
+	}, nil
+}
@@ -0,0 +1,220 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/batches/resolvers/apitest"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/notebooks"
+)
+
+const listNotebookRevisionsQuery = `
+query NotebookRevisions($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		... on Notebook {
+			revisions(first: $first, after: $after) {
+				nodes {
+					id
+					title
+					author {
+						username
+					}
+					diff
+				}
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+				totalCount
+			}
+		}
+	}
+}
+`
+
+const restoreNotebookRevisionMutation = `
+mutation RestoreNotebookRevision($id: ID!) {
+	restoreNotebookRevision(id: $id) {
+		title
+		markdown
+	}
+}
+`
+
+const importNotebookMutation = `
+mutation ImportNotebook($notebook: ImportNotebookInput!) {
+	importNotebook(notebook: $notebook) {
+		title
+		markdown
+		blocks {
+			__typename
+		}
+	}
+}
+`
+
+type notebookRevisionsResponse struct {
+	Node struct {
+		Revisions struct {
+			Nodes []struct {
+				ID     string
+				Title  string
+				Author struct{ Username string }
+				Diff   string
+			}
+			PageInfo   apitest.PageInfo
+			TotalCount int32
+		}
+	}
+}
+
+func TestNotebookRevisions(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	internalCtx := actor.WithInternalActor(context.Background())
+	u := db.Users()
+
+	user1, err := u.Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	user2, err := u.Create(internalCtx, database.NewUser{Username: "u2", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	notebook := createNotebooks(t, db, []*notebooks.Notebook{userNotebookFixture(user1.ID, true)})[0]
+	notebook.Title = "Updated Title"
+	notebook.Blocks = notebook.Blocks[:1]
+	if _, err := notebooks.Notebooks(db).UpdateNotebook(internalCtx, notebook); err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user1Ctx := actor.WithActor(context.Background(), actor.FromUser(user1.ID))
+	listRevisions := func(t *testing.T, first int, after *string) notebookRevisionsResponse {
+		t.Helper()
+		var response notebookRevisionsResponse
+		input := map[string]any{"id": marshalNotebookID(notebook.ID), "first": first, "after": after}
+		apitest.MustExec(user1Ctx, t, schema, input, &response, listNotebookRevisionsQuery)
+		return response
+	}
+
+	t.Run("list revisions", func(t *testing.T) {
+		response := listRevisions(t, 1, nil)
+		revisions := response.Node.Revisions
+		if revisions.TotalCount != 2 || !revisions.PageInfo.HasNextPage || len(revisions.Nodes) != 1 {
+			t.Fatalf("unexpected revisions %+v", revisions)
+		}
+		latest := revisions.Nodes[0]
+		if latest.Title != "Updated Title" || latest.Author.Username != user1.Username {
+			t.Fatalf("unexpected latest revision %+v", latest)
+		}
+		if !strings.Contains(latest.Diff, "-# Notebook Title\n") || !strings.Contains(latest.Diff, "+# Updated Title\n") {
+			t.Fatalf("expected diff to contain the title change, got:\n%s", latest.Diff)
+		}
+
+		response = listRevisions(t, 1, revisions.PageInfo.EndCursor)
+		revisions = response.Node.Revisions
+		if revisions.PageInfo.HasNextPage || len(revisions.Nodes) != 1 {
+			t.Fatalf("unexpected revisions %+v", revisions)
+		}
+		if first := revisions.Nodes[0]; !strings.HasPrefix(first.Diff, "--- /dev/null\n") {
+			t.Fatalf("expected first revision to be diffed against an empty document, got:\n%s", first.Diff)
+		}
+	})
+
+	t.Run("restore revision", func(t *testing.T) {
+		firstRevisionID := listRevisions(t, 2, nil).Node.Revisions.Nodes[1].ID
+		input := map[string]any{"id": firstRevisionID}
+
+		var response struct {
+			RestoreNotebookRevision struct {
+				Title    string
+				Markdown string
+			}
+		}
+		errs := apitest.Exec(actor.WithActor(context.Background(), actor.FromUser(user2.ID)), t, schema, input, &response, restoreNotebookRevisionMutation)
+		if len(errs) == 0 || !strings.Contains(errs[0].Message, "user does not match the notebook user namespace") {
+			t.Fatalf("expected user2 to be unable to restore the revision, got %v", errs)
+		}
+
+		apitest.MustExec(user1Ctx, t, schema, input, &response, restoreNotebookRevisionMutation)
+		fixture := userNotebookFixture(user1.ID, true)
+		if response.RestoreNotebookRevision.Title != fixture.Title {
+			t.Fatalf("wanted restored title %q, got %q", fixture.Title, response.RestoreNotebookRevision.Title)
+		}
+		if diff := cmp.Diff(notebooks.ExportMarkdown(fixture.Blocks, conf.ExternalURL()), response.RestoreNotebookRevision.Markdown); diff != "" {
+			t.Fatalf("unexpected restored markdown (-want +got):\n%s", diff)
+		}
+
+		// Restoring creates a new revision.
+		if got := listRevisions(t, 10, nil).Node.Revisions.TotalCount; got != 3 {
+			t.Fatalf("wanted 3 revisions, got %d", got)
+		}
+	})
+}
+
+func TestImportNotebook(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	internalCtx := actor.WithInternalActor(context.Background())
+
+	user, err := db.Users().Create(internalCtx, database.NewUser{Username: "u", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	schema, err := graphqlbackend.NewSchemaWithNotebooksResolver(db, NewResolver(db, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	markdown := notebooks.ExportMarkdown(userNotebookFixture(user.ID, true).Blocks, conf.ExternalURL())
+	input := map[string]any{"notebook": map[string]any{
+		"title":     "Imported",
+		"markdown":  markdown,
+		"namespace": graphqlbackend.MarshalUserID(user.ID),
+		"public":    false,
+	}}
+	var response struct {
+		ImportNotebook struct {
+			Title    string
+			Markdown string
+			Blocks   []struct {
+				Typename string `json:"__typename"`
+			}
+		}
+	}
+	apitest.MustExec(actor.WithActor(context.Background(), actor.FromUser(user.ID)), t, schema, input, &response, importNotebookMutation)
+
+	if response.ImportNotebook.Title != "Imported" {
+		t.Fatalf("wanted title %q, got %q", "Imported", response.ImportNotebook.Title)
+	}
+	gotTypes := make([]string, 0, len(response.ImportNotebook.Blocks))
+	for _, block := range response.ImportNotebook.Blocks {
+		gotTypes = append(gotTypes, block.Typename)
+	}
+	wantTypes := []string{"QueryBlock", "MarkdownBlock", "FileBlock", "SymbolBlock", "ReferencesBlock", "InsightBlock", "ComputeBlock"}
+	if diff := cmp.Diff(wantTypes, gotTypes); diff != "" {
+		t.Fatalf("unexpected block types (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(markdown, response.ImportNotebook.Markdown); diff != "" {
+		t.Fatalf("unexpected markdown (-want +got):\n%s", diff)
+	}
+}
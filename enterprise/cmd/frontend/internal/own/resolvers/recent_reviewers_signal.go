@@ -0,0 +1,52 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func computeRecentReviewerSignals(ctx context.Context, db database.DB, path string, repoID api.RepoID) ([]reasonAndReference, error) {
+	enabled, err := db.OwnSignalConfigurations().IsEnabled(ctx, types.SignalRecentReviewers)
+	if err != nil {
+		return nil, errors.Wrap(err, "IsEnabled")
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	recentReviewers, err := db.RecentReviewSignals().FindRecentReviewers(ctx, repoID, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindRecentReviewers")
+	}
+
+	var rrs []reasonAndReference
+	for _, r := range recentReviewers {
+		rrs = append(rrs, reasonAndReference{
+			reason: ownershipReason{recentReviewsCount: r.ReviewCount},
+			reference: own.Reference{
+				// Reviewers are known by their code host handle, the email
+				// is only available on some code hosts.
+				Handle: r.ReviewerHandle,
+				Email:  r.ReviewerEmail,
+			},
+		})
+	}
+	return rrs, nil
+}
+
+type recentReviewerOwnershipSignal struct {
+	total int32
+}
+
+func (g *recentReviewerOwnershipSignal) Title() (string, error) {
+	return "recent reviewer", nil
+}
+
+func (g *recentReviewerOwnershipSignal) Description() (string, error) {
+	return "Associated because they have approved changes to this file in the last 90 days.", nil
+}
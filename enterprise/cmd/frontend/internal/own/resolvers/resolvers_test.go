@@ -83,6 +83,10 @@ func (s fakeOwnService) AssignedTeams(context.Context, api.RepoID, api.CommitID)
 	return s.Teams, nil
 }
 
+func (s fakeOwnService) RecentReviewers(context.Context, api.RepoID) (own.RecentReviewers, error) {
+	return nil, nil
+}
+
 // fakeGitServer is a limited gitserver.Client that returns a file for every Stat call.
 type fakeGitserver struct {
 	gitserver.Client
@@ -99,6 +103,7 @@ func fakeOwnDb() *dbmocks.MockDB {
 	db := dbmocks.NewMockDB()
 	db.RecentContributionSignalsFunc.SetDefaultReturn(dbmocks.NewMockRecentContributionSignalStore())
 	db.RecentViewSignalFunc.SetDefaultReturn(dbmocks.NewMockRecentViewSignalStore())
+	db.RecentReviewSignalsFunc.SetDefaultReturn(dbmocks.NewMockRecentReviewSignalStore())
 	db.AssignedOwnersFunc.SetDefaultReturn(dbmocks.NewMockAssignedOwnersStore())
 
 	configStore := dbmocks.NewMockSignalConfigurationStore()
@@ -1247,6 +1252,118 @@ func TestCommitOwnershipSignals(t *testing.T) {
 	})
 }
 
+func TestBlobOwnershipRecentReviewerSignals(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fakeDB := fakedb.New()
+	db := fakeOwnDb()
+
+	recentReviewStore := dbmocks.NewMockRecentReviewSignalStore()
+	recentReviewStore.FindRecentReviewersFunc.SetDefaultReturn([]database.RecentReviewerSummary{{
+		ReviewerHandle: "santa",
+		ReviewerEmail:  "santa@northpole.com",
+		ReviewCount:    3,
+	}}, nil)
+	db.RecentReviewSignalsFunc.SetDefaultReturn(recentReviewStore)
+
+	fakeDB.Wire(db)
+	repoID := api.RepoID(1)
+
+	ctx := userCtx(fakeDB.AddUser(types.User{SiteAdmin: true}))
+	repos := dbmocks.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	own := fakeOwnService{}
+	schema, err := graphqlbackend.NewSchema(db, git, []graphqlbackend.OptionalResolver{{OwnResolver: resolvers.NewWithService(db, git, own, logger)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := &graphqlbackend.Test{
+		Schema:  schema,
+		Context: ctx,
+		Query: `
+			query FetchOwnership($repo: ID!, $currentPath: String!) {
+				node(id: $repo) {
+					... on Repository {
+						commit(rev: "revision") {
+							blob(path: $currentPath) {
+								ownership(reasons: [RECENT_REVIEWER_OWNERSHIP_SIGNAL]) {
+									nodes {
+										owner {
+											...on Person {
+												displayName
+												email
+											}
+										}
+										reasons {
+											...on RecentReviewerOwnershipSignal {
+												title
+												description
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}`,
+		ExpectedResult: `{
+			"node": {
+				"commit": {
+					"blob": {
+						"ownership": {
+							"nodes": [
+								{
+									"owner": {
+										"displayName": "santa",
+										"email": "santa@northpole.com"
+									},
+									"reasons": [
+										{
+											"title": "recent reviewer",
+											"description": "Associated because they have approved changes to this file in the last 90 days."
+										}
+									]
+								}
+							]
+						}
+					}
+				}
+			}
+		}`,
+		Variables: map[string]any{
+			"repo":        string(graphqlbackend.MarshalRepositoryID(repoID)),
+			"currentPath": "foo/bar.js",
+		},
+	}
+	graphqlbackend.RunTest(t, test)
+
+	t.Run("disabled recent-reviewer signal should not resolve", func(t *testing.T) {
+		mockStore := dbmocks.NewMockSignalConfigurationStore()
+		db.OwnSignalConfigurationsFunc.SetDefaultReturn(mockStore)
+		mockStore.IsEnabledFunc.SetDefaultHook(func(ctx context.Context, s string) (bool, error) {
+			return s != owntypes.SignalRecentReviewers, nil
+		})
+
+		test.ExpectedResult = `{
+			"node": {
+				"commit": {
+					"blob": {
+						"ownership": {
+							"nodes": []
+						}
+					}
+				}
+			}
+		}`
+		graphqlbackend.RunTest(t, test)
+	})
+}
+
 func Test_SignalConfigurations(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
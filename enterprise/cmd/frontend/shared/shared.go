@@ -67,7 +67,6 @@ var initFunctions = map[string]EnterpriseInitializer{
 	"guardrails":     guardrails.Init,
 	"insights":       insights.Init,
 	"licensing":      licensing.Init,
-	"notebooks":      notebooks.Init,
 	"own":            own.Init,
 	"rbac":           rbac.Init,
 	"repos.webhooks": webhooks.Init,
@@ -106,6 +105,12 @@ func EnterpriseSetupHook(db database.DB, conf conftypes.UnifiedWatchable) enterp
 		}
 	}
 
+	// Initialize notebooks after code insights, as insight blocks are rendered with the code
+	// insights database initialized by the insights initializer.
+	if err := notebooks.Init(ctx, observationCtx, db, codeIntelServices, conf, &enterpriseServices); err != nil {
+		logger.Fatal("failed to initialize", log.String("name", "notebooks"), log.Error(err))
+	}
+
 	// Inititalize executor last, as we require code intel and batch changes services to be
 	// already populated on the enterpriseServices object.
 	if err := executor.Init(observationCtx, db, conf, &enterpriseServices); err != nil {
@@ -0,0 +1,43 @@
+package codeintel
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeintel"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type coverageAggregatorJob struct{}
+
+func NewCoverageAggregatorJob() job.Job {
+	return &coverageAggregatorJob{}
+}
+
+func (j *coverageAggregatorJob) Description() string {
+	return ""
+}
+
+func (j *coverageAggregatorJob) Config() []env.Config {
+	return []env.Config{
+		uploads.CoverageConfigInst,
+	}
+}
+
+func (j *coverageAggregatorJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	services, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uploads.NewCoverageAggregator(observationCtx, services.UploadsService, db, services.GitserverClient), nil
+}
@@ -56,6 +56,7 @@ var additionalJobs = map[string]job.Job{
 	"codeintel-autoindexing-dependency-scheduler": codeintel.NewAutoindexingDependencySchedulerJob(),
 	"codeintel-autoindexing-scheduler":            codeintel.NewAutoindexingSchedulerJob(),
 	"codeintel-commitgraph-updater":               codeintel.NewCommitGraphUpdaterJob(),
+	"codeintel-coverage-aggregator":               codeintel.NewCoverageAggregatorJob(),
 	"codeintel-metrics-reporter":                  codeintel.NewMetricsReporterJob(),
 	"codeintel-upload-backfiller":                 codeintel.NewUploadBackfillerJob(),
 	"codeintel-upload-expirer":                    codeintel.NewUploadExpirerJob(),
@@ -3,12 +3,14 @@ package processor
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/batches/global"
 	bgql "github.com/sourcegraph/sourcegraph/internal/batches/graphql"
+	"github.com/sourcegraph/sourcegraph/internal/batches/reconciler"
 	"github.com/sourcegraph/sourcegraph/internal/batches/service"
 	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
 	"github.com/sourcegraph/sourcegraph/internal/batches/state"
@@ -18,6 +20,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -102,6 +106,8 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx)
 	case btypes.ChangesetJobTypePublish:
 		return nil, b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeUpdateMetadata:
+		return b.updateMetadata(ctx, job)
 
 	default:
 		return nil, &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -289,6 +295,149 @@ func (b *bulkProcessor) publishChangeset(ctx context.Context, job *btypes.Change
 	return nil
 }
 
+func (b *bulkProcessor) updateMetadata(ctx context.Context, job *btypes.ChangesetJob) (afterDone func(*store.Store), err error) {
+	typedPayload, ok := job.Payload.(*btypes.ChangesetJobUpdateMetadataPayload)
+	if !ok {
+		return nil, errors.Errorf("invalid payload type for changeset_job, want=%T have=%T", &btypes.ChangesetJobUpdateMetadataPayload{}, job.Payload)
+	}
+
+	// We can only update changesets that are owned by the batch change, since
+	// imported changesets have no changeset template to render.
+	if b.ch.CurrentSpecID == 0 || b.ch.OwnedByBatchChangeID != job.BatchChangeID {
+		return nil, errcode.MakeNonRetryable(errors.New("cannot update the metadata of a changeset not created by this batch change"))
+	}
+
+	if b.ch.ExternalState == btypes.ChangesetExternalStateMerged || b.ch.ExternalState == btypes.ChangesetExternalStateDeleted {
+		return nil, errcode.MakeNonRetryable(errors.Newf("cannot update the metadata of a %s changeset", strings.ToLower(string(b.ch.ExternalState))))
+	}
+
+	spec, err := b.tx.GetChangesetSpecByID(ctx, b.ch.CurrentSpecID)
+	if err != nil {
+		b.logger.Error("GetChangesetSpecByID", log.Error(err))
+		return nil, errcode.MakeNonRetryable(errors.Wrapf(err, "getting changeset spec for changeset %d", b.ch.ID))
+	}
+
+	batchChange, err := b.tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: job.BatchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "loading batch change")
+	}
+
+	if err := renderChangesetSpecMetadata(spec, b.repo, batchChange, typedPayload); err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	// The code hosts don't allow changing the head branch of an existing
+	// changeset, so we only allow a new branch as long as nothing has been
+	// pushed yet.
+	if b.ch.Published() && spec.HeadRef != b.ch.ExternalBranch {
+		return nil, errcode.MakeNonRetryable(errors.New("cannot change the branch of a published changeset"))
+	}
+
+	// The spec is updated in place instead of creating a new one, so that the
+	// reconciler and the rewirer see the same changeset spec and never treat
+	// the new metadata as a reason to re-execute or re-push the changeset.
+	if err := b.tx.UpdateChangesetSpecMetadata(ctx, spec); err != nil {
+		b.logger.Error("UpdateChangesetSpecMetadata", log.Error(err))
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	// Unpublished changesets pick up the new metadata once they are published.
+	if !b.ch.Published() {
+		return nil, nil
+	}
+
+	body, err := reconciler.DecorateChangesetBody(ctx, b.tx, b.ch, spec.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decorating body for changeset %d", b.ch.ID)
+	}
+
+	remoteRepo, err := sources.GetRemoteRepo(ctx, b.css, b.repo, b.ch, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading remote repo")
+	}
+
+	cs := &sources.Changeset{
+		Title:      spec.Title,
+		Body:       body,
+		BaseRef:    spec.BaseRef,
+		HeadRef:    spec.HeadRef,
+		Changeset:  b.ch,
+		TargetRepo: b.repo,
+		RemoteRepo: remoteRepo,
+	}
+	if err := b.css.UpdateChangeset(ctx, cs); err != nil {
+		return nil, errors.Wrap(err, "updating changeset")
+	}
+
+	events, err := cs.Changeset.Events()
+	if err != nil {
+		b.logger.Error("Events", log.Error(err))
+		return nil, errcode.MakeNonRetryable(err)
+	}
+	state.SetDerivedState(ctx, b.tx.Repos(), gitserver.NewClient(), cs.Changeset, events)
+
+	if err := b.tx.UpsertChangesetEvents(ctx, events...); err != nil {
+		b.logger.Error("UpsertChangesetEvents", log.Error(err))
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	if err := b.tx.UpdateChangesetCodeHostState(ctx, cs.Changeset); err != nil {
+		b.logger.Error("UpdateChangeset", log.Error(err))
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	afterDone = func(s *store.Store) { b.enqueueWebhook(ctx, s, webhooks.ChangesetUpdate) }
+	return afterDone, nil
+}
+
+// renderChangesetSpecMetadata renders the changeset template fields given in
+// payload and sets them on spec. Step outputs are not persisted, so templates
+// referencing them fail to render.
+func renderChangesetSpecMetadata(spec *btypes.ChangesetSpec, repo *types.Repo, batchChange *btypes.BatchChange, payload *btypes.ChangesetJobUpdateMetadataPayload) error {
+	changes, err := git.ChangesInDiff(spec.Diff)
+	if err != nil {
+		return errors.Wrap(err, "parsing changeset spec diff")
+	}
+
+	tmplCtx := &template.ChangesetTemplateContext{
+		BatchChangeAttributes: template.BatchChangeAttributes{
+			Name:        batchChange.Name,
+			Description: batchChange.Description,
+		},
+		Steps: template.StepsContext{
+			Changes: changes,
+		},
+		Outputs: map[string]any{},
+		Repository: template.Repository{
+			Name:   string(repo.Name),
+			Branch: strings.TrimPrefix(spec.BaseRef, "refs/heads/"),
+		},
+	}
+
+	if payload.Title != nil {
+		if spec.Title, err = template.RenderChangesetTemplateField("title", *payload.Title, tmplCtx); err != nil {
+			return errors.Wrap(err, "rendering title")
+		}
+	}
+	if payload.Body != nil {
+		if spec.Body, err = template.RenderChangesetTemplateField("body", *payload.Body, tmplCtx); err != nil {
+			return errors.Wrap(err, "rendering body")
+		}
+	}
+	if payload.Branch != nil {
+		branch, err := template.RenderChangesetTemplateField("branch", *payload.Branch, tmplCtx)
+		if err != nil {
+			return errors.Wrap(err, "rendering branch")
+		}
+		if branch == "" {
+			return errors.New("branch cannot be empty")
+		}
+		spec.HeadRef = git.EnsureRefPrefix(branch)
+	}
+
+	return nil
+}
+
 func (b *bulkProcessor) enqueueWebhook(ctx context.Context, store *store.Store, eventType string) {
 	webhooks.EnqueueChangeset(ctx, b.logger, store, eventType, bgql.MarshalChangesetID(b.ch.ID))
 }
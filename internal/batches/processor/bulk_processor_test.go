@@ -26,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func mockDoer(req *http.Request) (*http.Response, error) {
@@ -483,4 +484,149 @@ func TestBulkProcessor(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Update metadata job", func(t *testing.T) {
+		fake := &stesting.FakeChangesetSource{FakeMetadata: &github.PullRequest{}}
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: stesting.NewFakeSourcer(nil, fake),
+			logger:  logtest.Scoped(t),
+		}
+
+		title := "${{ batch_change.name }}: ${{ repository.name }}"
+		branch := "batch-changes/${{ batch_change.name }}"
+
+		t.Run("unpublished", func(t *testing.T) {
+			changesetSpec := bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+				User:      user.ID,
+				Repo:      repo.ID,
+				BatchSpec: batchSpec.ID,
+				HeadRef:   "refs/heads/update-metadata",
+				Title:     "Old title",
+				Body:      "Old body",
+				Typ:       btypes.ChangesetSpecTypeBranch,
+			})
+			changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+				Repo:               repo.ID,
+				BatchChange:        batchChange.ID,
+				OwnedByBatchChange: batchChange.ID,
+				CurrentSpec:        changesetSpec.ID,
+				ReconcilerState:    btypes.ReconcilerStateCompleted,
+				PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+			})
+
+			job := &types.ChangesetJob{
+				JobType:       types.ChangesetJobTypeUpdateMetadata,
+				BatchChangeID: batchChange.ID,
+				ChangesetID:   changeset.ID,
+				UserID:        user.ID,
+				Payload:       &types.ChangesetJobUpdateMetadataPayload{Title: &title, Branch: &branch},
+			}
+			afterDone, err := bp.Process(ctx, job)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if afterDone != nil {
+				t.Fatal("unexpected non-nil afterDone")
+			}
+			if fake.UpdateChangesetCalled {
+				t.Fatal("unexpected call to UpdateChangeset")
+			}
+
+			have, err := bstore.GetChangesetSpecByID(ctx, changesetSpec.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := fmt.Sprintf("test-bulk: %s", repo.Name); have.Title != want {
+				t.Fatalf("wrong title. want=%q, have=%q", want, have.Title)
+			}
+			if want := "Old body"; have.Body != want {
+				t.Fatalf("wrong body. want=%q, have=%q", want, have.Body)
+			}
+			if want := "refs/heads/batch-changes/test-bulk"; have.HeadRef != want {
+				t.Fatalf("wrong head ref. want=%q, have=%q", want, have.HeadRef)
+			}
+		})
+
+		t.Run("branch of published changeset", func(t *testing.T) {
+			changesetSpec := bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+				User:      user.ID,
+				Repo:      repo.ID,
+				BatchSpec: batchSpec.ID,
+				HeadRef:   "refs/heads/update-metadata-published",
+				Typ:       btypes.ChangesetSpecTypeBranch,
+			})
+			changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+				Repo:               repo.ID,
+				BatchChange:        batchChange.ID,
+				OwnedByBatchChange: batchChange.ID,
+				CurrentSpec:        changesetSpec.ID,
+				ReconcilerState:    btypes.ReconcilerStateCompleted,
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				ExternalBranch:     "refs/heads/update-metadata-published",
+			})
+
+			job := &types.ChangesetJob{
+				JobType:       types.ChangesetJobTypeUpdateMetadata,
+				BatchChangeID: batchChange.ID,
+				ChangesetID:   changeset.ID,
+				UserID:        user.ID,
+				Payload:       &types.ChangesetJobUpdateMetadataPayload{Branch: &branch},
+			}
+			_, err := bp.Process(ctx, job)
+			if err == nil {
+				t.Fatal("unexpected nil error")
+			}
+			if !errcode.IsNonRetryable(err) {
+				t.Fatalf("error is not non-retryable: %v", err)
+			}
+			if fake.UpdateChangesetCalled {
+				t.Fatal("unexpected call to UpdateChangeset")
+			}
+		})
+	})
+}
+
+func TestRenderChangesetSpecMetadata(t *testing.T) {
+	spec := &btypes.ChangesetSpec{
+		Title:   "Old title",
+		Body:    "Old body",
+		HeadRef: "refs/heads/old-branch",
+		BaseRef: "refs/heads/main",
+		Diff: []byte(`diff --git README.md README.md
+index 1111111..2222222 100644
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-Hello
++Hello World
+`),
+	}
+	repo := &itypes.Repo{Name: "github.com/sourcegraph/sourcegraph"}
+	batchChange := &btypes.BatchChange{Name: "hello-world", Description: "Says hello"}
+
+	body := "Modified ${{ join steps.modified_files \", \" }} on ${{ repository.branch }}\n\n${{ batch_change_link }}"
+	if err := renderChangesetSpecMetadata(spec, repo, batchChange, &btypes.ChangesetJobUpdateMetadataPayload{Body: &body}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Old title"; spec.Title != want {
+		t.Errorf("wrong title. want=%q, have=%q", want, spec.Title)
+	}
+	if want := "Modified README.md on main\n\n${{ batch_change_link }}"; spec.Body != want {
+		t.Errorf("wrong body. want=%q, have=%q", want, spec.Body)
+	}
+	if want := "refs/heads/old-branch"; spec.HeadRef != want {
+		t.Errorf("wrong head ref. want=%q, have=%q", want, spec.HeadRef)
+	}
+
+	title := "${{ outputs.missing }}"
+	if err := renderChangesetSpecMetadata(spec, repo, batchChange, &btypes.ChangesetJobUpdateMetadataPayload{Title: &title}); err == nil {
+		t.Error("unexpected nil error for template referencing step outputs")
+	}
+
+	empty := ""
+	if err := renderChangesetSpecMetadata(spec, repo, batchChange, &btypes.ChangesetJobUpdateMetadataPayload{Branch: &empty}); err == nil {
+		t.Error("unexpected nil error for empty branch")
+	}
 }
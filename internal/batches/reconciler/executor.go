@@ -201,6 +201,12 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 		return afterDone, err
 	}
 	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
+	if btypes.SupportsGitserverCommitSigning(remoteRepo.ExternalRepo.ServiceType) {
+		opts.CommitInfo.SigningKey, err = e.commitSigningKey(ctx)
+		if err != nil {
+			return afterDone, errors.Wrap(err, "loading commit signing key")
+		}
+	}
 	resp, err := e.pushCommit(ctx, opts)
 	if err != nil {
 		var pce pushCommitError
@@ -691,6 +697,37 @@ func (e *executor) runAfterCommit(ctx context.Context, css sources.ChangesetSour
 	return nil
 }
 
+// commitSigningKey returns the key gitserver signs the changeset's commit with:
+// the key of the user that last applied the owning batch change or, if they
+// haven't configured one, the site-wide key. It returns nil if neither exists,
+// in which case the commit is pushed unsigned.
+func (e *executor) commitSigningKey(ctx context.Context) (*protocol.CommitSigningKey, error) {
+	var key *btypes.CommitSigningKey
+	if e.ch.OwnedByBatchChangeID != 0 {
+		batchChange, err := loadBatchChange(ctx, e.tx, e.ch.OwnedByBatchChangeID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load owning batch change")
+		}
+
+		key, err = e.tx.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{UserID: batchChange.LastApplierID})
+		if err != nil && err != store.ErrNoResults {
+			return nil, err
+		}
+	}
+
+	if key == nil {
+		var err error
+		key, err = e.tx.GetCommitSigningKey(ctx, store.GetCommitSigningKeyOpts{})
+		if err == store.ErrNoResults {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return key.GitserverSigningKey(ctx)
+}
+
 // handleArchivedRepo updates the changeset and repo once it has been
 // determined that the repo has been archived.
 func (e *executor) handleArchivedRepo(ctx context.Context) error {
@@ -754,6 +791,13 @@ type getNamespacer interface {
 	GetByID(ctx context.Context, orgID, userID int32) (*database.Namespace, error)
 }
 
+// DecorateChangesetBody adds the link to the owning batch change of cs to the
+// given body, the same way the reconciler does when publishing or updating a
+// changeset.
+func DecorateChangesetBody(ctx context.Context, tx *store.Store, cs *btypes.Changeset, body string) (string, error) {
+	return decorateChangesetBody(ctx, tx, database.NamespacesWith(tx), cs, body)
+}
+
 func decorateChangesetBody(ctx context.Context, tx getBatchChanger, nsStore getNamespacer, cs *btypes.Changeset, body string) (string, error) {
 	batchChange, err := loadBatchChange(ctx, tx, cs.OwnedByBatchChangeID)
 	if err != nil {
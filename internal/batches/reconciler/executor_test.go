@@ -10,6 +10,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +34,7 @@ import (
 	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	gitprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -1208,6 +1211,136 @@ func TestExecutor_UserCredentialsForGitserver(t *testing.T) {
 	}
 }
 
+func TestExecutor_CommitSigningKeyForGitserver(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	bstore := store.New(db, &observation.TestContext, et.TestKey{})
+
+	user := bt.CreateTestUser(t, db, false)
+	otherUser := bt.CreateTestUser(t, db, false)
+
+	gitHubRepo, gitHubExtSvc := bt.CreateTestRepo(t, ctx, db)
+
+	gitLabRepos, gitLabExtSvc := bt.CreateGitlabTestRepos(t, ctx, db, 1)
+	gitLabRepo := gitLabRepos[0]
+
+	siteKey := createTestCommitSigningKey(t, ctx, bstore, 0)
+	userKey := createTestCommitSigningKey(t, ctx, bstore, user.ID)
+
+	plan := &Plan{}
+	plan.AddOp(btypes.ReconcilerOperationPush)
+
+	tests := []struct {
+		name    string
+		user    *types.User
+		extSvc  *types.ExternalService
+		repo    *types.Repo
+		wantKey string
+	}{
+		{
+			name:   "github signs commits itself",
+			user:   user,
+			extSvc: gitHubExtSvc,
+			repo:   gitHubRepo,
+		},
+		{
+			name:    "gitlab user key",
+			user:    user,
+			extSvc:  gitLabExtSvc,
+			repo:    gitLabRepo,
+			wantKey: userKey,
+		},
+		{
+			name:    "gitlab site key",
+			user:    otherUser,
+			extSvc:  gitLabExtSvc,
+			repo:    gitLabRepo,
+			wantKey: siteKey,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credential := &auth.OAuthBearerToken{Token: "my-secret-token"}
+			cred, err := bstore.UserCredentials().Create(ctx, database.UserCredentialScope{
+				Domain:              database.UserCredentialDomainBatches,
+				UserID:              tt.user.ID,
+				ExternalServiceType: tt.repo.ExternalRepo.ServiceType,
+				ExternalServiceID:   tt.repo.ExternalRepo.ServiceID,
+			}, credential)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { bstore.UserCredentials().Delete(ctx, cred.ID) }()
+
+			batchSpec := bt.CreateBatchSpec(t, ctx, bstore, fmt.Sprintf("reconciler-signing-%d", i), tt.user.ID, 0)
+			batchChange := bt.CreateBatchChange(t, ctx, bstore, fmt.Sprintf("reconciler-signing-%d", i), tt.user.ID, batchSpec.ID)
+
+			plan.Changeset = &btypes.Changeset{
+				OwnedByBatchChangeID: batchChange.ID,
+				RepoID:               tt.repo.ID,
+			}
+			plan.ChangesetSpec = bt.BuildChangesetSpec(t, bt.TestSpecOpts{
+				HeadRef:    "refs/heads/my-branch",
+				Typ:        btypes.ChangesetSpecTypeBranch,
+				Published:  true,
+				CommitDiff: []byte("testdiff"),
+			})
+
+			fakeSource := &stesting.FakeChangesetSource{Svc: tt.extSvc, CurrentAuthenticator: credential}
+			sourcer := stesting.NewFakeSourcer(nil, fakeSource)
+
+			gitserverClient := gitserver.NewMockClient()
+			createCommitFromPatchReq := &gitprotocol.CreateCommitFromPatchRequest{}
+			gitserverClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (*gitprotocol.CreateCommitFromPatchResponse, error) {
+				createCommitFromPatchReq = &req
+				return new(gitprotocol.CreateCommitFromPatchResponse), nil
+			})
+
+			if _, err := executePlan(
+				actor.WithActor(ctx, actor.FromUser(tt.user.ID)),
+				logtest.Scoped(t),
+				gitserverClient,
+				sourcer,
+				true,
+				bstore,
+				plan,
+			); err != nil {
+				t.Fatalf("executing plan failed: %s", err)
+			}
+
+			signingKey := createCommitFromPatchReq.CommitInfo.SigningKey
+			if tt.wantKey == "" {
+				assert.Nil(t, signingKey)
+				return
+			}
+			require.NotNil(t, signingKey)
+			assert.Equal(t, commitsigning.FormatGPG, signingKey.Format)
+			assert.Equal(t, tt.wantKey, signingKey.PrivateKey)
+		})
+	}
+}
+
+func createTestCommitSigningKey(t *testing.T, ctx context.Context, s *store.Store, userID int32) string {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("Batch Changes", "", "batch-changes@sourcegraph.com", nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+
+	k := &btypes.CommitSigningKey{UserID: userID}
+	require.NoError(t, k.SetPrivateKey(commitsigning.FormatGPG, buf.String(), ""))
+	require.NoError(t, s.CreateCommitSigningKey(ctx, k))
+	return buf.String()
+}
+
 func TestDecorateChangesetBody(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/regexp"
+	"go.opentelemetry.io/otel/attribute"
+
+	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type CreateBatchSpecTemplateOpts struct {
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	Name        string
+	Description string
+	Spec        string
+	Inputs      []btypes.BatchSpecTemplateInput
+}
+
+// CreateBatchSpecTemplate creates a new batch spec template in the given
+// namespace. The template is validated by rendering it with sample values for
+// all of its inputs and parsing the result as a batch spec.
+func (s *Service) CreateBatchSpecTemplate(ctx context.Context, opts CreateBatchSpecTemplateOpts) (tmpl *btypes.BatchSpecTemplate, err error) {
+	ctx, _, endObservation := s.operations.createBatchSpecTemplate.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: Check whether the current user has access to either one of
+	// the namespaces.
+	if err := s.CheckNamespaceAccess(ctx, opts.NamespaceUserID, opts.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	tmpl = &btypes.BatchSpecTemplate{
+		Name:            opts.Name,
+		Description:     opts.Description,
+		Spec:            opts.Spec,
+		Inputs:          opts.Inputs,
+		NamespaceUserID: opts.NamespaceUserID,
+		NamespaceOrgID:  opts.NamespaceOrgID,
+		// Actor is guaranteed to be set here, because CheckNamespaceAccess
+		// above enforces it.
+		CreatorID: sgactor.FromContext(ctx).UID,
+	}
+
+	if err := ValidateBatchSpecTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CreateBatchSpecTemplate(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+type UpdateBatchSpecTemplateOpts struct {
+	ID int64
+
+	Name        string
+	Description string
+	Spec        string
+	Inputs      []btypes.BatchSpecTemplateInput
+}
+
+// UpdateBatchSpecTemplate replaces the name, description, spec and inputs of
+// the given batch spec template.
+func (s *Service) UpdateBatchSpecTemplate(ctx context.Context, opts UpdateBatchSpecTemplateOpts) (tmpl *btypes.BatchSpecTemplate, err error) {
+	ctx, _, endObservation := s.operations.updateBatchSpecTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int64("ID", opts.ID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tmpl, err = s.store.GetBatchSpecTemplate(ctx, store.GetBatchSpecTemplateOpts{ID: opts.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch spec template")
+	}
+
+	// 🚨 SECURITY: Only users with access to the namespace of the template
+	// can update it.
+	if err := s.CheckNamespaceAccess(ctx, tmpl.NamespaceUserID, tmpl.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	tmpl.Name = opts.Name
+	tmpl.Description = opts.Description
+	tmpl.Spec = opts.Spec
+	tmpl.Inputs = opts.Inputs
+
+	if err := ValidateBatchSpecTemplate(tmpl); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.UpdateBatchSpecTemplate(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// DeleteBatchSpecTemplate deletes the batch spec template with the given ID.
+func (s *Service) DeleteBatchSpecTemplate(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchSpecTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int64("ID", id),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tmpl, err := s.store.GetBatchSpecTemplate(ctx, store.GetBatchSpecTemplateOpts{ID: id})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec template")
+	}
+
+	// 🚨 SECURITY: Only users with access to the namespace of the template
+	// can delete it.
+	if err := s.CheckNamespaceAccess(ctx, tmpl.NamespaceUserID, tmpl.NamespaceOrgID); err != nil {
+		return err
+	}
+
+	return s.store.DeleteBatchSpecTemplate(ctx, id)
+}
+
+type CreateBatchSpecFromTemplateOpts struct {
+	BatchSpecTemplateID int64
+
+	// Inputs maps the names of the template inputs to their values.
+	Inputs map[string]string
+
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	BatchChange int64
+}
+
+// CreateBatchSpecFromTemplate renders the given batch spec template with the
+// given input values and creates a BatchSpec from the result, just like
+// CreateBatchSpecFromRaw.
+func (s *Service) CreateBatchSpecFromTemplate(ctx context.Context, opts CreateBatchSpecFromTemplateOpts) (spec *btypes.BatchSpec, err error) {
+	ctx, _, endObservation := s.operations.createBatchSpecFromTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int64("batchSpecTemplateID", opts.BatchSpecTemplateID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tmpl, err := s.store.GetBatchSpecTemplate(ctx, store.GetBatchSpecTemplateOpts{ID: opts.BatchSpecTemplateID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch spec template")
+	}
+
+	// 🚨 SECURITY: Templates can only be used by users with access to their
+	// namespace. Access to the namespace of the new batch spec is checked by
+	// CreateBatchSpecFromRaw.
+	if err := s.CheckNamespaceAccess(ctx, tmpl.NamespaceUserID, tmpl.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	rawSpec, err := RenderBatchSpecTemplate(tmpl, opts.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.CreateBatchSpecFromRaw(ctx, CreateBatchSpecFromRawOpts{
+		RawSpec:         rawSpec,
+		NamespaceUserID: opts.NamespaceUserID,
+		NamespaceOrgID:  opts.NamespaceOrgID,
+		BatchChange:     opts.BatchChange,
+	})
+}
+
+var batchSpecTemplateInputNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateBatchSpecTemplate validates the input definitions of the given
+// template and checks that rendering it with sample values for all inputs
+// results in a valid batch spec.
+func ValidateBatchSpecTemplate(tmpl *btypes.BatchSpecTemplate) error {
+	if strings.TrimSpace(tmpl.Name) == "" {
+		return errors.New("batch spec template name cannot be blank")
+	}
+
+	seen := make(map[string]struct{}, len(tmpl.Inputs))
+	sample := make(map[string]string, len(tmpl.Inputs))
+	for _, input := range tmpl.Inputs {
+		if !batchSpecTemplateInputNameRe.MatchString(input.Name) {
+			return errors.Newf("invalid input name %q: must start with a letter or underscore and only contain letters, digits and underscores", input.Name)
+		}
+		if _, ok := seen[input.Name]; ok {
+			return errors.Newf("duplicate input name %q", input.Name)
+		}
+		seen[input.Name] = struct{}{}
+
+		if !input.Type.Valid() {
+			return errors.Newf("input %q has unknown type %q", input.Name, input.Type)
+		}
+		if input.Type == btypes.BatchSpecTemplateInputTypeEnum && len(input.Options) == 0 {
+			return errors.Newf("input %q of type %s must declare at least one option", input.Name, input.Type)
+		}
+		if input.Type != btypes.BatchSpecTemplateInputTypeEnum && len(input.Options) > 0 {
+			return errors.Newf("input %q of type %s cannot declare options", input.Name, input.Type)
+		}
+		if input.Default != nil {
+			if _, err := coerceBatchSpecTemplateInputValue(input, *input.Default); err != nil {
+				return errors.Wrapf(err, "invalid default value for input %q", input.Name)
+			}
+		}
+
+		sample[input.Name] = sampleBatchSpecTemplateInputValue(input)
+	}
+
+	rendered, err := RenderBatchSpecTemplate(tmpl, sample)
+	if err != nil {
+		return err
+	}
+
+	if _, err := batcheslib.ParseBatchSpec([]byte(rendered)); err != nil {
+		return errors.Wrap(err, "rendered batch spec template is not a valid batch spec")
+	}
+
+	return nil
+}
+
+// sampleBatchSpecTemplateInputValue returns a valid value for the given input
+// that is used to validate a template before it is stored.
+func sampleBatchSpecTemplateInputValue(input btypes.BatchSpecTemplateInput) string {
+	if input.Default != nil {
+		return *input.Default
+	}
+	switch input.Type {
+	case btypes.BatchSpecTemplateInputTypeBoolean:
+		return "false"
+	case btypes.BatchSpecTemplateInputTypeEnum:
+		return input.Options[0]
+	case btypes.BatchSpecTemplateInputTypeRepoQuery:
+		return "repo:example"
+	default:
+		return "template-input"
+	}
+}
+
+// coerceBatchSpecTemplateInputValue validates the given value against the type
+// of the input and converts it into the value that is made available to the
+// template.
+func coerceBatchSpecTemplateInputValue(input btypes.BatchSpecTemplateInput, value string) (any, error) {
+	switch input.Type {
+	case btypes.BatchSpecTemplateInputTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Newf("%q is not a boolean", value)
+		}
+		return b, nil
+
+	case btypes.BatchSpecTemplateInputTypeEnum:
+		for _, o := range input.Options {
+			if o == value {
+				return value, nil
+			}
+		}
+		return nil, errors.Newf("%q is not one of %s", value, strings.Join(input.Options, ", "))
+
+	case btypes.BatchSpecTemplateInputTypeRepoQuery:
+		if strings.TrimSpace(value) == "" {
+			return nil, errors.New("repository query cannot be blank")
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errors.New("repository query must be a single line")
+		}
+		if _, err := query.ParseStandard(value); err != nil {
+			return nil, errors.Wrap(err, "invalid repository query")
+		}
+		return value, nil
+
+	default:
+		return value, nil
+	}
+}
+
+var (
+	batchSpecTemplateExpressionRe = regexp.MustCompile(`(?s)\$\{\{(.*?)\}\}`)
+	batchSpecTemplateInputsRe     = regexp.MustCompile(`\binputs\b`)
+)
+
+// RenderBatchSpecTemplate renders the spec of the given template with the
+// given input values, which are accessible as `${{ inputs.<name> }}`.
+//
+// Only template expressions that reference inputs are evaluated. All other
+// expressions, such as `${{ repository.name }}` or `${{ outputs.foo }}`, are
+// left untouched so that they can be evaluated when the resulting batch spec
+// is executed.
+func RenderBatchSpecTemplate(tmpl *btypes.BatchSpecTemplate, values map[string]string) (string, error) {
+	inputs := make(map[string]any, len(tmpl.Inputs))
+	for _, input := range tmpl.Inputs {
+		value, ok := values[input.Name]
+		if !ok || value == "" {
+			if input.Required {
+				return "", errors.Newf("missing value for required input %q", input.Name)
+			}
+			if input.Default != nil {
+				value = *input.Default
+			}
+		}
+
+		if value == "" {
+			// Optional inputs without a value render as the zero value of
+			// their type.
+			if input.Type == btypes.BatchSpecTemplateInputTypeBoolean {
+				inputs[input.Name] = false
+			} else {
+				inputs[input.Name] = ""
+			}
+			continue
+		}
+
+		v, err := coerceBatchSpecTemplateInputValue(input, value)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid value for input %q", input.Name)
+		}
+		inputs[input.Name] = v
+	}
+	for name := range values {
+		if _, ok := inputs[name]; !ok {
+			return "", errors.Newf("unknown input %q", name)
+		}
+	}
+
+	src, preserved, err := preserveNonInputExpressions(tmpl.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := template.New("batchSpecTemplate", src, "missingkey=error", map[string]any{
+		"inputs": func() map[string]any { return inputs },
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "parsing batch spec template")
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, nil); err != nil {
+		return "", errors.Wrap(err, "rendering batch spec template")
+	}
+
+	rendered := out.String()
+	for i, expr := range preserved {
+		rendered = strings.Replace(rendered, preservedExpressionPlaceholder(i), expr, 1)
+	}
+	return rendered, nil
+}
+
+// preserveNonInputExpressions replaces all template expressions in spec that
+// don't reference inputs with placeholders, so that only the expressions
+// referencing inputs are evaluated when the template is rendered. Control
+// structures such as `${{ if ... }}` keep their matching `${{ else }}` and
+// `${{ end }}` expressions.
+func preserveNonInputExpressions(spec string) (string, []string, error) {
+	var (
+		preserved []string
+		blocks    []bool
+		out       strings.Builder
+		last      int
+	)
+
+	for _, loc := range batchSpecTemplateExpressionRe.FindAllStringSubmatchIndex(spec, -1) {
+		expr := spec[loc[0]:loc[1]]
+		action := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(spec[loc[2]:loc[3]]), "-"), "-"))
+		keyword, _, _ := strings.Cut(action, " ")
+		referencesInputs := batchSpecTemplateInputsRe.MatchString(action)
+
+		var isInput bool
+		switch keyword {
+		case "if", "range", "with":
+			isInput = referencesInputs
+			blocks = append(blocks, isInput)
+		case "else", "end":
+			if len(blocks) == 0 {
+				isInput = false
+				break
+			}
+			isInput = blocks[len(blocks)-1]
+			if referencesInputs && !isInput {
+				return "", nil, errors.Newf("cannot reference inputs in %q of a block that doesn't reference inputs", expr)
+			}
+			if keyword == "end" {
+				blocks = blocks[:len(blocks)-1]
+			}
+		default:
+			isInput = referencesInputs
+		}
+
+		out.WriteString(spec[last:loc[0]])
+		if isInput {
+			out.WriteString(expr)
+		} else {
+			out.WriteString(preservedExpressionPlaceholder(len(preserved)))
+			preserved = append(preserved, expr)
+		}
+		last = loc[1]
+	}
+	out.WriteString(spec[last:])
+
+	return out.String(), preserved, nil
+}
+
+func preservedExpressionPlaceholder(i int) string {
+	return fmt.Sprintf("__batch_spec_template_expression_%d__", i)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+func TestRenderBatchSpecTemplate(t *testing.T) {
+	defaultBranch := "main"
+
+	tmpl := &btypes.BatchSpecTemplate{
+		Name: "upgrade-dependency",
+		Spec: `name: ${{ inputs.name }}
+on:
+  - repositoriesMatchingQuery: ${{ inputs.repos }}
+steps:
+  - run: echo ${{ repository.name }} ${{ inputs.branch }}
+    container: alpine:3
+${{ if inputs.draft }}
+changesetTemplate:
+  title: ${{ inputs.mode }} ${{ batch_change.name }}
+  published: draft
+${{ end }}`,
+		Inputs: []btypes.BatchSpecTemplateInput{
+			{Name: "name", Type: btypes.BatchSpecTemplateInputTypeString, Required: true},
+			{Name: "repos", Type: btypes.BatchSpecTemplateInputTypeRepoQuery, Required: true},
+			{Name: "branch", Type: btypes.BatchSpecTemplateInputTypeString, Default: &defaultBranch},
+			{Name: "draft", Type: btypes.BatchSpecTemplateInputTypeBoolean},
+			{Name: "mode", Type: btypes.BatchSpecTemplateInputTypeEnum, Options: []string{"major", "minor"}},
+		},
+	}
+
+	t.Run("all inputs", func(t *testing.T) {
+		have, err := RenderBatchSpecTemplate(tmpl, map[string]string{
+			"name":  "upgrade-lodash",
+			"repos": "repo:^github.com/sourcegraph/ file:package.json",
+			"draft": "true",
+			"mode":  "major",
+		})
+		require.NoError(t, err)
+
+		want := `name: upgrade-lodash
+on:
+  - repositoriesMatchingQuery: repo:^github.com/sourcegraph/ file:package.json
+steps:
+  - run: echo ${{ repository.name }} main
+    container: alpine:3
+
+changesetTemplate:
+  title: major ${{ batch_change.name }}
+  published: draft
+`
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("optional inputs omitted", func(t *testing.T) {
+		have, err := RenderBatchSpecTemplate(tmpl, map[string]string{
+			"name":  "upgrade-lodash",
+			"repos": "repo:foo",
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, have, "changesetTemplate")
+		assert.Contains(t, have, "echo ${{ repository.name }} main")
+	})
+
+	for name, tc := range map[string]struct {
+		values  map[string]string
+		wantErr string
+	}{
+		"missing required input": {
+			values:  map[string]string{"repos": "repo:foo"},
+			wantErr: `missing value for required input "name"`,
+		},
+		"unknown input": {
+			values:  map[string]string{"name": "foo", "repos": "repo:foo", "other": "bar"},
+			wantErr: `unknown input "other"`,
+		},
+		"invalid boolean": {
+			values:  map[string]string{"name": "foo", "repos": "repo:foo", "draft": "maybe"},
+			wantErr: `invalid value for input "draft"`,
+		},
+		"invalid enum option": {
+			values:  map[string]string{"name": "foo", "repos": "repo:foo", "mode": "patch"},
+			wantErr: `"patch" is not one of major, minor`,
+		},
+		"multi-line repo query": {
+			values:  map[string]string{"name": "foo", "repos": "repo:foo\nrepo:bar"},
+			wantErr: "repository query must be a single line",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := RenderBatchSpecTemplate(tmpl, tc.values)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+
+	t.Run("unknown input referenced", func(t *testing.T) {
+		_, err := RenderBatchSpecTemplate(&btypes.BatchSpecTemplate{Spec: "name: ${{ inputs.unknown }}"}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestValidateBatchSpecTemplate(t *testing.T) {
+	tmpl := func(spec string, inputs ...btypes.BatchSpecTemplateInput) *btypes.BatchSpecTemplate {
+		return &btypes.BatchSpecTemplate{Name: "template", Spec: spec, Inputs: inputs}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		err := ValidateBatchSpecTemplate(tmpl(
+			"name: ${{ inputs.name }}\non:\n  - repositoriesMatchingQuery: ${{ inputs.repos }}\n",
+			btypes.BatchSpecTemplateInput{Name: "name", Type: btypes.BatchSpecTemplateInputTypeString, Required: true},
+			btypes.BatchSpecTemplateInput{Name: "repos", Type: btypes.BatchSpecTemplateInputTypeRepoQuery, Required: true},
+		))
+		assert.NoError(t, err)
+	})
+
+	for name, tc := range map[string]struct {
+		tmpl    *btypes.BatchSpecTemplate
+		wantErr string
+	}{
+		"invalid input name": {
+			tmpl:    tmpl("name: foo", btypes.BatchSpecTemplateInput{Name: "foo-bar", Type: btypes.BatchSpecTemplateInputTypeString}),
+			wantErr: `invalid input name "foo-bar"`,
+		},
+		"duplicate input name": {
+			tmpl: tmpl("name: foo",
+				btypes.BatchSpecTemplateInput{Name: "foo", Type: btypes.BatchSpecTemplateInputTypeString},
+				btypes.BatchSpecTemplateInput{Name: "foo", Type: btypes.BatchSpecTemplateInputTypeBoolean},
+			),
+			wantErr: `duplicate input name "foo"`,
+		},
+		"unknown type": {
+			tmpl:    tmpl("name: foo", btypes.BatchSpecTemplateInput{Name: "foo", Type: "NUMBER"}),
+			wantErr: `unknown type "NUMBER"`,
+		},
+		"enum without options": {
+			tmpl:    tmpl("name: foo", btypes.BatchSpecTemplateInput{Name: "foo", Type: btypes.BatchSpecTemplateInputTypeEnum}),
+			wantErr: "must declare at least one option",
+		},
+		"invalid rendered spec": {
+			tmpl:    tmpl("name: ${{ inputs.foo }}", btypes.BatchSpecTemplateInput{Name: "foo", Type: btypes.BatchSpecTemplateInputTypeBoolean}),
+			wantErr: "rendered batch spec template is not a valid batch spec",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateBatchSpecTemplate(tc.tmpl)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
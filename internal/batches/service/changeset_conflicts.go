@@ -0,0 +1,319 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+
+	godiff "github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrConflictingChangesets is returned by ApplyBatchChange when
+// BlockConflictingChangesets is set and a changeset spec that would be
+// published touches the same files as an open changeset of another batch
+// change.
+var ErrConflictingChangesets = errors.New("changesets that would be published conflict with open changesets of other batch changes")
+
+// ChangesetConflict describes an open changeset of another batch change that
+// changes the same files as a changeset spec, in the same repository and
+// against the same base branch. Whichever of the two is merged last will likely
+// have to be rebased by hand.
+type ChangesetConflict struct {
+	ChangesetSpec *btypes.ChangesetSpec
+	// Changeset is the conflicting changeset and CurrentChangesetSpec the
+	// spec it was last published from.
+	Changeset            *btypes.Changeset
+	CurrentChangesetSpec *btypes.ChangesetSpec
+
+	Files []*ConflictingFile
+}
+
+// ConflictingFile is a file changed by both sides of a ChangesetConflict.
+type ConflictingFile struct {
+	Path string
+	// Hunks are the line ranges in the base revision of the file that are
+	// changed by both diffs. Empty if both diffs change the file, but in
+	// different places.
+	Hunks []LineRange
+}
+
+// LineRange is an inclusive range of 1-based line numbers.
+type LineRange struct {
+	StartLine int32
+	EndLine   int32
+}
+
+// DetectChangesetConflicts compares the diffs of the changeset specs of the
+// given batch spec against the open changesets of other batch changes in the
+// same repositories and returns the ones that change the same files. Changesets
+// of the batch change the batch spec applies to are not considered conflicts.
+func (s *Service) DetectChangesetConflicts(ctx context.Context, batchSpec *btypes.BatchSpec) (_ []*ChangesetConflict, err error) {
+	ctx, _, endObservation := s.operations.detectChangesetConflicts.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.GetBatchChangeMatchingBatchSpec(ctx, batchSpec)
+	if err != nil {
+		return nil, err
+	}
+	var batchChangeID int64
+	if batchChange != nil {
+		batchChangeID = batchChange.ID
+	}
+
+	return s.detectChangesetConflicts(ctx, batchSpec.ID, batchChangeID)
+}
+
+func (s *Service) detectChangesetConflicts(ctx context.Context, batchSpecID, batchChangeID int64) ([]*ChangesetConflict, error) {
+	specs, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{
+		BatchSpecID: batchSpecID,
+		Type:        batcheslib.ChangesetSpecDescriptionTypeBranch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	// 🚨 SECURITY: EnforceAuthz makes sure we only report conflicts with
+	// changesets in repositories the user has access to.
+	published := btypes.ChangesetPublicationStatePublished
+	changesets, _, err := s.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		RepoIDs:          specs.RepoIDs(),
+		PublicationState: &published,
+		ExternalStates: []btypes.ChangesetExternalState{
+			btypes.ChangesetExternalStateOpen,
+			btypes.ChangesetExternalStateDraft,
+		},
+		EnforceAuthz: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only changesets created by other batch changes carry a diff we can
+	// compare against.
+	changesetsByRepo := map[api.RepoID][]*btypes.Changeset{}
+	var currentSpecIDs []int64
+	for _, c := range changesets {
+		if c.OwnedByBatchChangeID == 0 || c.OwnedByBatchChangeID == batchChangeID || c.CurrentSpecID == 0 {
+			continue
+		}
+		changesetsByRepo[c.RepoID] = append(changesetsByRepo[c.RepoID], c)
+		currentSpecIDs = append(currentSpecIDs, c.CurrentSpecID)
+	}
+	if len(currentSpecIDs) == 0 {
+		return nil, nil
+	}
+
+	currentSpecs, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: currentSpecIDs})
+	if err != nil {
+		return nil, err
+	}
+	currentSpecsByID := make(map[int64]*btypes.ChangesetSpec, len(currentSpecs))
+	for _, spec := range currentSpecs {
+		currentSpecsByID[spec.ID] = spec
+	}
+
+	var conflicts []*ChangesetConflict
+	for _, spec := range specs {
+		for _, c := range changesetsByRepo[spec.BaseRepoID] {
+			current, ok := currentSpecsByID[c.CurrentSpecID]
+			if !ok || current.BaseRef != spec.BaseRef {
+				continue
+			}
+
+			files, err := conflictingFiles(spec.Diff, current.Diff)
+			if err != nil {
+				return nil, err
+			}
+			if len(files) == 0 {
+				continue
+			}
+
+			conflicts = append(conflicts, &ChangesetConflict{
+				ChangesetSpec:        spec,
+				Changeset:            c,
+				CurrentChangesetSpec: current,
+				Files:                files,
+			})
+		}
+	}
+
+	return conflicts, nil
+}
+
+// checkConflictingPublication returns ErrConflictingChangesets if any of the
+// changeset specs of the batch spec that would be published by applying it
+// conflicts with an open changeset of another batch change.
+func (s *Service) checkConflictingPublication(ctx context.Context, batchSpecID, batchChangeID int64, publicationStates UiPublicationStates) error {
+	conflicts, err := s.detectChangesetConflicts(ctx, batchSpecID, batchChangeID)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range conflicts {
+		if wouldPublish(c.ChangesetSpec, publicationStates) {
+			return ErrConflictingChangesets
+		}
+	}
+	return nil
+}
+
+// wouldPublish returns true if applying the changeset spec publishes it,
+// either through the published field of the spec or through a publication
+// state set in the UI.
+func wouldPublish(spec *btypes.ChangesetSpec, publicationStates UiPublicationStates) bool {
+	published := spec.Published
+	if published.Nil() {
+		published = publicationStates.rand[spec.RandID]
+	}
+	return published.True() || published.Draft()
+}
+
+// conflictingFiles returns the files changed by both diffs, together with the
+// ranges of lines in the base revision changed by both.
+func conflictingFiles(a, b []byte) ([]*ConflictingFile, error) {
+	aDiffs, err := godiff.ParseMultiFileDiff(a)
+	if err != nil {
+		return nil, err
+	}
+	bDiffs, err := godiff.ParseMultiFileDiff(b)
+	if err != nil {
+		return nil, err
+	}
+
+	bByPath := make(map[string]*godiff.FileDiff, len(bDiffs))
+	for _, fd := range bDiffs {
+		bByPath[conflictFilePath(fd)] = fd
+	}
+
+	var files []*ConflictingFile
+	for _, aFd := range aDiffs {
+		path := conflictFilePath(aFd)
+		bFd, ok := bByPath[path]
+		if !ok {
+			continue
+		}
+
+		var aRanges, bRanges []LineRange
+		for _, h := range aFd.Hunks {
+			aRanges = append(aRanges, hunkChangedRanges(h)...)
+		}
+		for _, h := range bFd.Hunks {
+			bRanges = append(bRanges, hunkChangedRanges(h)...)
+		}
+
+		var overlaps []LineRange
+		for _, aRange := range aRanges {
+			for _, bRange := range bRanges {
+				if r, ok := intersectLineRanges(aRange, bRange); ok {
+					overlaps = append(overlaps, r)
+				}
+			}
+		}
+
+		files = append(files, &ConflictingFile{Path: path, Hunks: mergeLineRanges(overlaps)})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
+
+// conflictFilePath returns the path of the file in the base revision, so that
+// renames and deletions are compared by the file they change. Added files use
+// their new name.
+func conflictFilePath(fd *godiff.FileDiff) string {
+	if fd.OrigName == "/dev/null" {
+		return impactFilePath(fd)
+	}
+	return strings.TrimPrefix(fd.OrigName, "a/")
+}
+
+// hunkChangedRanges returns the ranges of lines in the base revision changed
+// by the hunk. Context lines are not part of the ranges, so that hunks that
+// only share context lines don't overlap. Pure insertions are attributed to the
+// line they are inserted after, or the first line for insertions at the start
+// of a file.
+func hunkChangedRanges(h *godiff.Hunk) []LineRange {
+	var (
+		ranges  []LineRange
+		current *LineRange
+	)
+	line := h.OrigStartLine
+	for _, l := range bytes.Split(h.Body, []byte("\n")) {
+		if len(l) == 0 {
+			continue
+		}
+		switch l[0] {
+		case '-':
+			if current == nil {
+				current = &LineRange{StartLine: line}
+			}
+			current.EndLine = line
+			line++
+		case '+':
+			if current == nil {
+				at := line - 1
+				if at < 1 {
+					at = 1
+				}
+				current = &LineRange{StartLine: at, EndLine: at}
+			}
+		case '\\':
+			// "\ No newline at end of file" belongs to the previous line.
+		default:
+			if current != nil {
+				ranges = append(ranges, *current)
+				current = nil
+			}
+			line++
+		}
+	}
+	if current != nil {
+		ranges = append(ranges, *current)
+	}
+	return ranges
+}
+
+// intersectLineRanges returns the lines contained in both ranges, if any.
+func intersectLineRanges(a, b LineRange) (LineRange, bool) {
+	r := a
+	if b.StartLine > r.StartLine {
+		r.StartLine = b.StartLine
+	}
+	if b.EndLine < r.EndLine {
+		r.EndLine = b.EndLine
+	}
+	return r, r.StartLine <= r.EndLine
+}
+
+// mergeLineRanges sorts the ranges and merges the ones that overlap or are
+// adjacent.
+func mergeLineRanges(ranges []LineRange) []LineRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].StartLine < ranges[j].StartLine })
+
+	merged := []LineRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.StartLine <= last.EndLine+1 {
+			if r.EndLine > last.EndLine {
+				last.EndLine = r.EndLine
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	godiff "github.com/sourcegraph/go-diff/diff"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+const changesetConflictsTestDiff = `diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -10,3 +10,3 @@
+ # Title
+-Old text
++New text
+ Footer
+diff --git a/main.go b/main.go
+index 3333333..4444444 100644
+--- a/main.go
++++ b/main.go
+@@ -1,2 +1,3 @@
+ package main
++import "fmt"
+ func main() {}
+`
+
+func TestConflictingFiles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		other string
+		want  []*ConflictingFile
+	}{
+		"no common files": {
+			other: `diff --git a/other.go b/other.go
+index 1111111..2222222 100644
+--- a/other.go
++++ b/other.go
+@@ -1 +1 @@
+-package other
++package another
+`,
+		},
+		"same file, different lines": {
+			other: `diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -1,2 +1,2 @@
+-Intro
++Introduction
+ Text
+`,
+			want: []*ConflictingFile{{Path: "README.md"}},
+		},
+		"overlapping hunks": {
+			other: `diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -11,4 +11,3 @@
+-Old text
+-Footer
+-More
++Other text
++Footer and more
+ End
+diff --git a/main.go b/main.go
+deleted file mode 100644
+index 3333333..0000000
+--- a/main.go
++++ /dev/null
+@@ -1,2 +0,0 @@
+-package main
+-func main() {}
+`,
+			want: []*ConflictingFile{
+				{Path: "README.md", Hunks: []LineRange{{StartLine: 11, EndLine: 11}}},
+				{Path: "main.go", Hunks: []LineRange{{StartLine: 1, EndLine: 1}}},
+			},
+		},
+		"overlapping context lines only": {
+			other: `diff --git a/README.md b/README.md
+index 1111111..2222222 100644
+--- a/README.md
++++ b/README.md
+@@ -8,3 +8,3 @@
+ Line eight
+-Line nine
++Line 9
+ # Title
+`,
+			want: []*ConflictingFile{{Path: "README.md"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := conflictingFiles([]byte(changesetConflictsTestDiff), []byte(tc.other))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected conflicting files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHunkChangedRanges(t *testing.T) {
+	fd, err := godiff.ParseFileDiff([]byte(`--- a/file.txt
++++ b/file.txt
+@@ -1,9 +1,9 @@
++Inserted at start
+ One
+-Two
+-Three
++Two and three
+ Four
+ Five
++Inserted after five
+ Six
+ Seven
+-Eight
+ Nine
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LineRange{
+		{StartLine: 1, EndLine: 1},
+		{StartLine: 2, EndLine: 3},
+		{StartLine: 5, EndLine: 5},
+		{StartLine: 8, EndLine: 8},
+	}
+	if diff := cmp.Diff(want, hunkChangedRanges(fd.Hunks[0])); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+}
+
+func TestMergeLineRanges(t *testing.T) {
+	have := mergeLineRanges([]LineRange{
+		{StartLine: 20, EndLine: 25},
+		{StartLine: 1, EndLine: 3},
+		{StartLine: 4, EndLine: 5},
+		{StartLine: 22, EndLine: 30},
+		{StartLine: 10, EndLine: 10},
+	})
+	want := []LineRange{
+		{StartLine: 1, EndLine: 5},
+		{StartLine: 10, EndLine: 10},
+		{StartLine: 20, EndLine: 30},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+}
+
+func TestWouldPublish(t *testing.T) {
+	var ps UiPublicationStates
+	if err := ps.Add("ui-published", batcheslib.PublishedValue{Val: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ps.Add("ui-unpublished", batcheslib.PublishedValue{Val: false}); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		spec *btypes.ChangesetSpec
+		want bool
+	}{
+		"published in spec":   {spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: true}}, want: true},
+		"draft in spec":       {spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: "draft"}}, want: true},
+		"unpublished in spec": {spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: false}}},
+		"published in UI":     {spec: &btypes.ChangesetSpec{RandID: "ui-published"}, want: true},
+		"unpublished in UI":   {spec: &btypes.ChangesetSpec{RandID: "ui-unpublished"}},
+		"not set":             {spec: &btypes.ChangesetSpec{RandID: "other"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := wouldPublish(tc.spec, ps); have != tc.want {
+				t.Errorf("have %t, want %t", have, tc.want)
+			}
+		})
+	}
+}
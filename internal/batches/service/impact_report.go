@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	godiff "github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+// ImpactReport describes which changes applying a batch spec would propose and
+// who owns the files touched by them. It is computed from the changeset specs of
+// the batch spec before anything is published, so that reviewers can plan the
+// rollout per team.
+type ImpactReport struct {
+	// Changesets contains one entry per changeset spec that carries a diff.
+	Changesets []*ChangesetImpact
+	// Owners aggregates the changesets by the owners of the changed files,
+	// sorted by the number of changed lines in descending order.
+	Owners []*OwnerImpact
+}
+
+// ChangesetImpact describes the changes proposed by a single changeset spec.
+type ChangesetImpact struct {
+	ChangesetSpec *btypes.ChangesetSpec
+	Repo          *types.Repo
+
+	Files        []*FileImpact
+	LinesAdded   int32
+	LinesDeleted int32
+	// Languages are the distinct languages of the changed files, sorted by name.
+	Languages []string
+	// Owners are the distinct owners of the changed files, sorted by name.
+	Owners []string
+}
+
+// FileImpact describes the changes proposed to a single file.
+type FileImpact struct {
+	Path         string
+	LinesAdded   int32
+	LinesDeleted int32
+	Language     string
+	Owners       []string
+}
+
+// OwnerImpact aggregates all changesets that touch at least one file owned by
+// Owner.
+type OwnerImpact struct {
+	// Owner is the handle or email of a CODEOWNERS entry, or the name of an
+	// assigned user or team.
+	Owner        string
+	Changesets   int
+	Files        int
+	LinesAdded   int32
+	LinesDeleted int32
+	Repos        []api.RepoName
+}
+
+// fileOwnerResolver returns the owners of a path in a single repository.
+type fileOwnerResolver interface {
+	OwnersForPath(ctx context.Context, path string) []string
+}
+
+// GenerateImpactReport computes an ImpactReport for all changeset specs of the
+// given batch spec. Ownership is resolved through CODEOWNERS rulesets and owners
+// assigned within Sourcegraph, at the base revision of each changeset spec.
+func (s *Service) GenerateImpactReport(ctx context.Context, batchSpecID int64, ownService own.Service) (_ *ImpactReport, err error) {
+	ctx, _, endObservation := s.operations.generateImpactReport.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	specs, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{
+		BatchSpecID: batchSpecID,
+		Type:        batcheslib.ChangesetSpecDescriptionTypeBranch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: database.Repos.GetReposSetByIDs uses the authzFilter under
+	// the hood and filters out repositories that the user doesn't have access
+	// to. Changeset specs in those repositories are omitted from the report.
+	reposByID, err := s.store.Repos().GetReposSetByIDs(ctx, specs.RepoIDs()...)
+	if err != nil {
+		return nil, err
+	}
+
+	names := &ownerNameCache{db: s.store.DatabaseDB(), users: map[int32]string{}, teams: map[int32]string{}}
+	builder := newImpactReportBuilder()
+	for _, spec := range specs {
+		repo, ok := reposByID[spec.BaseRepoID]
+		if !ok {
+			continue
+		}
+
+		owners, err := newRepoOwnerResolver(ctx, ownService, names, repo, api.CommitID(spec.BaseRev))
+		if err != nil {
+			return nil, err
+		}
+		if err := builder.add(ctx, spec, repo, owners); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.report(), nil
+}
+
+type impactReportBuilder struct {
+	changesets []*ChangesetImpact
+	owners     map[string]*OwnerImpact
+	ownerRepos map[string]map[api.RepoName]struct{}
+}
+
+func newImpactReportBuilder() *impactReportBuilder {
+	return &impactReportBuilder{
+		owners:     map[string]*OwnerImpact{},
+		ownerRepos: map[string]map[api.RepoName]struct{}{},
+	}
+}
+
+// add parses the diff of the given changeset spec and records its impact.
+func (b *impactReportBuilder) add(ctx context.Context, spec *btypes.ChangesetSpec, repo *types.Repo, owners fileOwnerResolver) error {
+	fileDiffs, err := godiff.ParseMultiFileDiff(spec.Diff)
+	if err != nil {
+		return err
+	}
+
+	c := &ChangesetImpact{ChangesetSpec: spec, Repo: repo}
+	languages := map[string]struct{}{}
+	changesetOwners := map[string]struct{}{}
+	filesByOwner := map[string]int{}
+	linesByOwner := map[string][2]int32{}
+
+	for _, fd := range fileDiffs {
+		stat := fd.Stat()
+		f := &FileImpact{
+			Path:         impactFilePath(fd),
+			LinesAdded:   stat.Added + stat.Changed,
+			LinesDeleted: stat.Deleted + stat.Changed,
+		}
+		if lang, _ := inventory.GetLanguageByFilename(f.Path); lang != "" {
+			f.Language = lang
+			languages[lang] = struct{}{}
+		}
+		if owners != nil {
+			f.Owners = owners.OwnersForPath(ctx, f.Path)
+		}
+		for _, o := range f.Owners {
+			changesetOwners[o] = struct{}{}
+			filesByOwner[o]++
+			lines := linesByOwner[o]
+			linesByOwner[o] = [2]int32{lines[0] + f.LinesAdded, lines[1] + f.LinesDeleted}
+		}
+
+		c.Files = append(c.Files, f)
+		c.LinesAdded += f.LinesAdded
+		c.LinesDeleted += f.LinesDeleted
+	}
+
+	c.Languages = sortedKeys(languages)
+	c.Owners = sortedKeys(changesetOwners)
+	b.changesets = append(b.changesets, c)
+
+	for _, o := range c.Owners {
+		oi, ok := b.owners[o]
+		if !ok {
+			oi = &OwnerImpact{Owner: o}
+			b.owners[o] = oi
+			b.ownerRepos[o] = map[api.RepoName]struct{}{}
+		}
+		oi.Changesets++
+		oi.Files += filesByOwner[o]
+		oi.LinesAdded += linesByOwner[o][0]
+		oi.LinesDeleted += linesByOwner[o][1]
+		b.ownerRepos[o][repo.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (b *impactReportBuilder) report() *ImpactReport {
+	r := &ImpactReport{Changesets: b.changesets}
+	for name, oi := range b.owners {
+		for repo := range b.ownerRepos[name] {
+			oi.Repos = append(oi.Repos, repo)
+		}
+		sort.Slice(oi.Repos, func(i, j int) bool { return oi.Repos[i] < oi.Repos[j] })
+		r.Owners = append(r.Owners, oi)
+	}
+	sort.Slice(r.Owners, func(i, j int) bool {
+		li := r.Owners[i].LinesAdded + r.Owners[i].LinesDeleted
+		lj := r.Owners[j].LinesAdded + r.Owners[j].LinesDeleted
+		if li != lj {
+			return li > lj
+		}
+		return r.Owners[i].Owner < r.Owners[j].Owner
+	})
+	return r
+}
+
+// impactReportCSVHeader is the header row written by ImpactReport.WriteCSV.
+var impactReportCSVHeader = []string{
+	"repository",
+	"changeset_spec",
+	"title",
+	"head_ref",
+	"files_changed",
+	"lines_added",
+	"lines_deleted",
+	"languages",
+	"owners",
+}
+
+// WriteCSV writes one row per changeset to w. Multi-valued columns are
+// separated by semicolons.
+func (r *ImpactReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(impactReportCSVHeader); err != nil {
+		return err
+	}
+	for _, c := range r.Changesets {
+		row := []string{
+			string(c.Repo.Name),
+			c.ChangesetSpec.RandID,
+			c.ChangesetSpec.Title,
+			c.ChangesetSpec.HeadRef,
+			strconv.Itoa(len(c.Files)),
+			strconv.Itoa(int(c.LinesAdded)),
+			strconv.Itoa(int(c.LinesDeleted)),
+			strings.Join(c.Languages, ";"),
+			strings.Join(c.Owners, ";"),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// impactFilePath returns the path of the file changed by fd, without the
+// a/ and b/ prefixes of git diffs. For deleted files the original name is used.
+func impactFilePath(fd *godiff.FileDiff) string {
+	name := fd.NewName
+	if name == "/dev/null" {
+		name = fd.OrigName
+	}
+	if strings.HasPrefix(name, "a/") || strings.HasPrefix(name, "b/") {
+		name = name[2:]
+	}
+	return name
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// repoOwnerResolver resolves owners of paths in a repository from its
+// CODEOWNERS ruleset and from owners and teams assigned within Sourcegraph.
+type repoOwnerResolver struct {
+	ruleset        *codeowners.Ruleset
+	assignedOwners own.AssignedOwners
+	assignedTeams  own.AssignedTeams
+	names          *ownerNameCache
+}
+
+var _ fileOwnerResolver = &repoOwnerResolver{}
+
+func newRepoOwnerResolver(ctx context.Context, ownService own.Service, names *ownerNameCache, repo *types.Repo, commitID api.CommitID) (*repoOwnerResolver, error) {
+	ruleset, err := ownService.RulesetForRepo(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	assignedOwners, err := ownService.AssignedOwnership(ctx, repo.ID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	assignedTeams, err := ownService.AssignedTeams(ctx, repo.ID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	return &repoOwnerResolver{
+		ruleset:        ruleset,
+		assignedOwners: assignedOwners,
+		assignedTeams:  assignedTeams,
+		names:          names,
+	}, nil
+}
+
+func (r *repoOwnerResolver) OwnersForPath(ctx context.Context, path string) []string {
+	seen := map[string]struct{}{}
+	if r.ruleset != nil && path != "" {
+		if rule := r.ruleset.Match(path); rule != nil {
+			for _, o := range rule.GetOwner() {
+				if h := o.GetHandle(); h != "" {
+					seen["@"+h] = struct{}{}
+				} else if e := o.GetEmail(); e != "" {
+					seen[e] = struct{}{}
+				}
+			}
+		}
+	}
+	for _, summary := range r.assignedOwners.Match(path) {
+		if name := r.names.user(ctx, summary.OwnerUserID); name != "" {
+			seen["@"+name] = struct{}{}
+		}
+	}
+	for _, summary := range r.assignedTeams.Match(path) {
+		if name := r.names.team(ctx, summary.OwnerTeamID); name != "" {
+			seen["@"+name] = struct{}{}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// ownerNameCache resolves the names of assigned users and teams once per
+// report, since the same owners tend to be assigned across many repositories.
+type ownerNameCache struct {
+	db    database.DB
+	users map[int32]string
+	teams map[int32]string
+}
+
+func (c *ownerNameCache) user(ctx context.Context, id int32) string {
+	if name, ok := c.users[id]; ok {
+		return name
+	}
+	var name string
+	if u, err := c.db.Users().GetByID(ctx, id); err == nil {
+		name = u.Username
+	} else if !errcode.IsNotFound(err) {
+		return ""
+	}
+	c.users[id] = name
+	return name
+}
+
+func (c *ownerNameCache) team(ctx context.Context, id int32) string {
+	if name, ok := c.teams[id]; ok {
+		return name
+	}
+	var name string
+	if t, err := c.db.Teams().GetTeamByID(ctx, id); err == nil {
+		name = t.Name
+	} else if !errcode.IsNotFound(err) {
+		return ""
+	}
+	c.teams[id] = name
+	return name
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const impactReportTestDiff = `diff --git a/cmd/main.go b/cmd/main.go
+index 1111111..2222222 100644
+--- a/cmd/main.go
++++ b/cmd/main.go
+@@ -1,3 +1,4 @@
+ package main
+-import "fmt"
++import "log"
++import "os"
+ func main() {}
+diff --git a/docs/README.md b/docs/README.md
+deleted file mode 100644
+index 3333333..0000000
+--- a/docs/README.md
++++ /dev/null
+@@ -1,2 +0,0 @@
+-# Title
+-Text
+`
+
+type fakeOwnerResolver map[string][]string
+
+func (f fakeOwnerResolver) OwnersForPath(_ context.Context, path string) []string {
+	return f[path]
+}
+
+func TestImpactReportBuilder(t *testing.T) {
+	ctx := context.Background()
+	repoA := &types.Repo{ID: 1, Name: "github.com/sourcegraph/a"}
+	repoB := &types.Repo{ID: 2, Name: "github.com/sourcegraph/b"}
+
+	b := newImpactReportBuilder()
+	if err := b.add(ctx, &btypes.ChangesetSpec{RandID: "spec-a", Title: "Use log", HeadRef: "refs/heads/use-log", Diff: []byte(impactReportTestDiff)}, repoA, fakeOwnerResolver{
+		"cmd/main.go":    {"@backend"},
+		"docs/README.md": {"@backend", "@docs"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.add(ctx, &btypes.ChangesetSpec{RandID: "spec-b", Title: "Use log", HeadRef: "refs/heads/use-log", Diff: []byte(impactReportTestDiff)}, repoB, fakeOwnerResolver{
+		"cmd/main.go": {"@backend"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	report := b.report()
+
+	if have, want := len(report.Changesets), 2; have != want {
+		t.Fatalf("wrong number of changesets: have=%d want=%d", have, want)
+	}
+	c := report.Changesets[0]
+	if diff := cmp.Diff([]*FileImpact{
+		{Path: "cmd/main.go", LinesAdded: 2, LinesDeleted: 1, Language: "Go", Owners: []string{"@backend"}},
+		{Path: "docs/README.md", LinesDeleted: 2, Language: "Markdown", Owners: []string{"@backend", "@docs"}},
+	}, c.Files); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+	if have, want := [2]int32{c.LinesAdded, c.LinesDeleted}, [2]int32{2, 3}; have != want {
+		t.Errorf("wrong line counts: have=%v want=%v", have, want)
+	}
+	if diff := cmp.Diff([]string{"Go", "Markdown"}, c.Languages); diff != "" {
+		t.Errorf("unexpected languages (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]*OwnerImpact{
+		{Owner: "@backend", Changesets: 2, Files: 3, LinesAdded: 4, LinesDeleted: 4, Repos: []api.RepoName{repoA.Name, repoB.Name}},
+		{Owner: "@docs", Changesets: 1, Files: 1, LinesDeleted: 2, Repos: []api.RepoName{repoA.Name}},
+	}, report.Owners); diff != "" {
+		t.Errorf("unexpected owners (-want +got):\n%s", diff)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := `repository,changeset_spec,title,head_ref,files_changed,lines_added,lines_deleted,languages,owners
+github.com/sourcegraph/a,spec-a,Use log,refs/heads/use-log,2,2,3,Go;Markdown,@backend;@docs
+github.com/sourcegraph/b,spec-b,Use log,refs/heads/use-log,2,2,3,Go;Markdown,@backend
+`
+	if diff := cmp.Diff(wantCSV, buf.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want +got):\n%s", diff)
+	}
+}
@@ -95,6 +95,12 @@ type operations struct {
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	generateImpactReport                 *observation.Operation
+	detectChangesetConflicts             *observation.Operation
+	createBatchSpecTemplate              *observation.Operation
+	updateBatchSpecTemplate              *observation.Operation
+	deleteBatchSpecTemplate              *observation.Operation
+	createBatchSpecFromTemplate          *observation.Operation
 }
 
 var (
@@ -145,6 +151,12 @@ func newOperations(observationCtx *observation.Context) *operations {
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			generateImpactReport:                 op("GenerateImpactReport"),
+			detectChangesetConflicts:             op("DetectChangesetConflicts"),
+			createBatchSpecTemplate:              op("CreateBatchSpecTemplate"),
+			updateBatchSpecTemplate:              op("UpdateBatchSpecTemplate"),
+			deleteBatchSpecTemplate:              op("DeleteBatchSpecTemplate"),
+			createBatchSpecFromTemplate:          op("CreateBatchSpecFromTemplate"),
 		}
 	})
 
@@ -1681,12 +1693,13 @@ type GetAvailableBulkOperationsOpts struct {
 // on an array of changesets.
 func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvailableBulkOperationsOpts) ([]string, error) {
 	bulkOperationsCounter := map[btypes.ChangesetJobType]int{
-		btypes.ChangesetJobTypeClose:     0,
-		btypes.ChangesetJobTypeComment:   0,
-		btypes.ChangesetJobTypeDetach:    0,
-		btypes.ChangesetJobTypeMerge:     0,
-		btypes.ChangesetJobTypePublish:   0,
-		btypes.ChangesetJobTypeReenqueue: 0,
+		btypes.ChangesetJobTypeClose:          0,
+		btypes.ChangesetJobTypeComment:        0,
+		btypes.ChangesetJobTypeDetach:         0,
+		btypes.ChangesetJobTypeMerge:          0,
+		btypes.ChangesetJobTypePublish:        0,
+		btypes.ChangesetJobTypeReenqueue:      0,
+		btypes.ChangesetJobTypeUpdateMetadata: 0,
 	}
 
 	changesets, _, err := s.store.ListChangesets(ctx, store.ListChangesetsOpts{
@@ -1703,6 +1716,7 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		isChangesetOpen := changeset.ExternalState == btypes.ChangesetExternalStateOpen
 		isChangesetClosed := changeset.ExternalState == btypes.ChangesetExternalStateClosed
 		isChangesetMerged := changeset.ExternalState == btypes.ChangesetExternalStateMerged
+		isChangesetDeleted := changeset.ExternalState == btypes.ChangesetExternalStateDeleted
 		isChangesetReadOnly := changeset.ExternalState == btypes.ChangesetExternalStateReadOnly
 		isChangesetJobFailed := changeset.ReconcilerState == btypes.ReconcilerStateFailed
 
@@ -1747,6 +1761,11 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		if isChangesetCommentable {
 			bulkOperationsCounter[btypes.ChangesetJobTypeComment] += 1
 		}
+
+		// UPDATE_METADATA
+		if !isChangesetArchived && !changeset.IsImported() && !isChangesetMerged && !isChangesetDeleted {
+			bulkOperationsCounter[btypes.ChangesetJobTypeUpdateMetadata] += 1
+		}
 	}
 
 	noOfChangesets := len(opts.Changesets)
@@ -1757,8 +1776,11 @@ func (s *Service) GetAvailableBulkOperations(ctx context.Context, opts GetAvaila
 		// to all given changesets.
 		if count == noOfChangesets {
 			operation := strings.ToUpper(string(jobType))
-			if operation == "COMMENTATORE" {
+			switch operation {
+			case "COMMENTATORE":
 				operation = "COMMENT"
+			case "UPDATE-METADATA":
+				operation = "UPDATE_METADATA"
 			}
 			availableBulkOperations = append(availableBulkOperations, operation)
 		}
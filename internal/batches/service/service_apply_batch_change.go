@@ -37,6 +37,11 @@ type ApplyBatchChangeOpts struct {
 	FailIfBatchChangeExists bool
 
 	PublicationStates UiPublicationStates
+
+	// When BlockConflictingChangesets is true, ApplyBatchChange will fail with
+	// ErrConflictingChangesets if a changeset spec that would be published
+	// changes the same files as an open changeset of another batch change.
+	BlockConflictingChangesets bool
 }
 
 func (o ApplyBatchChangeOpts) String() string {
@@ -98,6 +103,12 @@ func (s *Service) ApplyBatchChange(
 		return batchChange, nil
 	}
 
+	if opts.BlockConflictingChangesets {
+		if err := s.checkConflictingPublication(ctx, batchSpec.ID, batchChange.ID, opts.PublicationStates); err != nil {
+			return nil, err
+		}
+	}
+
 	// Before we write to the database in a transaction, we cancel all
 	// currently enqueued/errored-and-retryable changesets the batch change might
 	// have.
@@ -1148,6 +1148,72 @@ func TestServiceApplyBatchChange(t *testing.T) {
 			})
 		})
 
+		t.Run("changeset specs conflicting with another batch change", func(t *testing.T) {
+			bt.TruncateTables(t, db, "changeset_events", "changesets", "batch_changes", "batch_specs", "changeset_specs")
+			otherBatchSpec := bt.CreateBatchSpec(t, ctx, store, "conflicting-other", admin.ID, 0)
+			bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+				User:       admin.ID,
+				Repo:       repos[0].ID,
+				BatchSpec:  otherBatchSpec.ID,
+				HeadRef:    "refs/heads/other-branch",
+				BaseRef:    "refs/heads/main",
+				CommitDiff: []byte(changesetConflictsTestDiff),
+				Typ:        btypes.ChangesetSpecTypeBranch,
+			})
+			_, otherChangesets := applyAndListChangesets(adminCtx, t, svc, otherBatchSpec.RandID, 1)
+			bt.SetChangesetPublished(t, ctx, store, otherChangesets[0], "12345", "refs/heads/other-branch")
+
+			batchSpec := bt.CreateBatchSpec(t, ctx, store, "conflicting", admin.ID, 0)
+			spec := bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+				User:       admin.ID,
+				Repo:       repos[0].ID,
+				BatchSpec:  batchSpec.ID,
+				HeadRef:    "refs/heads/my-branch",
+				BaseRef:    "refs/heads/main",
+				CommitDiff: []byte(changesetConflictsTestDiff),
+				Published:  true,
+				Typ:        btypes.ChangesetSpecTypeBranch,
+			})
+			// Same files, but a different base branch.
+			bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+				User:       admin.ID,
+				Repo:       repos[0].ID,
+				BatchSpec:  batchSpec.ID,
+				HeadRef:    "refs/heads/my-release-branch",
+				BaseRef:    "refs/heads/release",
+				CommitDiff: []byte(changesetConflictsTestDiff),
+				Typ:        btypes.ChangesetSpecTypeBranch,
+			})
+
+			conflicts, err := svc.DetectChangesetConflicts(adminCtx, batchSpec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have, want := len(conflicts), 1; have != want {
+				t.Fatalf("wrong number of conflicts. want=%d, have=%d", want, have)
+			}
+			if have, want := conflicts[0].ChangesetSpec.ID, spec.ID; have != want {
+				t.Errorf("wrong changeset spec. want=%d, have=%d", want, have)
+			}
+			if have, want := conflicts[0].Changeset.ID, otherChangesets[0].ID; have != want {
+				t.Errorf("wrong changeset. want=%d, have=%d", want, have)
+			}
+			if have, want := len(conflicts[0].Files), 2; have != want {
+				t.Errorf("wrong number of conflicting files. want=%d, have=%d", want, have)
+			}
+
+			_, err = svc.ApplyBatchChange(adminCtx, ApplyBatchChangeOpts{
+				BatchSpecRandID:            batchSpec.RandID,
+				BlockConflictingChangesets: true,
+			})
+			if err != ErrConflictingChangesets {
+				t.Fatalf("unexpected error. want=%s, got=%s", ErrConflictingChangesets, err)
+			}
+
+			// Without blocking, the conflicts are only reported.
+			applyAndListChangesets(adminCtx, t, svc, batchSpec.RandID, 2)
+		})
+
 		t.Run("invalid changeset specs", func(t *testing.T) {
 			bt.TruncateTables(t, db, "changeset_events", "changesets", "batch_changes", "batch_specs", "changeset_specs")
 			batchSpec := bt.CreateBatchSpec(t, ctx, store, "batchchange-invalid-specs", admin.ID, 0)
@@ -0,0 +1,401 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrDuplicateBatchSpecTemplateName is returned when a batch spec template is
+// stored with a name that is already used in its namespace.
+var ErrDuplicateBatchSpecTemplateName = errors.New("a batch spec template with this name already exists in the namespace")
+
+// batchSpecTemplateColumns are used by the batch spec template related Store
+// methods to query batch spec templates.
+var batchSpecTemplateColumns = []*sqlf.Query{
+	sqlf.Sprintf("batch_spec_templates.id"),
+	sqlf.Sprintf("batch_spec_templates.name"),
+	sqlf.Sprintf("batch_spec_templates.description"),
+	sqlf.Sprintf("batch_spec_templates.spec"),
+	sqlf.Sprintf("batch_spec_templates.inputs"),
+	sqlf.Sprintf("batch_spec_templates.namespace_user_id"),
+	sqlf.Sprintf("batch_spec_templates.namespace_org_id"),
+	sqlf.Sprintf("batch_spec_templates.creator_id"),
+	sqlf.Sprintf("batch_spec_templates.created_at"),
+	sqlf.Sprintf("batch_spec_templates.updated_at"),
+}
+
+// batchSpecTemplateInsertColumns is the list of batch spec template columns
+// that are modified in CreateBatchSpecTemplate and UpdateBatchSpecTemplate.
+var batchSpecTemplateInsertColumns = []*sqlf.Query{
+	sqlf.Sprintf("name"),
+	sqlf.Sprintf("description"),
+	sqlf.Sprintf("spec"),
+	sqlf.Sprintf("inputs"),
+	sqlf.Sprintf("namespace_user_id"),
+	sqlf.Sprintf("namespace_org_id"),
+	sqlf.Sprintf("creator_id"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+// CreateBatchSpecTemplate creates the given batch spec template.
+func (s *Store) CreateBatchSpecTemplate(ctx context.Context, t *btypes.BatchSpecTemplate) (err error) {
+	ctx, _, endObservation := s.operations.createBatchSpecTemplate.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q, err := s.createBatchSpecTemplateQuery(t)
+	if err != nil {
+		return err
+	}
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchSpecTemplate(t, sc) })
+	if isDuplicateBatchSpecTemplateNameErr(err) {
+		return ErrDuplicateBatchSpecTemplateName
+	}
+	return err
+}
+
+var createBatchSpecTemplateQueryFmtstr = `
+INSERT INTO batch_spec_templates (%s)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+func (s *Store) createBatchSpecTemplateQuery(t *btypes.BatchSpecTemplate) (*sqlf.Query, error) {
+	inputs, err := batchSpecTemplateInputsColumn(t.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = s.now()
+	}
+
+	if t.UpdatedAt.IsZero() {
+		t.UpdatedAt = t.CreatedAt
+	}
+
+	return sqlf.Sprintf(
+		createBatchSpecTemplateQueryFmtstr,
+		sqlf.Join(batchSpecTemplateInsertColumns, ", "),
+		t.Name,
+		t.Description,
+		t.Spec,
+		inputs,
+		dbutil.NullInt32Column(t.NamespaceUserID),
+		dbutil.NullInt32Column(t.NamespaceOrgID),
+		dbutil.NullInt32Column(t.CreatorID),
+		t.CreatedAt,
+		t.UpdatedAt,
+		sqlf.Join(batchSpecTemplateColumns, ", "),
+	), nil
+}
+
+// UpdateBatchSpecTemplate updates the given batch spec template.
+func (s *Store) UpdateBatchSpecTemplate(ctx context.Context, t *btypes.BatchSpecTemplate) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchSpecTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(t.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q, err := s.updateBatchSpecTemplateQuery(t)
+	if err != nil {
+		return err
+	}
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchSpecTemplate(t, sc) })
+	if isDuplicateBatchSpecTemplateNameErr(err) {
+		return ErrDuplicateBatchSpecTemplateName
+	}
+	return err
+}
+
+var updateBatchSpecTemplateQueryFmtstr = `
+UPDATE batch_spec_templates
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+WHERE id = %s
+RETURNING %s
+`
+
+func (s *Store) updateBatchSpecTemplateQuery(t *btypes.BatchSpecTemplate) (*sqlf.Query, error) {
+	inputs, err := batchSpecTemplateInputsColumn(t.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	t.UpdatedAt = s.now()
+
+	return sqlf.Sprintf(
+		updateBatchSpecTemplateQueryFmtstr,
+		sqlf.Join(batchSpecTemplateInsertColumns, ", "),
+		t.Name,
+		t.Description,
+		t.Spec,
+		inputs,
+		dbutil.NullInt32Column(t.NamespaceUserID),
+		dbutil.NullInt32Column(t.NamespaceOrgID),
+		dbutil.NullInt32Column(t.CreatorID),
+		t.CreatedAt,
+		t.UpdatedAt,
+		t.ID,
+		sqlf.Join(batchSpecTemplateColumns, ", "),
+	), nil
+}
+
+// DeleteBatchSpecTemplate deletes the batch spec template with the given ID.
+func (s *Store) DeleteBatchSpecTemplate(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchSpecTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(id)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(deleteBatchSpecTemplateQueryFmtstr, id))
+	if err != nil {
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+var deleteBatchSpecTemplateQueryFmtstr = `
+DELETE FROM batch_spec_templates WHERE id = %s
+`
+
+// GetBatchSpecTemplateOpts captures the query options needed for getting a
+// batch spec template.
+type GetBatchSpecTemplateOpts struct {
+	ID int64
+
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+	Name            string
+}
+
+// GetBatchSpecTemplate gets a batch spec template matching the given options.
+func (s *Store) GetBatchSpecTemplate(ctx context.Context, opts GetBatchSpecTemplateOpts) (t *btypes.BatchSpecTemplate, err error) {
+	ctx, _, endObservation := s.operations.getBatchSpecTemplate.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(opts.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := getBatchSpecTemplateQuery(&opts)
+
+	var c btypes.BatchSpecTemplate
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanBatchSpecTemplate(&c, sc) })
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &c, nil
+}
+
+var getBatchSpecTemplateQueryFmtstr = `
+SELECT %s FROM batch_spec_templates
+LEFT JOIN users namespace_user ON batch_spec_templates.namespace_user_id = namespace_user.id
+LEFT JOIN orgs  namespace_org  ON batch_spec_templates.namespace_org_id = namespace_org.id
+WHERE %s
+LIMIT 1
+`
+
+func getBatchSpecTemplateQuery(opts *GetBatchSpecTemplateOpts) *sqlf.Query {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("namespace_user.deleted_at IS NULL"),
+		sqlf.Sprintf("namespace_org.deleted_at IS NULL"),
+	}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.id = %s", opts.ID))
+	}
+
+	if opts.NamespaceUserID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.namespace_user_id = %s", opts.NamespaceUserID))
+	}
+
+	if opts.NamespaceOrgID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.namespace_org_id = %s", opts.NamespaceOrgID))
+	}
+
+	if opts.Name != "" {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.name = %s", opts.Name))
+	}
+
+	return sqlf.Sprintf(
+		getBatchSpecTemplateQueryFmtstr,
+		sqlf.Join(batchSpecTemplateColumns, ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// CountBatchSpecTemplatesOpts captures the query options needed for counting
+// batch spec templates.
+type CountBatchSpecTemplatesOpts struct {
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	// OnlyAccessibleByUserID limits the results to templates in the namespace
+	// of the given user or of the orgs they are a member of.
+	OnlyAccessibleByUserID int32
+}
+
+// CountBatchSpecTemplates returns the number of batch spec templates in the
+// database.
+func (s *Store) CountBatchSpecTemplates(ctx context.Context, opts CountBatchSpecTemplatesOpts) (count int, err error) {
+	ctx, _, endObservation := s.operations.countBatchSpecTemplates.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	return s.queryCount(ctx, countBatchSpecTemplatesQuery(&opts))
+}
+
+var countBatchSpecTemplatesQueryFmtstr = `
+SELECT COUNT(batch_spec_templates.id)
+FROM batch_spec_templates
+LEFT JOIN users namespace_user ON batch_spec_templates.namespace_user_id = namespace_user.id
+LEFT JOIN orgs  namespace_org  ON batch_spec_templates.namespace_org_id = namespace_org.id
+WHERE %s
+`
+
+func countBatchSpecTemplatesQuery(opts *CountBatchSpecTemplatesOpts) *sqlf.Query {
+	preds := batchSpecTemplateNamespacePreds(opts.NamespaceUserID, opts.NamespaceOrgID, opts.OnlyAccessibleByUserID)
+
+	return sqlf.Sprintf(
+		countBatchSpecTemplatesQueryFmtstr,
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// ListBatchSpecTemplatesOpts captures the query options needed for listing
+// batch spec templates.
+type ListBatchSpecTemplatesOpts struct {
+	LimitOpts
+	Cursor int64
+
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	// OnlyAccessibleByUserID limits the results to templates in the namespace
+	// of the given user or of the orgs they are a member of.
+	OnlyAccessibleByUserID int32
+}
+
+// ListBatchSpecTemplates lists batch spec templates with the given filters.
+func (s *Store) ListBatchSpecTemplates(ctx context.Context, opts ListBatchSpecTemplatesOpts) (ts []*btypes.BatchSpecTemplate, next int64, err error) {
+	ctx, _, endObservation := s.operations.listBatchSpecTemplates.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := listBatchSpecTemplatesQuery(&opts)
+
+	ts = make([]*btypes.BatchSpecTemplate, 0, opts.DBLimit())
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var t btypes.BatchSpecTemplate
+		if err := scanBatchSpecTemplate(&t, sc); err != nil {
+			return err
+		}
+		ts = append(ts, &t)
+		return nil
+	})
+
+	if opts.Limit != 0 && len(ts) == opts.DBLimit() {
+		next = ts[len(ts)-1].ID
+		ts = ts[:len(ts)-1]
+	}
+
+	return ts, next, err
+}
+
+var listBatchSpecTemplatesQueryFmtstr = `
+SELECT %s FROM batch_spec_templates
+LEFT JOIN users namespace_user ON batch_spec_templates.namespace_user_id = namespace_user.id
+LEFT JOIN orgs  namespace_org  ON batch_spec_templates.namespace_org_id = namespace_org.id
+WHERE %s
+ORDER BY id DESC
+`
+
+func listBatchSpecTemplatesQuery(opts *ListBatchSpecTemplatesOpts) *sqlf.Query {
+	preds := batchSpecTemplateNamespacePreds(opts.NamespaceUserID, opts.NamespaceOrgID, opts.OnlyAccessibleByUserID)
+
+	if opts.Cursor != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.id <= %s", opts.Cursor))
+	}
+
+	return sqlf.Sprintf(
+		listBatchSpecTemplatesQueryFmtstr+opts.LimitOpts.ToDB(),
+		sqlf.Join(batchSpecTemplateColumns, ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+func batchSpecTemplateNamespacePreds(namespaceUserID, namespaceOrgID, accessibleByUserID int32) []*sqlf.Query {
+	preds := []*sqlf.Query{
+		sqlf.Sprintf("namespace_user.deleted_at IS NULL"),
+		sqlf.Sprintf("namespace_org.deleted_at IS NULL"),
+	}
+
+	if namespaceUserID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.namespace_user_id = %s", namespaceUserID))
+	}
+
+	if namespaceOrgID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_templates.namespace_org_id = %s", namespaceOrgID))
+	}
+
+	if accessibleByUserID != 0 {
+		preds = append(preds, sqlf.Sprintf("(batch_spec_templates.namespace_user_id = %s OR (EXISTS (SELECT 1 FROM org_members WHERE org_id = batch_spec_templates.namespace_org_id AND user_id = %s AND org_id <> 0)))", accessibleByUserID, accessibleByUserID))
+	}
+
+	return preds
+}
+
+func batchSpecTemplateInputsColumn(inputs []btypes.BatchSpecTemplateInput) (json.RawMessage, error) {
+	if inputs == nil {
+		inputs = []btypes.BatchSpecTemplateInput{}
+	}
+	return jsonbColumn(inputs)
+}
+
+func scanBatchSpecTemplate(t *btypes.BatchSpecTemplate, s dbutil.Scanner) error {
+	var inputs json.RawMessage
+
+	err := s.Scan(
+		&t.ID,
+		&t.Name,
+		&t.Description,
+		&t.Spec,
+		&inputs,
+		&dbutil.NullInt32{N: &t.NamespaceUserID},
+		&dbutil.NullInt32{N: &t.NamespaceOrgID},
+		&dbutil.NullInt32{N: &t.CreatorID},
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Wrap(err, "scanning batch spec template")
+	}
+
+	if err := json.Unmarshal(inputs, &t.Inputs); err != nil {
+		return errors.Wrap(err, "scanBatchSpecTemplate: failed to unmarshal inputs")
+	}
+
+	return nil
+}
+
+func isDuplicateBatchSpecTemplateNameErr(err error) bool {
+	return isUniqueConstraintViolation(err, "batch_spec_templates_unique_user_id") ||
+		isUniqueConstraintViolation(err, "batch_spec_templates_unique_org_id")
+}
@@ -0,0 +1,265 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func testStoreBatchSpecTemplates(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	templates := make([]*btypes.BatchSpecTemplate, 0, 3)
+
+	var (
+		orgUser    = bt.CreateTestUser(t, s.DatabaseDB(), false)
+		nonOrgUser = bt.CreateTestUser(t, s.DatabaseDB(), false)
+		org        = bt.CreateTestOrg(t, s.DatabaseDB(), "bst-org", orgUser.ID)
+	)
+
+	defaultValue := "main"
+
+	t.Run("Create", func(t *testing.T) {
+		// 0: owned by org
+		// 1: owned by orgUser
+		// 2: owned by nonOrgUser
+		for i, tc := range []struct {
+			namespaceUserID int32
+			namespaceOrgID  int32
+		}{
+			{namespaceOrgID: org.ID},
+			{namespaceUserID: orgUser.ID},
+			{namespaceUserID: nonOrgUser.ID},
+		} {
+			tmpl := &btypes.BatchSpecTemplate{
+				Name:        fmt.Sprintf("template-%d", i),
+				Description: "Replaces all the things",
+				Spec:        "name: ${{ inputs.name }}",
+				Inputs: []btypes.BatchSpecTemplateInput{
+					{Name: "name", Type: btypes.BatchSpecTemplateInputTypeString, Required: true},
+					{Name: "branch", Type: btypes.BatchSpecTemplateInputTypeString, Default: &defaultValue},
+				},
+				NamespaceUserID: tc.namespaceUserID,
+				NamespaceOrgID:  tc.namespaceOrgID,
+				CreatorID:       orgUser.ID,
+			}
+
+			want := tmpl.Clone()
+			have := tmpl
+
+			if err := s.CreateBatchSpecTemplate(ctx, have); err != nil {
+				t.Fatal(err)
+			}
+
+			if have.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+
+			want.ID = have.ID
+			want.CreatedAt = clock.Now()
+			want.UpdatedAt = clock.Now()
+
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+
+			templates = append(templates, tmpl)
+		}
+	})
+
+	t.Run("Create duplicate name", func(t *testing.T) {
+		tmpl := &btypes.BatchSpecTemplate{
+			Name:           templates[0].Name,
+			Spec:           "name: foo",
+			NamespaceOrgID: org.ID,
+		}
+		tx, err := s.Transact(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Done(errors.New("always rollback"))
+		if err := tx.CreateBatchSpecTemplate(ctx, tmpl); err != ErrDuplicateBatchSpecTemplateName {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Create without inputs", func(t *testing.T) {
+		tmpl := &btypes.BatchSpecTemplate{
+			Name:            "no-inputs",
+			Spec:            "name: foo",
+			NamespaceUserID: nonOrgUser.ID,
+		}
+		if err := s.CreateBatchSpecTemplate(ctx, tmpl); err != nil {
+			t.Fatal(err)
+		}
+		if tmpl.Inputs == nil || len(tmpl.Inputs) != 0 {
+			t.Fatalf("unexpected inputs: %+v", tmpl.Inputs)
+		}
+		if err := s.DeleteBatchSpecTemplate(ctx, tmpl.ID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			opts CountBatchSpecTemplatesOpts
+			want int
+		}{
+			"all":                        {opts: CountBatchSpecTemplatesOpts{}, want: len(templates)},
+			"by org":                     {opts: CountBatchSpecTemplatesOpts{NamespaceOrgID: org.ID}, want: 1},
+			"by user":                    {opts: CountBatchSpecTemplatesOpts{NamespaceUserID: nonOrgUser.ID}, want: 1},
+			"accessible by org member":   {opts: CountBatchSpecTemplatesOpts{OnlyAccessibleByUserID: orgUser.ID}, want: 2},
+			"accessible by non-org user": {opts: CountBatchSpecTemplatesOpts{OnlyAccessibleByUserID: nonOrgUser.ID}, want: 1},
+		} {
+			t.Run(name, func(t *testing.T) {
+				have, err := s.CountBatchSpecTemplates(ctx, tc.opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if have != tc.want {
+					t.Fatalf("have count: %d, want: %d", have, tc.want)
+				}
+			})
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Run("All", func(t *testing.T) {
+			have, next, err := s.ListBatchSpecTemplates(ctx, ListBatchSpecTemplatesOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if next != 0 {
+				t.Fatalf("have next %d, want 0", next)
+			}
+
+			want := make([]*btypes.BatchSpecTemplate, 0, len(templates))
+			for i := len(templates) - 1; i >= 0; i-- {
+				want = append(want, templates[i])
+			}
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
+		t.Run("Paginated", func(t *testing.T) {
+			var cursor int64
+			var have []*btypes.BatchSpecTemplate
+			for i := 0; i < len(templates); i++ {
+				page, next, err := s.ListBatchSpecTemplates(ctx, ListBatchSpecTemplatesOpts{
+					LimitOpts: LimitOpts{Limit: 1},
+					Cursor:    cursor,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(page) != 1 {
+					t.Fatalf("have %d templates, want 1", len(page))
+				}
+				have = append(have, page...)
+				cursor = next
+			}
+			if cursor != 0 {
+				t.Fatalf("have next %d, want 0", cursor)
+			}
+			if len(have) != len(templates) {
+				t.Fatalf("have %d templates, want %d", len(have), len(templates))
+			}
+		})
+
+		t.Run("OnlyAccessibleByUserID", func(t *testing.T) {
+			have, _, err := s.ListBatchSpecTemplates(ctx, ListBatchSpecTemplatesOpts{OnlyAccessibleByUserID: orgUser.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := []*btypes.BatchSpecTemplate{templates[1], templates[0]}
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		t.Run("ByID", func(t *testing.T) {
+			want := templates[0]
+			have, err := s.GetBatchSpecTemplate(ctx, GetBatchSpecTemplateOpts{ID: want.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
+		t.Run("ByNamespaceAndName", func(t *testing.T) {
+			want := templates[1]
+			have, err := s.GetBatchSpecTemplate(ctx, GetBatchSpecTemplateOpts{
+				NamespaceUserID: orgUser.ID,
+				Name:            want.Name,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetBatchSpecTemplate(ctx, GetBatchSpecTemplateOpts{ID: 0xdeadbeef})
+			if have != ErrNoResults {
+				t.Fatalf("have err %v, want %v", have, ErrNoResults)
+			}
+		})
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		for _, tmpl := range templates {
+			clock.Add(1 * time.Second)
+
+			tmpl.Description += "-updated"
+			tmpl.Inputs = append(tmpl.Inputs, btypes.BatchSpecTemplateInput{
+				Name:    "draft",
+				Type:    btypes.BatchSpecTemplateInputTypeEnum,
+				Options: []string{"yes", "no"},
+			})
+
+			want := tmpl.Clone()
+			want.UpdatedAt = clock.Now()
+
+			if err := s.UpdateBatchSpecTemplate(ctx, tmpl); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tmpl, want); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		for i, tmpl := range templates {
+			if err := s.DeleteBatchSpecTemplate(ctx, tmpl.ID); err != nil {
+				t.Fatal(err)
+			}
+
+			count, err := s.CountBatchSpecTemplates(ctx, CountBatchSpecTemplatesOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if have, want := count, len(templates)-(i+1); have != want {
+				t.Fatalf("have count: %d, want: %d", have, want)
+			}
+		}
+
+		if err := s.DeleteBatchSpecTemplate(ctx, templates[0].ID); err != ErrNoResults {
+			t.Fatalf("have err %v, want %v", err, ErrNoResults)
+		}
+	})
+}
@@ -187,6 +187,39 @@ func (s *Store) updateChangesetSpecQuery(cs []int64, batchSpec int64) *sqlf.Quer
 	)
 }
 
+// UpdateChangesetSpecMetadata updates the title, body and head ref of the
+// given ChangesetSpec in place. All other columns, including the diff, are left
+// untouched.
+func (s *Store) UpdateChangesetSpecMetadata(ctx context.Context, c *btypes.ChangesetSpec) (err error) {
+	ctx, _, endObservation := s.operations.updateChangesetSpecMetadata.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(c.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	c.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateChangesetSpecMetadataQueryFmtstr,
+		c.Title,
+		c.Body,
+		c.HeadRef,
+		c.UpdatedAt,
+		c.ID,
+		sqlf.Join(changesetSpecColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetSpec(c, sc)
+	})
+}
+
+var updateChangesetSpecMetadataQueryFmtstr = `
+UPDATE changeset_specs
+SET (title, body, head_ref, updated_at) = (%s, %s, %s, %s)
+WHERE id = %s
+RETURNING %s
+`
+
 // DeleteChangesetSpec deletes the ChangesetSpec with the given ID.
 func (s *Store) DeleteChangesetSpec(ctx context.Context, id int64) (err error) {
 	ctx, _, endObservation := s.operations.deleteChangesetSpec.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
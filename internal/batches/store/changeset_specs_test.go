@@ -349,6 +349,24 @@ func testStoreChangesetSpecs(t *testing.T, ctx context.Context, s *Store, clock
 		}
 	})
 
+	t.Run("UpdateChangesetSpecMetadata", func(t *testing.T) {
+		c := changesetSpecs[0]
+		c.Title = "Updated title"
+		c.Body = "Updated body"
+		c.HeadRef = "refs/heads/updated-branch"
+		if err := s.UpdateChangesetSpecMetadata(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		want := c.Clone()
+		have, err := s.GetChangesetSpecByID(ctx, c.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(have, want); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
 	t.Run("Get", func(t *testing.T) {
 		want := changesetSpecs[1]
 		tests := map[string]GetChangesetSpecOpts{
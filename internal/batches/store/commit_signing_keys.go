@@ -0,0 +1,210 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrDuplicateCommitSigningKey is returned when a commit signing key is
+// created for a user, or for the site, that already has one.
+var ErrDuplicateCommitSigningKey = errors.New("a commit signing key is already configured")
+
+var commitSigningKeyColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("user_id"),
+	sqlf.Sprintf("format"),
+	sqlf.Sprintf("public_key"),
+	sqlf.Sprintf("fingerprint"),
+	sqlf.Sprintf("credential"),
+	sqlf.Sprintf("encryption_key_id"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+func (s *Store) CreateCommitSigningKey(ctx context.Context, k *btypes.CommitSigningKey) (err error) {
+	ctx, _, endObservation := s.operations.createCommitSigningKey.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("UserID", int(k.UserID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if k.CreatedAt.IsZero() {
+		k.CreatedAt = s.now()
+	}
+
+	if k.UpdatedAt.IsZero() {
+		k.UpdatedAt = k.CreatedAt
+	}
+
+	q, err := createCommitSigningKeyQuery(ctx, k, s.key)
+	if err != nil {
+		return err
+	}
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanCommitSigningKey(k, s.key, sc)
+	})
+	if isUniqueConstraintViolation(err, "batch_changes_commit_signing_keys_unique_user_id") ||
+		isUniqueConstraintViolation(err, "batch_changes_commit_signing_keys_unique_site") {
+		return ErrDuplicateCommitSigningKey
+	}
+	return err
+}
+
+var createCommitSigningKeyQueryFmtstr = `
+INSERT INTO batch_changes_commit_signing_keys (
+	user_id,
+	format,
+	public_key,
+	fingerprint,
+	credential,
+	encryption_key_id,
+	created_at,
+	updated_at
+)
+VALUES
+	(%s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING
+	%s
+`
+
+func createCommitSigningKeyQuery(ctx context.Context, k *btypes.CommitSigningKey, key encryption.Key) (*sqlf.Query, error) {
+	encryptedCredential, keyID, err := k.Credential.Encrypt(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlf.Sprintf(
+		createCommitSigningKeyQueryFmtstr,
+		dbutil.NullInt32Column(k.UserID),
+		k.Format,
+		k.PublicKey,
+		k.Fingerprint,
+		[]byte(encryptedCredential),
+		keyID,
+		k.CreatedAt,
+		k.UpdatedAt,
+		sqlf.Join(commitSigningKeyColumns, ","),
+	), nil
+}
+
+func (s *Store) DeleteCommitSigningKey(ctx context.Context, id int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteCommitSigningKey.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(id)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(deleteCommitSigningKeyQueryFmtstr, id))
+	if err != nil {
+		return err
+	}
+
+	// Check the key existed before.
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+var deleteCommitSigningKeyQueryFmtstr = `
+DELETE FROM
+	batch_changes_commit_signing_keys
+WHERE
+	id = %s
+`
+
+// GetCommitSigningKeyOpts captures the query options needed for getting a
+// commit signing key.
+type GetCommitSigningKeyOpts struct {
+	// ID, if set, selects the key by its ID and the other options are
+	// ignored.
+	ID int64
+	// UserID selects the key of the given user. If zero, the site-wide key is
+	// selected.
+	UserID int32
+}
+
+// GetCommitSigningKey gets a commit signing key matching the given options.
+func (s *Store) GetCommitSigningKey(ctx context.Context, opts GetCommitSigningKeyOpts) (k *btypes.CommitSigningKey, err error) {
+	ctx, _, endObservation := s.operations.getCommitSigningKey.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(opts.ID)),
+		attribute.Int("UserID", int(opts.UserID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := getCommitSigningKeyQuery(opts)
+
+	var key btypes.CommitSigningKey
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error { return scanCommitSigningKey(&key, s.key, sc) })
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &key, nil
+}
+
+var getCommitSigningKeyQueryFmtstr = `
+SELECT
+	%s
+FROM batch_changes_commit_signing_keys
+WHERE
+	%s
+`
+
+func getCommitSigningKeyQuery(opts GetCommitSigningKeyOpts) *sqlf.Query {
+	var pred *sqlf.Query
+	switch {
+	case opts.ID != 0:
+		pred = sqlf.Sprintf("id = %s", opts.ID)
+	case opts.UserID != 0:
+		pred = sqlf.Sprintf("user_id = %s", opts.UserID)
+	default:
+		pred = sqlf.Sprintf("user_id IS NULL")
+	}
+
+	return sqlf.Sprintf(
+		getCommitSigningKeyQueryFmtstr,
+		sqlf.Join(commitSigningKeyColumns, ","),
+		pred,
+	)
+}
+
+func scanCommitSigningKey(k *btypes.CommitSigningKey, key encryption.Key, sc dbutil.Scanner) error {
+	var (
+		format              string
+		encryptedCredential []byte
+		keyID               string
+	)
+	if err := sc.Scan(
+		&k.ID,
+		&dbutil.NullInt32{N: &k.UserID},
+		&format,
+		&k.PublicKey,
+		&k.Fingerprint,
+		&encryptedCredential,
+		&keyID,
+		&k.CreatedAt,
+		&k.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	k.Format = commitsigning.Format(format)
+	k.Credential = database.NewEncryptedCredential(string(encryptedCredential), keyID, key)
+	return nil
+}
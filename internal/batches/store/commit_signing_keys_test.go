@@ -0,0 +1,139 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func testStoreCommitSigningKeys(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	privateKey := testGPGPrivateKey(t)
+
+	keys := make([]*btypes.CommitSigningKey, 0, 2)
+
+	t.Run("Create", func(t *testing.T) {
+		// 0: site-wide key
+		// 1: owned by user
+		for _, userID := range []int32{0, user.ID} {
+			k := &btypes.CommitSigningKey{UserID: userID}
+			if err := k.SetPrivateKey(commitsigning.FormatGPG, privateKey, ""); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := s.CreateCommitSigningKey(ctx, k); err != nil {
+				t.Fatal(err)
+			}
+			if k.ID == 0 {
+				t.Fatal("id should not be zero")
+			}
+			if have, want := k.CreatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("have CreatedAt %s, want %s", have, want)
+			}
+			keys = append(keys, k)
+		}
+	})
+
+	t.Run("Create duplicate", func(t *testing.T) {
+		for _, userID := range []int32{0, user.ID} {
+			k := &btypes.CommitSigningKey{UserID: userID}
+			if err := k.SetPrivateKey(commitsigning.FormatGPG, privateKey, ""); err != nil {
+				t.Fatal(err)
+			}
+
+			tx, err := s.Transact(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = tx.CreateCommitSigningKey(ctx, k)
+			tx.Done(errors.New("always rollback"))
+			if err != ErrDuplicateCommitSigningKey {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			opts GetCommitSigningKeyOpts
+			want *btypes.CommitSigningKey
+		}{
+			"ByID":   {opts: GetCommitSigningKeyOpts{ID: keys[1].ID}, want: keys[1]},
+			"ByUser": {opts: GetCommitSigningKeyOpts{UserID: user.ID}, want: keys[1]},
+			"Site":   {opts: GetCommitSigningKeyOpts{}, want: keys[0]},
+		} {
+			t.Run(name, func(t *testing.T) {
+				have, err := s.GetCommitSigningKey(ctx, tc.opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(have, tc.want, et.CompareEncryptable); diff != "" {
+					t.Fatal(diff)
+				}
+
+				gk, err := have.GitserverSigningKey(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if gk.PrivateKey != privateKey {
+					t.Fatal("decrypted private key does not match")
+				}
+			})
+		}
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetCommitSigningKey(ctx, GetCommitSigningKeyOpts{ID: 0xdeadbeef})
+			if have != ErrNoResults {
+				t.Fatalf("have err %v, want %v", have, ErrNoResults)
+			}
+		})
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		for _, k := range keys {
+			if err := s.DeleteCommitSigningKey(ctx, k.ID); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.GetCommitSigningKey(ctx, GetCommitSigningKeyOpts{ID: k.ID}); err != ErrNoResults {
+				t.Fatalf("have err %v, want %v", err, ErrNoResults)
+			}
+		}
+
+		if err := s.DeleteCommitSigningKey(ctx, keys[0].ID); err != ErrNoResults {
+			t.Fatalf("have err %v, want %v", err, ErrNoResults)
+		}
+	})
+}
+
+func testGPGPrivateKey(t *testing.T) string {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("Batch Changes", "", "batch-changes@sourcegraph.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.SerializePrivate(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
@@ -203,6 +203,13 @@ type operations struct {
 	listBatchSpecRepoIDs    *observation.Operation
 	deleteExpiredBatchSpecs *observation.Operation
 
+	createBatchSpecTemplate *observation.Operation
+	updateBatchSpecTemplate *observation.Operation
+	deleteBatchSpecTemplate *observation.Operation
+	countBatchSpecTemplates *observation.Operation
+	getBatchSpecTemplate    *observation.Operation
+	listBatchSpecTemplates  *observation.Operation
+
 	upsertBatchSpecWorkspaceFile *observation.Operation
 	deleteBatchSpecWorkspaceFile *observation.Operation
 	getBatchSpecWorkspaceFile    *observation.Operation
@@ -224,6 +231,7 @@ type operations struct {
 
 	createChangesetSpec                      *observation.Operation
 	updateChangesetSpecBatchSpecID           *observation.Operation
+	updateChangesetSpecMetadata              *observation.Operation
 	deleteChangesetSpec                      *observation.Operation
 	countChangesetSpecs                      *observation.Operation
 	getChangesetSpec                         *observation.Operation
@@ -265,6 +273,10 @@ type operations struct {
 	listSiteCredentials  *observation.Operation
 	updateSiteCredential *observation.Operation
 
+	createCommitSigningKey *observation.Operation
+	deleteCommitSigningKey *observation.Operation
+	getCommitSigningKey    *observation.Operation
+
 	createBatchSpecWorkspace       *observation.Operation
 	getBatchSpecWorkspace          *observation.Operation
 	listBatchSpecWorkspaces        *observation.Operation
@@ -348,6 +360,13 @@ func newOperations(observationCtx *observation.Context) *operations {
 			listBatchSpecRepoIDs:    op("ListBatchSpecRepoIDs"),
 			deleteExpiredBatchSpecs: op("DeleteExpiredBatchSpecs"),
 
+			createBatchSpecTemplate: op("CreateBatchSpecTemplate"),
+			updateBatchSpecTemplate: op("UpdateBatchSpecTemplate"),
+			deleteBatchSpecTemplate: op("DeleteBatchSpecTemplate"),
+			countBatchSpecTemplates: op("CountBatchSpecTemplates"),
+			getBatchSpecTemplate:    op("GetBatchSpecTemplate"),
+			listBatchSpecTemplates:  op("ListBatchSpecTemplates"),
+
 			upsertBatchSpecWorkspaceFile: op("UpsertBatchSpecWorkspaceFile"),
 			deleteBatchSpecWorkspaceFile: op("DeleteBatchSpecWorkspaceFile"),
 			getBatchSpecWorkspaceFile:    op("GetBatchSpecWorkspaceFile"),
@@ -369,6 +388,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 			createChangesetSpec:                      op("CreateChangesetSpec"),
 			updateChangesetSpecBatchSpecID:           op("UpdateChangesetSpecBatchSpecID"),
+			updateChangesetSpecMetadata:              op("UpdateChangesetSpecMetadata"),
 			deleteChangesetSpec:                      op("DeleteChangesetSpec"),
 			countChangesetSpecs:                      op("CountChangesetSpecs"),
 			getChangesetSpec:                         op("GetChangesetSpec"),
@@ -410,6 +430,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 			listSiteCredentials:  op("ListSiteCredentials"),
 			updateSiteCredential: op("UpdateSiteCredential"),
 
+			createCommitSigningKey: op("CreateCommitSigningKey"),
+			deleteCommitSigningKey: op("DeleteCommitSigningKey"),
+			getCommitSigningKey:    op("GetCommitSigningKey"),
+
 			createBatchSpecWorkspace:       op("CreateBatchSpecWorkspace"),
 			getBatchSpecWorkspace:          op("GetBatchSpecWorkspace"),
 			listBatchSpecWorkspaces:        op("ListBatchSpecWorkspaces"),
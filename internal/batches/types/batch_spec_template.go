@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+)
+
+// BatchSpecTemplateInputType defines the possible types of an input declared
+// by a BatchSpecTemplate.
+type BatchSpecTemplateInputType string
+
+const (
+	BatchSpecTemplateInputTypeString    BatchSpecTemplateInputType = "STRING"
+	BatchSpecTemplateInputTypeRepoQuery BatchSpecTemplateInputType = "REPO_QUERY"
+	BatchSpecTemplateInputTypeBoolean   BatchSpecTemplateInputType = "BOOLEAN"
+	BatchSpecTemplateInputTypeEnum      BatchSpecTemplateInputType = "ENUM"
+)
+
+// Valid returns true if the given BatchSpecTemplateInputType is known.
+func (t BatchSpecTemplateInputType) Valid() bool {
+	switch t {
+	case BatchSpecTemplateInputTypeString,
+		BatchSpecTemplateInputTypeRepoQuery,
+		BatchSpecTemplateInputTypeBoolean,
+		BatchSpecTemplateInputTypeEnum:
+		return true
+	default:
+		return false
+	}
+}
+
+// BatchSpecTemplateInput is a typed input declared by a BatchSpecTemplate. Its
+// value is available in the template as `${{ inputs.<name> }}`.
+type BatchSpecTemplateInput struct {
+	Name        string                     `json:"name"`
+	Description string                     `json:"description,omitempty"`
+	Type        BatchSpecTemplateInputType `json:"type"`
+	Required    bool                       `json:"required,omitempty"`
+	// Default is used when no value is given for an input that is not
+	// required.
+	Default *string `json:"default,omitempty"`
+	// Options holds the allowed values of an ENUM input.
+	Options []string `json:"options,omitempty"`
+}
+
+// BatchSpecTemplate is a reusable batch spec with typed inputs, owned by a
+// namespace. It is rendered into a BatchSpec by substituting the values given
+// for its inputs.
+type BatchSpecTemplate struct {
+	ID          int64
+	Name        string
+	Description string
+
+	// Spec is the raw batch spec template.
+	Spec   string
+	Inputs []BatchSpecTemplateInput
+
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+
+	CreatorID int32
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Clone returns a clone of a BatchSpecTemplate.
+func (t *BatchSpecTemplate) Clone() *BatchSpecTemplate {
+	tt := *t
+	if t.Inputs != nil {
+		tt.Inputs = make([]BatchSpecTemplateInput, len(t.Inputs))
+		copy(tt.Inputs, t.Inputs)
+	}
+	return &tt
+}
@@ -42,12 +42,13 @@ func (s ChangesetJobState) ToDB() string { return strings.ToLower(string(s)) }
 type ChangesetJobType string
 
 var (
-	ChangesetJobTypeComment   ChangesetJobType = "commentatore"
-	ChangesetJobTypeDetach    ChangesetJobType = "detach"
-	ChangesetJobTypeReenqueue ChangesetJobType = "reenqueue"
-	ChangesetJobTypeMerge     ChangesetJobType = "merge"
-	ChangesetJobTypeClose     ChangesetJobType = "close"
-	ChangesetJobTypePublish   ChangesetJobType = "publish"
+	ChangesetJobTypeComment        ChangesetJobType = "commentatore"
+	ChangesetJobTypeDetach         ChangesetJobType = "detach"
+	ChangesetJobTypeReenqueue      ChangesetJobType = "reenqueue"
+	ChangesetJobTypeMerge          ChangesetJobType = "merge"
+	ChangesetJobTypeClose          ChangesetJobType = "close"
+	ChangesetJobTypePublish        ChangesetJobType = "publish"
+	ChangesetJobTypeUpdateMetadata ChangesetJobType = "update-metadata"
 )
 
 type ChangesetJobCommentPayload struct {
@@ -68,6 +69,14 @@ type ChangesetJobPublishPayload struct {
 	Draft bool `json:"draft"`
 }
 
+// ChangesetJobUpdateMetadataPayload holds the changeset template fields to be
+// re-rendered. Fields that are nil are left as they are on the changeset spec.
+type ChangesetJobUpdateMetadataPayload struct {
+	Title  *string `json:"title,omitempty"`
+	Body   *string `json:"body,omitempty"`
+	Branch *string `json:"branch,omitempty"`
+}
+
 // ChangesetJob describes a one-time action to be taken on a changeset.
 type ChangesetJob struct {
 	ID int64
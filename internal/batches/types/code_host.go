@@ -16,3 +16,15 @@ type CodeHost struct {
 func (c *CodeHost) IsSupported() bool {
 	return IsKindSupported(extsvc.TypeToKind(c.ExternalServiceType))
 }
+
+// SupportsGitserverCommitSigning returns true if the commits batch changes
+// pushes to code hosts of the given type can be signed by gitserver with a
+// configured commit signing key. Commits on GitHub are signed by a GitHub App
+// instead, and Perforce changelists don't carry commit signatures.
+func SupportsGitserverCommitSigning(externalServiceType string) bool {
+	switch externalServiceType {
+	case extsvc.TypeGitLab, extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps, extsvc.TypeGerrit:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CommitSigningKey is a GPG or SSH key used to sign the commits batch changes
+// push to code hosts that don't sign commits for us. A key without a UserID is
+// the site-wide default, used for all users that haven't configured their own
+// key.
+type CommitSigningKey struct {
+	ID          int64
+	UserID      int32
+	Format      commitsigning.Format
+	PublicKey   string
+	Fingerprint string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	Credential *database.EncryptableCredential
+}
+
+// IsSiteKey returns true if the key is the site-wide default key.
+func (k *CommitSigningKey) IsSiteKey() bool {
+	return k.UserID == 0
+}
+
+type commitSigningKeySecret struct {
+	PrivateKey string `json:"privateKey"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// SetPrivateKey validates the private key, derives the public key and
+// fingerprint from it and stores it with its passphrase in the credential.
+func (k *CommitSigningKey) SetPrivateKey(format commitsigning.Format, privateKey, passphrase string) error {
+	signer, err := commitsigning.NewSigner(format, privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(commitSigningKeySecret{PrivateKey: privateKey, Passphrase: passphrase})
+	if err != nil {
+		return err
+	}
+
+	k.Format = format
+	k.PublicKey = signer.PublicKey()
+	k.Fingerprint = signer.Fingerprint()
+	k.Credential = database.NewUnencryptedCredential(raw)
+	return nil
+}
+
+// GitserverSigningKey decrypts the private key and returns it in the form
+// gitserver expects it when creating a commit.
+func (k *CommitSigningKey) GitserverSigningKey(ctx context.Context) (*protocol.CommitSigningKey, error) {
+	decrypted, err := k.Credential.Decrypt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret commitSigningKeySecret
+	if err := json.Unmarshal([]byte(decrypted), &secret); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling commit signing key")
+	}
+
+	return &protocol.CommitSigningKey{
+		Format:     k.Format,
+		PrivateKey: secret.PrivateKey,
+		Passphrase: secret.Passphrase,
+	}, nil
+}
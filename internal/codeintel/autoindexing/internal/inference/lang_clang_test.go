@@ -0,0 +1,81 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestClangGenerator(t *testing.T) {
+	expectedIndexerImage := mockIndexer(t, "clang", "sourcegraph/scip-clang@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "compilation database",
+			repositoryContents: map[string]string{
+				"compile_commands.json":     "",
+				"CMakeLists.txt":            "",
+				"lib/compile_commands.json": "",
+			},
+			expected: []config.IndexJob{
+				{
+					Steps:       nil,
+					LocalSteps:  nil,
+					Root:        "",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-clang", "--compdb-path=compile_commands.json"},
+					Outfile:     "index.scip",
+				},
+			},
+		},
+		generatorTestCase{
+			description: "cmake projects",
+			repositoryContents: map[string]string{
+				"foo/CMakeLists.txt":                  "",
+				"foo/src/CMakeLists.txt":              "",
+				"bar/CMakeLists.txt":                  "",
+				"bar/third_party/zlib/CMakeLists.txt": "",
+			},
+			expected: []config.IndexJob{
+				{
+					Steps: []config.DockerStep{
+						{
+							Root:     "bar",
+							Image:    expectedIndexerImage,
+							Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+						},
+					},
+					LocalSteps:  nil,
+					Root:        "bar",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-clang", "--compdb-path=build/compile_commands.json"},
+					Outfile:     "index.scip",
+				},
+				{
+					Steps: []config.DockerStep{
+						{
+							Root:     "foo",
+							Image:    expectedIndexerImage,
+							Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+						},
+					},
+					LocalSteps:  nil,
+					Root:        "foo",
+					Indexer:     expectedIndexerImage,
+					IndexerArgs: []string{"scip-clang", "--compdb-path=build/compile_commands.json"},
+					Outfile:     "index.scip",
+				},
+			},
+		},
+		generatorTestCase{
+			description: "sources without build files",
+			repositoryContents: map[string]string{
+				"main.cpp":  "",
+				"util.h":    "",
+				"Makefile":  "",
+				"README.md": "",
+			},
+			expected: []config.IndexJob{},
+		},
+	)
+}
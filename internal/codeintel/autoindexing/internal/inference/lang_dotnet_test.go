@@ -0,0 +1,52 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestDotnetGenerator(t *testing.T) {
+	expectedIndexerImage := mockIndexer(t, "dotnet", "sourcegraph/scip-dotnet@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+	dotnetJob := func(root string) config.IndexJob {
+		return config.IndexJob{
+			Steps:       nil,
+			LocalSteps:  nil,
+			Root:        root,
+			Indexer:     expectedIndexerImage,
+			IndexerArgs: []string{"scip-dotnet", "index"},
+			Outfile:     "index.scip",
+		}
+	}
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "solution files",
+			repositoryContents: map[string]string{
+				"App.sln":            "",
+				"src/App/App.csproj": "",
+				"src/Lib/Lib.csproj": "",
+				"tools/Tools.sln":    "",
+			},
+			expected: []config.IndexJob{dotnetJob(""), dotnetJob("tools")},
+		},
+		generatorTestCase{
+			description: "project files without solution",
+			repositoryContents: map[string]string{
+				"src/App/App.csproj":       "",
+				"src/App/Program.cs":       "",
+				"src/Legacy/Legacy.vbproj": "",
+				"src/Legacy/Module.vb":     "",
+			},
+			expected: []config.IndexJob{dotnetJob("src/App"), dotnetJob("src/Legacy")},
+		},
+		generatorTestCase{
+			description: "sources without project files",
+			repositoryContents: map[string]string{
+				"Program.cs": "",
+			},
+			expected: []config.IndexJob{},
+		},
+	)
+}
@@ -59,6 +59,24 @@ func TestJavaGenerator(t *testing.T) {
 			},
 			expected: singleTopLevelJob,
 		},
+		generatorTestCase{
+			description: "Kotlin project with Gradle Kotlin DSL",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts":                    "",
+				"app/build.gradle.kts":                   "",
+				"app/src/main/kotlin/com/example/App.kt": "",
+			},
+			expected: singleTopLevelJob,
+		},
+		generatorTestCase{
+			description: "Scala project with SBT",
+			repositoryContents: map[string]string{
+				"build.sbt":                 "",
+				"project/build.properties":  "",
+				"src/main/scala/Main.scala": "",
+			},
+			expected: singleTopLevelJob,
+		},
 		generatorTestCase{
 			description: "JVM project without build file",
 			repositoryContents: map[string]string{
@@ -16,6 +16,8 @@ var Indexes = indexesAPI{}
 type indexesAPI struct{}
 
 var defaultIndexers = map[string]string{
+	"clang":      "sourcegraph/scip-clang",
+	"dotnet":     "sourcegraph/scip-dotnet",
 	"go":         "sourcegraph/scip-go",
 	"java":       "sourcegraph/scip-java",
 	"python":     "sourcegraph/scip-python",
@@ -24,8 +26,12 @@ var defaultIndexers = map[string]string{
 	"ruby":       "sourcegraph/scip-ruby",
 }
 
-// To update, run `DOCKER_USER=... DOCKER_PASS=... ./update-shas.sh`
+// To update, run `DOCKER_USER=... DOCKER_PASS=... ./update-shas.sh`. Indexers with an empty
+// SHA have not been pinned yet and have no default image, so they must be configured in the
+// codeIntelAutoIndexing.indexerMap site setting to be used.
 var defaultIndexerSHAs = map[string]string{
+	"sourcegraph/scip-clang":      "",
+	"sourcegraph/scip-dotnet":     "",
 	"sourcegraph/scip-go":         "sha256:4f82e2490c4385a3c47ac0d062c9c53ce5a0bfc5acf0c4032ad07486b39163ec",
 	"sourcegraph/lsif-rust":       "sha256:83cb769788987eb52f21a18b62d51ebb67c9436e1b0d2e99904c70fef424f9d1",
 	"sourcegraph/scip-rust":       "sha256:adf0047fc3050ba4f7be71302b42c74b49901f38fb40916d94ac5fc9181ac078",
@@ -45,6 +51,9 @@ func DefaultIndexerForLang(language string) (string, bool) {
 	if !ok {
 		panic(fmt.Sprintf("no SHA set for indexer %q", indexer))
 	}
+	if sha == "" {
+		return "", false
+	}
 
 	return fmt.Sprintf("%s@%s", indexer, sha), true
 }
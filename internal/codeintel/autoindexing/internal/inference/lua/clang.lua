@@ -0,0 +1,110 @@
+local path = require "path"
+local recognizer = require "sg.autoindex.recognizer"
+local pattern = require "sg.autoindex.patterns"
+
+local shared = require "sg.autoindex.shared"
+
+-- The default scip-clang image is not pinned yet, so jobs are only inferred when an
+-- image is configured in the codeIntelAutoIndexing.indexerMap site setting
+local has_indexer, indexer = pcall(require("sg.autoindex.indexes").get, "clang")
+local outfile = "index.scip"
+
+-- is_nested returns true if the given directory is a strict descendant of one
+-- of the given roots.
+local is_nested = function(dir, roots)
+  for i = 1, #roots do
+    local root = roots[i]
+    if root ~= dir and (root == "" or string.sub(dir, 1, string.len(root) + 1) == root .. "/") then
+      return true
+    end
+  end
+
+  return false
+end
+
+-- outermost_dirs returns the sorted, unique directories of the given paths that
+-- are not nested within the directory of another given path.
+local outermost_dirs = function(paths)
+  local seen = {}
+  local dirs = {}
+  for i = 1, #paths do
+    local dir = path.dirname(paths[i])
+    if not seen[dir] then
+      seen[dir] = true
+      table.insert(dirs, dir)
+    end
+  end
+  table.sort(dirs)
+
+  local roots = {}
+  for i = 1, #dirs do
+    if not is_nested(dirs[i], dirs) then
+      table.insert(roots, dirs[i])
+    end
+  end
+
+  return roots
+end
+
+local compdb_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "compile_commands.json",
+    pattern.new_path_exclude(shared.exclude_paths),
+  },
+
+  -- Invoked when a compilation database is checked into the repository
+  generate = function(_, paths)
+    local jobs = {}
+    for _, root in ipairs(outermost_dirs(paths)) do
+      table.insert(jobs, {
+        steps = {},
+        root = root,
+        indexer = indexer,
+        indexer_args = { "scip-clang", "--compdb-path=compile_commands.json" },
+        outfile = outfile,
+      })
+    end
+
+    return jobs
+  end,
+}
+
+local cmake_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "CMakeLists.txt",
+    pattern.new_path_exclude(shared.exclude_paths),
+  },
+
+  -- Invoked when no compilation database exists but CMake projects do. Nested
+  -- CMakeLists.txt files are generally included by the enclosing project via
+  -- add_subdirectory, so we only configure the outermost projects.
+  generate = function(_, paths)
+    local jobs = {}
+    for _, root in ipairs(outermost_dirs(paths)) do
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            commands = { "cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "scip-clang", "--compdb-path=build/compile_commands.json" },
+        outfile = outfile,
+      })
+    end
+
+    return jobs
+  end,
+}
+
+if not has_indexer then
+  return recognizer.new_fallback_recognizer {}
+end
+
+return recognizer.new_fallback_recognizer {
+  compdb_recognizer,
+  cmake_recognizer,
+}
@@ -0,0 +1,69 @@
+local path = require "path"
+local recognizer = require "sg.autoindex.recognizer"
+local pattern = require "sg.autoindex.patterns"
+
+local shared = require "sg.autoindex.shared"
+
+-- The default scip-dotnet image is not pinned yet, so jobs are only inferred when an
+-- image is configured in the codeIntelAutoIndexing.indexerMap site setting
+local has_indexer, indexer = pcall(require("sg.autoindex.indexes").get, "dotnet")
+local outfile = "index.scip"
+
+local new_job = function(root)
+  return {
+    steps = {},
+    root = root,
+    indexer = indexer,
+    indexer_args = { "scip-dotnet", "index" },
+    outfile = outfile,
+  }
+end
+
+local new_jobs = function(paths)
+  local roots = {}
+  for i = 1, #paths do
+    roots[path.dirname(paths[i])] = true
+  end
+
+  local jobs = {}
+  for root in pairs(roots) do
+    table.insert(jobs, new_job(root))
+  end
+
+  return jobs
+end
+
+local solution_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "sln",
+    pattern.new_path_exclude(shared.exclude_paths),
+  },
+
+  -- Invoked when solution files exist; scip-dotnet indexes every project
+  -- referenced by a solution in its working directory
+  generate = function(_, paths)
+    return new_jobs(paths)
+  end,
+}
+
+local project_recognizer = recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "csproj",
+    pattern.new_path_extension "vbproj",
+    pattern.new_path_exclude(shared.exclude_paths),
+  },
+
+  -- Invoked when no solution files exist but project files do
+  generate = function(_, paths)
+    return new_jobs(paths)
+  end,
+}
+
+if not has_indexer then
+  return recognizer.new_fallback_recognizer {}
+end
+
+return recognizer.new_fallback_recognizer {
+  solution_recognizer,
+  project_recognizer,
+}
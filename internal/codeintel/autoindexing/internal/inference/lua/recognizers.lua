@@ -1,6 +1,8 @@
 local config = require("sg.autoindex.config").new {}
 
 for _, name in ipairs {
+  "clang",
+  "dotnet",
   "go",
   "java",
   "python",
@@ -7,7 +7,10 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/inference/libs"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestEmptyGenerators(t *testing.T) {
@@ -20,6 +23,26 @@ func TestEmptyGenerators(t *testing.T) {
 	)
 }
 
+func TestUnpinnedIndexerGenerators(t *testing.T) {
+	for language, path := range map[string]string{
+		"clang":  "compile_commands.json",
+		"dotnet": "App.sln",
+	} {
+		if _, ok := libs.DefaultIndexerForLang(language); ok {
+			continue
+		}
+
+		// Projects are not indexed with unpinned images unless an image is configured
+		testGenerators(t,
+			generatorTestCase{
+				description:        language + " without configured indexer",
+				repositoryContents: map[string]string{path: ""},
+				expected:           []config.IndexJob{},
+			},
+		)
+	}
+}
+
 func TestOverrideGenerators(t *testing.T) {
 	testGenerators(t,
 		generatorTestCase{
@@ -133,6 +156,17 @@ type generatorTestCase struct {
 	expected           []config.IndexJob
 }
 
+// mockIndexer configures the image of the indexer of the given language in the site configuration
+// for the duration of the test, and returns it.
+func mockIndexer(t *testing.T, language, image string) string {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		CodeIntelAutoIndexingIndexerMap: map[string]string{language: image},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	return image
+}
+
 func testGenerators(t *testing.T, testCases ...generatorTestCase) {
 	for _, testCase := range testCases {
 		testGenerator(t, testCase)
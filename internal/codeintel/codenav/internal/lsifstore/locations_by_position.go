@@ -240,6 +240,7 @@ type extractedOccurrenceData struct {
 	references      []*scip.Range
 	implementations []*scip.Range
 	prototypes      []*scip.Range
+	typeDefinitions []*scip.Range
 	hoverText       []string
 }
 
@@ -259,6 +260,10 @@ func extractPrototypesRanges(document *scip.Document, occurrence *scip.Occurrenc
 	return extractOccurrenceData(document, occurrence).prototypes
 }
 
+func extractTypeDefinitionRanges(document *scip.Document, occurrence *scip.Occurrence) []*scip.Range {
+	return extractOccurrenceData(document, occurrence).typeDefinitions
+}
+
 func extractHoverData(document *scip.Document, occurrence *scip.Occurrence) []string {
 	return extractOccurrenceData(document, occurrence).hoverText
 }
@@ -276,6 +281,7 @@ func extractOccurrenceData(document *scip.Document, occurrence *scip.Occurrence)
 		referencesBySymbol      = map[string]struct{}{}
 		implementationsBySymbol = map[string]struct{}{}
 		prototypeBySymbol       = map[string]struct{}{}
+		typeDefinitionBySymbol  = map[string]struct{}{}
 	)
 
 	// Extract hover text and relationship data from the symbol information that
@@ -295,6 +301,9 @@ func extractOccurrenceData(document *scip.Document, occurrence *scip.Occurrence)
 			if rel.IsImplementation {
 				prototypeBySymbol[rel.Symbol] = struct{}{}
 			}
+			if rel.IsTypeDefinition {
+				typeDefinitionBySymbol[rel.Symbol] = struct{}{}
+			}
 		}
 	}
 
@@ -312,6 +321,7 @@ func extractOccurrenceData(document *scip.Document, occurrence *scip.Occurrence)
 	references := []*scip.Range{}
 	implementations := []*scip.Range{}
 	prototypes := []*scip.Range{}
+	typeDefinitions := []*scip.Range{}
 
 	// Include original symbol names for reference search below
 	referencesBySymbol[occurrence.Symbol] = struct{}{}
@@ -341,6 +351,11 @@ func extractOccurrenceData(document *scip.Document, occurrence *scip.Occurrence)
 		if _, ok := prototypeBySymbol[occ.Symbol]; ok && isDefinition {
 			prototypes = append(prototypes, scip.NewRange(occ.Range))
 		}
+
+		// This occurrence is a definition of the type of this symbol
+		if _, ok := typeDefinitionBySymbol[occ.Symbol]; ok && isDefinition {
+			typeDefinitions = append(typeDefinitions, scip.NewRange(occ.Range))
+		}
 	}
 
 	// Override symbol documentation with occurrence documentation, if it exists
@@ -354,6 +369,7 @@ func extractOccurrenceData(document *scip.Document, occurrence *scip.Occurrence)
 		implementations: implementations,
 		hoverText:       hoverText,
 		prototypes:      prototypes,
+		typeDefinitions: typeDefinitions,
 	}
 }
 
@@ -385,6 +401,10 @@ func (s *store) ExtractPrototypeLocationsFromPosition(ctx context.Context, locat
 	return s.extractLocationsFromPosition(ctx, extractPrototypesRanges, symbolExtractPrototype, s.operations.getPrototypesLocations, locationKey)
 }
 
+func (s *store) ExtractTypeDefinitionLocationsFromPosition(ctx context.Context, locationKey LocationKey) (_ []shared.Location, _ []string, err error) {
+	return s.extractLocationsFromPosition(ctx, extractTypeDefinitionRanges, symbolExtractTypeDefinitions, s.operations.getTypeDefinitionLocations, locationKey)
+}
+
 func symbolExtractDefault(document *scip.Document, symbolName string) (symbols []string) {
 	if symbol := scip.FindSymbol(document, symbolName); symbol != nil {
 		for _, rel := range symbol.Relationships {
@@ -423,6 +443,18 @@ func symbolExtractPrototype(document *scip.Document, symbolName string) (symbols
 	return symbols
 }
 
+func symbolExtractTypeDefinitions(document *scip.Document, symbolName string) (symbols []string) {
+	if symbol := scip.FindSymbol(document, symbolName); symbol != nil {
+		for _, rel := range symbol.Relationships {
+			if rel.IsTypeDefinition {
+				symbols = append(symbols, rel.Symbol)
+			}
+		}
+	}
+
+	return symbols
+}
+
 //
 //
 
@@ -672,6 +672,85 @@ func TestExtractOccurrenceData(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("type definitions", func(t *testing.T) {
+		testCases := []struct {
+			explanation    string
+			document       *scip.Document
+			occurrence     *scip.Occurrence
+			expectedRanges []*scip.Range
+		}{
+			{
+				explanation: "#1 happy path: the type of the symbol is defined in the document",
+				document: &scip.Document{
+					Occurrences: []*scip.Occurrence{
+						{
+							Range:       []int32{3, 300, 4, 400},
+							Symbol:      "react 17.1 main.go Props#",
+							SymbolRoles: 1, // a definition
+						},
+						{
+							Range:       []int32{5, 500, 6, 600},
+							Symbol:      "react 17.1 main.go Props#",
+							SymbolRoles: 0, // not a definition
+						},
+					},
+					Symbols: []*scip.SymbolInformation{
+						{
+							Symbol: "react 17.1 main.go props.",
+							Relationships: []*scip.Relationship{
+								{
+									Symbol:           "react 17.1 main.go Props#",
+									IsTypeDefinition: true,
+								},
+							},
+						},
+					},
+				},
+				occurrence: &scip.Occurrence{
+					Symbol:      "react 17.1 main.go props.",
+					SymbolRoles: 1,
+				},
+				expectedRanges: []*scip.Range{
+					scip.NewRange([]int32{3, 300, 4, 400}),
+				},
+			},
+			{
+				explanation: "#2 no ranges available: symbol has no type definition relationship",
+				document: &scip.Document{
+					Occurrences: []*scip.Occurrence{
+						{
+							Range:       []int32{3, 300, 4, 400},
+							Symbol:      "react 17.1 main.go Props#",
+							SymbolRoles: 1, // a definition
+						},
+					},
+					Symbols: []*scip.SymbolInformation{
+						{
+							Symbol: "react 17.1 main.go props.",
+							Relationships: []*scip.Relationship{
+								{
+									Symbol:      "react 17.1 main.go Props#",
+									IsReference: true,
+								},
+							},
+						},
+					},
+				},
+				occurrence: &scip.Occurrence{
+					Symbol:      "react 17.1 main.go props.",
+					SymbolRoles: 1,
+				},
+				expectedRanges: []*scip.Range{},
+			},
+		}
+
+		for _, testCase := range testCases {
+			if diff := cmp.Diff(testCase.expectedRanges, extractOccurrenceData(testCase.document, testCase.occurrence).typeDefinitions); diff != "" {
+				t.Errorf("unexpected ranges (-want +got):\n%s -- %s", diff, testCase.explanation)
+			}
+		}
+	})
 }
 
 func TestGetBulkMonikerLocations(t *testing.T) {
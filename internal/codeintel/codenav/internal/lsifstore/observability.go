@@ -16,6 +16,7 @@ type operations struct {
 	getDefinitionLocations     *observation.Operation
 	getImplementationLocations *observation.Operation
 	getPrototypesLocations     *observation.Operation
+	getTypeDefinitionLocations *observation.Operation
 	getReferenceLocations      *observation.Operation
 	getBulkMonikerLocations    *observation.Operation
 	getHover                   *observation.Operation
@@ -52,6 +53,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getDefinitionLocations:     op("GetDefinitionLocations"),
 		getImplementationLocations: op("GetImplementationLocations"),
 		getPrototypesLocations:     op("GetPrototypesLocations"),
+		getTypeDefinitionLocations: op("GetTypeDefinitionLocations"),
 		getReferenceLocations:      op("GetReferenceLocations"),
 		getBulkMonikerLocations:    op("GetBulkMonikerLocations"),
 		getHover:                   op("GetHover"),
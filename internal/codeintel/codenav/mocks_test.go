@@ -38,6 +38,10 @@ type MockLsifStore struct {
 	// function object controlling the behavior of the method
 	// ExtractReferenceLocationsFromPosition.
 	ExtractReferenceLocationsFromPositionFunc *LsifStoreExtractReferenceLocationsFromPositionFunc
+	// ExtractTypeDefinitionLocationsFromPositionFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ExtractTypeDefinitionLocationsFromPosition.
+	ExtractTypeDefinitionLocationsFromPositionFunc *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc
 	// GetBulkMonikerLocationsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetBulkMonikerLocations.
 	GetBulkMonikerLocationsFunc *LsifStoreGetBulkMonikerLocationsFunc
@@ -108,6 +112,11 @@ func NewMockLsifStore() *MockLsifStore {
 				return
 			},
 		},
+		ExtractTypeDefinitionLocationsFromPositionFunc: &LsifStoreExtractTypeDefinitionLocationsFromPositionFunc{
+			defaultHook: func(context.Context, lsifstore.LocationKey) (r0 []shared.Location, r1 []string, r2 error) {
+				return
+			},
+		},
 		GetBulkMonikerLocationsFunc: &LsifStoreGetBulkMonikerLocationsFunc{
 			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) (r0 []shared.Location, r1 int, r2 error) {
 				return
@@ -205,6 +214,11 @@ func NewStrictMockLsifStore() *MockLsifStore {
 				panic("unexpected invocation of MockLsifStore.ExtractReferenceLocationsFromPosition")
 			},
 		},
+		ExtractTypeDefinitionLocationsFromPositionFunc: &LsifStoreExtractTypeDefinitionLocationsFromPositionFunc{
+			defaultHook: func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error) {
+				panic("unexpected invocation of MockLsifStore.ExtractTypeDefinitionLocationsFromPosition")
+			},
+		},
 		GetBulkMonikerLocationsFunc: &LsifStoreGetBulkMonikerLocationsFunc{
 			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) ([]shared.Location, int, error) {
 				panic("unexpected invocation of MockLsifStore.GetBulkMonikerLocations")
@@ -294,6 +308,9 @@ func NewMockLsifStoreFrom(i lsifstore.LsifStore) *MockLsifStore {
 		ExtractReferenceLocationsFromPositionFunc: &LsifStoreExtractReferenceLocationsFromPositionFunc{
 			defaultHook: i.ExtractReferenceLocationsFromPosition,
 		},
+		ExtractTypeDefinitionLocationsFromPositionFunc: &LsifStoreExtractTypeDefinitionLocationsFromPositionFunc{
+			defaultHook: i.ExtractTypeDefinitionLocationsFromPosition,
+		},
 		GetBulkMonikerLocationsFunc: &LsifStoreGetBulkMonikerLocationsFunc{
 			defaultHook: i.GetBulkMonikerLocations,
 		},
@@ -804,6 +821,122 @@ func (c LsifStoreExtractReferenceLocationsFromPositionFuncCall) Results() []inte
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// LsifStoreExtractTypeDefinitionLocationsFromPositionFunc describes the behavior
+// when the ExtractTypeDefinitionLocationsFromPosition method of the parent
+// MockLsifStore instance is invoked.
+type LsifStoreExtractTypeDefinitionLocationsFromPositionFunc struct {
+	defaultHook func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error)
+	hooks       []func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error)
+	history     []LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall
+	mutex       sync.Mutex
+}
+
+// ExtractTypeDefinitionLocationsFromPosition delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockLsifStore) ExtractTypeDefinitionLocationsFromPosition(v0 context.Context, v1 lsifstore.LocationKey) ([]shared.Location, []string, error) {
+	r0, r1, r2 := m.ExtractTypeDefinitionLocationsFromPositionFunc.nextHook()(v0, v1)
+	m.ExtractTypeDefinitionLocationsFromPositionFunc.appendCall(LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// ExtractTypeDefinitionLocationsFromPosition method of the parent MockLsifStore
+// instance is invoked and the hook queue is empty.
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) SetDefaultHook(hook func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ExtractTypeDefinitionLocationsFromPosition method of the parent MockLsifStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) PushHook(hook func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) SetDefaultReturn(r0 []shared.Location, r1 []string, r2 error) {
+	f.SetDefaultHook(func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) PushReturn(r0 []shared.Location, r1 []string, r2 error) {
+	f.PushHook(func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) nextHook() func(context.Context, lsifstore.LocationKey) ([]shared.Location, []string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) appendCall(r0 LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall objects describing
+// the invocations of this function.
+func (f *LsifStoreExtractTypeDefinitionLocationsFromPositionFunc) History() []LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall {
+	f.mutex.Lock()
+	history := make([]LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall is an object that
+// describes an invocation of method ExtractTypeDefinitionLocationsFromPosition
+// on an instance of MockLsifStore.
+type LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 lsifstore.LocationKey
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.Location
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 []string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LsifStoreExtractTypeDefinitionLocationsFromPositionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // LsifStoreGetBulkMonikerLocationsFunc describes the behavior when the
 // GetBulkMonikerLocations method of the parent MockLsifStore instance is
 // invoked.
@@ -20,6 +20,10 @@ type operations struct {
 	getDefinitions         *observation.Operation
 	getRanges              *observation.Operation
 	getStencil             *observation.Operation
+	getIncomingCalls       *observation.Operation
+	getOutgoingCalls       *observation.Operation
+	getTypeDefinitions     *observation.Operation
+	previewRename          *observation.Operation
 	getClosestDumpsForBlob *observation.Operation
 	snapshotForDocument    *observation.Operation
 	visibleUploadsForPath  *observation.Operation
@@ -54,6 +58,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getDefinitions:         op("getDefinitions"),
 		getRanges:              op("getRanges"),
 		getStencil:             op("getStencil"),
+		getIncomingCalls:       op("getIncomingCalls"),
+		getOutgoingCalls:       op("getOutgoingCalls"),
+		getTypeDefinitions:     op("getTypeDefinitions"),
+		previewRename:          op("previewRename"),
 		getClosestDumpsForBlob: op("GetClosestDumpsForBlob"),
 		snapshotForDocument:    op("SnapshotForDocument"),
 		visibleUploadsForPath:  op("VisibleUploadsForPath"),
@@ -0,0 +1,262 @@
+package codenav
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Patch is a set of changes, given as a unified diff, applied on top of a commit that is known to
+// gitserver. Patches describe the state of files that do not exist on gitserver (yet), such as
+// unsaved editor buffers or the head of an unpushed pull request.
+type Patch struct {
+	fileDiffsByNewPath  map[string]*patchedFile
+	fileDiffsByOrigPath map[string]*patchedFile
+}
+
+type patchedFile struct {
+	origPath string
+	newPath  string
+	hunks    []*diff.Hunk
+	// inverseHunks translate from the patched file back into the original file
+	inverseHunks []*diff.Hunk
+}
+
+const devNull = "/dev/null"
+
+// ParsePatch parses the given unified diff. File names may carry the a/ and b/ prefixes added by
+// git diff.
+func ParsePatch(patch string) (*Patch, error) {
+	fileDiffs, err := diff.ParseMultiFileDiff([]byte(patch))
+	if err != nil {
+		return nil, errors.Wrap(err, "diff.ParseMultiFileDiff")
+	}
+
+	p := &Patch{
+		fileDiffsByNewPath:  make(map[string]*patchedFile, len(fileDiffs)),
+		fileDiffsByOrigPath: make(map[string]*patchedFile, len(fileDiffs)),
+	}
+	for _, fileDiff := range fileDiffs {
+		file := &patchedFile{
+			origPath:     trimDiffPathPrefix(fileDiff.OrigName, "a/"),
+			newPath:      trimDiffPathPrefix(fileDiff.NewName, "b/"),
+			hunks:        fileDiff.Hunks,
+			inverseHunks: invertHunks(fileDiff.Hunks),
+		}
+		if file.origPath == "" && file.newPath == "" {
+			return nil, errors.New("file diff has no file names")
+		}
+		for _, hunk := range fileDiff.Hunks {
+			if err := validateHunk(hunk); err != nil {
+				return nil, errors.Wrapf(err, "malformed hunk in %q", fileDiff.NewName)
+			}
+		}
+
+		if file.origPath != "" {
+			p.fileDiffsByOrigPath[file.origPath] = file
+		}
+		if file.newPath != "" {
+			p.fileDiffsByNewPath[file.newPath] = file
+		}
+	}
+
+	return p, nil
+}
+
+// OriginalPath returns the path of the given patched file within the commit the patch applies to.
+// If the file was created by the patch, a false-valued flag is returned.
+func (p *Patch) OriginalPath(path string) (string, bool) {
+	if file, ok := p.fileDiffsByNewPath[path]; ok {
+		return file.origPath, file.origPath != ""
+	}
+	if _, ok := p.fileDiffsByOrigPath[path]; ok {
+		// Deleted or renamed away by the patch
+		return "", false
+	}
+
+	return path, true
+}
+
+// patchedPath returns the path of the given file of the commit the patch applies to once the patch
+// is applied along with the hunks that translate positions of that file. If the file was deleted by
+// the patch, a false-valued flag is returned.
+func (p *Patch) patchedPath(path string) (string, []*diff.Hunk, bool) {
+	if file, ok := p.fileDiffsByOrigPath[path]; ok {
+		return file.newPath, file.hunks, file.newPath != ""
+	}
+
+	return path, nil, true
+}
+
+// originalHunks returns the hunks that translate positions of the given patched file into the
+// equivalent positions of the commit the patch applies to.
+func (p *Patch) originalHunks(path string) []*diff.Hunk {
+	if file, ok := p.fileDiffsByNewPath[path]; ok {
+		return file.inverseHunks
+	}
+
+	return nil
+}
+
+func trimDiffPathPrefix(name, prefix string) string {
+	if name == devNull {
+		return ""
+	}
+
+	return strings.TrimPrefix(name, prefix)
+}
+
+// validateHunk ensures that the body of the given hunk matches its header. Translating positions
+// through a hunk with a body shorter than its header claims is a programming error.
+func validateHunk(hunk *diff.Hunk) error {
+	var origLines, newLines int32
+	for _, line := range bytes.Split(hunk.Body, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		switch line[0] {
+		case '+':
+			newLines++
+		case '-':
+			origLines++
+		case ' ':
+			origLines++
+			newLines++
+		}
+	}
+
+	if origLines != hunk.OrigLines || newLines != hunk.NewLines {
+		return errors.Newf("hunk header describes -%d,+%d lines but body contains -%d,+%d lines", hunk.OrigLines, hunk.NewLines, origLines, newLines)
+	}
+
+	return nil
+}
+
+// invertHunks returns hunks that describe the reverse of the given hunks, in which every added
+// line is removed and every removed line is added.
+func invertHunks(hunks []*diff.Hunk) []*diff.Hunk {
+	inverted := make([]*diff.Hunk, 0, len(hunks))
+	for _, hunk := range hunks {
+		lines := bytes.Split(hunk.Body, []byte("\n"))
+		for i, line := range lines {
+			if len(line) == 0 {
+				continue
+			}
+
+			switch line[0] {
+			case '+':
+				lines[i] = append([]byte{'-'}, line[1:]...)
+			case '-':
+				lines[i] = append([]byte{'+'}, line[1:]...)
+			}
+		}
+
+		inverted = append(inverted, &diff.Hunk{
+			OrigStartLine: hunk.NewStartLine,
+			OrigLines:     hunk.NewLines,
+			NewStartLine:  hunk.OrigStartLine,
+			NewLines:      hunk.OrigLines,
+			Section:       hunk.Section,
+			Body:          bytes.Join(lines, []byte("\n")),
+		})
+	}
+
+	return inverted
+}
+
+// patchedGitTreeTranslator translates positions of a patched file through the patch into the
+// commit the patch applies to, then delegates to the git tree translator of that commit.
+type patchedGitTreeTranslator struct {
+	base  GitTreeTranslator
+	patch *Patch
+	path  string
+}
+
+// NewPatchedGitTreeTranslator creates a GitTreeTranslator for the given path of the given patch. The
+// given translator must translate from the original path of that file within the commit the patch
+// applies to.
+func NewPatchedGitTreeTranslator(base GitTreeTranslator, patch *Patch, path string) GitTreeTranslator {
+	return &patchedGitTreeTranslator{
+		base:  base,
+		patch: patch,
+		path:  path,
+	}
+}
+
+func (g *patchedGitTreeTranslator) GetTargetCommitPathFromSourcePath(ctx context.Context, commit, path string, reverse bool) (string, bool, error) {
+	if reverse {
+		targetPath, ok, err := g.base.GetTargetCommitPathFromSourcePath(ctx, commit, path, true)
+		if err != nil || !ok {
+			return "", false, err
+		}
+
+		patchedPath, _, ok := g.patch.patchedPath(targetPath)
+		return patchedPath, ok, nil
+	}
+
+	originalPath, ok := g.patch.OriginalPath(path)
+	if !ok {
+		return "", false, nil
+	}
+
+	return g.base.GetTargetCommitPathFromSourcePath(ctx, commit, originalPath, false)
+}
+
+func (g *patchedGitTreeTranslator) GetTargetCommitPositionFromSourcePosition(ctx context.Context, commit string, px shared.Position, reverse bool) (string, shared.Position, bool, error) {
+	if reverse {
+		targetPath, targetPosition, ok, err := g.base.GetTargetCommitPositionFromSourcePosition(ctx, commit, px, true)
+		if err != nil || !ok {
+			return "", shared.Position{}, false, err
+		}
+
+		patchedPath, hunks, ok := g.patch.patchedPath(targetPath)
+		if !ok {
+			return "", shared.Position{}, false, nil
+		}
+		patchedPosition, ok := translatePosition(hunks, targetPosition)
+		return patchedPath, patchedPosition, ok, nil
+	}
+
+	if _, ok := g.patch.OriginalPath(g.path); !ok {
+		return "", shared.Position{}, false, nil
+	}
+	originalPosition, ok := translatePosition(g.patch.originalHunks(g.path), px)
+	if !ok {
+		return "", shared.Position{}, false, nil
+	}
+
+	return g.base.GetTargetCommitPositionFromSourcePosition(ctx, commit, originalPosition, false)
+}
+
+func (g *patchedGitTreeTranslator) GetTargetCommitRangeFromSourceRange(ctx context.Context, commit, path string, rx shared.Range, reverse bool) (string, shared.Range, bool, error) {
+	if reverse {
+		targetPath, targetRange, ok, err := g.base.GetTargetCommitRangeFromSourceRange(ctx, commit, path, rx, true)
+		if err != nil || !ok {
+			return "", shared.Range{}, false, err
+		}
+
+		patchedPath, hunks, ok := g.patch.patchedPath(targetPath)
+		if !ok {
+			return "", shared.Range{}, false, nil
+		}
+		patchedRange, ok := translateRange(hunks, targetRange)
+		return patchedPath, patchedRange, ok, nil
+	}
+
+	originalPath, ok := g.patch.OriginalPath(path)
+	if !ok {
+		return "", shared.Range{}, false, nil
+	}
+	originalRange, ok := translateRange(g.patch.originalHunks(path), rx)
+	if !ok {
+		return "", shared.Range{}, false, nil
+	}
+
+	return g.base.GetTargetCommitRangeFromSourceRange(ctx, commit, originalPath, originalRange, false)
+}
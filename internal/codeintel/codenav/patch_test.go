@@ -0,0 +1,206 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const testPatch = `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,4 +1,6 @@
+ package foo
++
++// Added documentation
+ func A() {}
+-func B() {}
++func C() {}
+ func D() {}
+diff --git a/deleted.go b/deleted.go
+deleted file mode 100644
+index 3333333..0000000
+--- a/deleted.go
++++ /dev/null
+@@ -1,1 +0,0 @@
+-package foo
+diff --git a/added.go b/added.go
+new file mode 100644
+index 0000000..4444444
+--- /dev/null
++++ b/added.go
+@@ -0,0 +1,1 @@
++package foo
+diff --git a/old.go b/new.go
+similarity index 80%
+rename from old.go
+rename to new.go
+index 5555555..6666666 100644
+--- a/old.go
++++ b/new.go
+@@ -1,2 +1,2 @@
+-package bar
++package foo
+ func E() {}
+`
+
+func TestPatchOriginalPath(t *testing.T) {
+	patch, err := ParsePatch(testPatch)
+	if err != nil {
+		t.Fatalf("unexpected error parsing patch: %s", err)
+	}
+
+	testCases := []struct {
+		path         string
+		expectedPath string
+		expectedOK   bool
+	}{
+		{"foo.go", "foo.go", true},
+		{"new.go", "old.go", true},
+		{"old.go", "", false},
+		{"added.go", "", false},
+		{"deleted.go", "", false},
+		{"unchanged.go", "unchanged.go", true},
+	}
+
+	for _, testCase := range testCases {
+		path, ok := patch.OriginalPath(testCase.path)
+		if path != testCase.expectedPath || ok != testCase.expectedOK {
+			t.Errorf("unexpected original path for %q. want=(%q, %v) have=(%q, %v)", testCase.path, testCase.expectedPath, testCase.expectedOK, path, ok)
+		}
+	}
+}
+
+func TestPatchedGitTreeTranslatorPosition(t *testing.T) {
+	patch, err := ParsePatch(testPatch)
+	if err != nil {
+		t.Fatalf("unexpected error parsing patch: %s", err)
+	}
+
+	// The base translator is an identity translation as source and target commits match
+	args := &requestArgs{repo: &sgtypes.Repo{ID: 50}, commit: "deadbeef", path: "foo.go"}
+	translator := NewPatchedGitTreeTranslator(NewGitTreeTranslator(gitserver.NewMockClient(), args, nil), patch, "foo.go")
+
+	testCases := []struct {
+		line         int
+		expectedLine int
+		expectedOK   bool
+	}{
+		{0, 0, true},  // package foo
+		{1, 0, false}, // added blank line
+		{3, 1, true},  // func A
+		{4, 0, false}, // func C replaced func B
+		{5, 3, true},  // func D
+		{9, 7, true},  // beyond the hunk
+	}
+
+	for _, testCase := range testCases {
+		path, position, ok, err := translator.GetTargetCommitPositionFromSourcePosition(context.Background(), "deadbeef", shared.Position{Line: testCase.line, Character: 5}, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if ok != testCase.expectedOK {
+			t.Errorf("unexpected flag for line %d. want=%v have=%v", testCase.line, testCase.expectedOK, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if path != "foo.go" {
+			t.Errorf("unexpected path for line %d. want=%q have=%q", testCase.line, "foo.go", path)
+		}
+		if expected := (shared.Position{Line: testCase.expectedLine, Character: 5}); position != expected {
+			t.Errorf("unexpected position for line %d. want=%v have=%v", testCase.line, expected, position)
+		}
+	}
+}
+
+func TestPatchedGitTreeTranslatorRange(t *testing.T) {
+	patch, err := ParsePatch(testPatch)
+	if err != nil {
+		t.Fatalf("unexpected error parsing patch: %s", err)
+	}
+
+	args := &requestArgs{repo: &sgtypes.Repo{ID: 50}, commit: "deadbeef", path: "foo.go"}
+	translator := NewPatchedGitTreeTranslator(NewGitTreeTranslator(gitserver.NewMockClient(), args, nil), patch, "foo.go")
+
+	rangeOnLine := func(line int) shared.Range {
+		return shared.Range{Start: shared.Position{Line: line, Character: 5}, End: shared.Position{Line: line, Character: 6}}
+	}
+
+	testCases := []struct {
+		path         string
+		line         int
+		expectedPath string
+		expectedLine int
+		expectedOK   bool
+	}{
+		{"foo.go", 1, "foo.go", 3, true},
+		{"foo.go", 2, "", 0, false},
+		{"foo.go", 3, "foo.go", 5, true},
+		{"old.go", 1, "new.go", 1, true},
+		{"old.go", 0, "", 0, false},
+		{"deleted.go", 0, "", 0, false},
+		{"unchanged.go", 4, "unchanged.go", 4, true},
+	}
+
+	for _, testCase := range testCases {
+		path, r, ok, err := translator.GetTargetCommitRangeFromSourceRange(context.Background(), "deadbeef", testCase.path, rangeOnLine(testCase.line), true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if ok != testCase.expectedOK {
+			t.Errorf("unexpected flag for %s:%d. want=%v have=%v", testCase.path, testCase.line, testCase.expectedOK, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if path != testCase.expectedPath {
+			t.Errorf("unexpected path for %s:%d. want=%q have=%q", testCase.path, testCase.line, testCase.expectedPath, path)
+		}
+		if expected := rangeOnLine(testCase.expectedLine); r != expected {
+			t.Errorf("unexpected range for %s:%d. want=%v have=%v", testCase.path, testCase.line, expected, r)
+		}
+	}
+}
+
+func TestPatchedGitTreeTranslatorPath(t *testing.T) {
+	patch, err := ParsePatch(testPatch)
+	if err != nil {
+		t.Fatalf("unexpected error parsing patch: %s", err)
+	}
+
+	args := &requestArgs{repo: &sgtypes.Repo{ID: 50}, commit: "deadbeef", path: "old.go"}
+	translator := NewPatchedGitTreeTranslator(NewGitTreeTranslator(gitserver.NewMockClient(), args, nil), patch, "new.go")
+
+	if path, ok, err := translator.GetTargetCommitPathFromSourcePath(context.Background(), "deadbeef", "new.go", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !ok || path != "old.go" {
+		t.Errorf("unexpected path. want=(%q, true) have=(%q, %v)", "old.go", path, ok)
+	}
+
+	if _, ok, err := translator.GetTargetCommitPathFromSourcePath(context.Background(), "deadbeef", "added.go", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if ok {
+		t.Errorf("expected translation of added file to fail")
+	}
+}
+
+func TestParsePatchMalformedHunk(t *testing.T) {
+	malformedPatch := `--- a/foo.go
++++ b/foo.go
+@@ -1,4 +1,4 @@
+ package foo
+-func B() {}
++func C() {}
+`
+
+	if _, err := ParsePatch(malformedPatch); err == nil {
+		t.Fatalf("expected error parsing malformed patch")
+	}
+}
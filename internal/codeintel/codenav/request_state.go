@@ -91,6 +91,14 @@ func (r *RequestState) SetLocalGitTreeTranslator(client gitserver.Client, repo *
 	return nil
 }
 
+// SetPatch makes positions of the requested path relative to the given patch applied on top of the
+// requested commit. The request state must have been created for the original path of the patched
+// file, which is replaced by the given patched path.
+func (r *RequestState) SetPatch(patch *Patch, path string) {
+	r.GitTreeTranslator = NewPatchedGitTreeTranslator(r.GitTreeTranslator, patch, path)
+	r.Path = path
+}
+
 func (r *RequestState) SetLocalCommitCache(repoStore database.RepoStore, client gitserver.Client) {
 	r.commitCache = NewCommitCache(repoStore, client)
 }
@@ -0,0 +1,566 @@
+package codenav
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/collections"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	// DefaultCallHierarchyDepth is the call hierarchy depth used when no depth is supplied.
+	DefaultCallHierarchyDepth = 1
+
+	// MaximumCallHierarchyDepth is the maximum number of levels of calls traversed by a
+	// single call hierarchy request.
+	MaximumCallHierarchyDepth = 5
+
+	// callHierarchySymbolsPerDepth is the maximum number of symbols expanded at a single
+	// depth of a call hierarchy traversal.
+	callHierarchySymbolsPerDepth = 100
+
+	// callHierarchyLocationsLimit is the maximum number of definition or reference locations
+	// read for a single symbol while resolving its calls.
+	callHierarchyLocationsLimit = 1000
+)
+
+// GetIncomingCalls returns the callers of the function or method under the given position,
+// and transitively their callers up to the requested depth. Call sites are found in all
+// indexes referencing the function, including ones of other repositories.
+func (s *Service) GetIncomingCalls(
+	ctx context.Context,
+	args CallHierarchyArgs,
+	requestState RequestState,
+	cursor CallHierarchyCursor,
+) (_ []CallHierarchyCall, nextCursor CallHierarchyCursor, err error) {
+	return s.gatherCalls(ctx, args, requestState, cursor, s.operations.getIncomingCalls, s.resolveIncomingCalls)
+}
+
+// GetOutgoingCalls returns the functions and methods called from the body of the function or
+// method under the given position, and transitively the ones they call up to the requested
+// depth. Callees defined in other repositories are resolved via their symbol names.
+func (s *Service) GetOutgoingCalls(
+	ctx context.Context,
+	args CallHierarchyArgs,
+	requestState RequestState,
+	cursor CallHierarchyCursor,
+) (_ []CallHierarchyCall, nextCursor CallHierarchyCursor, err error) {
+	return s.gatherCalls(ctx, args, requestState, cursor, s.operations.getOutgoingCalls, s.resolveOutgoingCalls)
+}
+
+// callEdge is a call hierarchy edge with locations relative to the indexed commits.
+type callEdge struct {
+	parentSymbolName string
+	symbolName       string
+	definition       *shared.Location
+	fromRanges       []shared.Location
+}
+
+type resolveCallsFunc func(
+	ctx context.Context,
+	args RequestArgs,
+	requestState RequestState,
+	documents *callHierarchyDocuments,
+	symbolNames []string,
+) ([]callEdge, error)
+
+func (s *Service) gatherCalls(
+	ctx context.Context,
+	args CallHierarchyArgs,
+	requestState RequestState,
+	cursor CallHierarchyCursor,
+	operation *observation.Operation,
+	resolveCalls resolveCallsFunc,
+) (allCalls []CallHierarchyCall, _ CallHierarchyCursor, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, operation, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+		attribute.Int("depth", args.Depth),
+	}})
+	defer endObservation()
+
+	maxDepth := args.Depth
+	if maxDepth <= 0 {
+		maxDepth = DefaultCallHierarchyDepth
+	}
+	if maxDepth > MaximumCallHierarchyDepth {
+		maxDepth = MaximumCallHierarchyDepth
+	}
+
+	documents := &callHierarchyDocuments{
+		lsifstore: s.lsifstore,
+		documents: map[lsifstore.LocationKey]*scip.Document{},
+	}
+
+	if cursor.Phase == "" {
+		symbolNames, err := s.getCallHierarchyRootSymbols(ctx, args.PositionalRequestArgs, requestState, documents)
+		if err != nil {
+			return nil, CallHierarchyCursor{}, err
+		}
+		if len(symbolNames) == 0 {
+			return nil, exhaustedCallHierarchyCursor, nil
+		}
+
+		cursor = CallHierarchyCursor{Phase: "traverse", Depth: 1, SymbolNames: symbolNames}
+	}
+
+	for cursor.Phase != "done" && len(allCalls) < args.Limit {
+		trace.AddEvent("ResolveCalls", attribute.Int("depth", cursor.Depth), attribute.Int("numSymbolNames", len(cursor.SymbolNames)))
+
+		// The calls of a single depth are resolved in full on each request so that we can
+		// determine the symbols to expand at the next depth. Only the page of calls that is
+		// returned is adjusted to the target commit.
+		edges, err := resolveCalls(ctx, args.RequestArgs, requestState, documents, cursor.SymbolNames)
+		if err != nil {
+			return nil, CallHierarchyCursor{}, err
+		}
+
+		page := pageSlice(edges, args.Limit-len(allCalls), cursor.Offset)
+		calls, err := s.adjustCallEdges(ctx, args.RequestArgs, requestState, page, cursor.Depth)
+		if err != nil {
+			return nil, CallHierarchyCursor{}, err
+		}
+		allCalls = append(allCalls, calls...)
+
+		cursor.Offset += len(page)
+		if cursor.Offset < len(edges) {
+			// page is full
+			break
+		}
+		cursor = cursor.next(edges, maxDepth)
+	}
+
+	return allCalls, cursor, nil
+}
+
+// next returns the cursor that continues the traversal at the next depth once all of the
+// given edges of the current depth have been consumed.
+func (c CallHierarchyCursor) next(edges []callEdge, maxDepth int) CallHierarchyCursor {
+	if c.Depth >= maxDepth {
+		return exhaustedCallHierarchyCursor
+	}
+
+	visited := collections.NewSet(c.Visited...)
+	visited.Add(c.SymbolNames...)
+
+	symbolNames := collections.NewSet[string]()
+	for _, edge := range edges {
+		if !visited.Has(edge.symbolName) && scip.IsGlobalSymbol(edge.symbolName) && isCallableSymbol(edge.symbolName) {
+			symbolNames.Add(edge.symbolName)
+		}
+	}
+	if symbolNames.IsEmpty() {
+		return exhaustedCallHierarchyCursor
+	}
+
+	next := symbolNames.Sorted(compareStrings)
+	if len(next) > callHierarchySymbolsPerDepth {
+		next = next[:callHierarchySymbolsPerDepth]
+	}
+
+	return CallHierarchyCursor{
+		Phase:       "traverse",
+		Depth:       c.Depth + 1,
+		SymbolNames: next,
+		Visited:     visited.Sorted(compareStrings),
+	}
+}
+
+// getCallHierarchyRootSymbols returns the names of the callable symbols occurring at the
+// requested position in any of the visible uploads.
+func (s *Service) getCallHierarchyRootSymbols(
+	ctx context.Context,
+	args PositionalRequestArgs,
+	requestState RequestState,
+	documents *callHierarchyDocuments,
+) ([]string, error) {
+	visibleUploads, err := s.getVisibleUploads(ctx, args.Line, args.Character, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	symbolNames := collections.NewSet[string]()
+	for _, upload := range visibleUploads {
+		document, err := documents.get(ctx, upload.Upload.ID, upload.TargetPathWithoutRoot)
+		if err != nil {
+			return nil, err
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range scip.FindOccurrences(document.Occurrences, int32(upload.TargetPosition.Line), int32(upload.TargetPosition.Character)) {
+			if strings.HasPrefix(occurrence.Symbol, skipPrefix) || scip.IsLocalSymbol(occurrence.Symbol) || !isCallableSymbol(occurrence.Symbol) {
+				continue
+			}
+			symbolNames.Add(occurrence.Symbol)
+		}
+	}
+
+	return symbolNames.Sorted(compareStrings), nil
+}
+
+// resolveIncomingCalls finds the references to each of the given symbols and groups them by
+// the innermost definition whose enclosing range contains them, which is their caller.
+func (s *Service) resolveIncomingCalls(
+	ctx context.Context,
+	args RequestArgs,
+	requestState RequestState,
+	documents *callHierarchyDocuments,
+	symbolNames []string,
+) ([]callEdge, error) {
+	var edges []callEdge
+	for _, symbolName := range symbolNames {
+		references, err := s.getCallHierarchySymbolLocations(ctx, args, requestState, symbolName, "references", true)
+		if err != nil {
+			return nil, err
+		}
+
+		edgesByCaller := map[string]*callEdge{}
+		for _, reference := range references {
+			document, err := documents.get(ctx, reference.DumpID, reference.Path)
+			if err != nil {
+				return nil, err
+			}
+			if document == nil {
+				continue
+			}
+
+			caller := enclosingDefinition(document, reference.Range)
+			if caller == nil {
+				continue
+			}
+
+			edge, ok := edgesByCaller[caller.Symbol]
+			if !ok {
+				edge = &callEdge{
+					parentSymbolName: symbolName,
+					symbolName:       caller.Symbol,
+					definition: &shared.Location{
+						DumpID: reference.DumpID,
+						Path:   reference.Path,
+						Range:  translateSCIPRange(scip.NewRange(caller.Range)),
+					},
+				}
+				edgesByCaller[caller.Symbol] = edge
+			}
+			edge.fromRanges = append(edge.fromRanges, reference)
+		}
+
+		edges = appendSortedEdges(edges, edgesByCaller)
+	}
+
+	return edges, nil
+}
+
+// resolveOutgoingCalls finds the definitions of each of the given symbols and groups the
+// references to callable symbols within their enclosing ranges by the symbol they call.
+func (s *Service) resolveOutgoingCalls(
+	ctx context.Context,
+	args RequestArgs,
+	requestState RequestState,
+	documents *callHierarchyDocuments,
+	symbolNames []string,
+) ([]callEdge, error) {
+	definitionsBySymbol := map[string]*shared.Location{}
+	definitionOf := func(symbolName string, document *scip.Document, uploadID int, path string) (*shared.Location, error) {
+		// Prefer a definition in the same document, which is free to look up
+		for _, occurrence := range document.Occurrences {
+			if occurrence.Symbol == symbolName && scip.SymbolRole_Definition.Matches(occurrence) {
+				return &shared.Location{DumpID: uploadID, Path: path, Range: translateSCIPRange(scip.NewRange(occurrence.Range))}, nil
+			}
+		}
+		if scip.IsLocalSymbol(symbolName) {
+			return nil, nil
+		}
+
+		if definition, ok := definitionsBySymbol[symbolName]; ok {
+			return definition, nil
+		}
+		locations, err := s.getCallHierarchySymbolLocations(ctx, args, requestState, symbolName, "definitions", false)
+		if err != nil {
+			return nil, err
+		}
+
+		var definition *shared.Location
+		if len(locations) > 0 {
+			definition = &locations[0]
+		}
+		definitionsBySymbol[symbolName] = definition
+		return definition, nil
+	}
+
+	var edges []callEdge
+	for _, symbolName := range symbolNames {
+		definitions, err := s.getCallHierarchySymbolLocations(ctx, args, requestState, symbolName, "definitions", false)
+		if err != nil {
+			return nil, err
+		}
+
+		edgesByCallee := map[string]*callEdge{}
+		for _, definition := range definitions {
+			document, err := documents.get(ctx, definition.DumpID, definition.Path)
+			if err != nil {
+				return nil, err
+			}
+			if document == nil {
+				continue
+			}
+
+			body, ok := enclosingRangeOf(document, symbolName, definition.Range)
+			if !ok {
+				continue
+			}
+
+			for _, occurrence := range document.Occurrences {
+				if scip.SymbolRole_Definition.Matches(occurrence) || !isCallableSymbol(occurrence.Symbol) {
+					continue
+				}
+				r := translateSCIPRange(scip.NewRange(occurrence.Range))
+				if !rangeContains(body, r) {
+					continue
+				}
+
+				edge, ok := edgesByCallee[occurrence.Symbol]
+				if !ok {
+					calleeDefinition, err := definitionOf(occurrence.Symbol, document, definition.DumpID, definition.Path)
+					if err != nil {
+						return nil, err
+					}
+
+					edge = &callEdge{
+						parentSymbolName: symbolName,
+						symbolName:       occurrence.Symbol,
+						definition:       calleeDefinition,
+					}
+					edgesByCallee[occurrence.Symbol] = edge
+				}
+				edge.fromRanges = append(edge.fromRanges, shared.Location{
+					DumpID: definition.DumpID,
+					Path:   definition.Path,
+					Range:  r,
+				})
+			}
+		}
+
+		edges = appendSortedEdges(edges, edgesByCallee)
+	}
+
+	return edges, nil
+}
+
+// getCallHierarchySymbolLocations returns the definition or reference locations of the given
+// symbol in the uploads visible from the requested path, the uploads defining the symbol, and
+// (if includeReferencingIndexes is set) the first batch of uploads referencing it.
+func (s *Service) getCallHierarchySymbolLocations(
+	ctx context.Context,
+	args RequestArgs,
+	requestState RequestState,
+	symbolName string,
+	tableName string,
+	includeReferencingIndexes bool,
+) ([]shared.Location, error) {
+	monikers, err := symbolsToMonikers([]string{symbolName})
+	if err != nil || len(monikers) == 0 {
+		return nil, err
+	}
+
+	uploads, err := s.getUploadsWithDefinitionsForMonikers(ctx, monikers, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	idSet := collections.NewSet[int]()
+	for _, upload := range requestState.GetCacheUploads() {
+		idSet.Add(upload.ID)
+	}
+	for _, upload := range uploads {
+		idSet.Add(upload.ID)
+	}
+	ids := idSet.Values()
+	sort.Ints(ids)
+
+	if includeReferencingIndexes {
+		uploadIDs, _, _, err := s.uploadSvc.GetUploadIDsWithReferences(
+			ctx,
+			monikers,
+			ids,
+			args.RepositoryID,
+			args.Commit,
+			requestState.maximumIndexesPerMonikerSearch, // limit
+			0, // offset
+		)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uploadIDs...)
+	}
+
+	// Hydrate upload records into the request state data loader so that the locations can
+	// be adjusted to the target commit later on.
+	if _, err := s.getUploadsByIDs(ctx, ids, requestState); err != nil {
+		return nil, err
+	}
+
+	locations, _, err := s.lsifstore.GetMinimalBulkMonikerLocations(
+		ctx,
+		tableName,
+		ids,
+		nil,
+		[]precise.MonikerData{monikers[0].MonikerData},
+		callHierarchyLocationsLimit,
+		0,
+	)
+	return locations, err
+}
+
+// adjustCallEdges converts the given edges into calls with locations adjusted to the target
+// commit.
+func (s *Service) adjustCallEdges(ctx context.Context, args RequestArgs, requestState RequestState, edges []callEdge, depth int) ([]CallHierarchyCall, error) {
+	calls := make([]CallHierarchyCall, 0, len(edges))
+	for _, edge := range edges {
+		fromRanges, err := s.getUploadLocations(ctx, args, requestState, edge.fromRanges, true)
+		if err != nil {
+			return nil, err
+		}
+		if len(fromRanges) == 0 {
+			// all call sites are hidden from the user
+			continue
+		}
+
+		item := CallHierarchyItem{SymbolName: edge.symbolName}
+		if edge.definition != nil {
+			definitions, err := s.getUploadLocations(ctx, args, requestState, []shared.Location{*edge.definition}, true)
+			if err != nil {
+				return nil, err
+			}
+			if len(definitions) > 0 {
+				item.Definition = &definitions[0]
+			}
+		}
+
+		calls = append(calls, CallHierarchyCall{
+			Item:             item,
+			ParentSymbolName: edge.parentSymbolName,
+			Depth:            depth,
+			FromRanges:       fromRanges,
+		})
+	}
+
+	return calls, nil
+}
+
+func appendSortedEdges(edges []callEdge, edgesBySymbol map[string]*callEdge) []callEdge {
+	symbolNames := make([]string, 0, len(edgesBySymbol))
+	for symbolName := range edgesBySymbol {
+		symbolNames = append(symbolNames, symbolName)
+	}
+	sort.Strings(symbolNames)
+
+	for _, symbolName := range symbolNames {
+		edges = append(edges, *edgesBySymbol[symbolName])
+	}
+	return edges
+}
+
+// callHierarchyDocuments caches the SCIP documents read during a single call hierarchy request.
+type callHierarchyDocuments struct {
+	lsifstore lsifstore.LsifStore
+	documents map[lsifstore.LocationKey]*scip.Document
+}
+
+func (d *callHierarchyDocuments) get(ctx context.Context, uploadID int, path string) (*scip.Document, error) {
+	key := lsifstore.LocationKey{UploadID: uploadID, Path: path}
+	if document, ok := d.documents[key]; ok {
+		return document, nil
+	}
+
+	document, err := d.lsifstore.SCIPDocument(ctx, uploadID, path)
+	if err != nil {
+		return nil, err
+	}
+	d.documents[key] = document
+	return document, nil
+}
+
+// enclosingDefinition returns the definition occurrence with the smallest enclosing range
+// that contains the given range, or nil if there is none.
+func enclosingDefinition(document *scip.Document, r shared.Range) *scip.Occurrence {
+	var (
+		innermost      *scip.Occurrence
+		innermostRange shared.Range
+	)
+	for _, occurrence := range document.Occurrences {
+		if len(occurrence.EnclosingRange) == 0 || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+
+		enclosingRange := translateSCIPRange(scip.NewRange(occurrence.EnclosingRange))
+		if !rangeContains(enclosingRange, r) {
+			continue
+		}
+		if innermost == nil || rangeContains(innermostRange, enclosingRange) {
+			innermost, innermostRange = occurrence, enclosingRange
+		}
+	}
+
+	return innermost
+}
+
+// enclosingRangeOf returns the enclosing range of the definition of the given symbol at the
+// given range.
+func enclosingRangeOf(document *scip.Document, symbolName string, r shared.Range) (shared.Range, bool) {
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol != symbolName || len(occurrence.EnclosingRange) == 0 || !scip.SymbolRole_Definition.Matches(occurrence) {
+			continue
+		}
+		if translateSCIPRange(scip.NewRange(occurrence.Range)) == r {
+			return translateSCIPRange(scip.NewRange(occurrence.EnclosingRange)), true
+		}
+	}
+
+	return shared.Range{}, false
+}
+
+// isCallableSymbol returns true if the given symbol names a function or method.
+func isCallableSymbol(symbolName string) bool {
+	if scip.IsLocalSymbol(symbolName) {
+		// Local symbols carry no descriptors; we can't tell them apart from variables
+		return false
+	}
+
+	symbol, err := scip.ParseSymbol(symbolName)
+	if err != nil || len(symbol.Descriptors) == 0 {
+		return false
+	}
+
+	return symbol.Descriptors[len(symbol.Descriptors)-1].Suffix == scip.Descriptor_Method
+}
+
+func rangeContains(outer, inner shared.Range) bool {
+	return !positionBefore(inner.Start, outer.Start) && !positionBefore(outer.End, inner.End)
+}
+
+func positionBefore(a, b shared.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
+
+func translateSCIPRange(r *scip.Range) shared.Range {
+	return shared.Range{
+		Start: shared.Position{Line: int(r.Start.Line), Character: int(r.Start.Character)},
+		End:   shared.Position{Line: int(r.End.Line), Character: int(r.End.Character)},
+	}
+}
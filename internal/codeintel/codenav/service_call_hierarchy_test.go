@@ -0,0 +1,203 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const (
+	calleeSymbol = "scip-go gomod example v1 pkg/callee()."
+	callerSymbol = "scip-go gomod example v1 pkg/caller()."
+	mainSymbol   = "scip-go gomod example v1 pkg/main()."
+	valueSymbol  = "scip-go gomod example v1 pkg/value."
+)
+
+// callHierarchyDocument is a document in which main calls caller, which in turn calls callee.
+var callHierarchyDocument = &scip.Document{
+	RelativePath: mockPath,
+	Occurrences: []*scip.Occurrence{
+		{Range: []int32{10, 5, 11}, Symbol: calleeSymbol, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{10, 0, 12, 1}},
+		{Range: []int32{20, 5, 11}, Symbol: callerSymbol, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{20, 0, 25, 1}},
+		{Range: []int32{21, 2, 7}, Symbol: valueSymbol},
+		{Range: []int32{22, 2, 8}, Symbol: calleeSymbol},
+		{Range: []int32{23, 2, 8}, Symbol: calleeSymbol},
+		{Range: []int32{30, 5, 9}, Symbol: mainSymbol, SymbolRoles: int32(scip.SymbolRole_Definition), EnclosingRange: []int32{30, 0, 35, 1}},
+		{Range: []int32{31, 2, 8}, Symbol: callerSymbol},
+	},
+}
+
+func newCallHierarchyTestService(t *testing.T) (*Service, *MockLsifStore, RequestState, uploadsshared.Dump) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	if err := mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache); err != nil {
+		t.Fatalf("unexpected error setting local git tree translator: %s", err)
+	}
+	upload := uploadsshared.Dump{ID: 50, Commit: mockCommit}
+	mockRequestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	mockLsifStore.SCIPDocumentFunc.SetDefaultReturn(callHierarchyDocument, nil)
+
+	// Definitions and references of each symbol in the document above
+	locationsBySymbol := map[string]map[string][]shared.Location{
+		"definitions": {
+			calleeSymbol: {{DumpID: 50, Path: mockPath, Range: newRange(10, 5, 10, 11)}},
+			callerSymbol: {{DumpID: 50, Path: mockPath, Range: newRange(20, 5, 20, 11)}},
+			mainSymbol:   {{DumpID: 50, Path: mockPath, Range: newRange(30, 5, 30, 9)}},
+		},
+		"references": {
+			calleeSymbol: {
+				{DumpID: 50, Path: mockPath, Range: newRange(22, 2, 22, 8)},
+				{DumpID: 50, Path: mockPath, Range: newRange(23, 2, 23, 8)},
+			},
+			callerSymbol: {{DumpID: 50, Path: mockPath, Range: newRange(31, 2, 31, 8)}},
+		},
+	}
+	mockLsifStore.GetMinimalBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, _ []int, _ map[int]string, monikers []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		locations := locationsBySymbol[tableName][monikers[0].Identifier]
+		return locations, len(locations), nil
+	})
+
+	return svc, mockLsifStore, mockRequestState, upload
+}
+
+func TestGetIncomingCalls(t *testing.T) {
+	svc, _, mockRequestState, upload := newCallHierarchyTestService(t)
+
+	args := CallHierarchyArgs{
+		PositionalRequestArgs: PositionalRequestArgs{
+			RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Limit: 1},
+			Path:        mockPath,
+			Line:        10,
+			Character:   7,
+		},
+		Depth: 2,
+	}
+
+	uploadLocation := func(r shared.Range) shared.UploadLocation {
+		return shared.UploadLocation{Dump: upload, Path: mockPath, TargetCommit: mockCommit, TargetRange: r}
+	}
+	callerDefinition := uploadLocation(newRange(20, 5, 20, 11))
+	mainDefinition := uploadLocation(newRange(30, 5, 30, 9))
+
+	expectedPages := [][]CallHierarchyCall{
+		{
+			{
+				Item:             CallHierarchyItem{SymbolName: callerSymbol, Definition: &callerDefinition},
+				ParentSymbolName: calleeSymbol,
+				Depth:            1,
+				FromRanges:       []shared.UploadLocation{uploadLocation(newRange(22, 2, 22, 8)), uploadLocation(newRange(23, 2, 23, 8))},
+			},
+		},
+		{
+			{
+				Item:             CallHierarchyItem{SymbolName: mainSymbol, Definition: &mainDefinition},
+				ParentSymbolName: callerSymbol,
+				Depth:            2,
+				FromRanges:       []shared.UploadLocation{uploadLocation(newRange(31, 2, 31, 8))},
+			},
+		},
+	}
+
+	var cursor CallHierarchyCursor
+	for i, expectedCalls := range expectedPages {
+		calls, nextCursor, err := svc.GetIncomingCalls(context.Background(), args, mockRequestState, cursor)
+		if err != nil {
+			t.Fatalf("unexpected error querying incoming calls: %s", err)
+		}
+		if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+			t.Errorf("unexpected calls on page %d (-want +got):\n%s", i, diff)
+		}
+		cursor = nextCursor
+	}
+
+	if cursor.Phase != "done" {
+		t.Errorf("expected exhausted cursor, got %+v", cursor)
+	}
+}
+
+func TestGetOutgoingCalls(t *testing.T) {
+	svc, _, mockRequestState, upload := newCallHierarchyTestService(t)
+
+	args := CallHierarchyArgs{
+		PositionalRequestArgs: PositionalRequestArgs{
+			RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Limit: 50},
+			Path:        mockPath,
+			Line:        30,
+			Character:   6,
+		},
+		Depth: 5,
+	}
+
+	uploadLocation := func(r shared.Range) shared.UploadLocation {
+		return shared.UploadLocation{Dump: upload, Path: mockPath, TargetCommit: mockCommit, TargetRange: r}
+	}
+	callerDefinition := uploadLocation(newRange(20, 5, 20, 11))
+	calleeDefinition := uploadLocation(newRange(10, 5, 10, 11))
+
+	calls, cursor, err := svc.GetOutgoingCalls(context.Background(), args, mockRequestState, CallHierarchyCursor{})
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+
+	// The reference to a value within caller is not a call
+	expectedCalls := []CallHierarchyCall{
+		{
+			Item:             CallHierarchyItem{SymbolName: callerSymbol, Definition: &callerDefinition},
+			ParentSymbolName: mainSymbol,
+			Depth:            1,
+			FromRanges:       []shared.UploadLocation{uploadLocation(newRange(31, 2, 31, 8))},
+		},
+		{
+			Item:             CallHierarchyItem{SymbolName: calleeSymbol, Definition: &calleeDefinition},
+			ParentSymbolName: callerSymbol,
+			Depth:            2,
+			FromRanges:       []shared.UploadLocation{uploadLocation(newRange(22, 2, 22, 8)), uploadLocation(newRange(23, 2, 23, 8))},
+		},
+	}
+	if diff := cmp.Diff(expectedCalls, calls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+	if cursor.Phase != "done" {
+		t.Errorf("expected exhausted cursor, got %+v", cursor)
+	}
+}
+
+func TestIsCallableSymbol(t *testing.T) {
+	for symbolName, expected := range map[string]bool{
+		calleeSymbol:                          true,
+		"scip-go gomod example v1 pkg/T#M().": true,
+		valueSymbol:                           false,
+		"scip-go gomod example v1 pkg/T#":     false,
+		"local 3":                             false,
+		"not a symbol":                        false,
+	} {
+		if callable := isCallableSymbol(symbolName); callable != expected {
+			t.Errorf("unexpected result for %q: want %v, got %v", symbolName, expected, callable)
+		}
+	}
+}
+
+func newRange(startLine, startCharacter, endLine, endCharacter int) shared.Range {
+	return shared.Range{
+		Start: shared.Position{Line: startLine, Character: startCharacter},
+		End:   shared.Position{Line: endLine, Character: endCharacter},
+	}
+}
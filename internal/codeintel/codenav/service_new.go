@@ -48,6 +48,23 @@ func (s *Service) NewGetReferences(
 	)
 }
 
+func (s *Service) GetTypeDefinitions(
+	ctx context.Context,
+	args PositionalRequestArgs,
+	requestState RequestState,
+) (_ []shared.UploadLocation, err error) {
+	locations, _, err := s.gatherLocations(
+		ctx, args, requestState, Cursor{},
+
+		s.operations.getTypeDefinitions, // operation
+		"definitions",                   // N.B.: we're looking for definitions of types
+		false,                           // includeReferencingIndexes
+		LocationExtractorFunc(s.lsifstore.ExtractTypeDefinitionLocationsFromPosition),
+	)
+
+	return locations, err
+}
+
 func (s *Service) NewGetImplementations(
 	ctx context.Context,
 	args PositionalRequestArgs,
@@ -447,6 +447,60 @@ func TestNewGetReferences(t *testing.T) {
 	})
 }
 
+func TestGetTypeDefinitions(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	// Set up request state
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	uploads := []uploadsshared.Dump{
+		{ID: 50, Commit: mockCommit, Root: "sub1/"},
+		{ID: 51, Commit: mockCommit, Root: "sub2/"},
+	}
+	mockRequestState.SetUploadsDataLoader(uploads)
+
+	locations := []shared.Location{
+		{DumpID: 51, Path: "a.go", Range: testRange1},
+		{DumpID: 51, Path: "b.go", Range: testRange2},
+	}
+	mockLsifStore.ExtractTypeDefinitionLocationsFromPositionFunc.PushReturn(locations, nil, nil)
+
+	mockRequest := PositionalRequestArgs{
+		RequestArgs: RequestArgs{
+			RepositoryID: 51,
+			Commit:       mockCommit,
+			Limit:        50,
+		},
+		Path:      mockPath,
+		Line:      10,
+		Character: 20,
+	}
+	adjustedLocations, err := svc.GetTypeDefinitions(context.Background(), mockRequest, mockRequestState)
+	if err != nil {
+		t.Fatalf("unexpected error querying type definitions: %s", err)
+	}
+	expectedLocations := []shared.UploadLocation{
+		{Dump: uploads[1], Path: "sub2/a.go", TargetCommit: mockCommit, TargetRange: testRange1},
+		{Dump: uploads[1], Path: "sub2/b.go", TargetCommit: mockCommit, TargetRange: testRange2},
+	}
+	if diff := cmp.Diff(expectedLocations, adjustedLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockLsifStore.ExtractDefinitionLocationsFromPositionFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected calls to ExtractDefinitionLocationsFromPosition: %d", len(history))
+	}
+}
+
 func TestNewGetImplementations(t *testing.T) {
 	t.Run("local", func(t *testing.T) {
 		// Set up mocks
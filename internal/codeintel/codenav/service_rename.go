@@ -0,0 +1,377 @@
+package codenav
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// renameLocationsLimit is the maximum number of occurrences a rename preview is computed for.
+const renameLocationsLimit = 10000
+
+var (
+	// ErrInvalidRenameName occurs when the new name of a rename is empty or contains whitespace.
+	ErrInvalidRenameName = errors.New("invalid name")
+
+	// ErrNoRenameSymbol occurs when there is no global symbol at the position of a rename.
+	ErrNoRenameSymbol = errors.New("no symbol that can be renamed at the given position")
+
+	// ErrTooManyRenameLocations occurs when a symbol has more occurrences than a rename preview
+	// is computed for.
+	ErrTooManyRenameLocations = errors.Newf("symbol has more than %d occurrences", renameLocationsLimit)
+)
+
+// RenamePreview holds every precise occurrence of a symbol grouped by repository and file,
+// together with the diff renaming them in each repository.
+type RenamePreview struct {
+	SymbolName   string
+	OldName      string
+	NewName      string
+	Repositories []RenameRepository
+}
+
+// RenameRepository holds the occurrences of a renamed symbol within a single repository.
+type RenameRepository struct {
+	RepositoryID   int
+	RepositoryName string
+	Commit         string
+	// BaseRef is the default branch of the repository, on which a changeset applying the
+	// rename is opened.
+	BaseRef string
+	Files   []RenameFile
+	// Diff is a unified diff renaming all occurrences within the repository at Commit.
+	Diff string
+}
+
+// RenameFile holds the occurrences of a renamed symbol within a single file.
+type RenameFile struct {
+	Path      string
+	Locations []shared.UploadLocation
+	// SkippedLocations are occurrences whose text doesn't match the old name, for example
+	// because the symbol is imported under an alias. They are not renamed.
+	SkippedLocations []shared.UploadLocation
+}
+
+// ChangesetSpec returns a changeset spec applying the rename to the given repository of the
+// preview. The spec can be passed to the createChangesetSpec mutation of batch changes as is.
+// The base repository is the GraphQL ID of the repository, and the author is the author of
+// the commit, usually the user requesting the rename.
+func (p *RenamePreview) ChangesetSpec(repository RenameRepository, baseRepository string, author batcheslib.ChangesetSpecAuthor) *batcheslib.ChangesetSpec {
+	title := fmt.Sprintf("Rename %s to %s", p.OldName, p.NewName)
+
+	return &batcheslib.ChangesetSpec{
+		BaseRepository: baseRepository,
+		BaseRev:        repository.Commit,
+		BaseRef:        repository.BaseRef,
+		HeadRepository: baseRepository,
+		HeadRef:        fmt.Sprintf("refs/heads/rename-%s-to-%s", p.OldName, p.NewName),
+		Title:          title,
+		Body:           fmt.Sprintf("Renames `%s` to `%s`.\n\nSymbol: `%s`", p.OldName, p.NewName, p.SymbolName),
+		Commits: []batcheslib.GitCommitDescription{
+			{
+				Message:     title,
+				Diff:        []byte(repository.Diff),
+				AuthorName:  author.Name,
+				AuthorEmail: author.Email,
+			},
+		},
+	}
+}
+
+// PreviewRename collects every precise occurrence of the symbol under the given position,
+// in the requested index as well as in other indexes via monikers, and computes the diffs
+// renaming it to the given name.
+func (s *Service) PreviewRename(ctx context.Context, args PositionalRequestArgs, requestState RequestState, newName string) (_ *RenamePreview, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.previewRename, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+	}})
+	defer endObservation()
+
+	if newName == "" || strings.ContainsAny(newName, " \t\r\n") {
+		return nil, ErrInvalidRenameName
+	}
+
+	symbolName, oldName, err := s.getRenameSymbol(ctx, args, requestState)
+	if err != nil {
+		return nil, err
+	}
+	if symbolName == "" {
+		return nil, ErrNoRenameSymbol
+	}
+
+	locations, err := s.getRenameLocations(ctx, args, requestState)
+	if err != nil {
+		return nil, err
+	}
+	trace.AddEvent("RenameLocations", attribute.Int("numLocations", len(locations)))
+
+	preview := &RenamePreview{
+		SymbolName: symbolName,
+		OldName:    oldName,
+		NewName:    newName,
+	}
+	for _, group := range groupRenameLocations(locations) {
+		repository, err := s.renameRepository(ctx, requestState, group, oldName, newName)
+		if err != nil {
+			return nil, err
+		}
+		preview.Repositories = append(preview.Repositories, repository)
+	}
+
+	return preview, nil
+}
+
+// getRenameSymbol returns the name of the global symbol under the given position and the
+// name of the identifier it is referred to by, as given by its last descriptor.
+func (s *Service) getRenameSymbol(ctx context.Context, args PositionalRequestArgs, requestState RequestState) (symbolName, name string, _ error) {
+	visibleUploads, err := s.getVisibleUploads(ctx, args.Line, args.Character, requestState)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, upload := range visibleUploads {
+		document, err := s.lsifstore.SCIPDocument(ctx, upload.Upload.ID, upload.TargetPathWithoutRoot)
+		if err != nil {
+			return "", "", err
+		}
+		if document == nil {
+			continue
+		}
+
+		for _, occurrence := range scip.FindOccurrences(document.Occurrences, int32(upload.TargetPosition.Line), int32(upload.TargetPosition.Character)) {
+			if strings.HasPrefix(occurrence.Symbol, skipPrefix) || !scip.IsGlobalSymbol(occurrence.Symbol) {
+				continue
+			}
+
+			symbol, err := scip.ParseSymbol(occurrence.Symbol)
+			if err != nil || len(symbol.Descriptors) == 0 {
+				continue
+			}
+
+			return occurrence.Symbol, symbol.Descriptors[len(symbol.Descriptors)-1].Name, nil
+		}
+	}
+
+	return "", "", nil
+}
+
+// getRenameLocations returns the definitions and all pages of references of the symbol under
+// the given position.
+func (s *Service) getRenameLocations(ctx context.Context, args PositionalRequestArgs, requestState RequestState) ([]shared.UploadLocation, error) {
+	args.Limit = renameLocationsLimit
+
+	locations, err := s.NewGetDefinitions(ctx, args, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor Cursor
+	for {
+		var references []shared.UploadLocation
+
+		// N.B.: cursor is purposefully re-assigned here
+		references, cursor, err = s.NewGetReferences(ctx, args, requestState, cursor)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, references...)
+
+		if len(locations) > renameLocationsLimit {
+			return nil, ErrTooManyRenameLocations
+		}
+		if cursor.Phase == "done" {
+			break
+		}
+	}
+
+	return locations, nil
+}
+
+type renameLocationGroup struct {
+	repositoryID   int
+	repositoryName string
+	commit         string
+	locations      []shared.UploadLocation
+}
+
+// groupRenameLocations de-duplicates the given locations and groups them by repository and
+// commit. Groups are ordered by repository name, and locations within a group by path and
+// range.
+func groupRenameLocations(locations []shared.UploadLocation) []*renameLocationGroup {
+	type groupKey struct {
+		repositoryID int
+		commit       string
+	}
+	type locationKey struct {
+		groupKey
+		path string
+		rng  shared.Range
+	}
+
+	groups := map[groupKey]*renameLocationGroup{}
+	seen := map[locationKey]struct{}{}
+	for _, location := range locations {
+		gk := groupKey{location.Dump.RepositoryID, location.TargetCommit}
+		lk := locationKey{gk, location.Path, location.TargetRange}
+		if _, ok := seen[lk]; ok {
+			continue
+		}
+		seen[lk] = struct{}{}
+
+		group, ok := groups[gk]
+		if !ok {
+			group = &renameLocationGroup{
+				repositoryID:   location.Dump.RepositoryID,
+				repositoryName: location.Dump.RepositoryName,
+				commit:         location.TargetCommit,
+			}
+			groups[gk] = group
+		}
+		group.locations = append(group.locations, location)
+	}
+
+	sorted := make([]*renameLocationGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group.locations, func(i, j int) bool {
+			a, b := group.locations[i], group.locations[j]
+			if a.Path != b.Path {
+				return a.Path < b.Path
+			}
+			return positionBefore(a.TargetRange.Start, b.TargetRange.Start)
+		})
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].repositoryName != sorted[j].repositoryName {
+			return sorted[i].repositoryName < sorted[j].repositoryName
+		}
+		return sorted[i].commit < sorted[j].commit
+	})
+
+	return sorted
+}
+
+// renameRepository reads the files containing the given locations and computes the diff
+// renaming the occurrences whose text matches the old name.
+func (s *Service) renameRepository(ctx context.Context, requestState RequestState, group *renameLocationGroup, oldName, newName string) (RenameRepository, error) {
+	repoName := api.RepoName(group.repositoryName)
+
+	baseRef, _, err := s.gitserver.GetDefaultBranch(ctx, repoName, false)
+	if err != nil {
+		return RenameRepository{}, err
+	}
+
+	repository := RenameRepository{
+		RepositoryID:   group.repositoryID,
+		RepositoryName: group.repositoryName,
+		Commit:         group.commit,
+		BaseRef:        baseRef,
+	}
+
+	var diff strings.Builder
+	for i := 0; i < len(group.locations); {
+		path := group.locations[i].Path
+		j := i
+		for j < len(group.locations) && group.locations[j].Path == path {
+			j++
+		}
+		locations := group.locations[i:j]
+		i = j
+
+		content, err := s.gitserver.ReadFile(ctx, requestState.authChecker, repoName, api.CommitID(group.commit), path)
+		if err != nil {
+			return RenameRepository{}, err
+		}
+
+		renamed, file, err := renameInFile(content, path, locations, oldName, newName)
+		if err != nil {
+			return RenameRepository{}, err
+		}
+		repository.Files = append(repository.Files, file)
+
+		if len(file.Locations) > 0 {
+			edits := myers.ComputeEdits("", string(content), string(renamed))
+			fmt.Fprintf(&diff, "diff --git a/%s b/%s\n", path, path)
+			fmt.Fprint(&diff, gotextdiff.ToUnified("a/"+path, "b/"+path, string(content), edits))
+		}
+	}
+	repository.Diff = diff.String()
+
+	return repository, nil
+}
+
+// renameInFile replaces the old name with the new name at each of the given locations of a
+// file. Locations spanning multiple lines, or whose text does not match the old name, are
+// left untouched and reported as skipped. Ranges are interpreted as byte offsets, and an
+// error is returned for ranges that are out of bounds of the file. The line endings of the
+// file are preserved.
+func renameInFile(content []byte, path string, locations []shared.UploadLocation, oldName, newName string) ([]byte, RenameFile, error) {
+	file := RenameFile{Path: path}
+
+	// Lines keep their line endings, so CRLF files are renamed in place. Offsets are not
+	// affected, as the carriage return is the last character of each line.
+	lines := bytes.SplitAfter(content, []byte("\n"))
+
+	renamesByLine := map[int][]shared.UploadLocation{}
+	for _, location := range locations {
+		r := location.TargetRange
+		if r.Start.Line < 0 || r.Start.Line >= len(lines) {
+			return nil, RenameFile{}, errors.Newf("invalid range %d:%d-%d:%d in %s: line out of bounds", r.Start.Line, r.Start.Character, r.End.Line, r.End.Character, path)
+		}
+		if r.Start.Line != r.End.Line {
+			file.SkippedLocations = append(file.SkippedLocations, location)
+			continue
+		}
+
+		line := bytes.TrimRight(lines[r.Start.Line], "\r\n")
+		if r.Start.Character < 0 || r.Start.Character > r.End.Character || r.End.Character > len(line) {
+			return nil, RenameFile{}, errors.Newf("invalid range %d:%d-%d:%d in %s: character out of bounds", r.Start.Line, r.Start.Character, r.End.Line, r.End.Character, path)
+		}
+		if string(line[r.Start.Character:r.End.Character]) != oldName {
+			file.SkippedLocations = append(file.SkippedLocations, location)
+			continue
+		}
+
+		file.Locations = append(file.Locations, location)
+		renamesByLine[r.Start.Line] = append(renamesByLine[r.Start.Line], location)
+	}
+
+	for lineNumber, renames := range renamesByLine {
+		// Replace from right to left so that earlier offsets on the line remain valid
+		sort.Slice(renames, func(i, j int) bool {
+			return renames[i].TargetRange.Start.Character > renames[j].TargetRange.Start.Character
+		})
+
+		line := lines[lineNumber]
+		for _, rename := range renames {
+			r := rename.TargetRange
+			renamed := make([]byte, 0, len(line)+len(newName)-len(oldName))
+			renamed = append(renamed, line[:r.Start.Character]...)
+			renamed = append(renamed, newName...)
+			renamed = append(renamed, line[r.End.Character:]...)
+			line = renamed
+		}
+		lines[lineNumber] = line
+	}
+
+	return bytes.Join(lines, nil), file, nil
+}
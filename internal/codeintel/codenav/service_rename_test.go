@@ -0,0 +1,221 @@
+package codenav
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const renameContent = `package pkg
+
+func callee() {}
+
+func caller() { callee(); callee() }
+
+var f = alias
+`
+
+func newRenameTestService(t *testing.T) (*Service, *MockLsifStore, RequestState, uploadsshared.Dump) {
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	if err := mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache); err != nil {
+		t.Fatalf("unexpected error setting local git tree translator: %s", err)
+	}
+	upload := uploadsshared.Dump{ID: 50, Commit: mockCommit, RepositoryID: 42, RepositoryName: "github.com/example/pkg"}
+	mockRequestState.SetUploadsDataLoader([]uploadsshared.Dump{upload})
+
+	mockLsifStore.SCIPDocumentFunc.SetDefaultReturn(&scip.Document{
+		RelativePath: mockPath,
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{2, 5, 11}, Symbol: calleeSymbol, SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{4, 5, 11}, Symbol: "local 1", SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+	}, nil)
+
+	// Empty result set (prevents nil pointer as scanner is always non-nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.SetDefaultReturn([]int{}, 0, 0, nil)
+
+	mockGitserverClient.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, repo api.RepoName, commit api.CommitID, path string) ([]byte, error) {
+		if repo != "github.com/example/pkg" || commit != api.CommitID(mockCommit) || path != mockPath {
+			return nil, errors.Newf("unexpected file %s@%s:%s", repo, commit, path)
+		}
+		return []byte(renameContent), nil
+	})
+	mockGitserverClient.GetDefaultBranchFunc.SetDefaultReturn("refs/heads/main", api.CommitID(mockCommit), nil)
+
+	return svc, mockLsifStore, mockRequestState, upload
+}
+
+func TestPreviewRename(t *testing.T) {
+	svc, mockLsifStore, mockRequestState, upload := newRenameTestService(t)
+
+	mockLsifStore.ExtractDefinitionLocationsFromPositionFunc.PushReturn([]shared.Location{
+		{DumpID: 50, Path: mockPath, Range: newRange(2, 5, 2, 11)},
+	}, nil, nil)
+	mockLsifStore.ExtractReferenceLocationsFromPositionFunc.PushReturn([]shared.Location{
+		{DumpID: 50, Path: mockPath, Range: newRange(4, 26, 4, 32)},
+		{DumpID: 50, Path: mockPath, Range: newRange(2, 5, 2, 11)},
+		{DumpID: 50, Path: mockPath, Range: newRange(4, 16, 4, 22)},
+		{DumpID: 50, Path: mockPath, Range: newRange(6, 8, 6, 13)},
+	}, nil, nil)
+
+	args := PositionalRequestArgs{
+		RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Limit: 50},
+		Path:        mockPath,
+		Line:        2,
+		Character:   7,
+	}
+	preview, err := svc.PreviewRename(context.Background(), args, mockRequestState, "target")
+	if err != nil {
+		t.Fatalf("unexpected error previewing rename: %s", err)
+	}
+
+	uploadLocation := func(r shared.Range) shared.UploadLocation {
+		return shared.UploadLocation{Dump: upload, Path: mockPath, TargetCommit: mockCommit, TargetRange: r}
+	}
+	expectedPreview := &RenamePreview{
+		SymbolName: calleeSymbol,
+		OldName:    "callee",
+		NewName:    "target",
+		Repositories: []RenameRepository{
+			{
+				RepositoryID:   42,
+				RepositoryName: "github.com/example/pkg",
+				Commit:         mockCommit,
+				BaseRef:        "refs/heads/main",
+				Files: []RenameFile{
+					{
+						Path: mockPath,
+						Locations: []shared.UploadLocation{
+							uploadLocation(newRange(2, 5, 2, 11)),
+							uploadLocation(newRange(4, 16, 4, 22)),
+							uploadLocation(newRange(4, 26, 4, 32)),
+						},
+						// The text at this location doesn't match the old name
+						SkippedLocations: []shared.UploadLocation{
+							uploadLocation(newRange(6, 8, 6, 13)),
+						},
+					},
+				},
+				Diff: "diff --git a/s1/main.go b/s1/main.go\n" +
+					"--- a/s1/main.go\n" +
+					"+++ b/s1/main.go\n" +
+					"@@ -1,7 +1,7 @@\n" +
+					" package pkg\n" +
+					" \n" +
+					"-func callee() {}\n" +
+					"+func target() {}\n" +
+					" \n" +
+					"-func caller() { callee(); callee() }\n" +
+					"+func caller() { target(); target() }\n" +
+					" \n" +
+					" var f = alias\n",
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedPreview, preview); diff != "" {
+		t.Errorf("unexpected preview (-want +got):\n%s", diff)
+	}
+
+	author := batcheslib.ChangesetSpecAuthor{Name: "Alice", Email: "alice@example.com"}
+	spec := preview.ChangesetSpec(preview.Repositories[0], "UmVwb3NpdG9yeTo0Mg==", author)
+	if spec.HeadRef != "refs/heads/rename-callee-to-target" {
+		t.Errorf("unexpected head ref: %s", spec.HeadRef)
+	}
+	if spec.BaseRev != mockCommit || spec.BaseRef != "refs/heads/main" {
+		t.Errorf("unexpected base: %s@%s", spec.BaseRef, spec.BaseRev)
+	}
+	if len(spec.Commits) != 1 || string(spec.Commits[0].Diff) != preview.Repositories[0].Diff {
+		t.Errorf("unexpected commits: %+v", spec.Commits)
+	}
+
+	// The spec must be accepted by the createChangesetSpec mutation as is
+	rawSpec, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling changeset spec: %s", err)
+	}
+	parsed, err := batcheslib.ParseChangesetSpec(rawSpec)
+	if err != nil {
+		t.Fatalf("changeset spec does not match schema: %s", err)
+	}
+	if diff := cmp.Diff(spec, parsed); diff != "" {
+		t.Errorf("unexpected parsed changeset spec (-want +got):\n%s", diff)
+	}
+}
+
+func TestRenameInFile(t *testing.T) {
+	location := func(r shared.Range) shared.UploadLocation {
+		return shared.UploadLocation{Path: mockPath, TargetCommit: mockCommit, TargetRange: r}
+	}
+
+	t.Run("CRLF", func(t *testing.T) {
+		content := "package pkg\r\n\r\nfunc callee() {}\r\n"
+		renamed, file, err := renameInFile([]byte(content), mockPath, []shared.UploadLocation{location(newRange(2, 5, 2, 11))}, "callee", "target")
+		if err != nil {
+			t.Fatalf("unexpected error renaming file: %s", err)
+		}
+		if want := "package pkg\r\n\r\nfunc target() {}\r\n"; string(renamed) != want {
+			t.Errorf("unexpected content: want %q, got %q", want, renamed)
+		}
+		if len(file.Locations) != 1 || len(file.SkippedLocations) != 0 {
+			t.Errorf("unexpected file: %+v", file)
+		}
+	})
+
+	for name, r := range map[string]shared.Range{
+		"start after end": newRange(2, 11, 2, 5),
+		"negative start":  newRange(2, -1, 2, 5),
+		"end past line":   newRange(2, 5, 2, 40),
+		"line past file":  newRange(12, 5, 12, 11),
+		"negative line":   newRange(-1, 5, -1, 11),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := renameInFile([]byte(renameContent), mockPath, []shared.UploadLocation{location(r)}, "callee", "target"); err == nil {
+				t.Error("expected error for invalid range")
+			}
+		})
+	}
+}
+
+func TestPreviewRenameErrors(t *testing.T) {
+	svc, _, mockRequestState, _ := newRenameTestService(t)
+
+	args := PositionalRequestArgs{
+		RequestArgs: RequestArgs{RepositoryID: 42, Commit: mockCommit, Limit: 50},
+		Path:        mockPath,
+		Line:        2,
+		Character:   7,
+	}
+	for _, newName := range []string{"", "two words"} {
+		if _, err := svc.PreviewRename(context.Background(), args, mockRequestState, newName); !errors.Is(err, ErrInvalidRenameName) {
+			t.Errorf("unexpected error for %q: want %s, got %v", newName, ErrInvalidRenameName, err)
+		}
+	}
+
+	// Local symbols cannot be renamed across files
+	args.Line, args.Character = 4, 7
+	if _, err := svc.PreviewRename(context.Background(), args, mockRequestState, "target"); !errors.Is(err, ErrNoRenameSymbol) {
+		t.Errorf("unexpected error: want %s, got %v", ErrNoRenameSymbol, err)
+	}
+}
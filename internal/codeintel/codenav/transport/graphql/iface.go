@@ -13,7 +13,11 @@ type CodeNavService interface {
 	NewGetReferences(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.Cursor) (_ []shared.UploadLocation, nextCursor codenav.Cursor, err error)
 	NewGetImplementations(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.Cursor) (_ []shared.UploadLocation, nextCursor codenav.Cursor, err error)
 	NewGetPrototypes(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.Cursor) (_ []shared.UploadLocation, nextCursor codenav.Cursor, err error)
+	GetIncomingCalls(ctx context.Context, args codenav.CallHierarchyArgs, requestState codenav.RequestState, cursor codenav.CallHierarchyCursor) (_ []codenav.CallHierarchyCall, nextCursor codenav.CallHierarchyCursor, err error)
+	GetOutgoingCalls(ctx context.Context, args codenav.CallHierarchyArgs, requestState codenav.RequestState, cursor codenav.CallHierarchyCursor) (_ []codenav.CallHierarchyCall, nextCursor codenav.CallHierarchyCursor, err error)
 	NewGetDefinitions(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (_ []shared.UploadLocation, err error)
+	GetTypeDefinitions(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (_ []shared.UploadLocation, err error)
+	PreviewRename(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, newName string) (_ *codenav.RenamePreview, err error)
 	GetDiagnostics(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (diagnosticsAtUploads []codenav.DiagnosticAtUpload, _ int, err error)
 	GetRanges(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, startLine, endLine int) (adjustedRanges []codenav.AdjustedCodeIntelligenceRange, err error)
 	GetStencil(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState) (adjustedRanges []shared.Range, err error)
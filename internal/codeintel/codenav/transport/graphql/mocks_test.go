@@ -187,12 +187,21 @@ type MockCodeNavService struct {
 	// GetHoverFunc is an instance of a mock function object controlling the
 	// behavior of the method GetHover.
 	GetHoverFunc *CodeNavServiceGetHoverFunc
+	// GetIncomingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIncomingCalls.
+	GetIncomingCallsFunc *CodeNavServiceGetIncomingCallsFunc
+	// GetOutgoingCallsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetOutgoingCalls.
+	GetOutgoingCallsFunc *CodeNavServiceGetOutgoingCallsFunc
 	// GetRangesFunc is an instance of a mock function object controlling
 	// the behavior of the method GetRanges.
 	GetRangesFunc *CodeNavServiceGetRangesFunc
 	// GetStencilFunc is an instance of a mock function object controlling
 	// the behavior of the method GetStencil.
 	GetStencilFunc *CodeNavServiceGetStencilFunc
+	// GetTypeDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTypeDefinitions.
+	GetTypeDefinitionsFunc *CodeNavServiceGetTypeDefinitionsFunc
 	// NewGetDefinitionsFunc is an instance of a mock function object
 	// controlling the behavior of the method NewGetDefinitions.
 	NewGetDefinitionsFunc *CodeNavServiceNewGetDefinitionsFunc
@@ -205,6 +214,9 @@ type MockCodeNavService struct {
 	// NewGetReferencesFunc is an instance of a mock function object
 	// controlling the behavior of the method NewGetReferences.
 	NewGetReferencesFunc *CodeNavServiceNewGetReferencesFunc
+	// PreviewRenameFunc is an instance of a mock function object controlling
+	// the behavior of the method PreviewRename.
+	PreviewRenameFunc *CodeNavServicePreviewRenameFunc
 	// SnapshotForDocumentFunc is an instance of a mock function object
 	// controlling the behavior of the method SnapshotForDocument.
 	SnapshotForDocumentFunc *CodeNavServiceSnapshotForDocumentFunc
@@ -232,6 +244,16 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) (r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+				return
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) (r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+				return
+			},
+		},
 		GetRangesFunc: &CodeNavServiceGetRangesFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, int) (r0 []codenav.AdjustedCodeIntelligenceRange, r1 error) {
 				return
@@ -242,6 +264,11 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		GetTypeDefinitionsFunc: &CodeNavServiceGetTypeDefinitionsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) (r0 []shared1.UploadLocation, r1 error) {
+				return
+			},
+		},
 		NewGetDefinitionsFunc: &CodeNavServiceNewGetDefinitionsFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) (r0 []shared1.UploadLocation, r1 error) {
 				return
@@ -262,6 +289,11 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (r0 *codenav.RenamePreview, r1 error) {
+				return
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) (r0 []shared1.SnapshotData, r1 error) {
 				return
@@ -294,6 +326,16 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetHover")
 			},
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+				panic("unexpected invocation of MockCodeNavService.GetIncomingCalls")
+			},
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+				panic("unexpected invocation of MockCodeNavService.GetOutgoingCalls")
+			},
+		},
 		GetRangesFunc: &CodeNavServiceGetRangesFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, int, int) ([]codenav.AdjustedCodeIntelligenceRange, error) {
 				panic("unexpected invocation of MockCodeNavService.GetRanges")
@@ -304,6 +346,11 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetStencil")
 			},
 		},
+		GetTypeDefinitionsFunc: &CodeNavServiceGetTypeDefinitionsFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
+				panic("unexpected invocation of MockCodeNavService.GetTypeDefinitions")
+			},
+		},
 		NewGetDefinitionsFunc: &CodeNavServiceNewGetDefinitionsFunc{
 			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
 				panic("unexpected invocation of MockCodeNavService.NewGetDefinitions")
@@ -324,6 +371,11 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.NewGetReferences")
 			},
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error) {
+				panic("unexpected invocation of MockCodeNavService.PreviewRename")
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) ([]shared1.SnapshotData, error) {
 				panic("unexpected invocation of MockCodeNavService.SnapshotForDocument")
@@ -351,12 +403,21 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetHoverFunc: &CodeNavServiceGetHoverFunc{
 			defaultHook: i.GetHover,
 		},
+		GetIncomingCallsFunc: &CodeNavServiceGetIncomingCallsFunc{
+			defaultHook: i.GetIncomingCalls,
+		},
+		GetOutgoingCallsFunc: &CodeNavServiceGetOutgoingCallsFunc{
+			defaultHook: i.GetOutgoingCalls,
+		},
 		GetRangesFunc: &CodeNavServiceGetRangesFunc{
 			defaultHook: i.GetRanges,
 		},
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: i.GetStencil,
 		},
+		GetTypeDefinitionsFunc: &CodeNavServiceGetTypeDefinitionsFunc{
+			defaultHook: i.GetTypeDefinitions,
+		},
 		NewGetDefinitionsFunc: &CodeNavServiceNewGetDefinitionsFunc{
 			defaultHook: i.NewGetDefinitions,
 		},
@@ -369,6 +430,9 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		NewGetReferencesFunc: &CodeNavServiceNewGetReferencesFunc{
 			defaultHook: i.NewGetReferences,
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: i.PreviewRename,
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: i.SnapshotForDocument,
 		},
@@ -734,6 +798,244 @@ func (c CodeNavServiceGetHoverFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
+// CodeNavServiceGetIncomingCallsFunc describes the behavior when the
+// GetIncomingCalls method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetIncomingCallsFunc struct {
+	defaultHook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	hooks       []func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	history     []CodeNavServiceGetIncomingCallsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIncomingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetIncomingCalls(v0 context.Context, v1 codenav.CallHierarchyArgs, v2 codenav.RequestState, v3 codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	r0, r1, r2 := m.GetIncomingCallsFunc.nextHook()(v0, v1, v2, v3)
+	m.GetIncomingCallsFunc.appendCall(CodeNavServiceGetIncomingCallsFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetIncomingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIncomingCalls method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetIncomingCallsFunc) PushHook(hook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetIncomingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetIncomingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.PushHook(func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetIncomingCallsFunc) nextHook() func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetIncomingCallsFunc) appendCall(r0 CodeNavServiceGetIncomingCallsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetIncomingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetIncomingCallsFunc) History() []CodeNavServiceGetIncomingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetIncomingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetIncomingCallsFuncCall is an object that describes an
+// invocation of method GetIncomingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetIncomingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.CallHierarchyArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 codenav.CallHierarchyCursor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 codenav.CallHierarchyCursor
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetIncomingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// CodeNavServiceGetOutgoingCallsFunc describes the behavior when the
+// GetOutgoingCalls method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetOutgoingCallsFunc struct {
+	defaultHook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	hooks       []func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+	history     []CodeNavServiceGetOutgoingCallsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetOutgoingCalls delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetOutgoingCalls(v0 context.Context, v1 codenav.CallHierarchyArgs, v2 codenav.RequestState, v3 codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	r0, r1, r2 := m.GetOutgoingCallsFunc.nextHook()(v0, v1, v2, v3)
+	m.GetOutgoingCallsFunc.appendCall(CodeNavServiceGetOutgoingCallsFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetOutgoingCalls
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultHook(hook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetOutgoingCalls method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushHook(hook func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) SetDefaultReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.SetDefaultHook(func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetOutgoingCallsFunc) PushReturn(r0 []codenav.CallHierarchyCall, r1 codenav.CallHierarchyCursor, r2 error) {
+	f.PushHook(func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) nextHook() func(context.Context, codenav.CallHierarchyArgs, codenav.RequestState, codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetOutgoingCallsFunc) appendCall(r0 CodeNavServiceGetOutgoingCallsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetOutgoingCallsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetOutgoingCallsFunc) History() []CodeNavServiceGetOutgoingCallsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetOutgoingCallsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetOutgoingCallsFuncCall is an object that describes an
+// invocation of method GetOutgoingCalls on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetOutgoingCallsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.CallHierarchyArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 codenav.CallHierarchyCursor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []codenav.CallHierarchyCall
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 codenav.CallHierarchyCursor
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetOutgoingCallsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // CodeNavServiceGetRangesFunc describes the behavior when the GetRanges
 // method of the parent MockCodeNavService instance is invoked.
 type CodeNavServiceGetRangesFunc struct {
@@ -962,6 +1264,120 @@ func (c CodeNavServiceGetStencilFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeNavServiceGetTypeDefinitionsFunc describes the behavior when the
+// GetTypeDefinitions method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServiceGetTypeDefinitionsFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error)
+	history     []CodeNavServiceGetTypeDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTypeDefinitions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeNavService) GetTypeDefinitions(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState) ([]shared1.UploadLocation, error) {
+	r0, r1 := m.GetTypeDefinitionsFunc.nextHook()(v0, v1, v2)
+	m.GetTypeDefinitionsFunc.appendCall(CodeNavServiceGetTypeDefinitionsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTypeDefinitions
+// method of the parent MockCodeNavService instance is invoked and the hook
+// queue is empty.
+func (f *CodeNavServiceGetTypeDefinitionsFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTypeDefinitions method of the parent MockCodeNavService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeNavServiceGetTypeDefinitionsFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServiceGetTypeDefinitionsFunc) SetDefaultReturn(r0 []shared1.UploadLocation, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServiceGetTypeDefinitionsFunc) PushReturn(r0 []shared1.UploadLocation, r1 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServiceGetTypeDefinitionsFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState) ([]shared1.UploadLocation, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServiceGetTypeDefinitionsFunc) appendCall(r0 CodeNavServiceGetTypeDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServiceGetTypeDefinitionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeNavServiceGetTypeDefinitionsFunc) History() []CodeNavServiceGetTypeDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServiceGetTypeDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServiceGetTypeDefinitionsFuncCall is an object that describes an
+// invocation of method GetTypeDefinitions on an instance of
+// MockCodeNavService.
+type CodeNavServiceGetTypeDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.UploadLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServiceGetTypeDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServiceGetTypeDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceNewGetDefinitionsFunc describes the behavior when the
 // NewGetDefinitions method of the parent MockCodeNavService instance is
 // invoked.
@@ -1434,6 +1850,120 @@ func (c CodeNavServiceNewGetReferencesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// CodeNavServicePreviewRenameFunc describes the behavior when the PreviewRename
+// method of the parent MockCodeNavService instance is invoked.
+type CodeNavServicePreviewRenameFunc struct {
+	defaultHook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error)
+	hooks       []func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error)
+	history     []CodeNavServicePreviewRenameFuncCall
+	mutex       sync.Mutex
+}
+
+// PreviewRename delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) PreviewRename(v0 context.Context, v1 codenav.PositionalRequestArgs, v2 codenav.RequestState, v3 string) (*codenav.RenamePreview, error) {
+	r0, r1 := m.PreviewRenameFunc.nextHook()(v0, v1, v2, v3)
+	m.PreviewRenameFunc.appendCall(CodeNavServicePreviewRenameFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PreviewRename method of
+// the parent MockCodeNavService instance is invoked and the hook queue is
+// empty.
+func (f *CodeNavServicePreviewRenameFunc) SetDefaultHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PreviewRename method of the parent MockCodeNavService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CodeNavServicePreviewRenameFunc) PushHook(hook func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServicePreviewRenameFunc) SetDefaultReturn(r0 *codenav.RenamePreview, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServicePreviewRenameFunc) PushReturn(r0 *codenav.RenamePreview, r1 error) {
+	f.PushHook(func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServicePreviewRenameFunc) nextHook() func(context.Context, codenav.PositionalRequestArgs, codenav.RequestState, string) (*codenav.RenamePreview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServicePreviewRenameFunc) appendCall(r0 CodeNavServicePreviewRenameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServicePreviewRenameFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServicePreviewRenameFunc) History() []CodeNavServicePreviewRenameFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServicePreviewRenameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServicePreviewRenameFuncCall is an object that describes an
+// invocation of method PreviewRename on an instance of MockCodeNavService.
+type CodeNavServicePreviewRenameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.PositionalRequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *codenav.RenamePreview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServicePreviewRenameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServicePreviewRenameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceSnapshotForDocumentFunc describes the behavior when the
 // SnapshotForDocument method of the parent MockCodeNavService instance is
 // invoked.
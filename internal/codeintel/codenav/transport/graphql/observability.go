@@ -15,11 +15,16 @@ import (
 
 type operations struct {
 	gitBlobLsifData *observation.Operation
+	patchedBlobLsif *observation.Operation
 	hover           *observation.Operation
 	definitions     *observation.Operation
+	typeDefinitions *observation.Operation
 	references      *observation.Operation
 	implementations *observation.Operation
 	prototypes      *observation.Operation
+	incomingCalls   *observation.Operation
+	outgoingCalls   *observation.Operation
+	previewRename   *observation.Operation
 	diagnostics     *observation.Operation
 	stencil         *observation.Operation
 	ranges          *observation.Operation
@@ -45,11 +50,16 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 	return &operations{
 		gitBlobLsifData: op("GitBlobLsifData"),
+		patchedBlobLsif: op("PatchedBlobLsif"),
 		hover:           op("Hover"),
 		definitions:     op("Definitions"),
+		typeDefinitions: op("TypeDefinitions"),
 		references:      op("References"),
 		implementations: op("Implementations"),
 		prototypes:      op("Prototypes"),
+		incomingCalls:   op("IncomingCalls"),
+		outgoingCalls:   op("OutgoingCalls"),
+		previewRename:   op("PreviewRename"),
 		diagnostics:     op("Diagnostics"),
 		stencil:         op("Stencil"),
 		ranges:          op("Ranges"),
@@ -7,6 +7,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
 	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
@@ -17,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type rootResolver struct {
@@ -25,6 +27,7 @@ type rootResolver struct {
 	gitserverClient                gitserver.Client
 	siteAdminChecker               sharedresolvers.SiteAdminChecker
 	repoStore                      database.RepoStore
+	userStore                      database.UserStore
 	uploadLoaderFactory            uploadsgraphql.UploadLoaderFactory
 	indexLoaderFactory             uploadsgraphql.IndexLoaderFactory
 	locationResolverFactory        *gitresolvers.CachedLocationResolverFactory
@@ -59,6 +62,7 @@ func NewRootResolver(
 		gitserverClient:                gitserverClient,
 		siteAdminChecker:               siteAdminChecker,
 		repoStore:                      repoStore,
+		userStore:                      database.UsersWith(observationCtx.Logger, repoStore),
 		uploadLoaderFactory:            uploadLoaderFactory,
 		indexLoaderFactory:             indexLoaderFactory,
 		indexResolverFactory:           indexResolverFactory,
@@ -113,6 +117,76 @@ func (r *rootResolver) GitBlobLSIFData(ctx context.Context, args *resolverstubs.
 		r.uploadLoaderFactory.Create(),
 		r.indexLoaderFactory.Create(),
 		r.locationResolverFactory.Create(),
+		r.userStore,
+		r.operations,
+	), nil
+}
+
+// 🚨 SECURITY: dbstore layer handles authz for query resolution
+func (r *rootResolver) PatchedBlobLSIF(ctx context.Context, args *resolverstubs.PatchedBlobLSIFArgs) (_ resolverstubs.GitBlobLSIFDataResolver, err error) {
+	ctx, _, endObservation := r.operations.patchedBlobLsif.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(args.Repository)),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("patchSize", len(args.Patch)),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	repositoryID, err := resolverstubs.UnmarshalID[int](args.Repository)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := r.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return nil, err
+	}
+	commit, err := r.gitserverClient.ResolveRevision(ctx, repo.Name, args.Commit, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := codenav.ParsePatch(args.Patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid patch")
+	}
+
+	// Files created by the patch have no precise code intelligence
+	originalPath, ok := patch.OriginalPath(args.Path)
+	if !ok {
+		return nil, nil
+	}
+
+	var toolName string
+	if args.ToolName != nil {
+		toolName = *args.ToolName
+	}
+
+	uploads, err := r.svc.GetClosestDumpsForBlob(ctx, repositoryID, string(commit), originalPath, true, toolName)
+	if err != nil || len(uploads) == 0 {
+		return nil, err
+	}
+
+	reqState := codenav.NewRequestState(
+		uploads,
+		r.repoStore,
+		authz.DefaultSubRepoPermsChecker,
+		r.gitserverClient,
+		repo,
+		string(commit),
+		originalPath,
+		r.maximumIndexesPerMonikerSearch,
+		r.hunkCache,
+	)
+	reqState.SetPatch(patch, args.Path)
+
+	return newGitBlobLSIFDataResolver(
+		r.svc,
+		r.indexResolverFactory,
+		reqState,
+		r.uploadLoaderFactory.Create(),
+		r.indexLoaderFactory.Create(),
+		r.locationResolverFactory.Create(),
+		r.userStore,
 		r.operations,
 	), nil
 }
@@ -128,6 +202,7 @@ type gitBlobLSIFDataResolver struct {
 	uploadLoader         uploadsgraphql.UploadLoader
 	indexLoader          uploadsgraphql.IndexLoader
 	locationResolver     *gitresolvers.CachedLocationResolver
+	userStore            database.UserStore
 	operations           *operations
 }
 
@@ -141,6 +216,7 @@ func newGitBlobLSIFDataResolver(
 	uploadLoader uploadsgraphql.UploadLoader,
 	indexLoader uploadsgraphql.IndexLoader,
 	locationResolver *gitresolvers.CachedLocationResolver,
+	userStore database.UserStore,
 	operations *operations,
 ) resolverstubs.GitBlobLSIFDataResolver {
 	return &gitBlobLSIFDataResolver{
@@ -150,6 +226,7 @@ func newGitBlobLSIFDataResolver(
 		indexResolverFactory: indexResolverFactory,
 		requestState:         requestState,
 		locationResolver:     locationResolver,
+		userStore:            userStore,
 		operations:           operations,
 	}
 }
@@ -0,0 +1,160 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// DefaultCallHierarchyPageSize is the call hierarchy result page size when no limit is supplied.
+const DefaultCallHierarchyPageSize = 100
+
+type callHierarchyFunc func(ctx context.Context, args codenav.CallHierarchyArgs, requestState codenav.RequestState, cursor codenav.CallHierarchyCursor) ([]codenav.CallHierarchyCall, codenav.CallHierarchyCursor, error)
+
+func (r *gitBlobLSIFDataResolver) IncomingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.incomingCalls, r.codeNavSvc.GetIncomingCalls)
+}
+
+func (r *gitBlobLSIFDataResolver) OutgoingCalls(ctx context.Context, args *resolverstubs.LSIFCallHierarchyArgs) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	return r.callHierarchy(ctx, args, r.operations.outgoingCalls, r.codeNavSvc.GetOutgoingCalls)
+}
+
+func (r *gitBlobLSIFDataResolver) callHierarchy(
+	ctx context.Context,
+	args *resolverstubs.LSIFCallHierarchyArgs,
+	operation *observation.Operation,
+	getCalls callHierarchyFunc,
+) (_ resolverstubs.CallHierarchyCallConnectionResolver, err error) {
+	limit := int(pointers.Deref(args.First, DefaultCallHierarchyPageSize))
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+
+	rawCursor, err := decodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	requestArgs := codenav.CallHierarchyArgs{
+		PositionalRequestArgs: codenav.PositionalRequestArgs{
+			RequestArgs: codenav.RequestArgs{
+				RepositoryID: r.requestState.RepositoryID,
+				Commit:       r.requestState.Commit,
+				Limit:        limit,
+				RawCursor:    rawCursor,
+			},
+			Path:      r.requestState.Path,
+			Line:      int(args.Line),
+			Character: int(args.Character),
+		},
+		Depth: int(args.Depth),
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, operation, time.Second, getObservationArgs(requestArgs.PositionalRequestArgs))
+	defer endObservation()
+
+	cursor, err := decodeCallHierarchyCursor(rawCursor)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	calls, callsCursor, err := getCalls(ctx, requestArgs, r.requestState, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetCalls")
+	}
+
+	var nextCursor string
+	if callsCursor.Phase != "done" {
+		nextCursor = encodeCallHierarchyCursor(callsCursor)
+	}
+
+	resolvers := make([]resolverstubs.CallHierarchyCallResolver, 0, len(calls))
+	for _, call := range calls {
+		resolvers = append(resolvers, &callHierarchyCallResolver{call: call, locationResolver: r.locationResolver})
+	}
+
+	return resolverstubs.NewCursorConnectionResolver(resolvers, encodeCursor(pointers.NonZeroPtr(nextCursor))), nil
+}
+
+//
+//
+
+type callHierarchyCallResolver struct {
+	call             codenav.CallHierarchyCall
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *callHierarchyCallResolver) Item() resolverstubs.CallHierarchyItemResolver {
+	return &callHierarchyItemResolver{item: r.call.Item, locationResolver: r.locationResolver}
+}
+
+func (r *callHierarchyCallResolver) ParentSymbol() string {
+	return r.call.ParentSymbolName
+}
+
+func (r *callHierarchyCallResolver) Depth() int32 {
+	return int32(r.call.Depth)
+}
+
+func (r *callHierarchyCallResolver) FromRanges(ctx context.Context) ([]resolverstubs.LocationResolver, error) {
+	return resolveLocations(ctx, r.locationResolver, r.call.FromRanges)
+}
+
+type callHierarchyItemResolver struct {
+	item             codenav.CallHierarchyItem
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *callHierarchyItemResolver) Symbol() string {
+	return r.item.SymbolName
+}
+
+func (r *callHierarchyItemResolver) Name() string {
+	symbol, err := scip.ParseSymbol(r.item.SymbolName)
+	if err != nil || len(symbol.Descriptors) == 0 {
+		return r.item.SymbolName
+	}
+
+	return symbol.Descriptors[len(symbol.Descriptors)-1].Name
+}
+
+func (r *callHierarchyItemResolver) Definition(ctx context.Context) (resolverstubs.LocationResolver, error) {
+	if r.item.Definition == nil {
+		return nil, nil
+	}
+
+	return resolveLocation(ctx, r.locationResolver, *r.item.Definition)
+}
+
+//
+//
+
+func decodeCallHierarchyCursor(rawEncoded string) (codenav.CallHierarchyCursor, error) {
+	if rawEncoded == "" {
+		return codenav.CallHierarchyCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return codenav.CallHierarchyCursor{}, err
+	}
+
+	var cursor codenav.CallHierarchyCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+func encodeCallHierarchyCursor(cursor codenav.CallHierarchyCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
@@ -54,3 +54,43 @@ func (r *gitBlobLSIFDataResolver) Definitions(ctx context.Context, args *resolve
 
 	return newLocationConnectionResolver(def, nil, r.locationResolver), nil
 }
+
+// TypeDefinitions returns the list of source locations that define the type of the symbol at the given position.
+func (r *gitBlobLSIFDataResolver) TypeDefinitions(ctx context.Context, args *resolverstubs.LSIFQueryPositionArgs) (_ resolverstubs.LocationConnectionResolver, err error) {
+	requestArgs := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: r.requestState.RepositoryID,
+			Commit:       r.requestState.Commit,
+			Limit:        DefaultDefinitionsPageSize,
+		},
+		Path:      r.requestState.Path,
+		Line:      int(args.Line),
+		Character: int(args.Character),
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.typeDefinitions, time.Second, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", requestArgs.RepositoryID),
+		attribute.String("commit", requestArgs.Commit),
+		attribute.String("path", requestArgs.Path),
+		attribute.Int("line", requestArgs.Line),
+		attribute.Int("character", requestArgs.Character),
+		attribute.Int("limit", requestArgs.Limit),
+	}})
+	defer endObservation()
+
+	def, err := r.codeNavSvc.GetTypeDefinitions(ctx, requestArgs, r.requestState)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetTypeDefinitions")
+	}
+
+	if args.Filter != nil && *args.Filter != "" {
+		filtered := def[:0]
+		for _, loc := range def {
+			if strings.Contains(loc.Path, *args.Filter) {
+				filtered = append(filtered, loc)
+			}
+		}
+		def = filtered
+	}
+
+	return newLocationConnectionResolver(def, nil, r.locationResolver), nil
+}
@@ -0,0 +1,163 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store/author"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+// PreviewRename returns every precise occurrence of the symbol at the given position grouped by
+// repository and file, along with the diffs renaming it to the given name.
+func (r *gitBlobLSIFDataResolver) PreviewRename(ctx context.Context, args *resolverstubs.LSIFRenameArgs) (_ resolverstubs.RenamePreviewResolver, err error) {
+	requestArgs := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: r.requestState.RepositoryID,
+			Commit:       r.requestState.Commit,
+		},
+		Path:      r.requestState.Path,
+		Line:      int(args.Line),
+		Character: int(args.Character),
+	}
+	ctx, _, endObservation := observeResolver(ctx, &err, r.operations.previewRename, time.Second, getObservationArgs(requestArgs))
+	defer endObservation()
+
+	preview, err := r.codeNavSvc.PreviewRename(ctx, requestArgs, r.requestState, args.NewName)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.PreviewRename")
+	}
+
+	return &renamePreviewResolver{preview: preview, locationResolver: r.locationResolver, userStore: r.userStore}, nil
+}
+
+//
+//
+
+type renamePreviewResolver struct {
+	preview          *codenav.RenamePreview
+	locationResolver *gitresolvers.CachedLocationResolver
+	userStore        database.UserStore
+}
+
+func (r *renamePreviewResolver) Symbol() string  { return r.preview.SymbolName }
+func (r *renamePreviewResolver) OldName() string { return r.preview.OldName }
+func (r *renamePreviewResolver) NewName() string { return r.preview.NewName }
+
+func (r *renamePreviewResolver) Repositories() []resolverstubs.RenamePreviewRepositoryResolver {
+	resolvers := make([]resolverstubs.RenamePreviewRepositoryResolver, 0, len(r.preview.Repositories))
+	for _, repository := range r.preview.Repositories {
+		resolvers = append(resolvers, &renamePreviewRepositoryResolver{
+			preview:          r.preview,
+			repository:       repository,
+			locationResolver: r.locationResolver,
+			userStore:        r.userStore,
+		})
+	}
+
+	return resolvers
+}
+
+type renamePreviewRepositoryResolver struct {
+	preview          *codenav.RenamePreview
+	repository       codenav.RenameRepository
+	locationResolver *gitresolvers.CachedLocationResolver
+	userStore        database.UserStore
+}
+
+func (r *renamePreviewRepositoryResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	repository, err := r.locationResolver.Repository(ctx, api.RepoID(r.repository.RepositoryID))
+	if err != nil {
+		return nil, err
+	}
+	if repository == nil {
+		return nil, errors.Newf("repository %d not found", r.repository.RepositoryID)
+	}
+
+	return repository, nil
+}
+
+func (r *renamePreviewRepositoryResolver) Commit() string { return r.repository.Commit }
+func (r *renamePreviewRepositoryResolver) BaseRef() *string {
+	return pointers.NonZeroPtr(r.repository.BaseRef)
+}
+func (r *renamePreviewRepositoryResolver) Diff() string { return r.repository.Diff }
+
+func (r *renamePreviewRepositoryResolver) Files() []resolverstubs.RenamePreviewFileResolver {
+	resolvers := make([]resolverstubs.RenamePreviewFileResolver, 0, len(r.repository.Files))
+	for _, file := range r.repository.Files {
+		resolvers = append(resolvers, &renamePreviewFileResolver{file: file, locationResolver: r.locationResolver})
+	}
+
+	return resolvers
+}
+
+// ChangesetSpec returns the rename of this repository as a JSON-encoded changeset spec, which
+// can be passed to the createChangesetSpec mutation. The commit is authored by the requesting
+// user.
+func (r *renamePreviewRepositoryResolver) ChangesetSpec(ctx context.Context) (string, error) {
+	repository, err := r.Repository(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	commitAuthor, err := renameCommitAuthor(ctx, r.userStore)
+	if err != nil {
+		return "", err
+	}
+
+	spec, err := json.Marshal(r.preview.ChangesetSpec(r.repository, string(repository.ID()), commitAuthor))
+	if err != nil {
+		return "", err
+	}
+
+	return string(spec), nil
+}
+
+// renameCommitAuthor returns the commit author for the user of the given context. As with
+// batch specs, the default author is used when the user has no primary email.
+func renameCommitAuthor(ctx context.Context, userStore database.UserStore) (batcheslib.ChangesetSpecAuthor, error) {
+	defaultAuthor := batcheslib.ChangesetSpecAuthor{
+		Name:  "Sourcegraph",
+		Email: "batch-changes@sourcegraph.com",
+	}
+
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() || userStore == nil {
+		return defaultAuthor, nil
+	}
+
+	commitAuthor, err := author.GetChangesetAuthorForUser(ctx, userStore, a.UID)
+	if err != nil {
+		return batcheslib.ChangesetSpecAuthor{}, err
+	}
+	if commitAuthor == nil {
+		return defaultAuthor, nil
+	}
+
+	return *commitAuthor, nil
+}
+
+type renamePreviewFileResolver struct {
+	file             codenav.RenameFile
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *renamePreviewFileResolver) Path() string { return r.file.Path }
+
+func (r *renamePreviewFileResolver) Locations(ctx context.Context) ([]resolverstubs.LocationResolver, error) {
+	return resolveLocations(ctx, r.locationResolver, r.file.Locations)
+}
+
+func (r *renamePreviewFileResolver) SkippedLocations(ctx context.Context) ([]resolverstubs.LocationResolver, error) {
+	return resolveLocations(ctx, r.locationResolver, r.file.SkippedLocations)
+}
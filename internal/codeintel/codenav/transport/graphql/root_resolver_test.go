@@ -3,10 +3,12 @@ package graphql
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
@@ -38,6 +40,7 @@ func TestRanges(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -73,6 +76,7 @@ func TestDefinitions(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -108,6 +112,7 @@ func TestReferences(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -161,6 +166,7 @@ func TestReferencesDefaultLimit(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -200,6 +206,7 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -217,6 +224,143 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestIncomingCalls(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	mockCallsCursor := codenav.CallHierarchyCursor{Phase: "traverse", Depth: 2, SymbolNames: []string{"scip-go gomod example v1 pkg/f()."}}
+	encodedCursor := encodeCallHierarchyCursor(mockCallsCursor)
+	mockCursor := base64.StdEncoding.EncodeToString([]byte(encodedCursor))
+	mockCodeNavService.GetIncomingCallsFunc.SetDefaultReturn(nil, codenav.CallHierarchyCursor{Phase: "done"}, nil)
+
+	args := &resolverstubs.LSIFCallHierarchyArgs{
+		LSIFQueryPositionArgs: resolverstubs.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		PagedConnectionArgs: resolverstubs.PagedConnectionArgs{After: &mockCursor},
+		Depth:               3,
+	}
+
+	connection, err := resolver.IncomingCalls(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if connection.PageInfo().HasNextPage() {
+		t.Fatalf("unexpected next page for an exhausted cursor")
+	}
+
+	if len(mockCodeNavService.GetIncomingCallsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.GetIncomingCallsFunc.History()))
+	}
+	call := mockCodeNavService.GetIncomingCallsFunc.History()[0]
+	if val := call.Arg1; val.Line != 10 || val.Character != 15 {
+		t.Fatalf("unexpected position. want=%d:%d have=%d:%d", 10, 15, val.Line, val.Character)
+	}
+	if val := call.Arg1; val.Limit != DefaultCallHierarchyPageSize {
+		t.Fatalf("unexpected limit. want=%d have=%d", DefaultCallHierarchyPageSize, val.Limit)
+	}
+	if val := call.Arg1; val.Depth != 3 {
+		t.Fatalf("unexpected depth. want=%d have=%d", 3, val.Depth)
+	}
+	if diff := cmp.Diff(mockCallsCursor, call.Arg3); diff != "" {
+		t.Fatalf("unexpected cursor (-want +got):\n%s", diff)
+	}
+}
+
+func TestPreviewRename(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	repos := dbmocks.NewStrictMockRepoStore()
+	repos.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*sgtypes.Repo, error) {
+		return &sgtypes.Repo{ID: id, Name: api.RepoName(fmt.Sprintf("repo%d", id))}, nil
+	})
+	locationResolver := gitresolvers.NewCachedLocationResolverFactory(repos, gitserver.NewMockClient()).Create()
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		locationResolver,
+		nil,
+		mockOperations,
+	)
+
+	mockCodeNavService.PreviewRenameFunc.SetDefaultReturn(&codenav.RenamePreview{
+		SymbolName: "scip-go gomod example v1 pkg/f().",
+		OldName:    "f",
+		NewName:    "g",
+		Repositories: []codenav.RenameRepository{
+			{RepositoryID: 50, RepositoryName: "repo50", Commit: "deadbeef1", BaseRef: "refs/heads/main", Diff: "diff --git a/p1 b/p1\n"},
+		},
+	}, nil)
+
+	preview, err := resolver.PreviewRename(context.Background(), &resolverstubs.LSIFRenameArgs{Line: 10, Character: 15, NewName: "g"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.PreviewRenameFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.PreviewRenameFunc.History()))
+	}
+	call := mockCodeNavService.PreviewRenameFunc.History()[0]
+	if val := call.Arg1; val.Line != 10 || val.Character != 15 {
+		t.Fatalf("unexpected position. want=%d:%d have=%d:%d", 10, 15, val.Line, val.Character)
+	}
+	if call.Arg3 != "g" {
+		t.Fatalf("unexpected new name. want=%q have=%q", "g", call.Arg3)
+	}
+
+	repositories := preview.Repositories()
+	if len(repositories) != 1 {
+		t.Fatalf("unexpected repository count. want=%d have=%d", 1, len(repositories))
+	}
+	spec, err := repositories[0].ChangesetSpec(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(spec), &decoded); err != nil {
+		t.Fatalf("unexpected error decoding changeset spec: %s", err)
+	}
+	for key, expected := range map[string]string{
+		"baseRepository": "UmVwb3NpdG9yeTo1MA==",
+		"baseRev":        "deadbeef1",
+		"baseRef":        "refs/heads/main",
+		"headRef":        "refs/heads/rename-f-to-g",
+		"title":          "Rename f to g",
+	} {
+		if val := decoded[key]; val != expected {
+			t.Errorf("unexpected %s. want=%q have=%v", key, expected, val)
+		}
+	}
+}
+
 func TestHover(t *testing.T) {
 	mockCodeNavService := NewMockCodeNavService()
 	mockRequestState := codenav.RequestState{
@@ -233,6 +377,7 @@ func TestHover(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -269,6 +414,7 @@ func TestDiagnostics(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -305,6 +451,7 @@ func TestDiagnosticsDefaultLimit(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -338,6 +485,7 @@ func TestDiagnosticsDefaultIllegalLimit(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		mockOperations,
 	)
 
@@ -199,3 +199,41 @@ type RemoteCursor struct {
 	// The location offset within the associated batch of uploads.
 	LocationOffset int `json:"locationOffset"`
 }
+
+type CallHierarchyArgs struct {
+	PositionalRequestArgs
+	// Depth is the number of levels of calls to traverse. Direct calls are at depth 1.
+	Depth int
+}
+
+// CallHierarchyItem is a function or method that takes part in a call hierarchy.
+type CallHierarchyItem struct {
+	SymbolName string
+	// Definition is the location of the item's definition adjusted to the target commit,
+	// or nil if the item isn't defined in an index visible to the request.
+	Definition *shared.UploadLocation
+}
+
+// CallHierarchyCall is an edge of a call hierarchy. For incoming calls, Item is the caller
+// of the item named by ParentSymbolName. For outgoing calls, Item is called by it.
+type CallHierarchyCall struct {
+	Item             CallHierarchyItem
+	ParentSymbolName string
+	Depth            int
+	// FromRanges are the call sites within the body of the caller, adjusted to the target
+	// commit.
+	FromRanges []shared.UploadLocation
+}
+
+// CallHierarchyCursor holds the state necessary to resume a call hierarchy traversal from a
+// second or subsequent request. The traversal is breadth-first: the calls of all symbols at
+// the current depth are paged through before the symbols they lead to are expanded.
+type CallHierarchyCursor struct {
+	Phase       string   `json:"p"`  // ""/"traverse" or "done"
+	Depth       int      `json:"d"`  // depth of the calls being paged through
+	SymbolNames []string `json:"ss"` // symbols whose calls are resolved at the current depth
+	Visited     []string `json:"vs"` // symbols whose calls have been resolved at a lower depth
+	Offset      int      `json:"o"`  // number of calls at the current depth already returned
+}
+
+var exhaustedCallHierarchyCursor = CallHierarchyCursor{Phase: "done"}
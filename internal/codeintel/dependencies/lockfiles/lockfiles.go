@@ -0,0 +1,107 @@
+// Package lockfiles extracts the package references pinned by the lockfiles
+// and manifests committed to a repository.
+package lockfiles
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type parser func(content []byte) ([]shared.MinimialVersionedPackageRepo, error)
+
+type lockfile struct {
+	scheme string
+	parse  parser
+}
+
+// lockfiles maps the base name of each supported lockfile to the package
+// scheme of the references it contains.
+var lockfiles = map[string]lockfile{
+	"go.mod":            {scheme: shared.GoPackagesScheme, parse: parseGoMod},
+	"package-lock.json": {scheme: shared.NpmPackagesScheme, parse: parsePackageLockJSON},
+	"yarn.lock":         {scheme: shared.NpmPackagesScheme, parse: parseYarnLock},
+	"Cargo.lock":        {scheme: shared.RustPackagesScheme, parse: parseCargoLock},
+}
+
+// Filenames returns the sorted base names of the supported lockfiles that
+// contain references to packages of the given scheme.
+func Filenames(scheme string) []string {
+	var names []string
+	for name, lockfile := range lockfiles {
+		if lockfile.scheme == scheme {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsSupportedScheme returns true if at least one supported lockfile contains
+// references to packages of the given scheme.
+func IsSupportedScheme(scheme string) bool {
+	return len(Filenames(scheme)) > 0
+}
+
+// Parse returns the package references in the content of the lockfile at
+// the given path. Paths that are not supported lockfiles yield no references.
+func Parse(filepath string, content []byte) ([]shared.MinimialVersionedPackageRepo, error) {
+	lockfile, ok := lockfiles[path.Base(filepath)]
+	if !ok {
+		return nil, nil
+	}
+	deps, err := lockfile.parse(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", filepath)
+	}
+	return deps, nil
+}
+
+// ListDependencies returns the deduplicated package references of the given
+// scheme in all lockfiles of the repository at the given commit.
+func ListDependencies(ctx context.Context, client gitserver.Client, repo api.RepoName, commit api.CommitID, scheme string) ([]shared.MinimialVersionedPackageRepo, error) {
+	var pathspecs []gitdomain.Pathspec
+	for _, name := range Filenames(scheme) {
+		pathspecs = append(pathspecs, gitdomain.Pathspec(name), gitdomain.Pathspec("*/"+name))
+	}
+	if len(pathspecs) == 0 {
+		return nil, nil
+	}
+
+	paths, err := client.LsFiles(ctx, authz.DefaultSubRepoPermsChecker, repo, commit, pathspecs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "LsFiles")
+	}
+
+	seen := map[shared.MinimialVersionedPackageRepo]struct{}{}
+	var deps []shared.MinimialVersionedPackageRepo
+	for _, p := range paths {
+		if _, ok := lockfiles[path.Base(p)]; !ok {
+			continue
+		}
+		content, err := client.ReadFile(ctx, authz.DefaultSubRepoPermsChecker, repo, commit, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ReadFile %s", p)
+		}
+		fileDeps, err := Parse(p, content)
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range fileDeps {
+			if _, ok := seen[dep]; ok {
+				continue
+			}
+			seen[dep] = struct{}{}
+			deps = append(deps, dep)
+		}
+	}
+
+	return deps, nil
+}
@@ -0,0 +1,201 @@
+package lockfiles
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+)
+
+func newDependency(scheme, name, version string) shared.MinimialVersionedPackageRepo {
+	return shared.MinimialVersionedPackageRepo{
+		Scheme:  scheme,
+		Name:    reposource.PackageName(name),
+		Version: version,
+	}
+}
+
+// parseGoMod returns the modules required by a go.mod file.
+func parseGoMod(content []byte) ([]shared.MinimialVersionedPackageRepo, error) {
+	f, err := modfile.ParseLax("go.mod", content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make([]shared.MinimialVersionedPackageRepo, 0, len(f.Require))
+	for _, require := range f.Require {
+		deps = append(deps, newDependency(shared.GoPackagesScheme, require.Mod.Path, require.Mod.Version))
+	}
+	return deps, nil
+}
+
+type packageLockEntry struct {
+	Version      string                      `json:"version"`
+	Link         bool                        `json:"link"`
+	Dependencies map[string]packageLockEntry `json:"dependencies"`
+}
+
+// parsePackageLockJSON returns the packages installed by a package-lock.json
+// file. Lockfile versions 2 and 3 list every installed package under
+// "packages", version 1 nests them under "dependencies".
+func parsePackageLockJSON(content []byte) ([]shared.MinimialVersionedPackageRepo, error) {
+	var lockfile struct {
+		Packages     map[string]packageLockEntry `json:"packages"`
+		Dependencies map[string]packageLockEntry `json:"dependencies"`
+	}
+	if err := json.Unmarshal(content, &lockfile); err != nil {
+		return nil, err
+	}
+
+	var deps []shared.MinimialVersionedPackageRepo
+	add := func(name string, entry packageLockEntry) {
+		// Links and packages installed from git or the file system have no
+		// registry version.
+		if name == "" || entry.Link || entry.Version == "" || strings.Contains(entry.Version, ":") {
+			return
+		}
+		deps = append(deps, newDependency(shared.NpmPackagesScheme, name, entry.Version))
+	}
+
+	if len(lockfile.Packages) > 0 {
+		for _, key := range sortedKeys(lockfile.Packages) {
+			// The root project is listed under the empty key.
+			i := strings.LastIndex(key, "node_modules/")
+			if i < 0 {
+				continue
+			}
+			add(key[i+len("node_modules/"):], lockfile.Packages[key])
+		}
+		return deps, nil
+	}
+
+	var walk func(map[string]packageLockEntry)
+	walk = func(dependencies map[string]packageLockEntry) {
+		for _, name := range sortedKeys(dependencies) {
+			entry := dependencies[name]
+			add(name, entry)
+			walk(entry.Dependencies)
+		}
+	}
+	walk(lockfile.Dependencies)
+	return deps, nil
+}
+
+// parseYarnLock returns the packages resolved by a yarn.lock file. Both the
+// classic format and the YAML format of newer yarn versions are supported.
+func parseYarnLock(content []byte) ([]shared.MinimialVersionedPackageRepo, error) {
+	var (
+		deps []shared.MinimialVersionedPackageRepo
+		name string
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !strings.HasPrefix(line, " ") {
+			// An entry header lists all of the descriptors resolved to the
+			// entry, e.g. `"react@^17.0.0", react@^17.0.2:`. They all refer
+			// to the same package, so the first one is enough.
+			descriptor, _, _ := strings.Cut(strings.TrimSuffix(line, ":"), ",")
+			name = yarnDescriptorName(strings.Trim(strings.TrimSpace(descriptor), `"`))
+			continue
+		}
+
+		// Only the fields of the entry itself are indented once; nested
+		// fields such as dependencies are indented further.
+		if name == "" || strings.HasPrefix(line, "   ") {
+			continue
+		}
+		field := strings.TrimSpace(line)
+		if !strings.HasPrefix(field, "version ") && !strings.HasPrefix(field, "version:") {
+			continue
+		}
+		version := strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(field, "version"), ":"))
+		if unquoted, err := strconv.Unquote(version); err == nil {
+			version = unquoted
+		}
+		if version != "" {
+			deps = append(deps, newDependency(shared.NpmPackagesScheme, name, version))
+		}
+		name = ""
+	}
+
+	return deps, scanner.Err()
+}
+
+// yarnDescriptorName returns the package name of a yarn descriptor such as
+// `@types/node@^18.0.0`, or the empty string for entries that are not
+// packages, such as the metadata entry of newer yarn versions.
+func yarnDescriptorName(descriptor string) string {
+	if i := strings.LastIndex(descriptor, "@"); i > 0 {
+		return descriptor[:i]
+	}
+	return ""
+}
+
+// parseCargoLock returns the crates listed by a Cargo.lock file.
+func parseCargoLock(content []byte) ([]shared.MinimialVersionedPackageRepo, error) {
+	var (
+		deps          []shared.MinimialVersionedPackageRepo
+		inPackage     bool
+		name, version string
+	)
+	flush := func() {
+		if inPackage && name != "" && version != "" {
+			deps = append(deps, newDependency(shared.RustPackagesScheme, name, version))
+		}
+		name, version = "", ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			flush()
+			inPackage = line == "[[package]]"
+			continue
+		}
+		if !inPackage {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "name":
+			name = value
+		case "version":
+			version = value
+		}
+	}
+	flush()
+
+	return deps, scanner.Err()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
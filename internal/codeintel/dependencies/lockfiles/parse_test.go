@@ -0,0 +1,170 @@
+package lockfiles
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name    string
+		path    string
+		content string
+		want    []shared.MinimialVersionedPackageRepo
+	}{
+		{
+			name: "go.mod",
+			path: "cmd/go.mod",
+			content: `module example.com/app
+
+go 1.20
+
+require (
+	github.com/google/go-cmp v0.5.9
+	golang.org/x/mod v0.12.0 // indirect
+)
+`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.GoPackagesScheme, "github.com/google/go-cmp", "v0.5.9"),
+				newDependency(shared.GoPackagesScheme, "golang.org/x/mod", "v0.12.0"),
+			},
+		},
+		{
+			name: "package-lock.json v3",
+			path: "package-lock.json",
+			content: `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "app", "version": "1.0.0"},
+    "node_modules/@types/node": {"version": "18.11.9"},
+    "node_modules/react": {"version": "18.2.0"},
+    "node_modules/react/node_modules/loose-envify": {"version": "1.4.0"},
+    "node_modules/local": {"resolved": "packages/local", "link": true},
+    "node_modules/from-git": {"version": "git+ssh://git@github.com/a/b.git#abc"}
+  }
+}`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.NpmPackagesScheme, "@types/node", "18.11.9"),
+				newDependency(shared.NpmPackagesScheme, "react", "18.2.0"),
+				newDependency(shared.NpmPackagesScheme, "loose-envify", "1.4.0"),
+			},
+		},
+		{
+			name: "package-lock.json v1",
+			path: "web/package-lock.json",
+			content: `{
+  "lockfileVersion": 1,
+  "dependencies": {
+    "react": {
+      "version": "17.0.2",
+      "dependencies": {"loose-envify": {"version": "1.4.0"}}
+    }
+  }
+}`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.NpmPackagesScheme, "react", "17.0.2"),
+				newDependency(shared.NpmPackagesScheme, "loose-envify", "1.4.0"),
+			},
+		},
+		{
+			name: "yarn.lock classic",
+			path: "yarn.lock",
+			content: `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@types/node@*", "@types/node@^18.0.0":
+  version "18.11.9"
+  resolved "https://registry.yarnpkg.com/@types/node/-/node-18.11.9.tgz"
+
+react@^17.0.2:
+  version "17.0.2"
+  dependencies:
+    loose-envify "^1.1.0"
+    version-pinned "^1.0.0"
+`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.NpmPackagesScheme, "@types/node", "18.11.9"),
+				newDependency(shared.NpmPackagesScheme, "react", "17.0.2"),
+			},
+		},
+		{
+			name: "yarn.lock berry",
+			path: "yarn.lock",
+			content: `__metadata:
+  version: 6
+  cacheKey: 8
+
+"react@npm:^18.2.0":
+  version: 18.2.0
+  resolution: "react@npm:18.2.0"
+`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.NpmPackagesScheme, "react", "18.2.0"),
+			},
+		},
+		{
+			name: "Cargo.lock",
+			path: "Cargo.lock",
+			content: `# This file is automatically @generated by Cargo.
+version = 3
+
+[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = [
+ "serde",
+]
+
+[[package]]
+name = "serde"
+version = "1.0.152"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[metadata]
+name = "ignored"
+`,
+			want: []shared.MinimialVersionedPackageRepo{
+				newDependency(shared.RustPackagesScheme, "app", "0.1.0"),
+				newDependency(shared.RustPackagesScheme, "serde", "1.0.152"),
+			},
+		},
+		{
+			name:    "unsupported file",
+			path:    "requirements.txt",
+			content: "requests==2.28.1\n",
+			want:    nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.path, []byte(tc.content))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	if _, err := Parse("package-lock.json", []byte("{")); err == nil {
+		t.Error("expected an error for an invalid package-lock.json")
+	}
+}
+
+func TestFilenames(t *testing.T) {
+	want := []string{"package-lock.json", "yarn.lock"}
+	if diff := cmp.Diff(want, Filenames(shared.NpmPackagesScheme)); diff != "" {
+		t.Errorf("unexpected filenames (-want +got):\n%s", diff)
+	}
+	if IsSupportedScheme(shared.JVMPackagesScheme) {
+		t.Error("expected JVM packages to be unsupported")
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 type CodeNavServiceResolver interface {
 	GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArgs) (GitBlobLSIFDataResolver, error)
+	PatchedBlobLSIF(ctx context.Context, args *PatchedBlobLSIFArgs) (GitBlobLSIFDataResolver, error)
 }
 
 type GitBlobLSIFDataArgs struct {
@@ -22,6 +23,14 @@ type GitBlobLSIFDataArgs struct {
 	ToolName  string
 }
 
+type PatchedBlobLSIFArgs struct {
+	Repository graphql.ID
+	Commit     string
+	Patch      string
+	Path       string
+	ToolName   *string
+}
+
 type GitBlobLSIFDataResolver interface {
 	GitTreeLSIFDataResolver
 	ToGitTreeLSIFData() (GitTreeLSIFDataResolver, bool)
@@ -30,9 +39,13 @@ type GitBlobLSIFDataResolver interface {
 	Stencil(ctx context.Context) ([]RangeResolver, error)
 	Ranges(ctx context.Context, args *LSIFRangesArgs) (CodeIntelligenceRangeConnectionResolver, error)
 	Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
+	TypeDefinitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Prototypes(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	IncomingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyCallConnectionResolver, error)
+	OutgoingCalls(ctx context.Context, args *LSIFCallHierarchyArgs) (CallHierarchyCallConnectionResolver, error)
+	PreviewRename(ctx context.Context, args *LSIFRenameArgs) (RenamePreviewResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	VisibleIndexes(ctx context.Context) (_ *[]PreciseIndexResolver, err error)
 	Snapshot(ctx context.Context, args *struct{ IndexID graphql.ID }) (_ *[]SnapshotDataResolver, err error)
@@ -61,6 +74,57 @@ type LSIFPagedQueryPositionArgs struct {
 	Filter *string
 }
 
+type LSIFCallHierarchyArgs struct {
+	LSIFQueryPositionArgs
+	PagedConnectionArgs
+	Depth int32
+}
+
+type (
+	CallHierarchyCallConnectionResolver = PagedConnectionResolver[CallHierarchyCallResolver]
+)
+
+type CallHierarchyCallResolver interface {
+	Item() CallHierarchyItemResolver
+	ParentSymbol() string
+	Depth() int32
+	FromRanges(ctx context.Context) ([]LocationResolver, error)
+}
+
+type CallHierarchyItemResolver interface {
+	Symbol() string
+	Name() string
+	Definition(ctx context.Context) (LocationResolver, error)
+}
+
+type LSIFRenameArgs struct {
+	Line      int32
+	Character int32
+	NewName   string
+}
+
+type RenamePreviewResolver interface {
+	Symbol() string
+	OldName() string
+	NewName() string
+	Repositories() []RenamePreviewRepositoryResolver
+}
+
+type RenamePreviewRepositoryResolver interface {
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Commit() string
+	BaseRef() *string
+	Files() []RenamePreviewFileResolver
+	Diff() string
+	ChangesetSpec(ctx context.Context) (string, error)
+}
+
+type RenamePreviewFileResolver interface {
+	Path() string
+	Locations(ctx context.Context) ([]LocationResolver, error)
+	SkippedLocations(ctx context.Context) ([]LocationResolver, error)
+}
+
 type (
 	CodeIntelligenceRangeConnectionResolver = ConnectionResolver[CodeIntelligenceRangeResolver]
 )
@@ -113,10 +113,30 @@ func (r *Resolver) VulnerabilityMatchesCountByRepository(ctx context.Context, ar
 	return r.sentinelRootResolver.VulnerabilityMatchesCountByRepository(ctx, args)
 }
 
+func (r *Resolver) VulnerabilityImports(ctx context.Context, args GetVulnerabilityImportsArgs) (_ VulnerabilityImportConnectionResolver, err error) {
+	return r.sentinelRootResolver.VulnerabilityImports(ctx, args)
+}
+
+func (r *Resolver) VulnerabilitySources(ctx context.Context) (_ []VulnerabilitySourceResolver, err error) {
+	return r.sentinelRootResolver.VulnerabilitySources(ctx)
+}
+
+func (r *Resolver) ImportVulnerabilities(ctx context.Context, args *ImportVulnerabilitiesArgs) (_ VulnerabilityImportResolver, err error) {
+	return r.sentinelRootResolver.ImportVulnerabilities(ctx, args)
+}
+
+func (r *Resolver) SBOM(ctx context.Context, args *SBOMArgs) (_ SBOMResolver, err error) {
+	return r.sentinelRootResolver.SBOM(ctx, args)
+}
+
 func (r *Resolver) IndexerKeys(ctx context.Context, opts *IndexerKeyQueryArgs) (_ []string, err error) {
 	return r.uploadsRootResolver.IndexerKeys(ctx, opts)
 }
 
+func (r *Resolver) PreviewPreciseIndexRetentionBudget(ctx context.Context, args *PreviewPreciseIndexRetentionBudgetArgs) (_ PreciseIndexRetentionBudgetPreviewResolver, err error) {
+	return r.uploadsRootResolver.PreviewPreciseIndexRetentionBudget(ctx, args)
+}
+
 func (r *Resolver) PreciseIndexes(ctx context.Context, args *PreciseIndexesQueryArgs) (_ PreciseIndexConnectionResolver, err error) {
 	return r.uploadsRootResolver.PreciseIndexes(ctx, args)
 }
@@ -157,6 +177,10 @@ func (r *Resolver) GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArg
 	return r.codenavResolver.GitBlobLSIFData(ctx, args)
 }
 
+func (r *Resolver) PatchedBlobLSIF(ctx context.Context, args *PatchedBlobLSIFArgs) (_ GitBlobLSIFDataResolver, err error) {
+	return r.codenavResolver.PatchedBlobLSIF(ctx, args)
+}
+
 func (r *Resolver) ConfigurationPolicyByID(ctx context.Context, id graphql.ID) (_ CodeIntelligenceConfigurationPolicyResolver, err error) {
 	return r.policiesRootResolver.ConfigurationPolicyByID(ctx, id)
 }
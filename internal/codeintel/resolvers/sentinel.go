@@ -18,6 +18,14 @@ type SentinelServiceResolver interface {
 	VulnerabilityMatchByID(ctx context.Context, id graphql.ID) (_ VulnerabilityMatchResolver, err error)
 	VulnerabilityMatchesSummaryCounts(ctx context.Context) (VulnerabilityMatchesSummaryCountResolver, error)
 	VulnerabilityMatchesCountByRepository(ctx context.Context, args GetVulnerabilityMatchCountByRepositoryArgs) (VulnerabilityMatchCountByRepositoryConnectionResolver, error)
+
+	// Vulnerability database imports
+	VulnerabilityImports(ctx context.Context, args GetVulnerabilityImportsArgs) (VulnerabilityImportConnectionResolver, error)
+	VulnerabilitySources(ctx context.Context) ([]VulnerabilitySourceResolver, error)
+	ImportVulnerabilities(ctx context.Context, args *ImportVulnerabilitiesArgs) (VulnerabilityImportResolver, error)
+
+	// Software bills of materials
+	SBOM(ctx context.Context, args *SBOMArgs) (SBOMResolver, error)
 }
 
 type (
@@ -25,6 +33,7 @@ type (
 	VulnerabilityConnectionResolver                       = PagedConnectionWithTotalCountResolver[VulnerabilityResolver]
 	VulnerabilityMatchConnectionResolver                  = PagedConnectionWithTotalCountResolver[VulnerabilityMatchResolver]
 	VulnerabilityMatchCountByRepositoryConnectionResolver = PagedConnectionWithTotalCountResolver[VulnerabilityMatchCountByRepositoryResolver]
+	VulnerabilityImportConnectionResolver                 = PagedConnectionWithTotalCountResolver[VulnerabilityImportResolver]
 )
 
 type GetVulnerabilityMatchesArgs struct {
@@ -32,6 +41,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       *string
 	Language       *string
 	RepositoryName *string
+	ReachableOnly  bool
 }
 
 type VulnerabilityResolver interface {
@@ -74,6 +84,8 @@ type VulnerabilityMatchResolver interface {
 	Vulnerability(ctx context.Context) (VulnerabilityResolver, error)
 	AffectedPackage(ctx context.Context) (VulnerabilityAffectedPackageResolver, error)
 	PreciseIndex(ctx context.Context) (PreciseIndexResolver, error)
+	Reachable() *bool
+	ReachableSymbols() []string
 }
 
 type VulnerabilityMatchesSummaryCountResolver interface {
@@ -94,3 +106,60 @@ type VulnerabilityMatchCountByRepositoryResolver interface {
 	RepositoryName() string
 	MatchCount() int32
 }
+
+type GetVulnerabilityImportsArgs struct {
+	PagedConnectionArgs
+	Source *string
+}
+
+type ImportVulnerabilitiesArgs struct {
+	Source  string
+	Archive string
+}
+
+type VulnerabilityImportResolver interface {
+	ID() graphql.ID
+	Source() string
+	Origin() string
+	NumVulnerabilities() int32
+	NumVulnerabilitiesInserted() int32
+	FailureMessage() *string
+	CreatedAt() gqlutil.DateTime
+}
+
+type VulnerabilitySourceResolver interface {
+	Name() string
+	LastImport() VulnerabilityImportResolver
+	LastSuccessfulImport() VulnerabilityImportResolver
+}
+
+type SBOMArgs struct {
+	Repository graphql.ID
+	Rev        *string
+	Format     string
+}
+
+type SBOMResolver interface {
+	Format() string
+	RepositoryName() string
+	Commit() string
+	Filename() string
+	DownloadURL() string
+	Content() (string, error)
+	Components() []SBOMComponentResolver
+}
+
+type SBOMComponentResolver interface {
+	Name() string
+	Version() string
+	PackageURL() string
+	Vulnerabilities() []SBOMVulnerabilityResolver
+}
+
+type SBOMVulnerabilityResolver interface {
+	SourceID() string
+	Summary() string
+	Severity() string
+	FixedIn() *string
+	Reachable() *bool
+}
@@ -13,6 +13,7 @@ type UploadsServiceResolver interface {
 	PreciseIndexes(ctx context.Context, args *PreciseIndexesQueryArgs) (PreciseIndexConnectionResolver, error)
 	PreciseIndexByID(ctx context.Context, id graphql.ID) (PreciseIndexResolver, error)
 	IndexerKeys(ctx context.Context, args *IndexerKeyQueryArgs) ([]string, error)
+	PreviewPreciseIndexRetentionBudget(ctx context.Context, args *PreviewPreciseIndexRetentionBudgetArgs) (PreciseIndexRetentionBudgetPreviewResolver, error)
 
 	// Modify precise indexes
 	DeletePreciseIndex(ctx context.Context, args *struct{ ID graphql.ID }) (*EmptyResponse, error)
@@ -43,6 +44,26 @@ type IndexerKeyQueryArgs struct {
 	Repo *graphql.ID
 }
 
+type PreviewPreciseIndexRetentionBudgetArgs struct {
+	GlobalBudget     *float64
+	RepositoryBudget *float64
+	Repository       *graphql.ID
+	First            *int32
+}
+
+type PreciseIndexRetentionBudgetPreviewResolver interface {
+	Nodes() []PreciseIndexOverRetentionBudgetResolver
+	TotalCount() int32
+	TotalSize() float64
+}
+
+type PreciseIndexOverRetentionBudgetResolver interface {
+	Index() PreciseIndexResolver
+	Size() float64
+	VisibleAtTip() bool
+	ReferenceCount() int32
+}
+
 type DeletePreciseIndexesArgs struct {
 	Query           *string
 	States          *[]string
@@ -175,6 +196,31 @@ type CodeIntelSummaryResolver interface {
 	NumRepositoriesWithCodeIntelligence(ctx context.Context) (int32, error)
 	RepositoriesWithErrors(ctx context.Context, args *RepositoriesWithErrorsArgs) (CodeIntelRepositoryWithErrorConnectionResolver, error)
 	RepositoriesWithConfiguration(ctx context.Context, args *RepositoriesWithConfigurationArgs) (CodeIntelRepositoryWithConfigurationConnectionResolver, error)
+	LanguageCoverage(ctx context.Context, args *LanguageCoverageArgs) (CodeIntelLanguageCoverageConnectionResolver, error)
+}
+
+type LanguageCoverageArgs struct {
+	PagedConnectionArgs
+	Repository *graphql.ID
+	Language   *string
+	States     *[]string
+}
+
+type (
+	CodeIntelLanguageCoverageConnectionResolver = PagedConnectionWithTotalCountResolver[CodeIntelLanguageCoverageResolver]
+)
+
+type CodeIntelLanguageCoverageResolver interface {
+	Repository() RepositoryResolver
+	Language() string
+	Commit() string
+	TotalBytes() float64
+	TotalLines() int32
+	State() string
+	Indexers() []string
+	FailureReason() *string
+	LastIndexedAt() *gqlutil.DateTime
+	UpdatedAt() gqlutil.DateTime
 }
 
 type CodeIntelRepositorySummaryResolver interface {
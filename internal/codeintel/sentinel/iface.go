@@ -0,0 +1,11 @@
+package sentinel
+
+import (
+	"context"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+type UploadService interface {
+	InferClosestUploads(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) (_ []uploadsshared.Dump, err error)
+}
@@ -4,8 +4,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/matcher"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	sentinelstore "github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -13,10 +16,17 @@ import (
 func NewService(
 	observationCtx *observation.Context,
 	db database.DB,
+	codeIntelDB codeintelshared.CodeIntelDB,
+	uploadSvc UploadService,
+	gitserverClient gitserver.Client,
 ) *Service {
 	return newService(
 		scopedContext("service", observationCtx),
 		sentinelstore.New(scopedContext("store", observationCtx), db),
+		lsifstore.New(scopedContext("lsifstore", observationCtx), codeIntelDB),
+		db.Repos(),
+		uploadSvc,
+		gitserverClient,
 	)
 }
 
@@ -29,6 +39,7 @@ func CVEScannerJob(observationCtx *observation.Context, service *Service) []goro
 	return background.CVEScannerJob(
 		scopedContext("cvescanner", observationCtx),
 		service.store,
+		service.lsifstore,
 		DownloaderConfigInst,
 		MatcherConfigInst,
 	)
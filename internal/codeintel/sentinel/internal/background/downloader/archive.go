@@ -0,0 +1,95 @@
+package downloader
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DownloadArchive fetches a zip archive of a vulnerability database from the given URL. The
+// caller is responsible for closing the returned reader.
+func DownloadArchive(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpcli.ExternalDoer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// ParseArchive converts a zip archive of the given vulnerability database to the internal
+// Vulnerability format.
+func (parser *CVEParser) ParseArchive(source string, r io.Reader) ([]shared.Vulnerability, error) {
+	switch source {
+	case shared.VulnerabilitySourceGitHub:
+		return parser.ParseGitHubAdvisoryDB(r)
+	case shared.VulnerabilitySourceGovulndb:
+		return parser.ParseGovulndbAdvisoryDB(r)
+	case shared.VulnerabilitySourceOSV:
+		return parser.ParseOSVDB(r)
+	}
+
+	return nil, errors.Newf("unknown vulnerability source %q", source)
+}
+
+// ImportArchive parses a zip archive of the given vulnerability database, inserts the resulting
+// vulnerabilities, and records the attempt in the import history. A failed import is recorded
+// along with its failure message before the error is returned.
+func ImportArchive(
+	ctx context.Context,
+	store store.Store,
+	parser *CVEParser,
+	source string,
+	origin string,
+	userID *int32,
+	r io.Reader,
+) (shared.VulnerabilityImport, error) {
+	vulnerabilities, err := parser.ParseArchive(source, r)
+	if err != nil {
+		return recordFailedImport(ctx, store, source, origin, userID, shared.InvalidArchiveError{Err: err})
+	}
+
+	numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
+	if err != nil {
+		return recordFailedImport(ctx, store, source, origin, userID, err)
+	}
+
+	return store.InsertVulnerabilityImport(ctx, shared.VulnerabilityImport{
+		Source:                     source,
+		Origin:                     origin,
+		UserID:                     userID,
+		NumVulnerabilities:         len(vulnerabilities),
+		NumVulnerabilitiesInserted: numVulnerabilitiesInserted,
+	})
+}
+
+func recordFailedImport(ctx context.Context, store store.Store, source, origin string, userID *int32, importErr error) (shared.VulnerabilityImport, error) {
+	failureMessage := importErr.Error()
+
+	vulnerabilityImport, err := store.InsertVulnerabilityImport(ctx, shared.VulnerabilityImport{
+		Source:         source,
+		Origin:         origin,
+		UserID:         userID,
+		FailureMessage: &failureMessage,
+	})
+	if err != nil {
+		return shared.VulnerabilityImport{}, errors.Append(importErr, err)
+	}
+
+	return vulnerabilityImport, importErr
+}
@@ -3,15 +3,37 @@ package downloader
 import (
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 )
 
 type Config struct {
 	env.BaseConfig
 
-	DownloaderInterval time.Duration
+	DownloaderInterval        time.Duration
+	GitHubAdvisoryDatabaseURL string
+	GovulndbURL               string
+	OSVURL                    string
 }
 
 func (c *Config) Load() {
 	c.DownloaderInterval = c.GetInterval("CODEINTEL_SENTINEL_DOWNLOADER_INTERVAL", "1h", "How frequently to sync the vulnerability database.")
+	c.GitHubAdvisoryDatabaseURL = c.Get("CODEINTEL_SENTINEL_GITHUB_ADVISORY_DATABASE_URL", advisoryDatabaseURL, "The URL (or mirror URL) of a zip archive of the GitHub advisory database. Set to an empty string to disable downloading it, e.g. on air-gapped instances.")
+	c.GovulndbURL = c.GetOptional("CODEINTEL_SENTINEL_GOVULNDB_URL", "The URL (or mirror URL) of a zip archive of the Go vulnerability database in OSV format. Not downloaded when empty.")
+	c.OSVURL = c.GetOptional("CODEINTEL_SENTINEL_OSV_URL", "The URL (or mirror URL) of a zip archive of OSV-formatted vulnerabilities. Not downloaded when empty.")
+}
+
+// sourceURL returns the URL from which the given vulnerability source is downloaded. An
+// empty string indicates that the source is not downloaded.
+func (c *Config) sourceURL(source string) string {
+	switch source {
+	case shared.VulnerabilitySourceGitHub:
+		return c.GitHubAdvisoryDatabaseURL
+	case shared.VulnerabilitySourceGovulndb:
+		return c.GovulndbURL
+	case shared.VulnerabilitySourceOSV:
+		return c.OSVURL
+	}
+
+	return ""
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func NewCVEDownloader(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
@@ -22,21 +23,26 @@ func NewCVEDownloader(store store.Store, observationCtx *observation.Context, co
 	return goroutine.NewPeriodicGoroutine(
 		actor.WithInternalActor(context.Background()),
 		goroutine.HandlerFunc(func(ctx context.Context) error {
-			vulnerabilities, err := cveParser.handle(ctx)
-			if err != nil {
-				return err
-			}
+			var errs error
+			for _, source := range shared.VulnerabilitySources {
+				url := config.sourceURL(source)
+				if url == "" {
+					continue
+				}
+
+				vulnerabilityImport, err := cveParser.downloadAndImport(ctx, source, url)
+				if err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "failed to import %s vulnerabilities", source))
+					continue
+				}
 
-			numVulnerabilitiesInserted, err := store.InsertVulnerabilities(ctx, vulnerabilities)
-			if err != nil {
-				return err
+				metrics.numVulnerabilitiesInserted.Add(float64(vulnerabilityImport.NumVulnerabilitiesInserted))
 			}
 
-			metrics.numVulnerabilitiesInserted.Add(float64(numVulnerabilitiesInserted))
-			return nil
+			return errs
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-downloader"),
-		goroutine.WithDescription("Periodically syncs GitHub advisory records into Postgres."),
+		goroutine.WithDescription("Periodically syncs vulnerability databases into Postgres."),
 		goroutine.WithInterval(config.DownloaderInterval),
 	)
 }
@@ -52,6 +58,13 @@ func NewCVEParser() *CVEParser {
 	}
 }
 
-func (parser *CVEParser) handle(ctx context.Context) ([]shared.Vulnerability, error) {
-	return parser.ReadGitHubAdvisoryDB(ctx, false)
+func (parser *CVEParser) downloadAndImport(ctx context.Context, source, url string) (shared.VulnerabilityImport, error) {
+	archive, err := DownloadArchive(ctx, url)
+	if err != nil {
+		// Record the failure so that stale sources are visible to site admins
+		return recordFailedImport(ctx, parser.store, source, shared.VulnerabilityImportOriginDownload, nil, err)
+	}
+	defer archive.Close()
+
+	return ImportArchive(ctx, parser.store, parser, source, shared.VulnerabilityImportOriginDownload, nil, archive)
 }
@@ -0,0 +1,90 @@
+package downloader
+
+// Parse vulnerabilities from an arbitrary database in the Open Source Vulnerability (OSV) format,
+// such as an export of osv.dev. No provider-specific extensions are interpreted.
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (parser *CVEParser) ParseOSVDB(osvReader io.Reader) (vulns []shared.Vulnerability, err error) {
+	content, err := io.ReadAll(osvReader)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		var osvVuln OSV
+		if err := json.NewDecoder(r).Decode(&osvVuln); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", f.Name)
+		}
+		if osvVuln.ID == "" {
+			continue
+		}
+
+		// Convert OSV to Vulnerability using the generic handler
+		var g GenericOSV
+		convertedVuln, err := parser.osvToVuln(osvVuln, g)
+		if err != nil {
+			return nil, err
+		}
+
+		vulns = append(vulns, convertedVuln)
+	}
+
+	return vulns, nil
+}
+
+//
+// Generic OSV handlers
+//
+
+type GenericOSV int64
+
+func (g GenericOSV) topLevelHandler(o OSV, v *shared.Vulnerability) error {
+	// Prefer the advisory itself over any other reference
+	for _, reference := range o.References {
+		if reference.Type == "ADVISORY" {
+			v.DataSource = reference.URL
+			return nil
+		}
+	}
+	if len(o.References) > 0 {
+		v.DataSource = o.References[0].URL
+	}
+
+	return nil
+}
+
+func (g GenericOSV) affectedHandler(a OSVAffected, affectedPackage *shared.AffectedPackage) error {
+	affectedPackage.Namespace = "osv:" + a.Package.Ecosystem
+
+	// OSV ecosystem names match the ones used by GHSA
+	if language := githubEcosystemToLanguage(a.Package.Ecosystem); language != "" {
+		affectedPackage.Language = language
+	}
+
+	return nil
+}
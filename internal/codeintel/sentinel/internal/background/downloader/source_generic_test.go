@@ -0,0 +1,93 @@
+package downloader
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+)
+
+func TestParseArchive(t *testing.T) {
+	archive := makeArchive(t, map[string]string{
+		"ID/GO-2022-0001.json": `{
+			"id": "GO-2022-0001",
+			"summary": "Bad things",
+			"affected": [{
+				"package": {"ecosystem": "Go", "name": "github.com/go-nacelle/config"},
+				"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.2.6"}]}],
+				"ecosystem_specific": {"imports": [{"path": "github.com/go-nacelle/config", "symbols": ["Load"]}]}
+			}],
+			"references": [{"type": "WEB", "url": "https://example.com/web"}, {"type": "ADVISORY", "url": "https://example.com/advisory"}]
+		}`,
+		"index/modules.json": `[{"path": "github.com/go-nacelle/config"}]`,
+		"README.md":          `not a vulnerability`,
+	})
+
+	parser := &CVEParser{logger: logtest.Scoped(t)}
+
+	vulns, err := parser.ParseArchive("govulndb", bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("unexpected error parsing govulndb archive: %s", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("unexpected number of vulnerabilities. want=%d have=%d", 1, len(vulns))
+	}
+	if vulns[0].DataSource != "https://pkg.go.dev/vuln/GO-2022-0001" {
+		t.Errorf("unexpected data source %q", vulns[0].DataSource)
+	}
+	if diff := cmp.Diff([]string{">=0", "<1.2.6"}, vulns[0].AffectedPackages[0].VersionConstraint); diff != "" {
+		t.Errorf("unexpected version constraint (-want +got):\n%s", diff)
+	}
+
+	// The generic OSV parser does not understand the govulndb index
+	if _, err := parser.ParseArchive("osv", bytes.NewReader(archive)); err == nil {
+		t.Fatalf("expected error parsing archive with non-OSV json files")
+	}
+
+	osvArchive := makeArchive(t, map[string]string{
+		"GHSA-xxxx.json": `{
+			"id": "GHSA-xxxx",
+			"affected": [{"package": {"ecosystem": "PyPI", "name": "requests"}, "versions": ["2.0.0"]}],
+			"references": [{"type": "WEB", "url": "https://example.com/web"}, {"type": "ADVISORY", "url": "https://example.com/advisory"}]
+		}`,
+	})
+
+	vulns, err = parser.ParseArchive("osv", bytes.NewReader(osvArchive))
+	if err != nil {
+		t.Fatalf("unexpected error parsing osv archive: %s", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("unexpected number of vulnerabilities. want=%d have=%d", 1, len(vulns))
+	}
+	if vulns[0].DataSource != "https://example.com/advisory" {
+		t.Errorf("unexpected data source %q", vulns[0].DataSource)
+	}
+	if ap := vulns[0].AffectedPackages[0]; ap.Namespace != "osv:PyPI" || ap.Language != "python" {
+		t.Errorf("unexpected affected package %+v", ap)
+	}
+
+	if _, err := parser.ParseArchive("nvd", bytes.NewReader(osvArchive)); err == nil {
+		t.Fatalf("expected error parsing archive of unknown source")
+	}
+}
+
+func makeArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("unexpected error creating archive: %s", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error writing archive: %s", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("unexpected error closing archive: %s", err)
+	}
+
+	return buf.Bytes()
+}
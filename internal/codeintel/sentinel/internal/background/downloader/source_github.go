@@ -6,11 +6,8 @@ package downloader
 import (
 	"archive/zip"
 	"bytes"
-	"context"
 	"encoding/json"
 	"io"
-	"net/http"
-	"os"
 	"path/filepath"
 	"time"
 
@@ -22,30 +19,6 @@ import (
 
 const advisoryDatabaseURL = "https://github.com/github/advisory-database/archive/refs/heads/main.zip"
 
-// ReadGitHubAdvisoryDB fetches a copy of the GHSA database and converts it to the internal Vulnerability format
-func (parser *CVEParser) ReadGitHubAdvisoryDB(ctx context.Context, useLocalCache bool) (vulns []shared.Vulnerability, err error) {
-	if useLocalCache {
-		zipReader, err := os.Open("main.zip")
-		if err != nil {
-			return nil, errors.New("unable to open zip file")
-		}
-
-		return parser.ParseGitHubAdvisoryDB(zipReader)
-	}
-
-	resp, err := http.Get(advisoryDatabaseURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
-	}
-
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
-}
-
 func (parser *CVEParser) ParseGitHubAdvisoryDB(ghsaReader io.Reader) (vulns []shared.Vulnerability, err error) {
 	content, err := io.ReadAll(ghsaReader)
 	if err != nil {
@@ -6,12 +6,10 @@ package downloader
 import (
 	"archive/zip"
 	"bytes"
-	"context"
 	"encoding/json"
 	"io"
-	"net/http"
-	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/mitchellh/mapstructure"
 
@@ -19,32 +17,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-const govulndbAdvisoryDatabaseURL = "https://github.com/golang/vuln/archive/refs/heads/master.zip"
-
-// ReadGoVulnDb fetches a copy of the Go Vulnerability Database and converts it to the internal Vulnerability format
-func (parser *CVEParser) ReadGoVulnDb(ctx context.Context, useLocalCache bool) (vulns []shared.Vulnerability, err error) {
-	if useLocalCache {
-		zipReader, err := os.Open("vulndb-govulndb.zip")
-		if err != nil {
-			return nil, errors.New("unable to open zip file")
-		}
-
-		return parser.ParseGovulndbAdvisoryDB(zipReader)
-	}
-
-	resp, err := http.Get(govulndbAdvisoryDatabaseURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, errors.Newf("unexpected status code %d", resp.StatusCode)
-	}
-
-	return parser.ParseGitHubAdvisoryDB(resp.Body)
-}
-
 func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vulns []shared.Vulnerability, err error) {
 	content, err := io.ReadAll(govulndbReader)
 	if err != nil {
@@ -57,7 +29,7 @@ func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vuln
 	}
 
 	for _, f := range zr.File {
-		if filepath.Dir(f.Name) != "vulndb-master/data/osv" {
+		if !isGovulndbEntry(f.Name) {
 			continue
 		}
 		if filepath.Ext(f.Name) != ".json" {
@@ -88,6 +60,14 @@ func (parser *CVEParser) ParseGovulndbAdvisoryDB(govulndbReader io.Reader) (vuln
 	return vulns, nil
 }
 
+// isGovulndbEntry returns true if the given archive entry is in a directory of OSV records. This accepts both
+// archives of the golang/vulndb repository (data/osv/GO-*.json) and archives of the database
+// as served by vuln.go.dev (ID/GO-*.json), which may be used to mirror the database.
+func isGovulndbEntry(name string) bool {
+	dir := filepath.ToSlash(filepath.Dir(name))
+	return dir == "ID" || strings.HasSuffix(dir, "/ID") || strings.HasSuffix(dir, "data/osv")
+}
+
 //
 // Govulndb-specific structs and handlers
 //
@@ -4,12 +4,13 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
-func NewCVEMatcher(store store.Store, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
+func NewCVEMatcher(store store.Store, lsifStore lsifstore.LsifStore, observationCtx *observation.Context, config *Config) goroutine.BackgroundRoutine {
 	metrics := newMetrics(observationCtx)
 
 	return goroutine.NewPeriodicGoroutine(
@@ -22,6 +23,14 @@ func NewCVEMatcher(store store.Store, observationCtx *observation.Context, confi
 
 			metrics.numReferencesScanned.Add(float64(numReferencesScanned))
 			metrics.numVulnerabilityMatches.Add(float64(numVulnerabilityMatches))
+
+			numMatchesChecked, numReachableMatches, err := scanReachability(ctx, store, lsifStore, config.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			metrics.numReachabilityChecks.Add(float64(numMatchesChecked))
+			metrics.numReachableMatches.Add(float64(numReachableMatches))
 			return nil
 		}),
 		goroutine.WithName("codeintel.sentinel-cve-matcher"),
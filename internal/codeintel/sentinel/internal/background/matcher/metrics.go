@@ -9,6 +9,8 @@ import (
 type metrics struct {
 	numReferencesScanned    prometheus.Counter
 	numVulnerabilityMatches prometheus.Counter
+	numReachabilityChecks   prometheus.Counter
+	numReachableMatches     prometheus.Counter
 }
 
 func newMetrics(observationCtx *observation.Context) *metrics {
@@ -30,9 +32,19 @@ func newMetrics(observationCtx *observation.Context) *metrics {
 		"src_codeintel_sentinel_num_vulnerability_matches_total",
 		"The total number of vulnerability matches found.",
 	)
+	numReachabilityChecks := counter(
+		"src_codeintel_sentinel_num_reachability_checks_total",
+		"The total number of vulnerability matches checked for references to affected symbols.",
+	)
+	numReachableMatches := counter(
+		"src_codeintel_sentinel_num_reachable_matches_total",
+		"The total number of vulnerability matches found to reference affected symbols.",
+	)
 
 	return &metrics{
 		numReferencesScanned:    numReferencesScanned,
 		numVulnerabilityMatches: numVulnerabilityMatches,
+		numReachabilityChecks:   numReachabilityChecks,
+		numReachableMatches:     numReachableMatches,
 	}
 }
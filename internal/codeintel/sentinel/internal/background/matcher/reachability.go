@@ -0,0 +1,126 @@
+package matcher
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// scanReachability determines for a batch of vulnerability matches whether the matched upload
+// references one of the symbols affected by the vulnerability, as opposed to only depending on
+// the affected package.
+func scanReachability(ctx context.Context, store store.Store, lsifStore lsifstore.LsifStore, batchSize int) (numMatchesChecked, numReachableMatches int, _ error) {
+	candidates, err := store.GetVulnerabilityMatchReachabilityCandidates(ctx, batchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reachabilities := make([]shared.VulnerabilityMatchReachability, 0, len(candidates))
+	for _, candidate := range candidates {
+		reachability := shared.VulnerabilityMatchReachability{ID: candidate.ID}
+
+		symbolNames := affectedSymbolNames(candidate.Packages, candidate.AffectedSymbols)
+		if len(symbolNames) > 0 {
+			referencedSymbolNames, err := lsifStore.GetReferencedSymbols(ctx, candidate.UploadID, symbolNames)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			reachable := len(referencedSymbolNames) > 0
+			reachability.Reachable = &reachable
+			reachability.ReachableSymbols = referencedSymbolNames
+
+			if reachable {
+				numReachableMatches++
+			}
+		}
+
+		// Matches without affected symbols are marked as checked with an unknown reachability
+		reachabilities = append(reachabilities, reachability)
+	}
+
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, reachabilities); err != nil {
+		return 0, 0, err
+	}
+
+	return len(candidates), numReachableMatches, nil
+}
+
+// affectedSymbolNames returns the SCIP symbol names that the given affected symbols have when
+// they are referenced from an index through one of the given packages.
+//
+// Affected symbols are named as in the Go vulnerability database: a symbol is either the name
+// of a package-level function or variable (e.g., `Parse`), or the name of a method qualified by
+// its receiver type (e.g., `Tag.String`). The path of an affected symbol is the import path of
+// the package that declares it.
+func affectedSymbolNames(packages []precise.Package, affectedSymbols []shared.AffectedSymbol) []string {
+	symbolNameMap := map[string]struct{}{}
+	for _, pkg := range packages {
+		prefix := strings.Join([]string{
+			escapePackageField(pkg.Scheme),
+			escapePackageField(pkg.Manager),
+			escapePackageField(pkg.Name),
+			escapePackageField(pkg.Version),
+		}, " ") + " "
+
+		for _, affectedSymbol := range affectedSymbols {
+			namespace := ""
+			if affectedSymbol.Path != "" {
+				namespace = escapeDescriptorName(affectedSymbol.Path) + "/"
+			}
+
+			for _, symbol := range affectedSymbol.Symbols {
+				var descriptor string
+				switch parts := strings.Split(symbol, "."); len(parts) {
+				case 1:
+					descriptor = escapeDescriptorName(parts[0])
+				case 2:
+					descriptor = escapeDescriptorName(parts[0]) + "#" + escapeDescriptorName(parts[1])
+				default:
+					continue
+				}
+
+				// The symbol may be a function or method, or a variable or field
+				symbolNameMap[prefix+namespace+descriptor+"()."] = struct{}{}
+				symbolNameMap[prefix+namespace+descriptor+"."] = struct{}{}
+			}
+		}
+	}
+
+	symbolNames := make([]string, 0, len(symbolNameMap))
+	for symbolName := range symbolNameMap {
+		symbolNames = append(symbolNames, symbolName)
+	}
+	sort.Strings(symbolNames)
+
+	return symbolNames
+}
+
+// escapePackageField escapes a space-separated field of a SCIP symbol.
+func escapePackageField(field string) string {
+	if field == "" {
+		return "."
+	}
+
+	return strings.ReplaceAll(field, " ", "  ")
+}
+
+// escapeDescriptorName escapes the name of a SCIP descriptor that is not a simple identifier.
+func escapeDescriptorName(name string) string {
+	for _, r := range name {
+		if !isIdentifierCharacter(r) {
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+	}
+
+	return name
+}
+
+func isIdentifierCharacter(r rune) bool {
+	return r == '_' || r == '+' || r == '-' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
@@ -0,0 +1,46 @@
+package matcher
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestAffectedSymbolNames(t *testing.T) {
+	packages := []precise.Package{
+		{Scheme: "scip-go", Manager: "gomod", Name: "golang.org/x/text", Version: "v0.3.7"},
+	}
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "golang.org/x/text/language", Symbols: []string{"Parse", "Tag.String", "a.b.c"}},
+	}
+
+	symbolNames := affectedSymbolNames(packages, affectedSymbols)
+	expectedSymbolNames := []string{
+		"scip-go gomod golang.org/x/text v0.3.7 `golang.org/x/text/language`/Parse().",
+		"scip-go gomod golang.org/x/text v0.3.7 `golang.org/x/text/language`/Parse.",
+		"scip-go gomod golang.org/x/text v0.3.7 `golang.org/x/text/language`/Tag#String().",
+		"scip-go gomod golang.org/x/text v0.3.7 `golang.org/x/text/language`/Tag#String.",
+	}
+	if diff := cmp.Diff(expectedSymbolNames, symbolNames); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+
+	// Generated names must round-trip through the SCIP symbol parser
+	for _, symbolName := range symbolNames {
+		symbol, err := scip.ParseSymbol(symbolName)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", symbolName, err)
+		}
+		if symbol.Descriptors[0].Name != "golang.org/x/text/language" {
+			t.Errorf("unexpected namespace %q in %q", symbol.Descriptors[0].Name, symbolName)
+		}
+	}
+
+	if symbolNames := affectedSymbolNames(nil, affectedSymbols); len(symbolNames) != 0 {
+		t.Errorf("unexpected symbol names without packages: %v", symbolNames)
+	}
+}
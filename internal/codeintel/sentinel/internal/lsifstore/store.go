@@ -0,0 +1,26 @@
+package lsifstore
+
+import (
+	"context"
+
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type LsifStore interface {
+	// Symbols
+	GetReferencedSymbols(ctx context.Context, uploadID int, symbolNames []string) (_ []string, err error)
+}
+
+type store struct {
+	db         *basestore.Store
+	operations *operations
+}
+
+func New(observationCtx *observation.Context, db codeintelshared.CodeIntelDB) LsifStore {
+	return &store{
+		db:         basestore.NewWithHandle(db.Handle()),
+		operations: newOperations(observationCtx),
+	}
+}
@@ -0,0 +1,79 @@
+package lsifstore
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetReferencedSymbols returns the subset of the given symbol names that are referenced from
+// at least one document of the given upload.
+func (s *store) GetReferencedSymbols(ctx context.Context, uploadID int, symbolNames []string) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getReferencedSymbols.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+		attribute.Int("numSymbolNames", len(symbolNames)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(symbolNames) == 0 {
+		return nil, nil
+	}
+
+	return basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(
+		getReferencedSymbolsQuery,
+		pq.Array(symbolNames),
+		uploadID,
+		uploadID,
+		uploadID,
+	)))
+}
+
+const getReferencedSymbolsQuery = `
+WITH RECURSIVE
+-- Search for the set of trie paths that match one of the given symbol names. We do
+-- a recursive walk starting at the roots of the trie of the upload, and only traverse
+-- down trie paths that continue to match one of the symbol names.
+matching_prefixes(id, prefix, search) AS (
+	(
+		SELECT
+			ssn.id,
+			ssn.name_segment,
+			substring(t.name from length(ssn.name_segment) + 1) AS search
+		FROM codeintel_scip_symbol_names ssn
+		JOIN unnest(%s::text[]) AS t(name) ON t.name LIKE ssn.name_segment || '%%'
+		WHERE
+			ssn.upload_id = %s AND
+			ssn.prefix_id IS NULL
+	) UNION (
+		SELECT
+			ssn.id,
+			mp.prefix || ssn.name_segment,
+			substring(mp.search from length(ssn.name_segment) + 1) AS search
+		FROM matching_prefixes mp
+		JOIN codeintel_scip_symbol_names ssn ON
+			ssn.upload_id = %s AND
+			ssn.prefix_id = mp.id
+		WHERE
+			mp.search != '' AND
+			mp.search LIKE ssn.name_segment || '%%'
+	)
+)
+SELECT DISTINCT mp.prefix
+FROM matching_prefixes mp
+WHERE
+	mp.search = '' AND
+	EXISTS (
+		SELECT 1
+		FROM codeintel_scip_symbols ss
+		WHERE
+			ss.upload_id = %s AND
+			ss.symbol_id = mp.id AND
+			ss.reference_ranges IS NOT NULL
+	)
+ORDER BY mp.prefix
+`
@@ -0,0 +1,61 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+	"github.com/sourcegraph/log/logtest"
+
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetReferencedSymbols(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, codeIntelDB)
+	db := basestore.NewWithHandle(codeIntelDB.Handle())
+
+	const prefix = "scip-go gomod example.com/lib v1.0.0 `example.com/lib`/"
+
+	for _, query := range []*sqlf.Query{
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_documents (id, payload_hash, schema_version, raw_scip_payload) VALUES (1, '\x01', 1, '\x01')`),
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_document_lookup (id, upload_id, document_path, document_id) VALUES (1, 42, 'main.go', 1)`),
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_symbol_names (id, upload_id, name_segment, prefix_id) VALUES (1, 42, %s, NULL)`, prefix),
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_symbol_names (id, upload_id, name_segment, prefix_id) VALUES (2, 42, 'Parse().', 1)`),
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_symbol_names (id, upload_id, name_segment, prefix_id) VALUES (3, 42, 'Format().', 1)`),
+		// Parse is referenced, Format is only defined
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_symbols (upload_id, symbol_id, document_lookup_id, schema_version, reference_ranges) VALUES (42, 2, 1, 1, '\x01')`),
+		sqlf.Sprintf(`INSERT INTO codeintel_scip_symbols (upload_id, symbol_id, document_lookup_id, schema_version, definition_ranges) VALUES (42, 3, 1, 1, '\x01')`),
+	} {
+		if err := db.Exec(ctx, query); err != nil {
+			t.Fatalf("unexpected error inserting test data: %s", err)
+		}
+	}
+
+	symbolNames, err := store.GetReferencedSymbols(ctx, 42, []string{
+		prefix + "Parse().",
+		prefix + "Format().",
+		prefix + "Missing().",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error getting referenced symbols: %s", err)
+	}
+	if diff := cmp.Diff([]string{prefix + "Parse()."}, symbolNames); diff != "" {
+		t.Errorf("unexpected symbol names (-want +got):\n%s", diff)
+	}
+
+	// Symbols of other uploads are not considered
+	symbolNames, err = store.GetReferencedSymbols(ctx, 43, []string{prefix + "Parse()."})
+	if err != nil {
+		t.Fatalf("unexpected error getting referenced symbols: %s", err)
+	}
+	if len(symbolNames) != 0 {
+		t.Errorf("unexpected symbol names: %v", symbolNames)
+	}
+}
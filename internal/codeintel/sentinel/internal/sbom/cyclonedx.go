@@ -0,0 +1,196 @@
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+// CycloneDX 1.5 JSON documents (see https://cyclonedx.org/docs/1.5/json/).
+
+type cycloneDXDocument struct {
+	BOMFormat       string                   `json:"bomFormat"`
+	SpecVersion     string                   `json:"specVersion"`
+	SerialNumber    string                   `json:"serialNumber"`
+	Version         int                      `json:"version"`
+	Metadata        cycloneDXMetadata        `json:"metadata"`
+	Components      []cycloneDXComponent     `json:"components"`
+	Dependencies    []cycloneDXDependency    `json:"dependencies"`
+	Vulnerabilities []cycloneDXVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef  string `json:"bom-ref"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+type cycloneDXVulnerability struct {
+	BOMRef         string              `json:"bom-ref"`
+	ID             string              `json:"id"`
+	Description    string              `json:"description,omitempty"`
+	Recommendation string              `json:"recommendation,omitempty"`
+	Ratings        []cycloneDXRating   `json:"ratings,omitempty"`
+	Advisories     []cycloneDXAdvisory `json:"advisories,omitempty"`
+	Affects        []cycloneDXAffects  `json:"affects"`
+	Properties     []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXRating struct {
+	Score    *float64 `json:"score,omitempty"`
+	Severity string   `json:"severity"`
+	Method   string   `json:"method,omitempty"`
+	Vector   string   `json:"vector,omitempty"`
+}
+
+type cycloneDXAdvisory struct {
+	URL string `json:"url"`
+}
+
+type cycloneDXAffects struct {
+	Ref string `json:"ref"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// reachablePropertyPrefix prefixes the properties attached to a vulnerability for each affected
+// component of which it is known whether the repository references an affected symbol.
+const reachablePropertyPrefix = "sourcegraph:reachable:"
+
+// WriteCycloneDX writes the given SBOM as a CycloneDX JSON document.
+func WriteCycloneDX(w io.Writer, sbom shared.SBOM) error {
+	rootRef := rootReference(sbom)
+	document := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + serialNumber(sbom),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: sbom.CreatedAt.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: toolVendor, Name: toolName}},
+			Component: cycloneDXComponent{
+				BOMRef:  rootRef,
+				Type:    "application",
+				Name:    sbom.RepositoryName,
+				Version: sbom.Commit,
+			},
+		},
+		Components:   []cycloneDXComponent{},
+		Dependencies: []cycloneDXDependency{{Ref: rootRef, DependsOn: []string{}}},
+	}
+
+	seen := map[string]struct{}{}
+	vulnerabilityIndexes := map[string]int{}
+	for _, component := range sbom.Components {
+		purl := component.PackageURL()
+		if _, ok := seen[purl]; ok {
+			// References of distinct schemes may refer to the same package
+			continue
+		}
+		seen[purl] = struct{}{}
+
+		document.Components = append(document.Components, cycloneDXComponent{
+			BOMRef:  purl,
+			Type:    "library",
+			Name:    component.Name,
+			Version: component.Version,
+			PURL:    purl,
+		})
+		document.Dependencies[0].DependsOn = append(document.Dependencies[0].DependsOn, purl)
+
+		for _, vulnerability := range component.Vulnerabilities {
+			i, ok := vulnerabilityIndexes[vulnerability.SourceID]
+			if !ok {
+				i = len(document.Vulnerabilities)
+				vulnerabilityIndexes[vulnerability.SourceID] = i
+				document.Vulnerabilities = append(document.Vulnerabilities, newCycloneDXVulnerability(vulnerability))
+			}
+
+			v := &document.Vulnerabilities[i]
+			v.Affects = append(v.Affects, cycloneDXAffects{Ref: purl})
+			if vulnerability.Reachable != nil {
+				v.Properties = append(v.Properties, cycloneDXProperty{
+					Name:  reachablePropertyPrefix + purl,
+					Value: strconv.FormatBool(*vulnerability.Reachable),
+				})
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+func newCycloneDXVulnerability(vulnerability shared.SBOMVulnerability) cycloneDXVulnerability {
+	v := cycloneDXVulnerability{
+		BOMRef:      vulnerability.SourceID,
+		ID:          vulnerability.SourceID,
+		Description: vulnerability.Summary,
+		Ratings: []cycloneDXRating{{
+			Severity: cycloneDXSeverity(vulnerability.Severity),
+			Method:   cvssMethod(vulnerability.CVSSVector),
+			Vector:   vulnerability.CVSSVector,
+		}},
+	}
+	if score, err := strconv.ParseFloat(vulnerability.CVSSScore, 64); err == nil {
+		v.Ratings[0].Score = &score
+	}
+	if vulnerability.FixedIn != nil {
+		v.Recommendation = "Upgrade to version " + *vulnerability.FixedIn + " or later."
+	}
+	for _, url := range vulnerability.URLs {
+		v.Advisories = append(v.Advisories, cycloneDXAdvisory{URL: url})
+	}
+
+	return v
+}
+
+func cycloneDXSeverity(severity string) string {
+	switch severity = strings.ToLower(severity); severity {
+	case "critical", "high", "medium", "low", "info", "none":
+		return severity
+	default:
+		return "unknown"
+	}
+}
+
+func cvssMethod(vector string) string {
+	switch {
+	case vector == "":
+		return ""
+	case strings.HasPrefix(vector, "CVSS:4."):
+		return "CVSSv4"
+	case strings.HasPrefix(vector, "CVSS:3.1/"):
+		return "CVSSv31"
+	case strings.HasPrefix(vector, "CVSS:3."):
+		return "CVSSv3"
+	default:
+		return "CVSSv2"
+	}
+}
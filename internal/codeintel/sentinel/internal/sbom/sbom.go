@@ -0,0 +1,65 @@
+package sbom
+
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	toolVendor = "Sourcegraph"
+	toolName   = "sourcegraph"
+)
+
+// Write writes the given SBOM as a document of the given format.
+func Write(w io.Writer, format string, sbom shared.SBOM) error {
+	switch format {
+	case shared.SBOMFormatCycloneDX:
+		return WriteCycloneDX(w, sbom)
+	case shared.SBOMFormatSPDX:
+		return WriteSPDX(w, sbom)
+	default:
+		return errors.Newf("unsupported SBOM format %q", format)
+	}
+}
+
+// Filename returns the name under which an SBOM of the given format should be downloaded.
+func Filename(format string, sbom shared.SBOM) string {
+	commit := sbom.Commit
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+
+	suffix := ".cdx.json"
+	if format == shared.SBOMFormatSPDX {
+		suffix = ".spdx.json"
+	}
+
+	return sanitizeFilename(sbom.RepositoryName) + "-" + commit + suffix
+}
+
+func sanitizeFilename(name string) string {
+	runes := []rune(name)
+	for i, r := range runes {
+		if !(r == '-' || r == '.' || r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')) {
+			runes[i] = '_'
+		}
+	}
+
+	return string(runes)
+}
+
+// rootReference identifies the repository described by an SBOM within the document.
+func rootReference(sbom shared.SBOM) string {
+	return sbom.RepositoryName + "@" + sbom.Commit
+}
+
+// serialNumber returns a UUID that is stable for an SBOM generated at the same time for the same
+// repository and commit.
+func serialNumber(sbom shared.SBOM) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(rootReference(sbom)+"#"+sbom.CreatedAt.UTC().Format(time.RFC3339Nano))).String()
+}
@@ -0,0 +1,112 @@
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+var reachable = true
+
+var testSBOM = shared.SBOM{
+	RepositoryName: "github.com/sourcegraph/sourcegraph",
+	Commit:         "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+	CreatedAt:      time.Date(2023, time.September, 15, 12, 0, 0, 0, time.UTC),
+	Components: []shared.SBOMComponent{
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3", Vulnerabilities: []shared.SBOMVulnerability{{
+			SourceID:   "CVE-ABC",
+			Summary:    "bad config",
+			Severity:   "HIGH",
+			CVSSScore:  "7.5",
+			CVSSVector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N",
+			URLs:       []string{"https://example.com/CVE-ABC"},
+			Reachable:  &reachable,
+		}}},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.4", Vulnerabilities: []shared.SBOMVulnerability{{SourceID: "CVE-ABC"}}},
+		{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.6"},
+	},
+}
+
+func TestWriteCycloneDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, shared.SBOMFormatCycloneDX, testSBOM); err != nil {
+		t.Fatalf("unexpected error writing SBOM: %s", err)
+	}
+
+	var document cycloneDXDocument
+	if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("unexpected error decoding SBOM: %s", err)
+	}
+
+	if document.BOMFormat != "CycloneDX" || document.Metadata.Component.Name != testSBOM.RepositoryName || document.Metadata.Timestamp != "2023-09-15T12:00:00Z" {
+		t.Errorf("unexpected document metadata: %+v", document)
+	}
+
+	var purls []string
+	for _, component := range document.Components {
+		purls = append(purls, component.PURL)
+	}
+	expectedPURLs := []string{
+		"pkg:golang/github.com/go-nacelle/config@v1.2.3",
+		"pkg:golang/github.com/go-nacelle/config@v1.2.4",
+		"pkg:golang/github.com/go-mockgen/xtools@v1.3.6",
+	}
+	if diff := cmp.Diff(expectedPURLs, purls); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedPURLs, document.Dependencies[0].DependsOn); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+
+	score := 7.5
+	expectedVulnerabilities := []cycloneDXVulnerability{{
+		BOMRef:      "CVE-ABC",
+		ID:          "CVE-ABC",
+		Description: "bad config",
+		Ratings:     []cycloneDXRating{{Score: &score, Severity: "high", Method: "CVSSv31", Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"}},
+		Advisories:  []cycloneDXAdvisory{{URL: "https://example.com/CVE-ABC"}},
+		Affects:     []cycloneDXAffects{{Ref: expectedPURLs[0]}, {Ref: expectedPURLs[1]}},
+		Properties:  []cycloneDXProperty{{Name: "sourcegraph:reachable:" + expectedPURLs[0], Value: "true"}},
+	}}
+	if diff := cmp.Diff(expectedVulnerabilities, document.Vulnerabilities); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteSPDX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, shared.SBOMFormatSPDX, testSBOM); err != nil {
+		t.Fatalf("unexpected error writing SBOM: %s", err)
+	}
+
+	var document spdxDocument
+	if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("unexpected error decoding SBOM: %s", err)
+	}
+
+	if document.SPDXVersion != "SPDX-2.3" || document.Name != "github.com/sourcegraph/sourcegraph@deadbeefdeadbeefdeadbeefdeadbeefdeadbeef" {
+		t.Errorf("unexpected document metadata: %+v", document)
+	}
+	if len(document.Packages) != 4 || len(document.Relationships) != 4 {
+		t.Fatalf("unexpected number of packages and relationships. want=%d have=%d/%d", 4, len(document.Packages), len(document.Relationships))
+	}
+
+	expectedRefs := []spdxExternalRef{
+		{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:golang/github.com/go-nacelle/config@v1.2.4"},
+		{ReferenceCategory: "SECURITY", ReferenceType: "advisory", ReferenceLocator: "https://osv.dev/vulnerability/CVE-ABC", Comment: "CVE-ABC"},
+	}
+	if diff := cmp.Diff(expectedRefs, document.Packages[2].ExternalRefs); diff != "" {
+		t.Errorf("unexpected external references (-want +got):\n%s", diff)
+	}
+}
+
+func TestFilename(t *testing.T) {
+	if filename := Filename(shared.SBOMFormatSPDX, testSBOM); filename != "github.com_sourcegraph_sourcegraph-deadbeefdead.spdx.json" {
+		t.Errorf("unexpected filename %q", filename)
+	}
+}
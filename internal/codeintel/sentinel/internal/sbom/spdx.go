@@ -0,0 +1,162 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+// SPDX 2.3 JSON documents (see https://spdx.github.io/spdx-spec/v2.3/).
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	DocumentDescribes []string           `json:"documentDescribes"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+	Comment           string `json:"comment,omitempty"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const (
+	spdxDocumentID   = "SPDXRef-DOCUMENT"
+	spdxRepositoryID = "SPDXRef-Repository"
+	spdxNoAssertion  = "NOASSERTION"
+)
+
+// WriteSPDX writes the given SBOM as an SPDX JSON document. Known vulnerabilities are attached to
+// the affected packages as security advisory references.
+func WriteSPDX(w io.Writer, sbom shared.SBOM) error {
+	document := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              rootReference(sbom),
+		DocumentNamespace: "urn:uuid:" + serialNumber(sbom),
+		CreationInfo: spdxCreationInfo{
+			Created:  sbom.CreatedAt.UTC().Format(time.RFC3339),
+			Creators: []string{"Organization: " + toolVendor, "Tool: " + toolName},
+		},
+		DocumentDescribes: []string{spdxRepositoryID},
+		Packages: []spdxPackage{{
+			SPDXID:           spdxRepositoryID,
+			Name:             sbom.RepositoryName,
+			VersionInfo:      sbom.Commit,
+			DownloadLocation: spdxNoAssertion,
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      spdxDocumentID,
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: spdxRepositoryID,
+		}},
+	}
+
+	seen := map[string]struct{}{}
+	for _, component := range sbom.Components {
+		purl := component.PackageURL()
+		if _, ok := seen[purl]; ok {
+			// References of distinct schemes may refer to the same package
+			continue
+		}
+		seen[purl] = struct{}{}
+
+		id := fmt.Sprintf("SPDXRef-Package-%d", len(document.Packages))
+		pkg := spdxPackage{
+			SPDXID:           id,
+			Name:             component.Name,
+			VersionInfo:      component.Version,
+			DownloadLocation: spdxNoAssertion,
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  purl,
+			}},
+		}
+		for _, vulnerability := range component.Vulnerabilities {
+			pkg.ExternalRefs = append(pkg.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "SECURITY",
+				ReferenceType:     "advisory",
+				ReferenceLocator:  advisoryURL(vulnerability),
+				Comment:           spdxVulnerabilityComment(vulnerability),
+			})
+		}
+
+		document.Packages = append(document.Packages, pkg)
+		document.Relationships = append(document.Relationships, spdxRelationship{
+			SPDXElementID:      spdxRepositoryID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
+
+// advisoryURL returns the first reference of the given vulnerability, falling back to its entry
+// in the OSV database as SPDX requires advisory references to be URLs.
+func advisoryURL(vulnerability shared.SBOMVulnerability) string {
+	if len(vulnerability.URLs) > 0 {
+		return vulnerability.URLs[0]
+	}
+
+	return "https://osv.dev/vulnerability/" + vulnerability.SourceID
+}
+
+func spdxVulnerabilityComment(vulnerability shared.SBOMVulnerability) string {
+	parts := []string{vulnerability.SourceID}
+	if vulnerability.Severity != "" {
+		parts = append(parts, "severity "+vulnerability.Severity)
+	}
+	if vulnerability.FixedIn != nil {
+		parts = append(parts, "fixed in "+*vulnerability.FixedIn)
+	}
+	if vulnerability.Reachable != nil {
+		if *vulnerability.Reachable {
+			parts = append(parts, "reachable")
+		} else {
+			parts = append(parts, "not reachable")
+		}
+	}
+
+	comment := strings.Join(parts, ", ")
+	if vulnerability.Summary != "" {
+		comment += ": " + vulnerability.Summary
+	}
+
+	return comment
+}
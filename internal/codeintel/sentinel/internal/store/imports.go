@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (s *store) InsertVulnerabilityImport(ctx context.Context, vulnerabilityImport shared.VulnerabilityImport) (_ shared.VulnerabilityImport, err error) {
+	ctx, _, endObservation := s.operations.insertVulnerabilityImport.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", vulnerabilityImport.Source),
+		attribute.String("origin", vulnerabilityImport.Origin),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	imports, _, err := scanVulnerabilityImportsAndCount(s.db.Query(ctx, sqlf.Sprintf(
+		insertVulnerabilityImportQuery,
+		vulnerabilityImport.Source,
+		vulnerabilityImport.Origin,
+		vulnerabilityImport.UserID,
+		vulnerabilityImport.NumVulnerabilities,
+		vulnerabilityImport.NumVulnerabilitiesInserted,
+		vulnerabilityImport.FailureMessage,
+	)))
+	if err != nil {
+		return shared.VulnerabilityImport{}, err
+	}
+	if len(imports) == 0 {
+		return shared.VulnerabilityImport{}, errors.New("no vulnerability import inserted")
+	}
+
+	return imports[0], nil
+}
+
+const insertVulnerabilityImportQuery = `
+INSERT INTO vulnerability_imports AS vi (source, origin, user_id, num_vulnerabilities, num_vulnerabilities_inserted, failure_message)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING
+	` + vulnerabilityImportFields + `,
+	0 AS count
+`
+
+func (s *store) GetVulnerabilityImports(ctx context.Context, args shared.GetVulnerabilityImportsArgs) (_ []shared.VulnerabilityImport, _ int, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityImports.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", args.Source),
+		attribute.Int("limit", args.Limit),
+		attribute.Int("offset", args.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var conds []*sqlf.Query
+	if args.Source != "" {
+		conds = append(conds, sqlf.Sprintf("vi.source = %s", args.Source))
+	}
+	if len(conds) == 0 {
+		conds = append(conds, sqlf.Sprintf("TRUE"))
+	}
+
+	return scanVulnerabilityImportsAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityImportsQuery, sqlf.Join(conds, " AND "), args.Limit, args.Offset)))
+}
+
+const vulnerabilityImportFields = `
+	vi.id,
+	vi.source,
+	vi.origin,
+	vi.user_id,
+	vi.num_vulnerabilities,
+	vi.num_vulnerabilities_inserted,
+	vi.failure_message,
+	vi.created_at
+`
+
+const getVulnerabilityImportsQuery = `
+SELECT
+	` + vulnerabilityImportFields + `,
+	COUNT(*) OVER() AS count
+FROM vulnerability_imports vi
+WHERE %s
+ORDER BY vi.created_at DESC, vi.id DESC
+LIMIT %s
+OFFSET %s
+`
+
+func (s *store) GetVulnerabilitySourceFreshness(ctx context.Context) (_ []shared.VulnerabilitySourceFreshness, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilitySourceFreshness.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	// Fetches the most recent successful and the most recent failed import of each source
+	imports, _, err := scanVulnerabilityImportsAndCount(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilitySourceFreshnessQuery)))
+	if err != nil {
+		return nil, err
+	}
+
+	freshness := make([]shared.VulnerabilitySourceFreshness, len(shared.VulnerabilitySources))
+	indexBySource := make(map[string]int, len(shared.VulnerabilitySources))
+	for i, source := range shared.VulnerabilitySources {
+		freshness[i].Source = source
+		indexBySource[source] = i
+	}
+
+	for _, vulnerabilityImport := range imports {
+		vulnerabilityImport := vulnerabilityImport
+
+		i, ok := indexBySource[vulnerabilityImport.Source]
+		if !ok {
+			continue
+		}
+		f := &freshness[i]
+
+		if vulnerabilityImport.FailureMessage == nil {
+			f.LastSuccessfulImport = &vulnerabilityImport
+		}
+		if f.LastImport == nil || vulnerabilityImport.CreatedAt.After(f.LastImport.CreatedAt) {
+			f.LastImport = &vulnerabilityImport
+		}
+	}
+
+	return freshness, nil
+}
+
+const getVulnerabilitySourceFreshnessQuery = `
+SELECT DISTINCT ON (vi.source, vi.failure_message IS NULL)
+	` + vulnerabilityImportFields + `,
+	0 AS count
+FROM vulnerability_imports vi
+ORDER BY vi.source, vi.failure_message IS NULL, vi.created_at DESC, vi.id DESC
+`
+
+//
+//
+
+var scanVulnerabilityImportsAndCount = basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (vi shared.VulnerabilityImport, count int, _ error) {
+	err := s.Scan(
+		&vi.ID,
+		&vi.Source,
+		&vi.Origin,
+		&vi.UserID,
+		&vi.NumVulnerabilities,
+		&vi.NumVulnerabilitiesInserted,
+		&vi.FailureMessage,
+		&vi.CreatedAt,
+		&count,
+	)
+	return vi, count, err
+})
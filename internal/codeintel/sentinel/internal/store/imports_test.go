@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestVulnerabilityImports(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	failureMessage := "unexpected status code 404"
+	for _, vulnerabilityImport := range []shared.VulnerabilityImport{
+		{Source: "github", Origin: "download", NumVulnerabilities: 10, NumVulnerabilitiesInserted: 10},
+		{Source: "github", Origin: "download", FailureMessage: &failureMessage},
+		{Source: "govulndb", Origin: "download", FailureMessage: &failureMessage},
+		{Source: "govulndb", Origin: "download", NumVulnerabilities: 5, NumVulnerabilitiesInserted: 4},
+	} {
+		if _, err := store.InsertVulnerabilityImport(ctx, vulnerabilityImport); err != nil {
+			t.Fatalf("unexpected error inserting vulnerability import: %s", err)
+		}
+	}
+
+	imports, totalCount, err := store.GetVulnerabilityImports(ctx, shared.GetVulnerabilityImportsArgs{Source: "github", Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability imports: %s", err)
+	}
+	if totalCount != 2 || len(imports) != 2 {
+		t.Fatalf("unexpected number of imports. want=%d have=%d (%d)", 2, len(imports), totalCount)
+	}
+	if imports[0].FailureMessage == nil || imports[1].NumVulnerabilities != 10 {
+		t.Errorf("unexpected imports (expected most recent first): %+v", imports)
+	}
+
+	freshness, err := store.GetVulnerabilitySourceFreshness(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error getting source freshness: %s", err)
+	}
+	if len(freshness) != len(shared.VulnerabilitySources) {
+		t.Fatalf("unexpected number of sources. want=%d have=%d", len(shared.VulnerabilitySources), len(freshness))
+	}
+
+	for _, f := range freshness {
+		switch f.Source {
+		case "github":
+			// Most recent import failed
+			if f.LastImport == nil || f.LastImport.FailureMessage == nil || f.LastSuccessfulImport == nil || f.LastSuccessfulImport.NumVulnerabilities != 10 {
+				t.Errorf("unexpected freshness for github: %+v", f)
+			}
+		case "govulndb":
+			// Most recent import succeeded
+			if f.LastImport == nil || f.LastSuccessfulImport == nil || f.LastImport.ID != f.LastSuccessfulImport.ID {
+				t.Errorf("unexpected freshness for govulndb: %+v", f)
+			}
+		case "osv":
+			if f.LastImport != nil || f.LastSuccessfulImport != nil {
+				t.Errorf("unexpected freshness for osv: %+v", f)
+			}
+		}
+	}
+}
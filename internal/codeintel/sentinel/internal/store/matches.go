@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"sort"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/batch"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 func (s *store) VulnerabilityMatchByID(ctx context.Context, id int) (_ shared.VulnerabilityMatch, _ bool, err error) {
@@ -35,6 +37,8 @@ const vulnerabilityMatchByIDQuery = `
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachable,
+	m.reachable_symbols,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -60,6 +64,7 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 		attribute.String("severity", args.Severity),
 		attribute.String("language", args.Language),
 		attribute.String("repositoryName", args.RepositoryName),
+		attribute.Bool("reachableOnly", args.ReachableOnly),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -73,6 +78,9 @@ func (s *store) GetVulnerabilityMatches(ctx context.Context, args shared.GetVuln
 	if args.RepositoryName != "" {
 		conds = append(conds, sqlf.Sprintf("r.name = %s", args.RepositoryName))
 	}
+	if args.ReachableOnly {
+		conds = append(conds, sqlf.Sprintf("m.reachable"))
+	}
 	if len(conds) == 0 {
 		conds = append(conds, sqlf.Sprintf("TRUE"))
 	}
@@ -85,13 +93,17 @@ WITH limited_matches AS (
 	SELECT
 		m.id,
 		m.upload_id,
-		m.vulnerability_affected_package_id
+		m.vulnerability_affected_package_id,
+		m.reachable,
+		m.reachable_symbols
 	FROM vulnerability_matches m
 	ORDER BY id
 )
 SELECT
 	m.id,
 	m.upload_id,
+	m.reachable,
+	m.reachable_symbols,
 	vap.vulnerability_id,
 	vap.package_name,
 	vap.language,
@@ -355,18 +367,129 @@ SELECT COUNT(*) FROM ins
 //
 //
 
+func (s *store) GetVulnerabilityMatchReachabilityCandidates(ctx context.Context, batchSize int) (_ []shared.VulnerabilityMatchReachabilityCandidate, err error) {
+	ctx, _, endObservation := s.operations.getVulnerabilityMatchReachabilityCandidates.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchSize", batchSize),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanVulnerabilityMatchReachabilityCandidates(s.db.Query(ctx, sqlf.Sprintf(getVulnerabilityMatchReachabilityCandidatesQuery, batchSize)))
+}
+
+const getVulnerabilityMatchReachabilityCandidatesQuery = `
+SELECT
+	m.id,
+	m.upload_id,
+	vap.version_constraint,
+	COALESCE((
+		SELECT json_agg(json_build_object('path', vas.path, 'symbols', vas.symbols) ORDER BY vas.id)
+		FROM vulnerability_affected_symbols vas
+		WHERE vas.vulnerability_affected_package_id = vap.id
+	), '[]'::json) AS affected_symbols,
+	COALESCE((
+		SELECT json_agg(json_build_object('scheme', r.scheme, 'manager', r.manager, 'name', r.name, 'version', r.version) ORDER BY r.id)
+		FROM lsif_references r
+		WHERE
+			r.dump_id = m.upload_id AND
+			-- NOTE: This mirrors the package name matching done in scanMatchesQuery
+			r.name LIKE '%%' || vap.package_name || '%%'
+	), '[]'::json) AS packages
+FROM vulnerability_matches m
+JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+WHERE m.reachability_checked_at IS NULL
+ORDER BY m.id
+LIMIT %s
+`
+
+var scanVulnerabilityMatchReachabilityCandidates = basestore.NewSliceScanner(func(s dbutil.Scanner) (candidate shared.VulnerabilityMatchReachabilityCandidate, _ error) {
+	var (
+		versionConstraints []string
+		rawAffectedSymbols []byte
+		rawPackages        []byte
+		packages           []precise.Package
+	)
+
+	if err := s.Scan(
+		&candidate.ID,
+		&candidate.UploadID,
+		pq.Array(&versionConstraints),
+		&rawAffectedSymbols,
+		&rawPackages,
+	); err != nil {
+		return shared.VulnerabilityMatchReachabilityCandidate{}, err
+	}
+
+	if err := json.Unmarshal(rawAffectedSymbols, &candidate.AffectedSymbols); err != nil {
+		return shared.VulnerabilityMatchReachabilityCandidate{}, err
+	}
+	if err := json.Unmarshal(rawPackages, &packages); err != nil {
+		return shared.VulnerabilityMatchReachabilityCandidate{}, err
+	}
+
+	// Only keep the referenced packages with a version that is actually affected
+	for _, pkg := range packages {
+		if matches, _ := versionMatchesConstraints(pkg.Version, versionConstraints); matches {
+			candidate.Packages = append(candidate.Packages, pkg)
+		}
+	}
+
+	return candidate, nil
+})
+
+func (s *store) UpdateVulnerabilityMatchReachability(ctx context.Context, reachabilities []shared.VulnerabilityMatchReachability) (err error) {
+	ctx, _, endObservation := s.operations.updateVulnerabilityMatchReachability.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numReachabilities", len(reachabilities)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.WithTransact(ctx, func(tx *basestore.Store) error {
+		for _, reachability := range reachabilities {
+			reachableSymbols := reachability.ReachableSymbols
+			if reachableSymbols == nil {
+				reachableSymbols = []string{}
+			}
+
+			if err := tx.Exec(ctx, sqlf.Sprintf(
+				updateVulnerabilityMatchReachabilityQuery,
+				reachability.Reachable,
+				pq.Array(reachableSymbols),
+				reachability.ID,
+			)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+const updateVulnerabilityMatchReachabilityQuery = `
+UPDATE vulnerability_matches
+SET
+	reachable = %s,
+	reachable_symbols = %s,
+	reachability_checked_at = NOW()
+WHERE id = %s
+`
+
+//
+//
+
 var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error) ([]shared.VulnerabilityMatch, int, error) {
 	matches, totalCount, err := basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (match shared.VulnerabilityMatch, count int, _ error) {
 		var (
-			vap     shared.AffectedPackage
-			vas     shared.AffectedSymbol
-			vul     shared.Vulnerability
-			fixedIn string
+			vap              shared.AffectedPackage
+			vas              shared.AffectedSymbol
+			vul              shared.Vulnerability
+			fixedIn          string
+			reachableSymbols []string
 		)
 
 		if err := s.Scan(
 			&match.ID,
 			&match.UploadID,
+			&match.Reachable,
+			pq.Array(&reachableSymbols),
 			&match.VulnerabilityID,
 			// RHS(s) of left join (may be null)
 			&dbutil.NullString{S: &vap.PackageName},
@@ -386,6 +509,9 @@ var scanVulnerabilityMatchesAndCount = func(rows basestore.Rows, queryErr error)
 		if fixedIn != "" {
 			vap.FixedIn = &fixedIn
 		}
+		if len(reachableSymbols) > 0 {
+			match.ReachableSymbols = reachableSymbols
+		}
 		if vas.Path != "" {
 			vap.AffectedSymbols = append(vap.AffectedSymbols, vas)
 		}
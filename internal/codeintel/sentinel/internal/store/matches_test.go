@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 func TestVulnerabilityMatchByID(t *testing.T) {
@@ -389,6 +390,85 @@ func TestGetVulnerabilityMatchesSummaryCount(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityMatchReachability(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	affectedSymbols := []shared.AffectedSymbol{
+		{Path: "github.com/go-nacelle/config", Symbols: []string{"Load"}},
+	}
+	badConfigWithSymbols := badConfig
+	badConfigWithSymbols.AffectedSymbols = affectedSymbols
+
+	if _, err := store.InsertVulnerabilities(ctx, []shared.Vulnerability{
+		{ID: 1, SourceID: "CVE-ABC", AffectedPackages: []shared.AffectedPackage{badConfigWithSymbols}},
+	}); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	candidates, err := store.GetVulnerabilityMatchReachabilityCandidates(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	}
+
+	var expectedCandidates []shared.VulnerabilityMatchReachabilityCandidate
+	for i, version := range []string{"v1.2.3", "v1.2.4", "v1.2.5"} {
+		expectedCandidates = append(expectedCandidates, shared.VulnerabilityMatchReachabilityCandidate{
+			ID:              i + 1,
+			UploadID:        50 + i,
+			AffectedSymbols: affectedSymbols,
+			Packages:        []precise.Package{{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: version}},
+		})
+	}
+	if diff := cmp.Diff(expectedCandidates, candidates); diff != "" {
+		t.Fatalf("unexpected reachability candidates (-want +got):\n%s", diff)
+	}
+
+	reachable, unreachable := true, false
+	if err := store.UpdateVulnerabilityMatchReachability(ctx, []shared.VulnerabilityMatchReachability{
+		{ID: 1, Reachable: &reachable, ReachableSymbols: []string{"scip-go gomod github.com/go-nacelle/config v1.2.3 `github.com/go-nacelle/config`/Load()."}},
+		{ID: 2, Reachable: &unreachable},
+		{ID: 3},
+	}); err != nil {
+		t.Fatalf("unexpected error updating reachability: %s", err)
+	}
+
+	// Checked matches are no longer candidates
+	candidates, err = store.GetVulnerabilityMatchReachabilityCandidates(ctx, 100)
+	if err != nil {
+		t.Fatalf("unexpected error getting reachability candidates: %s", err)
+	}
+	if len(candidates) != 0 {
+		t.Errorf("unexpected reachability candidates: %v", candidates)
+	}
+
+	matches, _, err := store.GetVulnerabilityMatches(ctx, shared.GetVulnerabilityMatchesArgs{Limit: 10, ReachableOnly: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability matches: %s", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("unexpected number of reachable matches. want=%d have=%d", 1, len(matches))
+	}
+	if matches[0].ID != 1 || matches[0].Reachable == nil || !*matches[0].Reachable || len(matches[0].ReachableSymbols) != 1 {
+		t.Errorf("unexpected reachable match: %+v", matches[0])
+	}
+
+	match, _, err := store.VulnerabilityMatchByID(ctx, 3)
+	if err != nil {
+		t.Fatalf("unexpected error getting vulnerability match: %s", err)
+	}
+	if match.Reachable != nil {
+		t.Errorf("expected unknown reachability, got %v", *match.Reachable)
+	}
+}
+
 func setupReferences(t *testing.T, db database.DB) {
 	store := basestore.NewWithHandle(db.Handle())
 
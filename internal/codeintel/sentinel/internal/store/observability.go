@@ -8,15 +8,22 @@ import (
 )
 
 type operations struct {
-	vulnerabilityByID                        *observation.Operation
-	getVulnerabilitiesByIDs                  *observation.Operation
-	getVulnerabilities                       *observation.Operation
-	insertVulnerabilities                    *observation.Operation
-	vulnerabilityMatchByID                   *observation.Operation
-	getVulnerabilityMatches                  *observation.Operation
-	getVulnerabilityMatchesSummaryCount      *observation.Operation
-	getVulnerabilityMatchesCountByRepository *observation.Operation
-	scanMatches                              *observation.Operation
+	vulnerabilityByID                           *observation.Operation
+	getVulnerabilitiesByIDs                     *observation.Operation
+	getVulnerabilities                          *observation.Operation
+	insertVulnerabilities                       *observation.Operation
+	vulnerabilityMatchByID                      *observation.Operation
+	getVulnerabilityMatches                     *observation.Operation
+	getVulnerabilityMatchesSummaryCount         *observation.Operation
+	getVulnerabilityMatchesCountByRepository    *observation.Operation
+	scanMatches                                 *observation.Operation
+	getVulnerabilityMatchReachabilityCandidates *observation.Operation
+	updateVulnerabilityMatchReachability        *observation.Operation
+	insertVulnerabilityImport                   *observation.Operation
+	getVulnerabilityImports                     *observation.Operation
+	getVulnerabilitySourceFreshness             *observation.Operation
+	getSBOMComponents                           *observation.Operation
+	getSBOMComponentVulnerabilities             *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -40,14 +47,21 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		vulnerabilityByID:                        op("VulnerabilityByID"),
-		getVulnerabilitiesByIDs:                  op("GetVulnerabilitiesByIDs"),
-		getVulnerabilities:                       op("GetVulnerabilities"),
-		insertVulnerabilities:                    op("InsertVulnerabilities"),
-		vulnerabilityMatchByID:                   op("VulnerabilityMatchByID"),
-		getVulnerabilityMatches:                  op("GetVulnerabilityMatches"),
-		getVulnerabilityMatchesSummaryCount:      op("GetVulnerabilityMatchesSummaryCount"),
-		getVulnerabilityMatchesCountByRepository: op("GetVulnerabilityMatchesCountByRepository"),
-		scanMatches:                              op("ScanMatches"),
+		vulnerabilityByID:                           op("VulnerabilityByID"),
+		getVulnerabilitiesByIDs:                     op("GetVulnerabilitiesByIDs"),
+		getVulnerabilities:                          op("GetVulnerabilities"),
+		insertVulnerabilities:                       op("InsertVulnerabilities"),
+		vulnerabilityMatchByID:                      op("VulnerabilityMatchByID"),
+		getVulnerabilityMatches:                     op("GetVulnerabilityMatches"),
+		getVulnerabilityMatchesSummaryCount:         op("GetVulnerabilityMatchesSummaryCount"),
+		getVulnerabilityMatchesCountByRepository:    op("GetVulnerabilityMatchesCountByRepository"),
+		scanMatches:                                 op("ScanMatches"),
+		getVulnerabilityMatchReachabilityCandidates: op("GetVulnerabilityMatchReachabilityCandidates"),
+		updateVulnerabilityMatchReachability:        op("UpdateVulnerabilityMatchReachability"),
+		insertVulnerabilityImport:                   op("InsertVulnerabilityImport"),
+		getVulnerabilityImports:                     op("GetVulnerabilityImports"),
+		getVulnerabilitySourceFreshness:             op("GetVulnerabilitySourceFreshness"),
+		getSBOMComponents:                           op("GetSBOMComponents"),
+		getSBOMComponentVulnerabilities:             op("GetSBOMComponentVulnerabilities"),
 	}
 }
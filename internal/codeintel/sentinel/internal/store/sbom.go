@@ -0,0 +1,239 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetSBOMComponents returns the distinct packages referenced by the given uploads, each annotated
+// with the matched vulnerabilities that affect the referenced version.
+func (s *store) GetSBOMComponents(ctx context.Context, uploadIDs []int) (_ []shared.SBOMComponent, err error) {
+	ctx, _, endObservation := s.operations.getSBOMComponents.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.IntSlice("uploadIDs", uploadIDs),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+
+	components, err := scanSBOMComponents(s.db.Query(ctx, sqlf.Sprintf(getSBOMComponentsQuery, pq.Array(uploadIDs))))
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenSBOMComponents(components), nil
+}
+
+const getSBOMComponentsQuery = `
+SELECT
+	r.scheme,
+	COALESCE(r.manager, ''),
+	r.name,
+	r.version,
+	COALESCE((
+		SELECT json_agg(json_build_object(
+			'source_id', v.source_id,
+			'summary', v.summary,
+			'severity', v.severity,
+			'cvss_score', v.cvss_score,
+			'cvss_vector', v.cvss_vector,
+			'urls', v.urls,
+			'fixed_in', vap.fixed_in,
+			'version_constraint', vap.version_constraint,
+			'reachable', m.reachable
+		) ORDER BY v.source_id, m.id)
+		FROM vulnerability_matches m
+		JOIN vulnerability_affected_packages vap ON vap.id = m.vulnerability_affected_package_id
+		JOIN vulnerabilities v ON v.id = vap.vulnerability_id
+		WHERE
+			m.upload_id = r.dump_id AND
+			-- NOTE: This mirrors the package name matching done in scanMatchesQuery
+			r.name LIKE '%%' || vap.package_name || '%%'
+	), '[]'::json) AS vulnerabilities
+FROM lsif_references r
+WHERE r.dump_id = ANY(%s)
+ORDER BY r.scheme, r.manager, r.name, r.version, r.dump_id
+`
+
+// GetSBOMComponentVulnerabilities returns the given components, which are not referenced from any
+// upload, each annotated with the known vulnerabilities that affect its version. As these packages
+// are not matched against precise code intelligence data, their reachability is unknown.
+func (s *store) GetSBOMComponentVulnerabilities(ctx context.Context, components []shared.SBOMComponent) (_ []shared.SBOMComponent, err error) {
+	ctx, _, endObservation := s.operations.getSBOMComponentVulnerabilities.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numComponents", len(components)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(components) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(components))
+	for _, component := range components {
+		names = append(names, component.Name)
+	}
+
+	matchesByName, err := scanSBOMVulnerabilityMatchesByName(s.db.Query(ctx, sqlf.Sprintf(getSBOMComponentVulnerabilitiesQuery, pq.Array(names))))
+	if err != nil {
+		return nil, err
+	}
+
+	annotated := make([]shared.SBOMComponent, 0, len(components))
+	for _, component := range components {
+		component.Vulnerabilities = affectingVulnerabilities(component.Version, matchesByName[component.Name])
+
+		// A vulnerability may affect multiple matching packages; list it once
+		annotated = append(annotated, flattenSBOMComponents([]shared.SBOMComponent{component})...)
+	}
+
+	return annotated, nil
+}
+
+const getSBOMComponentVulnerabilitiesQuery = `
+SELECT
+	c.name,
+	json_agg(json_build_object(
+		'source_id', v.source_id,
+		'summary', v.summary,
+		'severity', v.severity,
+		'cvss_score', v.cvss_score,
+		'cvss_vector', v.cvss_vector,
+		'urls', v.urls,
+		'fixed_in', vap.fixed_in,
+		'version_constraint', vap.version_constraint
+	) ORDER BY v.source_id, vap.id)
+FROM (SELECT DISTINCT name FROM unnest(%s::text[]) AS name) c
+JOIN vulnerability_affected_packages vap ON
+	-- NOTE: This mirrors the package name matching done in scanMatchesQuery
+	c.name LIKE '%%' || vap.package_name || '%%'
+JOIN vulnerabilities v ON v.id = vap.vulnerability_id
+GROUP BY c.name
+`
+
+var scanSBOMVulnerabilityMatchesByName = basestore.NewMapScanner(func(s dbutil.Scanner) (name string, matches []sbomVulnerabilityMatch, _ error) {
+	var rawMatches []byte
+	if err := s.Scan(&name, &rawMatches); err != nil {
+		return "", nil, err
+	}
+
+	if err := json.Unmarshal(rawMatches, &matches); err != nil {
+		return "", nil, err
+	}
+
+	return name, matches, nil
+})
+
+type sbomVulnerabilityMatch struct {
+	SourceID          string   `json:"source_id"`
+	Summary           string   `json:"summary"`
+	Severity          string   `json:"severity"`
+	CVSSScore         string   `json:"cvss_score"`
+	CVSSVector        string   `json:"cvss_vector"`
+	URLs              []string `json:"urls"`
+	FixedIn           *string  `json:"fixed_in"`
+	VersionConstraint []string `json:"version_constraint"`
+	Reachable         *bool    `json:"reachable"`
+}
+
+var scanSBOMComponents = basestore.NewSliceScanner(func(s dbutil.Scanner) (component shared.SBOMComponent, _ error) {
+	var (
+		rawMatches []byte
+		matches    []sbomVulnerabilityMatch
+	)
+
+	if err := s.Scan(
+		&component.Scheme,
+		&component.Manager,
+		&component.Name,
+		&component.Version,
+		&rawMatches,
+	); err != nil {
+		return shared.SBOMComponent{}, err
+	}
+
+	if err := json.Unmarshal(rawMatches, &matches); err != nil {
+		return shared.SBOMComponent{}, err
+	}
+
+	component.Vulnerabilities = affectingVulnerabilities(component.Version, matches)
+	return component, nil
+})
+
+// affectingVulnerabilities returns the vulnerabilities of the given matches that actually affect
+// the given version.
+func affectingVulnerabilities(version string, matches []sbomVulnerabilityMatch) (vulnerabilities []shared.SBOMVulnerability) {
+	for _, match := range matches {
+		if ok, _ := versionMatchesConstraints(version, match.VersionConstraint); !ok {
+			continue
+		}
+
+		vulnerabilities = append(vulnerabilities, shared.SBOMVulnerability{
+			SourceID:   match.SourceID,
+			Summary:    match.Summary,
+			Severity:   match.Severity,
+			CVSSScore:  match.CVSSScore,
+			CVSSVector: match.CVSSVector,
+			URLs:       match.URLs,
+			FixedIn:    match.FixedIn,
+			Reachable:  match.Reachable,
+		})
+	}
+
+	return vulnerabilities
+}
+
+// flattenSBOMComponents merges components referenced from multiple uploads. The given components
+// are expected to be ordered by package. A vulnerability is reachable if it is reachable from any
+// of the uploads that reference the package.
+func flattenSBOMComponents(components []shared.SBOMComponent) []shared.SBOMComponent {
+	flattened := []shared.SBOMComponent{}
+	for _, component := range components {
+		if len(flattened) == 0 || !sameSBOMPackage(flattened[len(flattened)-1], component) {
+			flattened = append(flattened, shared.SBOMComponent{
+				Scheme:  component.Scheme,
+				Manager: component.Manager,
+				Name:    component.Name,
+				Version: component.Version,
+			})
+		}
+		last := &flattened[len(flattened)-1]
+
+	outer:
+		for _, vulnerability := range component.Vulnerabilities {
+			for i, existing := range last.Vulnerabilities {
+				if existing.SourceID == vulnerability.SourceID {
+					last.Vulnerabilities[i].Reachable = mergeReachable(existing.Reachable, vulnerability.Reachable)
+					continue outer
+				}
+			}
+
+			last.Vulnerabilities = append(last.Vulnerabilities, vulnerability)
+		}
+	}
+
+	return flattened
+}
+
+func sameSBOMPackage(a, b shared.SBOMComponent) bool {
+	return a.Scheme == b.Scheme && a.Manager == b.Manager && a.Name == b.Name && a.Version == b.Version
+}
+
+func mergeReachable(a, b *bool) *bool {
+	if a == nil {
+		return b
+	}
+	if b == nil || *a {
+		return a
+	}
+	return b
+}
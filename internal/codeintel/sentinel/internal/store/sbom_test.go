@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetSBOMComponents(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	setupReferences(t, db)
+
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	if _, _, err := store.ScanMatches(ctx, 100); err != nil {
+		t.Fatalf("unexpected error scanning matches: %s", err)
+	}
+
+	// Uploads 50 and 51 reference affected versions, 53 references the fixed version
+	components, err := store.GetSBOMComponents(ctx, []int{50, 51, 53, 54})
+	if err != nil {
+		t.Fatalf("unexpected error getting sbom components: %s", err)
+	}
+
+	vulnerabilities := []shared.SBOMVulnerability{{SourceID: "CVE-ABC"}}
+	expectedComponents := []shared.SBOMComponent{
+		{Scheme: "gomod", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3", Vulnerabilities: vulnerabilities},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.4", Vulnerabilities: vulnerabilities},
+		{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.6"},
+	}
+	if diff := cmp.Diff(expectedComponents, components, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSBOMComponentVulnerabilities(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	if _, err := store.InsertVulnerabilities(ctx, testVulnerabilities); err != nil {
+		t.Fatalf("unexpected error inserting vulnerabilities: %s", err)
+	}
+
+	components, err := store.GetSBOMComponentVulnerabilities(ctx, []shared.SBOMComponent{
+		{Scheme: "go", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+		{Scheme: "go", Name: "github.com/go-nacelle/config", Version: "v1.2.3"},
+		{Scheme: "go", Name: "github.com/go-nacelle/config", Version: "v1.2.6"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error getting sbom component vulnerabilities: %s", err)
+	}
+
+	// Only v1.2.3 is affected, and reachability is unknown
+	expectedComponents := []shared.SBOMComponent{
+		{Scheme: "go", Name: "github.com/go-mockgen/xtools", Version: "v1.3.2"},
+		{Scheme: "go", Name: "github.com/go-nacelle/config", Version: "v1.2.3", Vulnerabilities: []shared.SBOMVulnerability{{SourceID: "CVE-ABC"}}},
+		{Scheme: "go", Name: "github.com/go-nacelle/config", Version: "v1.2.6"},
+	}
+	if diff := cmp.Diff(expectedComponents, components, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenSBOMComponents(t *testing.T) {
+	reachable, unreachable := true, false
+
+	components := flattenSBOMComponents([]shared.SBOMComponent{
+		{Scheme: "npm", Name: "left-pad", Version: "1.0.0", Vulnerabilities: []shared.SBOMVulnerability{{SourceID: "CVE-1", Reachable: &unreachable}}},
+		{Scheme: "npm", Name: "left-pad", Version: "1.0.0", Vulnerabilities: []shared.SBOMVulnerability{{SourceID: "CVE-1", Reachable: &reachable}, {SourceID: "CVE-2"}}},
+		{Scheme: "npm", Name: "left-pad", Version: "1.0.1"},
+	})
+
+	expectedComponents := []shared.SBOMComponent{
+		{Scheme: "npm", Name: "left-pad", Version: "1.0.0", Vulnerabilities: []shared.SBOMVulnerability{{SourceID: "CVE-1", Reachable: &reachable}, {SourceID: "CVE-2"}}},
+		{Scheme: "npm", Name: "left-pad", Version: "1.0.1"},
+	}
+	if diff := cmp.Diff(expectedComponents, components); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+}
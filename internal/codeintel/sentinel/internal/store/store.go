@@ -24,6 +24,19 @@ type Store interface {
 	GetVulnerabilityMatchesSummaryCount(ctx context.Context) (counts shared.GetVulnerabilityMatchesSummaryCounts, err error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
 	ScanMatches(ctx context.Context, batchSize int) (numReferencesScanned int, numVulnerabilityMatches int, _ error)
+
+	// Vulnerability match reachability
+	GetVulnerabilityMatchReachabilityCandidates(ctx context.Context, batchSize int) (_ []shared.VulnerabilityMatchReachabilityCandidate, err error)
+	UpdateVulnerabilityMatchReachability(ctx context.Context, reachabilities []shared.VulnerabilityMatchReachability) (err error)
+
+	// Vulnerability imports
+	InsertVulnerabilityImport(ctx context.Context, vulnerabilityImport shared.VulnerabilityImport) (_ shared.VulnerabilityImport, err error)
+	GetVulnerabilityImports(ctx context.Context, args shared.GetVulnerabilityImportsArgs) (_ []shared.VulnerabilityImport, _ int, err error)
+	GetVulnerabilitySourceFreshness(ctx context.Context) (_ []shared.VulnerabilitySourceFreshness, err error)
+
+	// SBOMs
+	GetSBOMComponents(ctx context.Context, uploadIDs []int) (_ []shared.SBOMComponent, err error)
+	GetSBOMComponentVulnerabilities(ctx context.Context, components []shared.SBOMComponent) (_ []shared.SBOMComponent, err error)
 }
 
 type store struct {
@@ -2,24 +2,43 @@ package sentinel
 
 import (
 	"context"
+	"io"
+	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/background/downloader"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/store"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type Service struct {
-	store      store.Store
-	operations *operations
+	store           store.Store
+	lsifstore       lsifstore.LsifStore
+	repoStore       database.RepoStore
+	uploadSvc       UploadService
+	gitserverClient gitserver.Client
+	operations      *operations
 }
 
 func newService(
 	observationCtx *observation.Context,
 	store store.Store,
+	lsifstore lsifstore.LsifStore,
+	repoStore database.RepoStore,
+	uploadSvc UploadService,
+	gitserverClient gitserver.Client,
 ) *Service {
 	return &Service{
-		store:      store,
-		operations: newOperations(observationCtx),
+		store:           store,
+		lsifstore:       lsifstore,
+		repoStore:       repoStore,
+		uploadSvc:       uploadSvc,
+		gitserverClient: gitserverClient,
+		operations:      newOperations(observationCtx),
 	}
 }
 
@@ -50,3 +69,22 @@ func (s *Service) GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (sha
 func (s *Service) GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) ([]shared.VulnerabilityMatchesByRepository, int, error) {
 	return s.store.GetVulnerabilityMatchesCountByRepository(ctx, args)
 }
+
+// ImportVulnerabilities parses and inserts an OSV-formatted zip archive of the given vulnerability
+// database uploaded by the given user. The attempt is recorded in the import history even when it
+// fails to parse.
+func (s *Service) ImportVulnerabilities(ctx context.Context, source string, userID int32, archive io.Reader) (shared.VulnerabilityImport, error) {
+	if !shared.IsVulnerabilitySource(source) {
+		return shared.VulnerabilityImport{}, errors.Newf("unknown vulnerability source %q (expected one of %s)", source, strings.Join(shared.VulnerabilitySources, ", "))
+	}
+
+	return downloader.ImportArchive(ctx, s.store, downloader.NewCVEParser(), source, shared.VulnerabilityImportOriginUpload, &userID, archive)
+}
+
+func (s *Service) GetVulnerabilityImports(ctx context.Context, args shared.GetVulnerabilityImportsArgs) ([]shared.VulnerabilityImport, int, error) {
+	return s.store.GetVulnerabilityImports(ctx, args)
+}
+
+func (s *Service) GetVulnerabilitySourceFreshness(ctx context.Context) ([]shared.VulnerabilitySourceFreshness, error) {
+	return s.store.GetVulnerabilitySourceFreshness(ctx)
+}
@@ -0,0 +1,119 @@
+package sentinel
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/lockfiles"
+	dependenciesshared "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/internal/sbom"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GenerateSBOM returns a software bill of materials of the given repository at the given revision.
+// The components are the packages referenced from the precise uploads visible at the resolved
+// commit and the packages pinned by the lockfiles committed at that commit, annotated with the
+// vulnerabilities affecting their versions.
+func (s *Service) GenerateSBOM(ctx context.Context, repositoryID int, rev string) (_ shared.SBOM, err error) {
+	ctx, _, endObservation := s.operations.generateSBOM.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("rev", rev),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: The repository store enforces repository permissions
+	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return shared.SBOM{}, err
+	}
+
+	commit, err := s.gitserverClient.ResolveRevision(ctx, repo.Name, rev, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "gitserverClient.ResolveRevision")
+	}
+
+	dumps, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, string(commit), "", false, "")
+	if err != nil {
+		return shared.SBOM{}, errors.Wrap(err, "uploadSvc.InferClosestUploads")
+	}
+
+	uploadIDs := make([]int, 0, len(dumps))
+	for _, dump := range dumps {
+		uploadIDs = append(uploadIDs, dump.ID)
+	}
+
+	components, err := s.store.GetSBOMComponents(ctx, uploadIDs)
+	if err != nil {
+		return shared.SBOM{}, err
+	}
+
+	lockfileComponents, err := s.getLockfileSBOMComponents(ctx, repo.Name, commit, components)
+	if err != nil {
+		return shared.SBOM{}, err
+	}
+	components = append(components, lockfileComponents...)
+
+	return shared.SBOM{
+		RepositoryName: string(repo.Name),
+		Commit:         string(commit),
+		CreatedAt:      time.Now().UTC(),
+		UploadIDs:      uploadIDs,
+		Components:     components,
+	}, nil
+}
+
+// sbomLockfileSchemes are the package schemes of the lockfiles read when generating an SBOM.
+var sbomLockfileSchemes = []string{
+	dependenciesshared.GoPackagesScheme,
+	dependenciesshared.NpmPackagesScheme,
+	dependenciesshared.RustPackagesScheme,
+}
+
+// getLockfileSBOMComponents returns the packages pinned by the lockfiles of the given repository at
+// the given commit that are not already listed in the given components of the precise uploads.
+func (s *Service) getLockfileSBOMComponents(ctx context.Context, repoName api.RepoName, commit api.CommitID, preciseComponents []shared.SBOMComponent) ([]shared.SBOMComponent, error) {
+	seen := make(map[string]struct{}, len(preciseComponents))
+	for _, component := range preciseComponents {
+		seen[component.PackageURL()] = struct{}{}
+	}
+
+	var components []shared.SBOMComponent
+	for _, scheme := range sbomLockfileSchemes {
+		deps, err := lockfiles.ListDependencies(ctx, s.gitserverClient, repoName, commit, scheme)
+		if err != nil {
+			return nil, errors.Wrap(err, "lockfiles.ListDependencies")
+		}
+
+		for _, dep := range deps {
+			component := shared.SBOMComponent{
+				Scheme:  dep.Scheme,
+				Name:    string(dep.Name),
+				Version: dep.Version,
+			}
+			if _, ok := seen[component.PackageURL()]; ok {
+				continue
+			}
+			seen[component.PackageURL()] = struct{}{}
+			components = append(components, component)
+		}
+	}
+
+	return s.store.GetSBOMComponentVulnerabilities(ctx, components)
+}
+
+// WriteSBOM writes the given SBOM as a document of the given format.
+func (s *Service) WriteSBOM(w io.Writer, format string, bom shared.SBOM) error {
+	return sbom.Write(w, format, bom)
+}
+
+// SBOMFilename returns the name under which the given SBOM should be downloaded.
+func (s *Service) SBOMFilename(format string, bom shared.SBOM) string {
+	return sbom.Filename(format, bom)
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"net/url"
+	"strings"
+)
+
+// purlTypes maps the package managers and schemes of precise package references and lockfile
+// dependencies to the types of package URLs (see https://github.com/package-url/purl-spec).
+var purlTypes = map[string]string{
+	"cargo":         "cargo",
+	"gem":           "gem",
+	"go":            "golang",
+	"gomod":         "golang",
+	"maven":         "maven",
+	"npm":           "npm",
+	"nuget":         "nuget",
+	"pip":           "pypi",
+	"python":        "pypi",
+	"rubygems":      "gem",
+	"rust-analyzer": "cargo",
+	"semanticdb":    "maven",
+}
+
+// PackageURL returns the package URL identifying the component.
+func (component SBOMComponent) PackageURL() string {
+	purlType, ok := purlTypes[component.Manager]
+	if !ok {
+		if purlType, ok = purlTypes[component.Scheme]; !ok {
+			purlType = "generic"
+		}
+	}
+
+	namespace, name := "", component.Name
+	switch purlType {
+	case "maven":
+		// Maven coordinates are formatted as group:artifact
+		if i := strings.LastIndex(name, ":"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	case "golang", "npm":
+		// Go module paths and scoped npm packages carry their namespace as a path prefix
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("pkg:")
+	b.WriteString(purlType)
+	b.WriteString("/")
+	if namespace != "" {
+		for _, segment := range strings.Split(namespace, "/") {
+			b.WriteString(escapePURLSegment(segment))
+			b.WriteString("/")
+		}
+	}
+	b.WriteString(escapePURLSegment(name))
+	if component.Version != "" {
+		b.WriteString("@")
+		b.WriteString(escapePURLSegment(component.Version))
+	}
+
+	return b.String()
+}
+
+func escapePURLSegment(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), "@", "%40")
+}
@@ -0,0 +1,23 @@
+package shared
+
+import "testing"
+
+func TestPackageURL(t *testing.T) {
+	testCases := []struct {
+		component SBOMComponent
+		expected  string
+	}{
+		{SBOMComponent{Scheme: "gomod", Name: "github.com/go-nacelle/config", Version: "v1.2.3"}, "pkg:golang/github.com/go-nacelle/config@v1.2.3"},
+		{SBOMComponent{Scheme: "scip-typescript", Manager: "npm", Name: "@types/node", Version: "20.1.0"}, "pkg:npm/%40types/node@20.1.0"},
+		{SBOMComponent{Scheme: "semanticdb", Manager: "maven", Name: "org.apache.commons:commons-text", Version: "1.9"}, "pkg:maven/org.apache.commons/commons-text@1.9"},
+		{SBOMComponent{Scheme: "scip-python", Manager: "python", Name: "requests", Version: "2.31.0"}, "pkg:pypi/requests@2.31.0"},
+		{SBOMComponent{Scheme: "rust-analyzer", Name: "serde", Version: "1.0.188"}, "pkg:cargo/serde@1.0.188"},
+		{SBOMComponent{Scheme: "lsif-clang", Name: "zlib"}, "pkg:generic/zlib"},
+	}
+
+	for _, testCase := range testCases {
+		if purl := testCase.component.PackageURL(); purl != testCase.expected {
+			t.Errorf("unexpected package URL for %+v. want=%q have=%q", testCase.component, testCase.expected, purl)
+		}
+	}
+}
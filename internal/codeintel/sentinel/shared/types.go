@@ -1,8 +1,11 @@
 package shared
 
 import (
+	"fmt"
 	"strconv"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 type Vulnerability struct {
@@ -56,6 +59,24 @@ type VulnerabilityMatch struct {
 	UploadID        int
 	VulnerabilityID int
 	AffectedPackage AffectedPackage
+	// Reachable is nil if it is unknown whether the upload references an affected symbol
+	Reachable        *bool
+	ReachableSymbols []string
+}
+
+// VulnerabilityMatchReachabilityCandidate is a vulnerability match whose reachability has not yet
+// been determined, along with the packages of the upload that match the affected package.
+type VulnerabilityMatchReachabilityCandidate struct {
+	ID              int
+	UploadID        int
+	AffectedSymbols []AffectedSymbol
+	Packages        []precise.Package
+}
+
+type VulnerabilityMatchReachability struct {
+	ID               int
+	Reachable        *bool
+	ReachableSymbols []string
 }
 
 type GetVulnerabilitiesArgs struct {
@@ -69,6 +90,7 @@ type GetVulnerabilityMatchesArgs struct {
 	Severity       string
 	Language       string
 	RepositoryName string
+	ReachableOnly  bool
 }
 
 type GetVulnerabilityMatchesSummaryCounts struct {
@@ -90,3 +112,113 @@ type VulnerabilityMatchesByRepository struct {
 	RepositoryName string
 	MatchCount     int32
 }
+
+// Vulnerability databases that can be imported.
+const (
+	VulnerabilitySourceGitHub   = "github"
+	VulnerabilitySourceGovulndb = "govulndb"
+	VulnerabilitySourceOSV      = "osv"
+)
+
+// VulnerabilitySources lists the vulnerability databases that can be imported.
+var VulnerabilitySources = []string{
+	VulnerabilitySourceGitHub,
+	VulnerabilitySourceGovulndb,
+	VulnerabilitySourceOSV,
+}
+
+// IsVulnerabilitySource returns true if the given name is a known vulnerability database.
+func IsVulnerabilitySource(source string) bool {
+	for _, s := range VulnerabilitySources {
+		if s == source {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InvalidArchiveError occurs when an archive of a vulnerability database cannot be parsed.
+type InvalidArchiveError struct {
+	Err error
+}
+
+func (e InvalidArchiveError) Error() string {
+	return fmt.Sprintf("invalid vulnerability database archive: %s", e.Err)
+}
+
+func (e InvalidArchiveError) Unwrap() error {
+	return e.Err
+}
+
+// Origins of a vulnerability import.
+const (
+	VulnerabilityImportOriginDownload = "download"
+	VulnerabilityImportOriginUpload   = "upload"
+)
+
+type VulnerabilityImport struct {
+	ID                         int
+	Source                     string
+	Origin                     string
+	UserID                     *int32 // nil for downloads
+	NumVulnerabilities         int
+	NumVulnerabilitiesInserted int
+	FailureMessage             *string
+	CreatedAt                  time.Time
+}
+
+type GetVulnerabilityImportsArgs struct {
+	Source string
+	Limit  int
+	Offset int
+}
+
+// VulnerabilitySourceFreshness describes the most recent imports of a single vulnerability database.
+type VulnerabilitySourceFreshness struct {
+	Source               string
+	LastImport           *VulnerabilityImport // the most recent import, successful or not
+	LastSuccessfulImport *VulnerabilityImport
+}
+
+// Formats in which an SBOM can be rendered.
+const (
+	SBOMFormatCycloneDX = "cyclonedx"
+	SBOMFormatSPDX      = "spdx"
+)
+
+// IsSBOMFormat returns true if the given name is a supported SBOM format.
+func IsSBOMFormat(format string) bool {
+	return format == SBOMFormatCycloneDX || format == SBOMFormatSPDX
+}
+
+// SBOM is a software bill of materials of a repository at a commit.
+type SBOM struct {
+	RepositoryName string
+	Commit         string
+	CreatedAt      time.Time
+	UploadIDs      []int // the uploads the components were gathered from
+	Components     []SBOMComponent
+}
+
+// SBOMComponent is a single package referenced from a repository.
+type SBOMComponent struct {
+	Scheme          string
+	Manager         string
+	Name            string
+	Version         string
+	Vulnerabilities []SBOMVulnerability
+}
+
+// SBOMVulnerability is a known vulnerability affecting an SBOM component.
+type SBOMVulnerability struct {
+	SourceID   string
+	Summary    string
+	Severity   string
+	CVSSScore  string
+	CVSSVector string
+	URLs       []string
+	FixedIn    *string
+	// Reachable is nil if it is unknown whether the repository references an affected symbol
+	Reachable *bool
+}
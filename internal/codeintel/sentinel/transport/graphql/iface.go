@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"io"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
 )
@@ -15,4 +16,12 @@ type SentinelService interface {
 	VulnerabilityMatchByID(ctx context.Context, id int) (shared.VulnerabilityMatch, bool, error)
 	GetVulnerabilityMatchesSummaryCounts(ctx context.Context) (shared.GetVulnerabilityMatchesSummaryCounts, error)
 	GetVulnerabilityMatchesCountByRepository(ctx context.Context, args shared.GetVulnerabilityMatchesCountByRepositoryArgs) (_ []shared.VulnerabilityMatchesByRepository, _ int, err error)
+
+	ImportVulnerabilities(ctx context.Context, source string, userID int32, archive io.Reader) (shared.VulnerabilityImport, error)
+	GetVulnerabilityImports(ctx context.Context, args shared.GetVulnerabilityImportsArgs) ([]shared.VulnerabilityImport, int, error)
+	GetVulnerabilitySourceFreshness(ctx context.Context) ([]shared.VulnerabilitySourceFreshness, error)
+
+	GenerateSBOM(ctx context.Context, repositoryID int, rev string) (shared.SBOM, error)
+	WriteSBOM(w io.Writer, format string, sbom shared.SBOM) error
+	SBOMFilename(format string, sbom shared.SBOM) string
 }
@@ -14,6 +14,10 @@ type operations struct {
 	vulnerabilityMatchByID                *observation.Operation
 	vulnerabilityMatchesSummaryCounts     *observation.Operation
 	vulnerabilityMatchesCountByRepository *observation.Operation
+	vulnerabilityImports                  *observation.Operation
+	vulnerabilitySources                  *observation.Operation
+	importVulnerabilities                 *observation.Operation
+	sbom                                  *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -39,5 +43,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		vulnerabilityMatchByID:                op("VulnerabilityMatchByID"),
 		vulnerabilityMatchesSummaryCounts:     op("VulnerabilityMatchesSummaryCounts"),
 		vulnerabilityMatchesCountByRepository: op("VulnerabilityMatchesCountByRepository"),
+		vulnerabilityImports:                  op("VulnerabilityImports"),
+		vulnerabilitySources:                  op("VulnerabilitySources"),
+		importVulnerabilities:                 op("ImportVulnerabilities"),
+		sbom:                                  op("SBOM"),
 	}
 }
@@ -1,17 +1,22 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 
 	"github.com/graph-gophers/graphql-go"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	sharedresolvers "github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
 	uploadsgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -22,6 +27,7 @@ type rootResolver struct {
 	indexLoaderFactory          uploadsgraphql.IndexLoaderFactory
 	locationResolverFactory     *gitresolvers.CachedLocationResolverFactory
 	preciseIndexResolverFactory *uploadsgraphql.PreciseIndexResolverFactory
+	siteAdminChecker            sharedresolvers.SiteAdminChecker
 	operations                  *operations
 }
 
@@ -32,6 +38,7 @@ func NewRootResolver(
 	indexLoaderFactory uploadsgraphql.IndexLoaderFactory,
 	locationResolverFactory *gitresolvers.CachedLocationResolverFactory,
 	preciseIndexResolverFactory *uploadsgraphql.PreciseIndexResolverFactory,
+	siteAdminChecker sharedresolvers.SiteAdminChecker,
 ) resolverstubs.SentinelServiceResolver {
 	return &rootResolver{
 		sentinelSvc:                 sentinelSvc,
@@ -40,6 +47,7 @@ func NewRootResolver(
 		indexLoaderFactory:          indexLoaderFactory,
 		locationResolverFactory:     locationResolverFactory,
 		preciseIndexResolverFactory: preciseIndexResolverFactory,
+		siteAdminChecker:            siteAdminChecker,
 		operations:                  newOperations(observationCtx),
 	}
 }
@@ -105,6 +113,7 @@ func (r *rootResolver) VulnerabilityMatches(ctx context.Context, args resolverst
 		Language:       language,
 		Severity:       severity,
 		RepositoryName: repositoryName,
+		ReachableOnly:  args.ReachableOnly,
 	})
 	if err != nil {
 		return nil, err
@@ -239,6 +248,87 @@ func (r *rootResolver) VulnerabilityMatchesSummaryCounts(ctx context.Context) (_
 	}, nil
 }
 
+func (r *rootResolver) VulnerabilityImports(ctx context.Context, args resolverstubs.GetVulnerabilityImportsArgs) (_ resolverstubs.VulnerabilityImportConnectionResolver, err error) {
+	ctx, _, endObservation := r.operations.vulnerabilityImports.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("first", int(pointers.Deref(args.First, 0))),
+		attribute.String("after", pointers.Deref(args.After, "")),
+		attribute.String("source", pointers.Deref(args.Source, "")),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	// 🚨 SECURITY: Only site admins may view the import history
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := args.ParseLimitOffset(50)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, totalCount, err := r.sentinelSvc.GetVulnerabilityImports(ctx, shared.GetVulnerabilityImportsArgs{
+		Source: pointers.Deref(args.Source, ""),
+		Limit:  int(limit),
+		Offset: int(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resolvers []resolverstubs.VulnerabilityImportResolver
+	for _, vi := range imports {
+		resolvers = append(resolvers, &vulnerabilityImportResolver{vi: vi})
+	}
+
+	return resolverstubs.NewTotalCountConnectionResolver(resolvers, offset, int32(totalCount)), nil
+}
+
+func (r *rootResolver) VulnerabilitySources(ctx context.Context) (_ []resolverstubs.VulnerabilitySourceResolver, err error) {
+	ctx, _, endObservation := r.operations.vulnerabilitySources.WithErrors(ctx, &err, observation.Args{})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	// 🚨 SECURITY: Only site admins may view the import history
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	freshness, err := r.sentinelSvc.GetVulnerabilitySourceFreshness(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]resolverstubs.VulnerabilitySourceResolver, 0, len(freshness))
+	for _, f := range freshness {
+		resolvers = append(resolvers, &vulnerabilitySourceResolver{f: f})
+	}
+
+	return resolvers, nil
+}
+
+func (r *rootResolver) ImportVulnerabilities(ctx context.Context, args *resolverstubs.ImportVulnerabilitiesArgs) (_ resolverstubs.VulnerabilityImportResolver, err error) {
+	ctx, _, endObservation := r.operations.importVulnerabilities.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("source", args.Source),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	// 🚨 SECURITY: Only site admins may import vulnerability databases
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	archive, err := base64.StdEncoding.DecodeString(args.Archive)
+	if err != nil {
+		return nil, errors.Wrap(err, "archive is not base64 encoded")
+	}
+
+	vulnerabilityImport, err := r.sentinelSvc.ImportVulnerabilities(ctx, args.Source, actor.FromContext(ctx).UID, bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+
+	return &vulnerabilityImportResolver{vi: vulnerabilityImport}, nil
+}
+
 //
 //
 
@@ -352,6 +442,18 @@ func (r *vulnerabilityMatchResolver) PreciseIndex(ctx context.Context) (resolver
 	return r.preciseIndexResolverFactory.Create(ctx, r.uploadLoader, r.indexLoader, r.locationResolver, r.errTracer, &upload, nil)
 }
 
+func (r *vulnerabilityMatchResolver) Reachable() *bool {
+	return r.m.Reachable
+}
+
+func (r *vulnerabilityMatchResolver) ReachableSymbols() []string {
+	if r.m.ReachableSymbols == nil {
+		return []string{}
+	}
+
+	return r.m.ReachableSymbols
+}
+
 //
 //
 
@@ -386,3 +488,59 @@ func (v vulnerabilityMatchCountByRepositoryResolver) RepositoryName() string {
 func (v vulnerabilityMatchCountByRepositoryResolver) MatchCount() int32 {
 	return v.v.MatchCount
 }
+
+type vulnerabilityImportResolver struct {
+	vi shared.VulnerabilityImport
+}
+
+func (r *vulnerabilityImportResolver) ID() graphql.ID {
+	return resolverstubs.MarshalID("VulnerabilityImport", r.vi.ID)
+}
+
+func (r *vulnerabilityImportResolver) Source() string {
+	return r.vi.Source
+}
+
+func (r *vulnerabilityImportResolver) Origin() string {
+	return r.vi.Origin
+}
+
+func (r *vulnerabilityImportResolver) FailureMessage() *string {
+	return r.vi.FailureMessage
+}
+
+func (r *vulnerabilityImportResolver) NumVulnerabilities() int32 {
+	return int32(r.vi.NumVulnerabilities)
+}
+
+func (r *vulnerabilityImportResolver) NumVulnerabilitiesInserted() int32 {
+	return int32(r.vi.NumVulnerabilitiesInserted)
+}
+
+func (r *vulnerabilityImportResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.vi.CreatedAt}
+}
+
+type vulnerabilitySourceResolver struct {
+	f shared.VulnerabilitySourceFreshness
+}
+
+func (r *vulnerabilitySourceResolver) Name() string {
+	return r.f.Source
+}
+
+func (r *vulnerabilitySourceResolver) LastImport() resolverstubs.VulnerabilityImportResolver {
+	if r.f.LastImport == nil {
+		return nil
+	}
+
+	return &vulnerabilityImportResolver{vi: *r.f.LastImport}
+}
+
+func (r *vulnerabilitySourceResolver) LastSuccessfulImport() resolverstubs.VulnerabilityImportResolver {
+	if r.f.LastSuccessfulImport == nil {
+		return nil
+	}
+
+	return &vulnerabilityImportResolver{vi: *r.f.LastSuccessfulImport}
+}
@@ -0,0 +1,109 @@
+package graphql
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func (r *rootResolver) SBOM(ctx context.Context, args *resolverstubs.SBOMArgs) (_ resolverstubs.SBOMResolver, err error) {
+	ctx, _, endObservation := r.operations.sbom.WithErrors(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(args.Repository)),
+		attribute.String("rev", pointers.Deref(args.Rev, "")),
+		attribute.String("format", args.Format),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	format := strings.ToLower(args.Format)
+	if !shared.IsSBOMFormat(format) {
+		return nil, errors.Newf("unknown SBOM format %q", format)
+	}
+
+	repositoryID, err := resolverstubs.UnmarshalID[int](args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: The service enforces repository permissions
+	bom, err := r.sentinelSvc.GenerateSBOM(ctx, repositoryID, pointers.Deref(args.Rev, "HEAD"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sbomResolver{sentinelSvc: r.sentinelSvc, format: format, sbom: bom}, nil
+}
+
+type sbomResolver struct {
+	sentinelSvc SentinelService
+	format      string
+	sbom        shared.SBOM
+}
+
+func (r *sbomResolver) Format() string         { return strings.ToUpper(r.format) }
+func (r *sbomResolver) RepositoryName() string { return r.sbom.RepositoryName }
+func (r *sbomResolver) Commit() string         { return r.sbom.Commit }
+
+func (r *sbomResolver) Filename() string {
+	return r.sentinelSvc.SBOMFilename(r.format, r.sbom)
+}
+
+func (r *sbomResolver) DownloadURL() string {
+	return "/.api/sbom?" + url.Values{
+		"repository": []string{r.sbom.RepositoryName},
+		"rev":        []string{r.sbom.Commit},
+		"format":     []string{r.format},
+	}.Encode()
+}
+
+func (r *sbomResolver) Content() (string, error) {
+	var b strings.Builder
+	if err := r.sentinelSvc.WriteSBOM(&b, r.format, r.sbom); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func (r *sbomResolver) Components() []resolverstubs.SBOMComponentResolver {
+	resolvers := make([]resolverstubs.SBOMComponentResolver, 0, len(r.sbom.Components))
+	for _, component := range r.sbom.Components {
+		resolvers = append(resolvers, &sbomComponentResolver{c: component})
+	}
+
+	return resolvers
+}
+
+type sbomComponentResolver struct {
+	c shared.SBOMComponent
+}
+
+func (r *sbomComponentResolver) Name() string       { return r.c.Name }
+func (r *sbomComponentResolver) Version() string    { return r.c.Version }
+func (r *sbomComponentResolver) PackageURL() string { return r.c.PackageURL() }
+
+func (r *sbomComponentResolver) Vulnerabilities() []resolverstubs.SBOMVulnerabilityResolver {
+	resolvers := make([]resolverstubs.SBOMVulnerabilityResolver, 0, len(r.c.Vulnerabilities))
+	for _, vulnerability := range r.c.Vulnerabilities {
+		resolvers = append(resolvers, &sbomVulnerabilityResolver{v: vulnerability})
+	}
+
+	return resolvers
+}
+
+type sbomVulnerabilityResolver struct {
+	v shared.SBOMVulnerability
+}
+
+func (r *sbomVulnerabilityResolver) SourceID() string { return r.v.SourceID }
+func (r *sbomVulnerabilityResolver) Summary() string  { return r.v.Summary }
+func (r *sbomVulnerabilityResolver) Severity() string { return r.v.Severity }
+func (r *sbomVulnerabilityResolver) FixedIn() *string { return r.v.FixedIn }
+func (r *sbomVulnerabilityResolver) Reachable() *bool { return r.v.Reachable }
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxArchiveSize is the maximum size of an uploaded vulnerability database archive.
+const maxArchiveSize = 1 << 30 // 1GiB
+
+type importResponse struct {
+	ID                         int    `json:"id"`
+	Source                     string `json:"source"`
+	NumVulnerabilities         int    `json:"numVulnerabilities"`
+	NumVulnerabilitiesInserted int    `json:"numVulnerabilitiesInserted"`
+}
+
+// newImportHandler returns a handler that imports an OSV-formatted zip archive of a vulnerability
+// database, given as the request body, into the vulnerability database of this instance. This is
+// meant for instances that cannot reach the public vulnerability databases, e.g.:
+//
+//	curl -XPOST -H "Authorization: token $TOKEN" --data-binary @vulndb.zip \
+//	    "$SRC_ENDPOINT/.api/vulnerabilities/import?source=govulndb"
+func newImportHandler(logger log.Logger, db database.DB, svc SentinelService, operations *operations) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		source := r.URL.Query().Get("source")
+
+		ctx, trace, endObservation := operations.importVulnerabilities.With(r.Context(), &err, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.String("source", source),
+		}})
+		defer endObservation(1, observation.Args{})
+
+		// 🚨 SECURITY: Only site admins may import vulnerability databases
+		if err = auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			if errors.Is(err, auth.ErrNotAuthenticated) {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+			} else {
+				http.Error(w, err.Error(), http.StatusForbidden)
+			}
+			return
+		}
+
+		if !shared.IsVulnerabilitySource(source) {
+			err = errors.Newf("unknown vulnerability source %q", source)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		vulnerabilityImport, err := svc.ImportVulnerabilities(ctx, source, actor.FromContext(ctx).UID, http.MaxBytesReader(w, r.Body, maxArchiveSize))
+		if err != nil {
+			if errors.HasType(err, shared.InvalidArchiveError{}) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			} else {
+				http.Error(w, "failed to import vulnerabilities", http.StatusInternalServerError)
+			}
+			return
+		}
+		trace.AddEvent("imported", attribute.Int("numVulnerabilities", vulnerabilityImport.NumVulnerabilities))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(importResponse{
+			ID:                         vulnerabilityImport.ID,
+			Source:                     vulnerabilityImport.Source,
+			NumVulnerabilities:         vulnerabilityImport.NumVulnerabilities,
+			NumVulnerabilitiesInserted: vulnerabilityImport.NumVulnerabilitiesInserted,
+		}); err != nil {
+			logger.Error("failed to write response", log.Error(err))
+		}
+	})
+}
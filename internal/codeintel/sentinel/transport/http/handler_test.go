@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeSentinelService struct {
+	source  string
+	userID  int32
+	archive string
+}
+
+func (s *fakeSentinelService) ImportVulnerabilities(ctx context.Context, source string, userID int32, archive io.Reader) (shared.VulnerabilityImport, error) {
+	content, err := io.ReadAll(archive)
+	if err != nil {
+		return shared.VulnerabilityImport{}, err
+	}
+	s.source, s.userID, s.archive = source, userID, string(content)
+
+	if s.archive == "not a zip" {
+		return shared.VulnerabilityImport{}, shared.InvalidArchiveError{Err: errors.New("zip: not a valid zip file")}
+	}
+
+	return shared.VulnerabilityImport{ID: 7, Source: source, NumVulnerabilities: 3, NumVulnerabilitiesInserted: 2}, nil
+}
+
+func TestImportHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		siteAdmin  bool
+		source     string
+		body       string
+		statusCode int
+	}{
+		{name: "success", siteAdmin: true, source: "govulndb", body: "zip", statusCode: http.StatusOK},
+		{name: "not site admin", siteAdmin: false, source: "govulndb", body: "zip", statusCode: http.StatusForbidden},
+		{name: "unknown source", siteAdmin: true, source: "nvd", body: "zip", statusCode: http.StatusBadRequest},
+		{name: "invalid archive", siteAdmin: true, source: "osv", body: "not a zip", statusCode: http.StatusBadRequest},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			users := dbmocks.NewMockUserStore()
+			users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 42, SiteAdmin: testCase.siteAdmin}, nil)
+			db := dbmocks.NewMockDB()
+			db.UsersFunc.SetDefaultReturn(users)
+
+			svc := &fakeSentinelService{}
+			handler := newImportHandler(logtest.Scoped(t), db, svc, newOperations(&observation.TestContext))
+
+			r := httptest.NewRequest("POST", "/.api/vulnerabilities/import?source="+testCase.source, strings.NewReader(testCase.body))
+			r = r.WithContext(actor.WithActor(r.Context(), actor.FromUser(42)))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != testCase.statusCode {
+				t.Fatalf("unexpected status code. want=%d have=%d (%s)", testCase.statusCode, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			if svc.source != testCase.source || svc.userID != 42 || svc.archive != testCase.body {
+				t.Errorf("unexpected import. source=%q userID=%d archive=%q", svc.source, svc.userID, svc.archive)
+			}
+
+			var resp importResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("unexpected error decoding response: %s", err)
+			}
+			if expected := (importResponse{ID: 7, Source: testCase.source, NumVulnerabilities: 3, NumVulnerabilitiesInserted: 2}); resp != expected {
+				t.Errorf("unexpected response. want=%+v have=%+v", expected, resp)
+			}
+		})
+	}
+}
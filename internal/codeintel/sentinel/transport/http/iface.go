@@ -0,0 +1,15 @@
+package http
+
+import (
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+)
+
+type SentinelService interface {
+	ImportVulnerabilities(ctx context.Context, source string, userID int32, archive io.Reader) (shared.VulnerabilityImport, error)
+	GenerateSBOM(ctx context.Context, repositoryID int, rev string) (shared.SBOM, error)
+	WriteSBOM(w io.Writer, format string, sbom shared.SBOM) error
+	SBOMFilename(format string, sbom shared.SBOM) string
+}
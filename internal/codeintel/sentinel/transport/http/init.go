@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+var (
+	handler     http.Handler
+	handlerOnce sync.Once
+
+	sbomHandler     http.Handler
+	sbomHandlerOnce sync.Once
+
+	handlerOperations     *operations
+	handlerOperationsOnce sync.Once
+)
+
+// GetImportHandler returns the handler of the vulnerability database import endpoint.
+func GetImportHandler(svc *sentinel.Service, db database.DB) http.Handler {
+	handlerOnce.Do(func() {
+		logger := log.Scoped(
+			"sentinel.handler",
+			"codeintel sentinel http handler",
+		)
+
+		handler = newImportHandler(logger, db, svc, getOperations(logger))
+	})
+
+	return handler
+}
+
+// GetSBOMHandler returns the handler of the SBOM export endpoint.
+func GetSBOMHandler(svc *sentinel.Service, db database.DB) http.Handler {
+	sbomHandlerOnce.Do(func() {
+		logger := log.Scoped(
+			"sentinel.sbomhandler",
+			"codeintel sentinel sbom http handler",
+		)
+
+		sbomHandler = newSBOMHandler(logger, db, svc, getOperations(logger))
+	})
+
+	return sbomHandler
+}
+
+// getOperations returns the operations shared by all handlers, as their metrics can only be
+// registered once.
+func getOperations(logger log.Logger) *operations {
+	handlerOperationsOnce.Do(func() {
+		handlerOperations = newOperations(observation.NewContext(logger))
+	})
+
+	return handlerOperations
+}
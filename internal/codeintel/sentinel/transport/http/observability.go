@@ -0,0 +1,35 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type operations struct {
+	importVulnerabilities *observation.Operation
+	exportSBOM            *observation.Operation
+}
+
+func newOperations(observationCtx *observation.Context) *operations {
+	redMetrics := metrics.NewREDMetrics(
+		observationCtx.Registerer,
+		"codeintel_sentinel_transport_http",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationCtx.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.sentinel.transport.http.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           redMetrics,
+		})
+	}
+
+	return &operations{
+		importVulnerabilities: op("ImportVulnerabilities"),
+		exportSBOM:            op("ExportSBOM"),
+	}
+}
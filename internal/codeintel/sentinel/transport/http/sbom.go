@@ -0,0 +1,91 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// newSBOMHandler returns a handler that downloads a software bill of materials of a repository at
+// a revision, built from its precise code intelligence and lockfiles, e.g.:
+//
+//	curl -H "Authorization: token $TOKEN" -OJ \
+//	    "$SRC_ENDPOINT/.api/sbom?repository=github.com/sourcegraph/sourcegraph&rev=main&format=spdx"
+//
+// The format is either cyclonedx (the default) or spdx.
+func newSBOMHandler(logger log.Logger, db database.DB, svc SentinelService, operations *operations) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		query := r.URL.Query()
+		repositoryName, rev, format := query.Get("repository"), query.Get("rev"), query.Get("format")
+		if rev == "" {
+			rev = "HEAD"
+		}
+		if format == "" {
+			format = shared.SBOMFormatCycloneDX
+		}
+
+		ctx, _, endObservation := operations.exportSBOM.With(r.Context(), &err, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.String("repository", repositoryName),
+			attribute.String("rev", rev),
+			attribute.String("format", format),
+		}})
+		defer endObservation(1, observation.Args{})
+
+		if !shared.IsSBOMFormat(format) {
+			err = errors.Newf("unknown SBOM format %q", format)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if repositoryName == "" {
+			err = errors.New("no repository given")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// 🚨 SECURITY: The repository store enforces repository permissions
+		repo, err := db.Repos().GetByName(ctx, api.RepoName(repositoryName))
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				http.Error(w, fmt.Sprintf("repository %q not found", repositoryName), http.StatusNotFound)
+			} else {
+				http.Error(w, "failed to resolve repository", http.StatusInternalServerError)
+			}
+			return
+		}
+
+		sbom, err := svc.GenerateSBOM(ctx, int(repo.ID), rev)
+		if err != nil {
+			if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+				http.Error(w, fmt.Sprintf("revision %q not found", rev), http.StatusNotFound)
+			} else {
+				http.Error(w, "failed to generate SBOM", http.StatusInternalServerError)
+			}
+			return
+		}
+
+		var buf bytes.Buffer
+		if err = svc.WriteSBOM(&buf, format, sbom); err != nil {
+			http.Error(w, "failed to write SBOM", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", svc.SBOMFilename(format, sbom)))
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			logger.Error("failed to write response", log.Error(err))
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/sentinel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func (s *fakeSentinelService) GenerateSBOM(ctx context.Context, repositoryID int, rev string) (shared.SBOM, error) {
+	if rev == "missing" {
+		return shared.SBOM{}, &gitdomain.RevisionNotFoundError{Repo: "github.com/test/test", Spec: rev}
+	}
+
+	return shared.SBOM{RepositoryName: "github.com/test/test", Commit: rev}, nil
+}
+
+func (s *fakeSentinelService) WriteSBOM(w io.Writer, format string, sbom shared.SBOM) error {
+	_, err := io.WriteString(w, format+":"+sbom.RepositoryName+"@"+sbom.Commit)
+	return err
+}
+
+func (s *fakeSentinelService) SBOMFilename(format string, sbom shared.SBOM) string {
+	return "test." + format + ".json"
+}
+
+func TestSBOMHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		query      string
+		statusCode int
+		body       string
+	}{
+		{name: "default format", query: "repository=github.com/test/test&rev=deadbeef", statusCode: http.StatusOK, body: "cyclonedx:github.com/test/test@deadbeef"},
+		{name: "spdx", query: "repository=github.com/test/test&format=spdx", statusCode: http.StatusOK, body: "spdx:github.com/test/test@HEAD"},
+		{name: "unknown format", query: "repository=github.com/test/test&format=swid", statusCode: http.StatusBadRequest},
+		{name: "missing repository", query: "format=spdx", statusCode: http.StatusBadRequest},
+		{name: "unknown repository", query: "repository=github.com/test/private", statusCode: http.StatusNotFound},
+		{name: "unknown revision", query: "repository=github.com/test/test&rev=missing", statusCode: http.StatusNotFound},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repos := dbmocks.NewMockRepoStore()
+			repos.GetByNameFunc.SetDefaultHook(func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+				if name != "github.com/test/test" {
+					return nil, &database.RepoNotFoundErr{Name: name}
+				}
+				return &types.Repo{ID: 42, Name: name}, nil
+			})
+			db := dbmocks.NewMockDB()
+			db.ReposFunc.SetDefaultReturn(repos)
+
+			handler := newSBOMHandler(logtest.Scoped(t), db, &fakeSentinelService{}, newOperations(&observation.TestContext))
+
+			r := httptest.NewRequest("GET", "/.api/sbom?"+testCase.query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != testCase.statusCode {
+				t.Fatalf("unexpected status code. want=%d have=%d (%s)", testCase.statusCode, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			if body := w.Body.String(); body != testCase.body {
+				t.Errorf("unexpected body. want=%q have=%q", testCase.body, body)
+			}
+			if disposition := w.Header().Get("Content-Disposition"); disposition == "" {
+				t.Errorf("expected content disposition header")
+			}
+		})
+	}
+}
@@ -44,7 +44,7 @@ func NewServices(deps ServiceDependencies) (Services, error) {
 	autoIndexingSvc := autoindexing.NewService(deps.ObservationCtx, db, dependenciesSvc, policiesSvc, gitserverClient)
 	codenavSvc := codenav.NewService(deps.ObservationCtx, db, codeIntelDB, uploadsSvc, gitserverClient)
 	rankingSvc := ranking.NewService(deps.ObservationCtx, db, codeIntelDB)
-	sentinelService := sentinel.NewService(deps.ObservationCtx, db)
+	sentinelService := sentinel.NewService(deps.ObservationCtx, db, codeIntelDB, uploadsSvc, gitserverClient)
 	contextService := context.NewService(deps.ObservationCtx, db)
 
 	return Services{
@@ -8,6 +8,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/backfiller"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/commitgraph"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/coverage"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/expirer"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/janitor"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/processor"
@@ -50,6 +51,7 @@ var (
 var (
 	BackfillerConfigInst  = &backfiller.Config{}
 	CommitGraphConfigInst = &commitgraph.Config{}
+	CoverageConfigInst    = &coverage.Config{}
 	ExpirerConfigInst     = &expirer.Config{}
 	JanitorConfigInst     = &janitor.Config{}
 	ProcessorConfigInst   = &processor.Config{}
@@ -136,6 +138,22 @@ func NewExpirationTasks(
 	)
 }
 
+func NewCoverageAggregator(
+	observationCtx *observation.Context,
+	uploadSvc *Service,
+	db database.DB,
+	gitserverClient gitserver.Client,
+) []goroutine.BackgroundRoutine {
+	observationCtx = scopedContext("coverage", observationCtx)
+
+	return background.NewCoverageAggregator(
+		observationCtx,
+		uploadSvc.store,
+		backend.NewRepos(observationCtx.Logger, db, gitserverClient),
+		CoverageConfigInst,
+	)
+}
+
 func scopedContext(component string, parent *observation.Context) *observation.Context {
 	return observation.ScopedContext("codeintel", "uploads", component, parent)
 }
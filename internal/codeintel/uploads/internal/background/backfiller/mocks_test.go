@@ -100,6 +100,9 @@ type MockStore struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *StoreGetIndexesByIDsFunc
+	// GetLanguageCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLanguageCoverage.
+	GetLanguageCoverageFunc *StoreGetLanguageCoverageFunc
 	// GetLastUploadRetentionScanForRepositoryFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetLastUploadRetentionScanForRepository.
@@ -134,6 +137,10 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetUploadsByIDsAllowDeleted.
 	GetUploadsByIDsAllowDeletedFunc *StoreGetUploadsByIDsAllowDeletedFunc
+	// GetUploadsOverRetentionBudgetFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUploadsOverRetentionBudget.
+	GetUploadsOverRetentionBudgetFunc *StoreGetUploadsOverRetentionBudgetFunc
 	// GetVisibleUploadsMatchingMonikersFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetVisibleUploadsMatchingMonikers.
@@ -195,6 +202,10 @@ type MockStore struct {
 	// RepositoryIDsWithErrorsFunc is an instance of a mock function object
 	// controlling the behavior of the method RepositoryIDsWithErrors.
 	RepositoryIDsWithErrorsFunc *StoreRepositoryIDsWithErrorsFunc
+	// SetRepositoriesForCoverageScanFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetRepositoriesForCoverageScan.
+	SetRepositoriesForCoverageScanFunc *StoreSetRepositoriesForCoverageScanFunc
 	// SetRepositoriesForRetentionScanFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// SetRepositoriesForRetentionScan.
@@ -216,6 +227,9 @@ type MockStore struct {
 	// UpdateCommittedAtFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateCommittedAt.
 	UpdateCommittedAtFunc *StoreUpdateCommittedAtFunc
+	// UpdateLanguageCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateLanguageCoverage.
+	UpdateLanguageCoverageFunc *StoreUpdateLanguageCoverageFunc
 	// UpdatePackageReferencesFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdatePackageReferences.
 	UpdatePackageReferencesFunc *StoreUpdatePackageReferencesFunc
@@ -356,6 +370,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) (r0 []shared.LanguageCoverage, r1 int, r2 error) {
+				return
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (r0 *time.Time, r1 error) {
 				return
@@ -406,6 +425,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) (r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+				return
+			},
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (r0 shared.PackageReferenceScanner, r1 int, r2 error) {
 				return
@@ -501,6 +525,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: func(context.Context, time.Duration, int) (r0 []int, r1 error) {
+				return
+			},
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: func(context.Context, time.Duration, int) (r0 []int, r1 error) {
 				return
@@ -531,6 +560,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: func(context.Context, int, []shared.LanguageCoverage) (r0 error) {
+				return
+			},
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: func(context.Context, int, []precise.PackageReference) (r0 error) {
 				return
@@ -683,6 +717,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetIndexesByIDs")
 			},
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+				panic("unexpected invocation of MockStore.GetLanguageCoverage")
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (*time.Time, error) {
 				panic("unexpected invocation of MockStore.GetLastUploadRetentionScanForRepository")
@@ -733,6 +772,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetUploadsByIDsAllowDeleted")
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+				panic("unexpected invocation of MockStore.GetUploadsOverRetentionBudget")
+			},
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (shared.PackageReferenceScanner, int, error) {
 				panic("unexpected invocation of MockStore.GetVisibleUploadsMatchingMonikers")
@@ -828,6 +872,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.RepositoryIDsWithErrors")
 			},
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: func(context.Context, time.Duration, int) ([]int, error) {
+				panic("unexpected invocation of MockStore.SetRepositoriesForCoverageScan")
+			},
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: func(context.Context, time.Duration, int) ([]int, error) {
 				panic("unexpected invocation of MockStore.SetRepositoriesForRetentionScan")
@@ -858,6 +907,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.UpdateCommittedAt")
 			},
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: func(context.Context, int, []shared.LanguageCoverage) error {
+				panic("unexpected invocation of MockStore.UpdateLanguageCoverage")
+			},
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: func(context.Context, int, []precise.PackageReference) error {
 				panic("unexpected invocation of MockStore.UpdatePackageReferences")
@@ -964,6 +1018,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetIndexesByIDsFunc: &StoreGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: i.GetLanguageCoverage,
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: i.GetLastUploadRetentionScanForRepository,
 		},
@@ -994,6 +1051,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetUploadsByIDsAllowDeletedFunc: &StoreGetUploadsByIDsAllowDeletedFunc{
 			defaultHook: i.GetUploadsByIDsAllowDeleted,
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: i.GetUploadsOverRetentionBudget,
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: i.GetVisibleUploadsMatchingMonikers,
 		},
@@ -1051,6 +1111,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		RepositoryIDsWithErrorsFunc: &StoreRepositoryIDsWithErrorsFunc{
 			defaultHook: i.RepositoryIDsWithErrors,
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: i.SetRepositoriesForCoverageScan,
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: i.SetRepositoriesForRetentionScan,
 		},
@@ -1069,6 +1132,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		UpdateCommittedAtFunc: &StoreUpdateCommittedAtFunc{
 			defaultHook: i.UpdateCommittedAt,
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: i.UpdateLanguageCoverage,
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: i.UpdatePackageReferences,
 		},
@@ -3661,6 +3727,117 @@ func (c StoreGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetLanguageCoverageFunc describes the behavior when the
+// GetLanguageCoverage method of the parent MockStore instance is invoked.
+type StoreGetLanguageCoverageFunc struct {
+	defaultHook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	hooks       []func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	history     []StoreGetLanguageCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLanguageCoverage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetLanguageCoverage(v0 context.Context, v1 shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	r0, r1, r2 := m.GetLanguageCoverageFunc.nextHook()(v0, v1)
+	m.GetLanguageCoverageFunc.appendCall(StoreGetLanguageCoverageFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetLanguageCoverage
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetLanguageCoverageFunc) SetDefaultHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLanguageCoverage method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetLanguageCoverageFunc) PushHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetLanguageCoverageFunc) SetDefaultReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetLanguageCoverageFunc) PushReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.PushHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *StoreGetLanguageCoverageFunc) nextHook() func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetLanguageCoverageFunc) appendCall(r0 StoreGetLanguageCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetLanguageCoverageFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetLanguageCoverageFunc) History() []StoreGetLanguageCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetLanguageCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetLanguageCoverageFuncCall is an object that describes an
+// invocation of method GetLanguageCoverage on an instance of MockStore.
+type StoreGetLanguageCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetLanguageCoverageOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.LanguageCoverage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetLanguageCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetLanguageCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // StoreGetLastUploadRetentionScanForRepositoryFunc describes the behavior
 // when the GetLastUploadRetentionScanForRepository method of the parent
 // MockStore instance is invoked.
@@ -4799,6 +4976,123 @@ func (c StoreGetUploadsByIDsAllowDeletedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetUploadsOverRetentionBudgetFunc describes the behavior when the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance is
+// invoked.
+type StoreGetUploadsOverRetentionBudgetFunc struct {
+	defaultHook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	hooks       []func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	history     []StoreGetUploadsOverRetentionBudgetFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadsOverRetentionBudget delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadsOverRetentionBudget(v0 context.Context, v1 shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	r0, r1, r2, r3 := m.GetUploadsOverRetentionBudgetFunc.nextHook()(v0, v1)
+	m.GetUploadsOverRetentionBudgetFunc.appendCall(StoreGetUploadsOverRetentionBudgetFuncCall{v0, v1, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) SetDefaultHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) PushHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) SetDefaultReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) PushReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.PushHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *StoreGetUploadsOverRetentionBudgetFunc) nextHook() func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadsOverRetentionBudgetFunc) appendCall(r0 StoreGetUploadsOverRetentionBudgetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadsOverRetentionBudgetFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) History() []StoreGetUploadsOverRetentionBudgetFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadsOverRetentionBudgetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadsOverRetentionBudgetFuncCall is an object that describes an
+// invocation of method GetUploadsOverRetentionBudget on an instance of
+// MockStore.
+type StoreGetUploadsOverRetentionBudgetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetUploadsOverRetentionBudgetOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RetentionBudgetCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 int64
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadsOverRetentionBudgetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadsOverRetentionBudgetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+}
+
 // StoreGetVisibleUploadsMatchingMonikersFunc describes the behavior when
 // the GetVisibleUploadsMatchingMonikers method of the parent MockStore
 // instance is invoked.
@@ -6893,6 +7187,120 @@ func (c StoreRepositoryIDsWithErrorsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreSetRepositoriesForCoverageScanFunc describes the behavior when the
+// SetRepositoriesForCoverageScan method of the parent MockStore instance is
+// invoked.
+type StoreSetRepositoriesForCoverageScanFunc struct {
+	defaultHook func(context.Context, time.Duration, int) ([]int, error)
+	hooks       []func(context.Context, time.Duration, int) ([]int, error)
+	history     []StoreSetRepositoriesForCoverageScanFuncCall
+	mutex       sync.Mutex
+}
+
+// SetRepositoriesForCoverageScan delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) SetRepositoriesForCoverageScan(v0 context.Context, v1 time.Duration, v2 int) ([]int, error) {
+	r0, r1 := m.SetRepositoriesForCoverageScanFunc.nextHook()(v0, v1, v2)
+	m.SetRepositoriesForCoverageScanFunc.appendCall(StoreSetRepositoriesForCoverageScanFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// SetRepositoriesForCoverageScan method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreSetRepositoriesForCoverageScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetRepositoriesForCoverageScan method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreSetRepositoriesForCoverageScanFunc) PushHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreSetRepositoriesForCoverageScanFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreSetRepositoriesForCoverageScanFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreSetRepositoriesForCoverageScanFunc) nextHook() func(context.Context, time.Duration, int) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreSetRepositoriesForCoverageScanFunc) appendCall(r0 StoreSetRepositoriesForCoverageScanFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreSetRepositoriesForCoverageScanFuncCall
+// objects describing the invocations of this function.
+func (f *StoreSetRepositoriesForCoverageScanFunc) History() []StoreSetRepositoriesForCoverageScanFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreSetRepositoriesForCoverageScanFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreSetRepositoriesForCoverageScanFuncCall is an object that describes
+// an invocation of method SetRepositoriesForCoverageScan on an instance of
+// MockStore.
+type StoreSetRepositoriesForCoverageScanFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreSetRepositoriesForCoverageScanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreSetRepositoriesForCoverageScanFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreSetRepositoriesForRetentionScanFunc describes the behavior when the
 // SetRepositoriesForRetentionScan method of the parent MockStore instance
 // is invoked.
@@ -7565,6 +7973,115 @@ func (c StoreUpdateCommittedAtFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreUpdateLanguageCoverageFunc describes the behavior when the
+// UpdateLanguageCoverage method of the parent MockStore instance is
+// invoked.
+type StoreUpdateLanguageCoverageFunc struct {
+	defaultHook func(context.Context, int, []shared.LanguageCoverage) error
+	hooks       []func(context.Context, int, []shared.LanguageCoverage) error
+	history     []StoreUpdateLanguageCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateLanguageCoverage delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) UpdateLanguageCoverage(v0 context.Context, v1 int, v2 []shared.LanguageCoverage) error {
+	r0 := m.UpdateLanguageCoverageFunc.nextHook()(v0, v1, v2)
+	m.UpdateLanguageCoverageFunc.appendCall(StoreUpdateLanguageCoverageFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateLanguageCoverage method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreUpdateLanguageCoverageFunc) SetDefaultHook(hook func(context.Context, int, []shared.LanguageCoverage) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateLanguageCoverage method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreUpdateLanguageCoverageFunc) PushHook(hook func(context.Context, int, []shared.LanguageCoverage) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpdateLanguageCoverageFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []shared.LanguageCoverage) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpdateLanguageCoverageFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []shared.LanguageCoverage) error {
+		return r0
+	})
+}
+
+func (f *StoreUpdateLanguageCoverageFunc) nextHook() func(context.Context, int, []shared.LanguageCoverage) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpdateLanguageCoverageFunc) appendCall(r0 StoreUpdateLanguageCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUpdateLanguageCoverageFuncCall objects
+// describing the invocations of this function.
+func (f *StoreUpdateLanguageCoverageFunc) History() []StoreUpdateLanguageCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpdateLanguageCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpdateLanguageCoverageFuncCall is an object that describes an
+// invocation of method UpdateLanguageCoverage on an instance of MockStore.
+type StoreUpdateLanguageCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []shared.LanguageCoverage
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpdateLanguageCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpdateLanguageCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreUpdatePackageReferencesFunc describes the behavior when the
 // UpdatePackageReferences method of the parent MockStore instance is
 // invoked.
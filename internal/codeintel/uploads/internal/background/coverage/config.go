@@ -0,0 +1,23 @@
+package coverage
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type Config struct {
+	env.BaseConfig
+
+	Interval               time.Duration
+	RepositoryBatchSize    int
+	RepositoryProcessDelay time.Duration
+	StaleAfter             time.Duration
+}
+
+func (c *Config) Load() {
+	c.Interval = c.GetInterval("CODEINTEL_COVERAGE_AGGREGATOR_INTERVAL", "1m", "How frequently to run the language coverage aggregator routine.")
+	c.RepositoryBatchSize = c.GetInt("CODEINTEL_COVERAGE_AGGREGATOR_REPOSITORY_BATCH_SIZE", "50", "The number of repositories to compute language coverage for at a time.")
+	c.RepositoryProcessDelay = c.GetInterval("CODEINTEL_COVERAGE_AGGREGATOR_REPOSITORY_PROCESS_DELAY", "24h", "The minimum frequency that the language coverage of the same repository is recomputed.")
+	c.StaleAfter = c.GetInterval("CODEINTEL_COVERAGE_AGGREGATOR_STALE_AFTER", "168h", "The age after which precise code intelligence data for a commit other than the tip of the default branch is considered stale.")
+}
@@ -0,0 +1,15 @@
+package coverage
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type RepoStore interface {
+	Get(ctx context.Context, repo api.RepoID) (*types.Repo, error)
+	ResolveRev(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error)
+	GetInventory(ctx context.Context, repo *types.Repo, commitID api.CommitID, forceEnhancedLanguageDetection bool) (*inventory.Inventory, error)
+}
@@ -0,0 +1,254 @@
+package coverage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func NewCoverageAggregator(
+	observationCtx *observation.Context,
+	store store.Store,
+	repoStore RepoStore,
+	config *Config,
+) goroutine.BackgroundRoutine {
+	aggregator := &aggregator{
+		store:     store,
+		repoStore: repoStore,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return aggregator.handleRepositoryBatch(ctx, config)
+		}),
+		goroutine.WithName("codeintel.coverage-aggregator"),
+		goroutine.WithDescription("computes the precise code intelligence coverage of the languages of each repository"),
+		goroutine.WithInterval(config.Interval),
+	)
+}
+
+type aggregator struct {
+	store     store.Store
+	repoStore RepoStore
+}
+
+// handleRepositoryBatch recomputes the language coverage of the repositories that have not been
+// considered for the longest time.
+func (a *aggregator) handleRepositoryBatch(ctx context.Context, cfg *Config) (err error) {
+	repositoryIDs, err := a.store.SetRepositoriesForCoverageScan(ctx, cfg.RepositoryProcessDelay, cfg.RepositoryBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "store.SetRepositoriesForCoverageScan")
+	}
+
+	now := timeutil.Now()
+
+	for _, repositoryID := range repositoryIDs {
+		if repositoryErr := a.handleRepository(ctx, repositoryID, cfg, now); repositoryErr != nil {
+			err = errors.Append(err, repositoryErr)
+		}
+	}
+
+	return err
+}
+
+func (a *aggregator) handleRepository(ctx context.Context, repositoryID int, cfg *Config, now time.Time) error {
+	repo, err := a.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "repoStore.Get")
+	}
+
+	commit, err := a.repoStore.ResolveRev(ctx, repo, "")
+	if err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+			// Empty or not yet cloned repository: there is no code to cover
+			return a.store.UpdateLanguageCoverage(ctx, repositoryID, nil)
+		}
+
+		return errors.Wrap(err, "repoStore.ResolveRev")
+	}
+
+	inv, err := a.repoStore.GetInventory(ctx, repo, commit, false)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.GetInventory")
+	}
+
+	visibleUploads, _, err := a.store.GetUploads(ctx, shared.GetUploadsOptions{
+		RepositoryID: repositoryID,
+		State:        "completed",
+		VisibleAtTip: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "store.GetUploads")
+	}
+
+	recentUploads, err := a.store.GetRecentUploadsSummary(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "store.GetRecentUploadsSummary")
+	}
+
+	recentIndexes, err := a.store.GetRecentIndexesSummary(ctx, repositoryID)
+	if err != nil {
+		return errors.Wrap(err, "store.GetRecentIndexesSummary")
+	}
+
+	coverage := computeLanguageCoverage(string(commit), inv, visibleUploads, recentUploads, recentIndexes, cfg.StaleAfter, now)
+	return a.store.UpdateLanguageCoverage(ctx, repositoryID, coverage)
+}
+
+// languageKeyState accumulates the upload and auto-indexing records of all indexers that support
+// a single language key.
+type languageKeyState struct {
+	indexers       map[string]struct{}
+	fresh          bool
+	lastIndexedAt  *time.Time
+	lastFailedAt   *time.Time
+	failureMessage *string
+}
+
+// computeLanguageCoverage determines the coverage of each language of the given inventory that is
+// supported by a known precise indexer. Records are attributed to a language via the language key
+// of their indexer, so that e.g. scip-typescript uploads cover both TypeScript and JavaScript.
+//
+// A language is indexed if an upload visible from the tip of the default branch was made for that
+// tip or within the given staleness threshold; stale if older precise data exists; failed if no
+// usable data exists and the most recent upload or auto-indexing job failed; and unindexed
+// otherwise. The failure reason is reported whenever the most recent attempt failed.
+func computeLanguageCoverage(
+	commit string,
+	inv *inventory.Inventory,
+	visibleUploads []shared.Upload,
+	recentUploads []shared.UploadsWithRepositoryNamespace,
+	recentIndexes []shared.IndexesWithRepositoryNamespace,
+	staleAfter time.Duration,
+	now time.Time,
+) []shared.LanguageCoverage {
+	states := map[string]*languageKeyState{}
+	stateFor := func(indexer string) *languageKeyState {
+		codeIntelIndexer := shared.IndexerFromName(indexer)
+		if codeIntelIndexer.LanguageKey == "" {
+			return nil
+		}
+
+		state, ok := states[codeIntelIndexer.LanguageKey]
+		if !ok {
+			state = &languageKeyState{indexers: map[string]struct{}{}}
+			states[codeIntelIndexer.LanguageKey] = state
+		}
+		state.indexers[codeIntelIndexer.Name] = struct{}{}
+		return state
+	}
+
+	for _, upload := range visibleUploads {
+		if state := stateFor(upload.Indexer); state != nil {
+			if upload.Commit == commit || (upload.FinishedAt != nil && now.Sub(*upload.FinishedAt) <= staleAfter) {
+				state.fresh = true
+			}
+			state.observeCompleted(upload.FinishedAt)
+		}
+	}
+
+	for _, group := range recentUploads {
+		for _, upload := range group.Uploads {
+			if state := stateFor(upload.Indexer); state != nil {
+				switch upload.State {
+				case "completed":
+					state.observeCompleted(upload.FinishedAt)
+				case "errored", "failed":
+					state.observeFailure(upload.FinishedAt, upload.FailureMessage)
+				}
+			}
+		}
+	}
+
+	for _, group := range recentIndexes {
+		for _, index := range group.Indexes {
+			if state := stateFor(index.Indexer); state != nil {
+				switch index.State {
+				case "errored", "failed":
+					state.observeFailure(index.FinishedAt, index.FailureMessage)
+				}
+			}
+		}
+	}
+
+	coverage := make([]shared.LanguageCoverage, 0, len(inv.Languages))
+	for _, lang := range inv.Languages {
+		languageKey, ok := shared.LanguageKeyForLanguage(lang.Name)
+		if !ok {
+			continue
+		}
+
+		c := shared.LanguageCoverage{
+			Language:   lang.Name,
+			Commit:     commit,
+			TotalBytes: int64(lang.TotalBytes),
+			TotalLines: int64(lang.TotalLines),
+			State:      shared.LanguageCoverageStateUnindexed,
+			Indexers:   []string{},
+		}
+
+		if state, ok := states[languageKey]; ok {
+			for indexer := range state.indexers {
+				c.Indexers = append(c.Indexers, indexer)
+			}
+			sort.Strings(c.Indexers)
+			c.LastIndexedAt = state.lastIndexedAt
+			c.State = state.coverageState()
+
+			if state.lastFailedAt != nil && (state.lastIndexedAt == nil || state.lastFailedAt.After(*state.lastIndexedAt)) {
+				c.FailureReason = state.failureMessage
+			}
+		}
+
+		coverage = append(coverage, c)
+	}
+
+	return coverage
+}
+
+func (s *languageKeyState) observeCompleted(finishedAt *time.Time) {
+	if finishedAt != nil && (s.lastIndexedAt == nil || finishedAt.After(*s.lastIndexedAt)) {
+		s.lastIndexedAt = finishedAt
+	}
+}
+
+func (s *languageKeyState) observeFailure(finishedAt *time.Time, failureMessage *string) {
+	if finishedAt == nil {
+		return
+	}
+
+	if s.lastFailedAt == nil || finishedAt.After(*s.lastFailedAt) {
+		s.lastFailedAt = finishedAt
+		s.failureMessage = failureMessage
+	}
+}
+
+func (s *languageKeyState) coverageState() string {
+	switch {
+	case s.fresh:
+		return shared.LanguageCoverageStateIndexed
+	case s.lastIndexedAt != nil:
+		return shared.LanguageCoverageStateStale
+	case s.lastFailedAt != nil:
+		return shared.LanguageCoverageStateFailed
+	default:
+		return shared.LanguageCoverageStateUnindexed
+	}
+}
@@ -0,0 +1,71 @@
+package coverage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+)
+
+func TestComputeLanguageCoverage(t *testing.T) {
+	now := time.Unix(1694950000, 0)
+	recently := now.Add(-time.Hour)
+	longAgo := now.Add(-time.Hour * 24 * 30)
+	earlier := longAgo.Add(-time.Hour)
+	failureMessage := "exit status 1"
+
+	inv := &inventory.Inventory{
+		Languages: []inventory.Lang{
+			{Name: "Go", TotalBytes: 5000, TotalLines: 200},
+			{Name: "TypeScript", TotalBytes: 4000, TotalLines: 150},
+			{Name: "JavaScript", TotalBytes: 3000, TotalLines: 100},
+			{Name: "Python", TotalBytes: 2000, TotalLines: 80},
+			{Name: "Java", TotalBytes: 1000, TotalLines: 40},
+			{Name: "Rust", TotalBytes: 500, TotalLines: 20},
+			{Name: "Markdown", TotalBytes: 100, TotalLines: 10},
+		},
+	}
+
+	visibleUploads := []shared.Upload{
+		// Visible at the tip itself: fresh regardless of age
+		{Commit: "deadbeef", Indexer: "sourcegraph/scip-go@sha256:123", State: "completed", FinishedAt: &longAgo},
+		// Visible from an older commit, but only a long time ago
+		{Commit: "cafebabe", Indexer: "scip-typescript", State: "completed", FinishedAt: &longAgo},
+	}
+
+	recentUploads := []shared.UploadsWithRepositoryNamespace{
+		{Root: "", Indexer: "scip-typescript", Uploads: []shared.Upload{
+			{Commit: "deadbeef", Indexer: "scip-typescript", State: "failed", FinishedAt: &recently, FailureMessage: &failureMessage},
+		}},
+		{Root: "", Indexer: "scip-java", Uploads: []shared.Upload{
+			{Commit: "cafebabe", Indexer: "scip-java", State: "completed", FinishedAt: &longAgo},
+		}},
+	}
+
+	recentIndexes := []shared.IndexesWithRepositoryNamespace{
+		{Root: "", Indexer: "sourcegraph/scip-python", Indexes: []shared.Index{
+			{Commit: "deadbeef", Indexer: "sourcegraph/scip-python:latest", State: "failed", FinishedAt: &recently, FailureMessage: &failureMessage},
+		}},
+		{Root: "", Indexer: "sourcegraph/scip-java", Indexes: []shared.Index{
+			{Commit: "cafebabe", Indexer: "sourcegraph/scip-java", State: "errored", FinishedAt: &earlier, FailureMessage: &failureMessage},
+		}},
+	}
+
+	coverage := computeLanguageCoverage("deadbeef", inv, visibleUploads, recentUploads, recentIndexes, time.Hour*24*7, now)
+
+	expected := []shared.LanguageCoverage{
+		{Language: "Go", Commit: "deadbeef", TotalBytes: 5000, TotalLines: 200, State: shared.LanguageCoverageStateIndexed, Indexers: []string{"scip-go"}, LastIndexedAt: &longAgo},
+		{Language: "TypeScript", Commit: "deadbeef", TotalBytes: 4000, TotalLines: 150, State: shared.LanguageCoverageStateStale, Indexers: []string{"scip-typescript"}, LastIndexedAt: &longAgo, FailureReason: &failureMessage},
+		{Language: "JavaScript", Commit: "deadbeef", TotalBytes: 3000, TotalLines: 100, State: shared.LanguageCoverageStateStale, Indexers: []string{"scip-typescript"}, LastIndexedAt: &longAgo, FailureReason: &failureMessage},
+		{Language: "Python", Commit: "deadbeef", TotalBytes: 2000, TotalLines: 80, State: shared.LanguageCoverageStateFailed, Indexers: []string{"scip-python"}, FailureReason: &failureMessage},
+		// The failed auto-indexing job predates the completed upload
+		{Language: "Java", Commit: "deadbeef", TotalBytes: 1000, TotalLines: 40, State: shared.LanguageCoverageStateStale, Indexers: []string{"scip-java"}, LastIndexedAt: &longAgo},
+		{Language: "Rust", Commit: "deadbeef", TotalBytes: 500, TotalLines: 20, State: shared.LanguageCoverageStateUnindexed, Indexers: []string{}},
+	}
+	if diff := cmp.Diff(expected, coverage); diff != "" {
+		t.Errorf("unexpected coverage (-want +got):\n%s", diff)
+	}
+}
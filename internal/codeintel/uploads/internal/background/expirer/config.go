@@ -16,6 +16,9 @@ type Config struct {
 	RepositoryProcessDelay time.Duration
 	UploadBatchSize        int
 	UploadProcessDelay     time.Duration
+	BudgetExpirerInterval  time.Duration
+	GlobalBudget           int64
+	RepositoryBudget       int64
 }
 
 func (c *Config) Load() {
@@ -33,4 +36,7 @@ func (c *Config) Load() {
 	c.RepositoryProcessDelay = c.GetInterval(repositoryProcessDelay, "24h", "The minimum frequency that the same repository's uploads can be considered for expiration.")
 	c.UploadBatchSize = c.GetInt(uploadBatchSize, "100", "The number of uploads to consider for expiration at a time.")
 	c.UploadProcessDelay = c.GetInterval(uploadProcessDelay, "24h", "The minimum frequency that the same upload record can be considered for expiration.")
+	c.BudgetExpirerInterval = c.GetInterval("CODEINTEL_UPLOAD_EXPIRER_BUDGET_INTERVAL", "1h", "How frequently to run the storage budget upload expirer routine.")
+	c.GlobalBudget = int64(c.GetInt("CODEINTEL_UPLOAD_EXPIRER_GLOBAL_BUDGET_BYTES", "0", "The estimated number of bytes of precise code intelligence data to retain over all repositories. The least useful uploads beyond this budget are expired. Zero disables the global budget."))
+	c.RepositoryBudget = int64(c.GetInt("CODEINTEL_UPLOAD_EXPIRER_REPOSITORY_BUDGET_BYTES", "0", "The estimated number of bytes of precise code intelligence data to retain per repository. The least useful uploads beyond this budget are expired. Zero disables the per-repository budget."))
 }
@@ -0,0 +1,72 @@
+package expirer
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func NewUploadBudgetExpirer(
+	observationCtx *observation.Context,
+	store store.Store,
+	config *Config,
+) goroutine.BackgroundRoutine {
+	expirer := &budgetExpirer{
+		store: store,
+	}
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return expirer.HandleUploadsOverBudget(ctx, NewExpirationMetrics(observationCtx), config)
+		}),
+		goroutine.WithName("codeintel.upload-budget-expirer"),
+		goroutine.WithDescription("marks the least useful uploads as expired when precise code intelligence data exceeds its storage budget"),
+		goroutine.WithInterval(config.BudgetExpirerInterval),
+	)
+}
+
+type budgetExpirer struct {
+	store store.Store
+}
+
+// HandleUploadsOverBudget marks the least useful uploads that do not fit into the configured global
+// or per-repository storage budgets as expired. Expired records with no dependents will be removed by
+// the expiredUploadDeleter; uploads with dependents are never selected, as expiring them would not free
+// any storage. The uploads over budget are re-ranked after each batch, so the loop ends once all
+// remaining uploads fit into the budgets.
+func (s *budgetExpirer) HandleUploadsOverBudget(ctx context.Context, metrics *ExpirationMetrics, cfg *Config) error {
+	if cfg.GlobalBudget <= 0 && cfg.RepositoryBudget <= 0 {
+		return nil
+	}
+
+	for {
+		candidates, _, _, err := s.store.GetUploadsOverRetentionBudget(ctx, shared.GetUploadsOverRetentionBudgetOptions{
+			GlobalBudget:     cfg.GlobalBudget,
+			RepositoryBudget: cfg.RepositoryBudget,
+			Limit:            cfg.UploadBatchSize,
+		})
+		if err != nil {
+			return errors.Wrap(err, "store.GetUploadsOverRetentionBudget")
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		expiredUploadIDs := make([]int, 0, len(candidates))
+		for _, candidate := range candidates {
+			expiredUploadIDs = append(expiredUploadIDs, candidate.UploadID)
+		}
+
+		if err := s.store.UpdateUploadRetention(ctx, nil, expiredUploadIDs); err != nil {
+			return errors.Wrap(err, "store.UpdateUploadRetention")
+		}
+
+		metrics.NumUploadsExpired.Add(float64(len(expiredUploadIDs)))
+		metrics.NumUploadsExpiredOverBudget.Add(float64(len(expiredUploadIDs)))
+	}
+}
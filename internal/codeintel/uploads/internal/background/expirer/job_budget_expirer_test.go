@@ -0,0 +1,56 @@
+package expirer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestUploadBudgetExpirer(t *testing.T) {
+	store := NewMockStore()
+	store.GetUploadsOverRetentionBudgetFunc.PushReturn([]shared.RetentionBudgetCandidate{{UploadID: 4}, {UploadID: 3}}, 3, 300, nil)
+	store.GetUploadsOverRetentionBudgetFunc.PushReturn([]shared.RetentionBudgetCandidate{{UploadID: 2}}, 1, 100, nil)
+
+	budgetExpirer := &budgetExpirer{store: store}
+	cfg := &Config{GlobalBudget: 1000, RepositoryBudget: 200, UploadBatchSize: 2}
+
+	if err := budgetExpirer.HandleUploadsOverBudget(context.Background(), NewExpirationMetrics(&observation.TestContext), cfg); err != nil {
+		t.Fatalf("unexpected error from handle: %s", err)
+	}
+
+	getCalls := store.GetUploadsOverRetentionBudgetFunc.History()
+	if len(getCalls) != 3 {
+		t.Fatalf("unexpected number of calls to GetUploadsOverRetentionBudget. want=%d have=%d", 3, len(getCalls))
+	}
+	expectedOpts := shared.GetUploadsOverRetentionBudgetOptions{GlobalBudget: 1000, RepositoryBudget: 200, Limit: 2}
+	if diff := cmp.Diff(expectedOpts, getCalls[0].Arg1); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+
+	var expiredIDs [][]int
+	for _, call := range store.UpdateUploadRetentionFunc.History() {
+		if len(call.Arg1) != 0 {
+			t.Errorf("unexpected protected identifiers: %v", call.Arg1)
+		}
+		expiredIDs = append(expiredIDs, call.Arg2)
+	}
+	if diff := cmp.Diff([][]int{{4, 3}, {2}}, expiredIDs); diff != "" {
+		t.Errorf("unexpected expired upload identifiers (-want +got):\n%s", diff)
+	}
+}
+
+func TestUploadBudgetExpirerDisabled(t *testing.T) {
+	store := NewMockStore()
+	budgetExpirer := &budgetExpirer{store: store}
+
+	if err := budgetExpirer.HandleUploadsOverBudget(context.Background(), NewExpirationMetrics(&observation.TestContext), &Config{}); err != nil {
+		t.Fatalf("unexpected error from handle: %s", err)
+	}
+	if calls := store.GetUploadsOverRetentionBudgetFunc.History(); len(calls) != 0 {
+		t.Errorf("unexpected calls to GetUploadsOverRetentionBudget: %d", len(calls))
+	}
+}
@@ -12,6 +12,8 @@ type ExpirationMetrics struct {
 	NumUploadsExpired      prometheus.Counter
 	NumUploadsScanned      prometheus.Counter
 	NumCommitsScanned      prometheus.Counter
+
+	NumUploadsExpiredOverBudget prometheus.Counter
 }
 
 var expirationMetrics = memo.NewMemoizedConstructorWithArg(func(r prometheus.Registerer) (*ExpirationMetrics, error) {
@@ -41,12 +43,18 @@ var expirationMetrics = memo.NewMemoizedConstructorWithArg(func(r prometheus.Reg
 		"src_codeintel_background_upload_records_expired_total",
 		"The number of codeintel upload records marked as expired.",
 	)
+	numUploadsExpiredOverBudget := counter(
+		"src_codeintel_background_upload_records_expired_over_budget_total",
+		"The number of codeintel upload records marked as expired as they did not fit into the storage budget.",
+	)
 
 	return &ExpirationMetrics{
 		NumRepositoriesScanned: numRepositoriesScanned,
 		NumUploadsScanned:      numUploadsScanned,
 		NumCommitsScanned:      numCommitsScanned,
 		NumUploadsExpired:      numUploadsExpired,
+
+		NumUploadsExpiredOverBudget: numUploadsExpiredOverBudget,
 	}, nil
 })
 
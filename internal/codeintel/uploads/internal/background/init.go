@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/backfiller"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/commitgraph"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/coverage"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/expirer"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/janitor"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/background/processor"
@@ -138,5 +139,26 @@ func NewExpirationTasks(
 			gitserverClient,
 			config,
 		),
+		expirer.NewUploadBudgetExpirer(
+			observationCtx,
+			store,
+			config,
+		),
+	}
+}
+
+func NewCoverageAggregator(
+	observationCtx *observation.Context,
+	store uploadsstore.Store,
+	repoStore coverage.RepoStore,
+	config *coverage.Config,
+) []goroutine.BackgroundRoutine {
+	return []goroutine.BackgroundRoutine{
+		coverage.NewCoverageAggregator(
+			observationCtx,
+			store,
+			repoStore,
+			config,
+		),
 	}
 }
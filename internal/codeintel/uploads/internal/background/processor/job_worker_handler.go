@@ -226,6 +226,10 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 		return requeued, err
 	}
 
+	if requeued, err := requeueIfBaseUploadUnprocessed(ctx, logger, h.store, h.workerStore, upload); err != nil || requeued {
+		return requeued, err
+	}
+
 	// Determine if the upload is for the default Git branch.
 	isDefaultBranch, err := h.defaultBranchContains(ctx, repo.Name, upload.Commit)
 	if err != nil {
@@ -275,7 +279,10 @@ func (h *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 			return errors.Wrap(err, "store.CommitDate")
 		}
 
-		scipDataStream, err := prepareSCIPDataStream(ctx, indexReader, upload.Root, getChildren)
+		// Documents of a base upload are read outside of the transaction that writes the processed
+		// documents of this upload below, as the writer holds its connection for the duration of the
+		// transaction.
+		scipDataStream, err := prepareSCIPDataStream(ctx, indexReader, upload.Root, getChildren, h.lsifStore, upload.BaseUploadID)
 		if err != nil {
 			return errors.Wrap(err, "prepareSCIPDataStream")
 		}
@@ -380,6 +387,42 @@ func requeueIfCloningOrCommitUnknown(ctx context.Context, logger log.Logger, git
 	return true, nil
 }
 
+// requeueIfBaseUploadUnprocessed ensures that the base upload of a partial upload has been processed so that
+// its documents can be merged. If the base upload is still being uploaded or processed, then the upload will be
+// requeued and this function returns a true valued flag. If the base upload has failed or no longer exists, the
+// partial upload cannot be completed and we'll fail on it.
+func requeueIfBaseUploadUnprocessed(ctx context.Context, logger log.Logger, store store.Store, workerStore dbworkerstore.Store[uploadsshared.Upload], upload uploadsshared.Upload) (requeued bool, _ error) {
+	if upload.BaseUploadID == nil {
+		return false, nil
+	}
+
+	baseUpload, exists, err := store.GetUploadByID(ctx, *upload.BaseUploadID)
+	if err != nil {
+		return false, errors.Wrap(err, "store.GetUploadByID")
+	}
+	if !exists {
+		return false, errors.Newf("base upload %d does not exist", *upload.BaseUploadID)
+	}
+
+	switch baseUpload.State {
+	case "completed":
+		return false, nil
+	case "uploading", "queued", "processing":
+	default:
+		return false, errors.Newf("base upload %d is in state %q", baseUpload.ID, baseUpload.State)
+	}
+
+	after := time.Now().UTC().Add(requeueDelay)
+
+	if err := workerStore.Requeue(ctx, upload.ID, after); err != nil {
+		return false, errors.Wrap(err, "store.Requeue")
+	}
+	logger.Warn("Requeued LSIF upload record",
+		log.Int("id", upload.ID),
+		log.String("reason", "base upload not yet processed"))
+	return true, nil
+}
+
 // NOTE(scip-index-size-stats) In practice, the following seem to be true:
 //   - The size of an uncompressed index is about 5x-10x the size of
 //     the gzip-compressed index
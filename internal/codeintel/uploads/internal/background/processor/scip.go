@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/pathexistence"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type firstPassResult struct {
@@ -57,6 +58,15 @@ type documentOneShotIterator struct {
 	ignorePaths  collections.Set[string]
 	indexSummary firstPassResult
 	indexReader  gzipReadSeeker
+	base         *baseDocumentSource
+}
+
+// baseDocumentSource describes the documents of a previously processed upload that are merged
+// into a partial index. Documents of the base upload are already stored in canonical form.
+type baseDocumentSource struct {
+	uploadID    int
+	lsifStore   lsifstore.Store
+	ignorePaths collections.Set[string]
 }
 
 var _ lsifstore.SCIPDocumentVisitor = &documentOneShotIterator{}
@@ -68,7 +78,7 @@ func (it *documentOneShotIterator) VisitAllDocuments(
 	doIt func(lsifstore.ProcessedSCIPDocument) error,
 ) error {
 	repeatedDocumentsByPath := make(map[string][]*scip.Document, 1)
-	packageSet := map[precise.Package]bool{}
+	packages := packageSet{}
 
 	var outerError error = nil
 
@@ -107,38 +117,7 @@ func (it *documentOneShotIterator) VisitAllDocuments(
 			return
 		}
 
-		// While processing this document, stash the unique packages of each symbol name
-		// in the document. If there is an occurrence that defines that symbol, mark that
-		// package as being one that we define (rather than simply reference).
-
-		for _, symbol := range document.Symbols {
-			if pkg, ok := packageFromSymbol(symbol.Symbol); ok {
-				// no-op if key exists; add false if key is absent
-				packageSet[pkg] = packageSet[pkg] || false
-			}
-
-			for _, relationship := range symbol.Relationships {
-				if pkg, ok := packageFromSymbol(relationship.Symbol); ok {
-					// no-op if key exists; add false if key is absent
-					packageSet[pkg] = packageSet[pkg] || false
-				}
-			}
-		}
-
-		for _, occurrence := range document.Occurrences {
-			if occurrence.Symbol == "" || scip.IsLocalSymbol(occurrence.Symbol) {
-				continue
-			}
-
-			if pkg, ok := packageFromSymbol(occurrence.Symbol); ok {
-				if isDefinition := scip.SymbolRole_Definition.Matches(occurrence); isDefinition {
-					packageSet[pkg] = true
-				} else {
-					// no-op if key exists; add false if key is absent
-					packageSet[pkg] = packageSet[pkg] || false
-				}
-			}
-		}
+		packages.add(document)
 	},
 	}
 	if err := secondPassVisitor.ParseStreaming(&it.indexReader); err != nil {
@@ -153,11 +132,76 @@ func (it *documentOneShotIterator) VisitAllDocuments(
 		return err
 	}
 
+	if it.base != nil {
+		// Merge the documents of the base upload that were not replaced by the partial index. Paths
+		// present in the partial index take precedence, even if the document there was ignored.
+		if err := it.base.lsifStore.ScanDocuments(ctx, it.base.uploadID, func(path string, document *scip.Document) error {
+			if _, ok := it.indexSummary.documentCountByPath[path]; ok || it.base.ignorePaths.Has(path) {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := doIt(lsifstore.ProcessedSCIPDocument{Path: path, Document: document}); err != nil {
+				return err
+			}
+
+			packages.add(document)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
 	// Now that we've populated our index-global packages map, separate them into ones that
 	// we define and ones that we simply reference. The closing of the documents channel at
 	// the end of this function will signal that these lists have been populated.
 
-	for pkg, hasDefinition := range packageSet {
+	return packages.appendTo(ctx, p)
+}
+
+// packageSet stashes the unique packages of symbol names referenced in a set of documents. The
+// value of each package is true if there is an occurrence that defines a symbol of that package.
+type packageSet map[precise.Package]bool
+
+// add stashes the packages of each symbol name in the given document. If there is an occurrence
+// that defines that symbol, mark that package as being one that we define (rather than simply
+// reference).
+func (s packageSet) add(document *scip.Document) {
+	for _, symbol := range document.Symbols {
+		if pkg, ok := packageFromSymbol(symbol.Symbol); ok {
+			// no-op if key exists; add false if key is absent
+			s[pkg] = s[pkg] || false
+		}
+
+		for _, relationship := range symbol.Relationships {
+			if pkg, ok := packageFromSymbol(relationship.Symbol); ok {
+				// no-op if key exists; add false if key is absent
+				s[pkg] = s[pkg] || false
+			}
+		}
+	}
+
+	for _, occurrence := range document.Occurrences {
+		if occurrence.Symbol == "" || scip.IsLocalSymbol(occurrence.Symbol) {
+			continue
+		}
+
+		if pkg, ok := packageFromSymbol(occurrence.Symbol); ok {
+			if isDefinition := scip.SymbolRole_Definition.Matches(occurrence); isDefinition {
+				s[pkg] = true
+			} else {
+				// no-op if key exists; add false if key is absent
+				s[pkg] = s[pkg] || false
+			}
+		}
+	}
+}
+
+// appendTo separates the stashed packages into ones that we define and ones that we simply
+// reference and appends them to the given package data.
+func (s packageSet) appendTo(ctx context.Context, p *lsifstore.ProcessedPackageData) error {
+	for pkg, hasDefinition := range s {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -174,12 +218,17 @@ func (it *documentOneShotIterator) VisitAllDocuments(
 // prepareSCIPDataStream performs a streaming traversal of the index to get some preliminary
 // information, and creates a SCIPDataStream that can be used to write Documents into the database.
 //
+// If a base upload identifier is supplied, the index is treated as a partial index and the documents
+// of the base upload that are not replaced by the index are merged into the resulting stream.
+//
 // Package information can be obtained when documents are visited.
 func prepareSCIPDataStream(
 	ctx context.Context,
 	indexReader gzipReadSeeker,
 	root string,
 	getChildren pathexistence.GetChildrenFunc,
+	lsifStore lsifstore.Store,
+	baseUploadID *int,
 ) (lsifstore.SCIPDataStream, error) {
 	indexSummary, err := aggregateExternalSymbolsAndPaths(&indexReader)
 	if err != nil {
@@ -191,6 +240,14 @@ func prepareSCIPDataStream(
 		return lsifstore.SCIPDataStream{}, err
 	}
 
+	var base *baseDocumentSource
+	if baseUploadID != nil {
+		base, err = prepareBaseDocumentSource(ctx, lsifStore, *baseUploadID, indexSummary, root, getChildren)
+		if err != nil {
+			return lsifstore.SCIPDataStream{}, err
+		}
+	}
+
 	metadata := lsifstore.ProcessedMetadata{
 		TextDocumentEncoding: indexSummary.metadata.TextDocumentEncoding.String(),
 		ToolName:             indexSummary.metadata.ToolInfo.Name,
@@ -201,7 +258,42 @@ func prepareSCIPDataStream(
 
 	return lsifstore.SCIPDataStream{
 		Metadata:         metadata,
-		DocumentIterator: &documentOneShotIterator{ignorePaths, indexSummary, indexReader},
+		DocumentIterator: &documentOneShotIterator{ignorePaths, indexSummary, indexReader, base},
+	}, nil
+}
+
+// prepareBaseDocumentSource determines which documents of the given base upload are no longer
+// resolvable via Git. Documents replaced by the partial index are not checked, as they are
+// never merged.
+func prepareBaseDocumentSource(
+	ctx context.Context,
+	lsifStore lsifstore.Store,
+	baseUploadID int,
+	indexSummary firstPassResult,
+	root string,
+	getChildren pathexistence.GetChildrenFunc,
+) (*baseDocumentSource, error) {
+	basePaths, err := lsifStore.GetDocumentPaths(ctx, baseUploadID)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.GetDocumentPaths")
+	}
+
+	unreplacedPaths := basePaths[:0]
+	for _, path := range basePaths {
+		if _, ok := indexSummary.documentCountByPath[path]; !ok {
+			unreplacedPaths = append(unreplacedPaths, path)
+		}
+	}
+
+	ignorePaths, err := ignorePaths(ctx, unreplacedPaths, root, getChildren)
+	if err != nil {
+		return nil, err
+	}
+
+	return &baseDocumentSource{
+		uploadID:    baseUploadID,
+		lsifStore:   lsifStore,
+		ignorePaths: ignorePaths,
 	}, nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/scip/bindings/go/scip"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/lsifstore"
@@ -52,7 +53,7 @@ func implCorrelateSCIP(t *testing.T, testIndexPath string, indexSizeLimit int64)
 	// Correlate and consume channels from returned object
 	scipDataStream, err := prepareSCIPDataStream(ctx, testReader(), "", func(ctx context.Context, dirnames []string) (map[string][]string, error) {
 		return scipDirectoryChildren, nil
-	})
+	}, nil, nil)
 	if err != nil {
 		t.Fatalf("unexpected error processing SCIP: %s", err)
 	}
@@ -186,6 +187,112 @@ func implCorrelateSCIP(t *testing.T, testIndexPath string, indexSizeLimit int64)
 	}
 }
 
+func TestCorrelateSCIPWithBaseUpload(t *testing.T) {
+	ctx := context.Background()
+
+	gzipped, err := os.Open("./testdata/index1.scip.gz")
+	require.NoError(t, err)
+	indexReader, err := newGzipReadSeeker(gzipped)
+	require.NoError(t, err)
+
+	directoryChildren := map[string][]string{}
+	for dirname, children := range scipDirectoryChildren {
+		directoryChildren[dirname] = children
+	}
+	directoryChildren["template/src"] = append(append([]string(nil), directoryChildren["template/src"]...), "template/src/unchanged.ts")
+
+	baseDocuments := map[string]*scip.Document{
+		// replaced by the partial index
+		"template/src/util/graphql.ts": {},
+		// merged into the partial index
+		"template/src/unchanged.ts": {
+			Occurrences: []*scip.Occurrence{
+				{Symbol: "scip-typescript npm template 0.0.0-DEVELOPMENT src/`unchanged.ts`/unchanged().", SymbolRoles: int32(scip.SymbolRole_Definition)},
+				{Symbol: "scip-typescript npm left-pad 1.3.0 `index.d.ts`/leftPad()."},
+			},
+		},
+		// no longer exists in the repository
+		"template/src/deleted.ts": {},
+	}
+
+	mockLSIFStore := NewMockLSIFStore()
+	mockLSIFStore.GetDocumentPathsFunc.SetDefaultHook(func(ctx context.Context, uploadID int) ([]string, error) {
+		paths := make([]string, 0, len(baseDocuments))
+		for path := range baseDocuments {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		return paths, nil
+	})
+	mockLSIFStore.ScanDocumentsFunc.SetDefaultHook(func(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) error {
+		for path, document := range baseDocuments {
+			if err := f(path, document); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	baseUploadID := 41
+	scipDataStream, err := prepareSCIPDataStream(ctx, indexReader, "", func(ctx context.Context, dirnames []string) (map[string][]string, error) {
+		return directoryChildren, nil
+	}, mockLSIFStore, &baseUploadID)
+	require.NoError(t, err)
+
+	documentMap := map[string]lsifstore.ProcessedSCIPDocument{}
+	packageData := lsifstore.ProcessedPackageData{}
+	err = scipDataStream.DocumentIterator.VisitAllDocuments(ctx, log.NoOp(), &packageData, func(d lsifstore.ProcessedSCIPDocument) error {
+		documentMap[d.Path] = d
+		return nil
+	})
+	require.NoError(t, err)
+	packageData.Normalize()
+
+	if calls := mockLSIFStore.ScanDocumentsFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of ScanDocuments calls. want=%d have=%d", 1, len(calls))
+	} else if calls[0].Arg1 != baseUploadID {
+		t.Errorf("unexpected ScanDocuments upload id. want=%d have=%d", baseUploadID, calls[0].Arg1)
+	}
+
+	var paths []string
+	for path := range documentMap {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	expectedPaths := []string{
+		"template/src/extension.ts",
+		"template/src/indicators.ts",
+		"template/src/language.ts",
+		"template/src/logging.ts",
+		"template/src/unchanged.ts",
+		"template/src/util/api.ts",
+		"template/src/util/graphql.ts",
+		"template/src/util/ix.test.ts",
+		"template/src/util/ix.ts",
+		"template/src/util/promise.ts",
+		"template/src/util/uri.test.ts",
+		"template/src/util/uri.ts",
+	}
+	if diff := cmp.Diff(expectedPaths, paths); diff != "" {
+		t.Errorf("unexpected paths (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(testedInvertedRangeIndex, shared.ExtractSymbolIndexes(documentMap["template/src/util/graphql.ts"].Document)); diff != "" {
+		t.Errorf("unexpected inverted symbols (-want +got):\n%s", diff)
+	}
+
+	foundReference := false
+	for _, reference := range packageData.PackageReferences {
+		if reference.Package.Name == "left-pad" && reference.Package.Version == "1.3.0" {
+			foundReference = true
+		}
+	}
+	if !foundReference {
+		t.Errorf("expected package reference of base document to be merged")
+	}
+}
+
 var testedInvertedRangeIndex = []shared.InvertedRangeIndex{
 	{
 		SymbolName:      "scip-typescript npm js-base64 3.7.1 `base64.d.ts`/",
@@ -15,6 +15,8 @@ type operations struct {
 	deleteLsifDataByUploadIds                 *observation.Operation
 	deleteUnreferencedDocuments               *observation.Operation
 	insertDefinitionsAndReferencesForDocument *observation.Operation
+	getDocumentPaths                          *observation.Operation
+	scanDocuments                             *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -45,5 +47,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		deleteLsifDataByUploadIds:                 op("DeleteLsifDataByUploadIds"),
 		deleteUnreferencedDocuments:               op("DeleteUnreferencedDocuments"),
 		insertDefinitionsAndReferencesForDocument: op("InsertDefinitionsAndReferencesForDocument"),
+		getDocumentPaths:                          op("GetDocumentPaths"),
+		scanDocuments:                             op("ScanDocuments"),
 	}
 }
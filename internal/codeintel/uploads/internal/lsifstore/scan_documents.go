@@ -57,6 +57,61 @@ func (s *store) InsertDefinitionsAndReferencesForDocument(
 	return nil
 }
 
+// GetDocumentPaths returns the paths of all documents of the given upload.
+func (s *store) GetDocumentPaths(ctx context.Context, uploadID int) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getDocumentPaths.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(getDocumentPathsQuery, uploadID)))
+}
+
+const getDocumentPathsQuery = `
+SELECT sid.document_path
+FROM codeintel_scip_document_lookup sid
+WHERE sid.upload_id = %s
+ORDER BY sid.document_path
+`
+
+// ScanDocuments invokes the given function with each document of the given upload in path order.
+// Documents are returned in the canonical form in which they were written.
+func (s *store) ScanDocuments(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) (err error) {
+	ctx, _, endObservation := s.operations.scanDocuments.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(getDocumentsByUploadIDQuery, uploadID))
+	if err != nil {
+		return err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var path string
+		var compressedSCIPPayload []byte
+		if err := rows.Scan(&path, &compressedSCIPPayload); err != nil {
+			return err
+		}
+
+		scipPayload, err := shared.Decompressor.Decompress(bytes.NewReader(compressedSCIPPayload))
+		if err != nil {
+			return err
+		}
+
+		var document scip.Document
+		if err := proto.Unmarshal(scipPayload, &document); err != nil {
+			return err
+		}
+		if err := f(path, &document); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 const getDocumentsByUploadIDQuery = `
 SELECT
 	sid.document_path,
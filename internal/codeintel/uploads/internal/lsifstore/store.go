@@ -29,6 +29,8 @@ type Store interface {
 
 	// Scan/export document data
 	InsertDefinitionsAndReferencesForDocument(ctx context.Context, upload shared.ExportedUpload, rankingGraphKey string, rankingBatchSize int, f func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey, path string, document *scip.Document) error) (err error)
+	GetDocumentPaths(ctx context.Context, uploadID int) ([]string, error)
+	ScanDocuments(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) error
 }
 
 type SCIPWriter interface {
@@ -9,6 +9,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
@@ -131,6 +132,187 @@ const updateUploadRetentionQuery = `
 UPDATE lsif_uploads SET %s WHERE id IN (%s)
 `
 
+// GetUploadsOverRetentionBudget returns the least useful completed and unexpired uploads that do not
+// fit into the given global or per-repository storage budgets, least useful first, along with the total
+// number and size of such uploads.
+//
+// Uploads are ranked by whether they are visible from the tip of the default branch (or have not yet
+// been installed into the commit graph), then by the number of references to their definitions counted
+// by the ranking pipeline, then by recency. The most useful uploads are retained until their cumulative
+// estimated size exceeds a budget. The size of an upload is estimated by the size of its uncompressed
+// index, falling back to its compressed size. A zero budget is not enforced.
+//
+// Uploads canonically providing a package referenced by another upload are never deleted, even once
+// expired, so they are never returned and their size always counts towards the budgets.
+func (s *store) GetUploadsOverRetentionBudget(ctx context.Context, opts shared.GetUploadsOverRetentionBudgetOptions) (_ []shared.RetentionBudgetCandidate, totalCount int, totalSize int64, err error) {
+	ctx, _, endObservation := s.operations.getUploadsOverRetentionBudget.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", opts.RepositoryID),
+		attribute.Int64("globalBudget", opts.GlobalBudget),
+		attribute.Int64("repositoryBudget", opts.RepositoryBudget),
+		attribute.Int("limit", opts.Limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if opts.GlobalBudget <= 0 && opts.RepositoryBudget <= 0 {
+		return nil, 0, 0, nil
+	}
+
+	var budgetConds []*sqlf.Query
+	if opts.RepositoryBudget > 0 {
+		budgetConds = append(budgetConds, sqlf.Sprintf("r.repository_cumulative_size > %s", opts.RepositoryBudget))
+	}
+	if opts.GlobalBudget > 0 {
+		budgetConds = append(budgetConds, sqlf.Sprintf("r.global_cumulative_size > %s", opts.GlobalBudget))
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("(%s)", sqlf.Join(budgetConds, " OR "))}
+	if opts.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("r.repository_id = %s", opts.RepositoryID))
+	}
+
+	limitExpr := sqlf.Sprintf("")
+	if opts.Limit > 0 {
+		limitExpr = sqlf.Sprintf("LIMIT %s", opts.Limit)
+	}
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(getUploadsOverRetentionBudgetQuery, sqlf.Join(conds, " AND "), limitExpr))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var candidates []shared.RetentionBudgetCandidate
+	for rows.Next() {
+		var candidate shared.RetentionBudgetCandidate
+		if err := rows.Scan(
+			&candidate.UploadID,
+			&candidate.RepositoryID,
+			&candidate.RepositoryName,
+			&candidate.Commit,
+			&candidate.Root,
+			&candidate.Indexer,
+			&candidate.FinishedAt,
+			&candidate.Size,
+			&candidate.VisibleAtTip,
+			&candidate.ReferenceCount,
+			&totalCount,
+			&totalSize,
+		); err != nil {
+			return nil, 0, 0, err
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, totalCount, totalSize, nil
+}
+
+const getUploadsOverRetentionBudgetQuery = `
+WITH
+-- Rank the uploads providing each package in the same way as the expired upload deleter,
+-- where rank = 1 indicates that the upload canonically provides that package.
+ranked_uploads_providing_packages AS (
+	SELECT
+		u.id,
+		p.scheme,
+		p.manager,
+		p.name,
+		p.version,
+		` + packageRankingQueryFragment + ` AS rank
+	FROM lsif_uploads u
+	JOIN lsif_packages p ON p.dump_id = u.id
+	WHERE u.state = 'completed'
+),
+referenced_uploads_providing_package_canonically AS (
+	SELECT ru.id
+	FROM ranked_uploads_providing_packages ru
+	WHERE
+		ru.rank = 1 AND
+		EXISTS (
+			SELECT 1
+			FROM lsif_references r
+			WHERE
+				r.scheme = ru.scheme AND
+				r.manager = ru.manager AND
+				r.name = ru.name AND
+				r.version = ru.version AND
+				r.dump_id != ru.id
+		)
+),
+candidates AS (
+	SELECT
+		u.id,
+		u.repository_id,
+		repo.name AS repository_name,
+		u.commit,
+		u.root,
+		u.indexer,
+		u.finished_at,
+		COALESCE(u.uncompressed_size, u.upload_size, 0) AS size,
+		u.expired,
+		EXISTS (SELECT 1 FROM lsif_uploads_visible_at_tip vt WHERE vt.upload_id = u.id) AS visible_at_tip,
+
+		-- Uploads canonically providing a package referenced by another upload are not
+		-- deleted by the expired upload deleter, so expiring them would not free any storage.
+		EXISTS (
+			SELECT 1
+			FROM referenced_uploads_providing_package_canonically pkg_refcount
+			WHERE pkg_refcount.id = u.id
+		) AS has_dependents,
+
+		-- Uploads that finished after the last commit graph update are not yet visible
+		-- from any commit; never consider these less useful than older uploads.
+		(u.finished_at < (SELECT ldr.updated_at FROM lsif_dirty_repositories ldr WHERE ldr.repository_id = u.repository_id)) IS TRUE AS in_commit_graph,
+
+		COALESCE((
+			SELECT SUM(pci.count)
+			FROM codeintel_ranking_exports e
+			JOIN codeintel_ranking_definitions d ON d.exported_upload_id = e.id
+			JOIN codeintel_ranking_path_counts_inputs pci ON pci.definition_id = d.id
+			WHERE e.upload_id = u.id AND e.deleted_at IS NULL
+		), 0) AS reference_count
+	FROM lsif_uploads u
+	JOIN repo ON repo.id = u.repository_id
+	WHERE
+		u.state = 'completed' AND
+		repo.deleted_at IS NULL AND
+		repo.blocked IS NULL
+),
+ranked AS (
+	-- Uploads with dependents are retained regardless of the budgets, so they occupy
+	-- storage before any other upload. Expired uploads without dependents are about
+	-- to be deleted and do not count towards the budgets.
+	SELECT
+		c.*,
+		SUM(c.size) OVER (
+			PARTITION BY c.repository_id
+			ORDER BY c.has_dependents DESC, (c.visible_at_tip OR NOT c.in_commit_graph) DESC, c.reference_count DESC, c.finished_at DESC, c.id DESC
+		) AS repository_cumulative_size,
+		SUM(c.size) OVER (
+			ORDER BY c.has_dependents DESC, (c.visible_at_tip OR NOT c.in_commit_graph) DESC, c.reference_count DESC, c.finished_at DESC, c.id DESC
+		) AS global_cumulative_size
+	FROM candidates c
+	WHERE NOT c.expired OR c.has_dependents
+)
+SELECT
+	r.id,
+	r.repository_id,
+	r.repository_name,
+	r.commit,
+	r.root,
+	r.indexer,
+	r.finished_at,
+	r.size,
+	r.visible_at_tip,
+	r.reference_count,
+	COUNT(*) OVER() AS count,
+	SUM(r.size) OVER() AS total_size
+FROM ranked r
+WHERE NOT r.has_dependents AND %s
+ORDER BY (r.visible_at_tip OR NOT r.in_commit_graph), r.reference_count, r.finished_at, r.id
+%s
+`
+
 // SoftDeleteExpiredUploads marks upload records that are both expired and have no references
 // as deleted. The associated repositories will be marked as dirty so that their commit graphs
 // are updated in the near future.
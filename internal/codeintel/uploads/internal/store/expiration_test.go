@@ -4,8 +4,10 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
@@ -94,6 +96,153 @@ func TestSoftDeleteExpiredUploads(t *testing.T) {
 	}
 }
 
+func TestGetUploadsOverRetentionBudget(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	now := time.Unix(1694950000, 0).UTC()
+	hoursAgo := func(n int) *time.Time { ts := now.Add(-time.Duration(n) * time.Hour); return &ts }
+	size := func(n int64) *int64 { return &n }
+
+	insertUploads(t, db,
+		shared.Upload{ID: 1, RepositoryID: 50, FinishedAt: hoursAgo(1), UploadSize: size(100)}, // visible at tip
+		shared.Upload{ID: 2, RepositoryID: 50, FinishedAt: hoursAgo(2), UploadSize: size(100)},
+		shared.Upload{ID: 3, RepositoryID: 50, FinishedAt: hoursAgo(3), UploadSize: size(100)},
+		shared.Upload{ID: 4, RepositoryID: 51, FinishedAt: hoursAgo(4), UploadSize: size(200)},
+		shared.Upload{ID: 5, RepositoryID: 51, FinishedAt: hoursAgo(5), UploadSize: size(200)}, // referenced
+		shared.Upload{ID: 6, RepositoryID: 51, FinishedAt: hoursAgo(-1), UploadSize: size(50)}, // not yet in commit graph
+		shared.Upload{ID: 7, RepositoryID: 51, FinishedAt: hoursAgo(6), UploadSize: size(500), State: "errored"},
+	)
+	insertVisibleAtTip(t, db, 50, 1)
+
+	for _, query := range []*sqlf.Query{
+		sqlf.Sprintf(`INSERT INTO lsif_dirty_repositories (repository_id, update_token, dirty_token, updated_at) VALUES (50, 10, 10, %s), (51, 10, 10, %s)`, now, now),
+		sqlf.Sprintf(`INSERT INTO codeintel_ranking_exports (id, upload_id, graph_key) VALUES (100, 5, 'test')`),
+		sqlf.Sprintf(`INSERT INTO codeintel_ranking_definitions (id, symbol_name, document_path, graph_key, exported_upload_id) VALUES (200, 'sym', 'foo.go', 'test', 100)`),
+		sqlf.Sprintf(`INSERT INTO codeintel_ranking_path_counts_inputs (count, graph_key, definition_id) VALUES (10, 'test', 200)`),
+	} {
+		if _, err := db.ExecContext(ctx, query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		opts               shared.GetUploadsOverRetentionBudgetOptions
+		expectedIDs        []int
+		expectedTotalCount int
+		expectedTotalSize  int64
+	}{
+		{"no budget", shared.GetUploadsOverRetentionBudgetOptions{}, nil, 0, 0},
+		{"repository budget", shared.GetUploadsOverRetentionBudgetOptions{RepositoryBudget: 250}, []int{4, 3}, 2, 300},
+		{"global budget", shared.GetUploadsOverRetentionBudgetOptions{GlobalBudget: 400}, []int{4, 3, 2}, 3, 400},
+		{"global budget with limit", shared.GetUploadsOverRetentionBudgetOptions{GlobalBudget: 400, Limit: 1}, []int{4}, 3, 400},
+		{"global budget for repository", shared.GetUploadsOverRetentionBudgetOptions{GlobalBudget: 400, RepositoryID: 50}, []int{3, 2}, 2, 200},
+		{"both budgets", shared.GetUploadsOverRetentionBudgetOptions{GlobalBudget: 500, RepositoryBudget: 150}, []int{4, 3, 2, 5}, 4, 600},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			candidates, totalCount, totalSize, err := store.GetUploadsOverRetentionBudget(ctx, testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error getting uploads over budget: %s", err)
+			}
+
+			var ids []int
+			for _, candidate := range candidates {
+				ids = append(ids, candidate.UploadID)
+			}
+			if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+				t.Errorf("unexpected upload identifiers (-want +got):\n%s", diff)
+			}
+			if totalCount != testCase.expectedTotalCount {
+				t.Errorf("unexpected total count. want=%d have=%d", testCase.expectedTotalCount, totalCount)
+			}
+			if totalSize != testCase.expectedTotalSize {
+				t.Errorf("unexpected total size. want=%d have=%d", testCase.expectedTotalSize, totalSize)
+			}
+		})
+	}
+}
+
+func TestGetUploadsOverRetentionBudgetWithDependents(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+	ctx := context.Background()
+
+	now := time.Unix(1694950000, 0).UTC()
+	hoursAgo := func(n int) *time.Time { ts := now.Add(-time.Duration(n) * time.Hour); return &ts }
+	size := func(n int64) *int64 { return &n }
+
+	insertUploads(t, db,
+		shared.Upload{ID: 10, RepositoryID: 60, FinishedAt: hoursAgo(1), UploadSize: size(100)}, // visible at tip
+		shared.Upload{ID: 11, RepositoryID: 60, FinishedAt: hoursAgo(2), UploadSize: size(100)}, // referenced by 10
+		shared.Upload{ID: 12, RepositoryID: 60, FinishedAt: hoursAgo(3), UploadSize: size(100)},
+		shared.Upload{ID: 13, RepositoryID: 60, FinishedAt: hoursAgo(4), UploadSize: size(100)}, // expired, referenced by 10
+		shared.Upload{ID: 14, RepositoryID: 60, FinishedAt: hoursAgo(5), UploadSize: size(100)}, // expired
+		shared.Upload{ID: 15, RepositoryID: 60, FinishedAt: hoursAgo(6), UploadSize: size(100)}, // non-canonical provider of p1
+	)
+	insertVisibleAtTip(t, db, 60, 10)
+	insertPackages(t, store, []shared.Package{
+		{DumpID: 11, Scheme: "test", Name: "p1", Version: "1.2.3"},
+		{DumpID: 13, Scheme: "test", Name: "p2", Version: "1.2.3"},
+		{DumpID: 14, Scheme: "test", Name: "p3", Version: "1.2.3"},
+		{DumpID: 15, Scheme: "test", Name: "p1", Version: "1.2.3"},
+	})
+	insertPackageReferences(t, store, []shared.PackageReference{
+		{Package: shared.Package{DumpID: 10, Scheme: "test", Name: "p1", Version: "1.2.3"}},
+		{Package: shared.Package{DumpID: 10, Scheme: "test", Name: "p2", Version: "1.2.3"}},
+	})
+
+	query := sqlf.Sprintf(`INSERT INTO lsif_dirty_repositories (repository_id, update_token, dirty_token, updated_at) VALUES (60, 10, 10, %s)`, now)
+	if _, err := db.ExecContext(ctx, query.Query(sqlf.PostgresBindVar), query.Args()...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := store.UpdateUploadRetention(ctx, []int{}, []int{13, 14}); err != nil {
+		t.Fatalf("unexpected error marking uploads as expired: %s", err)
+	}
+
+	// Uploads 11 and 13 are never deleted, so they are never candidates but occupy the budget first;
+	// upload 14 is about to be deleted and occupies nothing. Upload 15 also provides p1, but upload 11
+	// is its canonical provider, so upload 15 would be deleted once expired.
+	testCases := []struct {
+		name               string
+		opts               shared.GetUploadsOverRetentionBudgetOptions
+		expectedIDs        []int
+		expectedTotalCount int
+		expectedTotalSize  int64
+	}{
+		{"retained uploads fit", shared.GetUploadsOverRetentionBudgetOptions{RepositoryBudget: 350}, []int{15, 12}, 2, 200},
+		{"retained uploads over budget", shared.GetUploadsOverRetentionBudgetOptions{RepositoryBudget: 150}, []int{15, 12, 10}, 3, 300},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			candidates, totalCount, totalSize, err := store.GetUploadsOverRetentionBudget(ctx, testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error getting uploads over budget: %s", err)
+			}
+
+			var ids []int
+			for _, candidate := range candidates {
+				ids = append(ids, candidate.UploadID)
+			}
+			if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+				t.Errorf("unexpected upload identifiers (-want +got):\n%s", diff)
+			}
+			if totalCount != testCase.expectedTotalCount {
+				t.Errorf("unexpected total count. want=%d have=%d", testCase.expectedTotalCount, totalCount)
+			}
+			if totalSize != testCase.expectedTotalSize {
+				t.Errorf("unexpected total size. want=%d have=%d", testCase.expectedTotalSize, totalSize)
+			}
+		})
+	}
+}
+
 func TestSoftDeleteExpiredUploadsViaTraversal(t *testing.T) {
 	logger := logtest.Scoped(t)
 	db := database.NewDB(logger, dbtest.NewDB(logger, t))
@@ -0,0 +1,230 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+// SetRepositoriesForCoverageScan returns a set of cloned repository identifiers whose language
+// coverage should be (re)computed. Repositories that were returned previously from this call within
+// the given process delay are not returned.
+func (s *store) SetRepositoriesForCoverageScan(ctx context.Context, processDelay time.Duration, limit int) (_ []int, err error) {
+	ctx, _, endObservation := s.operations.setRepositoriesForCoverageScan.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	now := timeutil.Now()
+
+	return basestore.ScanInts(s.db.Query(ctx, sqlf.Sprintf(
+		repositoryIDsForCoverageScanQuery,
+		now,
+		int(processDelay/time.Second),
+		limit,
+		now,
+		now,
+	)))
+}
+
+const repositoryIDsForCoverageScanQuery = `
+WITH repositories AS (
+	SELECT r.id
+	FROM repo r
+	JOIN gitserver_repos gr ON gr.repo_id = r.id
+	LEFT JOIN codeintel_last_coverage_scan lcs ON lcs.repository_id = r.id
+	WHERE
+		r.deleted_at IS NULL AND
+		r.blocked IS NULL AND
+		gr.clone_status = 'cloned' AND
+
+		-- Ignore records that have been checked recently. Note this condition is
+		-- true for a null last_coverage_scan_at (which has never been checked).
+		(%s - lcs.last_coverage_scan_at > (%s * '1 second'::interval)) IS DISTINCT FROM FALSE
+	ORDER BY
+		lcs.last_coverage_scan_at NULLS FIRST,
+		r.id -- tie breaker
+	LIMIT %s
+)
+INSERT INTO codeintel_last_coverage_scan (repository_id, last_coverage_scan_at)
+SELECT r.id, %s::timestamp FROM repositories r
+ON CONFLICT (repository_id) DO UPDATE
+SET last_coverage_scan_at = %s
+RETURNING repository_id
+`
+
+// UpdateLanguageCoverage replaces the language coverage of the repository with the given identifier.
+func (s *store) UpdateLanguageCoverage(ctx context.Context, repositoryID int, coverage []shared.LanguageCoverage) (err error) {
+	ctx, _, endObservation := s.operations.updateLanguageCoverage.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.Int("numLanguages", len(coverage)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.withTransaction(ctx, func(tx *store) error {
+		if err := tx.db.Exec(ctx, sqlf.Sprintf(updateLanguageCoverageTemporaryTableQuery)); err != nil {
+			return err
+		}
+
+		if err := batch.WithInserter(
+			ctx,
+			tx.db.Handle(),
+			"t_codeintel_language_coverage",
+			batch.MaxNumPostgresParameters,
+			[]string{"language", "commit", "total_bytes", "total_lines", "state", "indexers", "failure_reason", "last_indexed_at"},
+			func(inserter *batch.Inserter) error {
+				for _, c := range coverage {
+					if err := inserter.Insert(
+						ctx,
+						c.Language,
+						c.Commit,
+						c.TotalBytes,
+						c.TotalLines,
+						c.State,
+						pq.Array(c.Indexers),
+						c.FailureReason,
+						c.LastIndexedAt,
+					); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		); err != nil {
+			return err
+		}
+
+		return tx.db.Exec(ctx, sqlf.Sprintf(updateLanguageCoverageQuery, repositoryID, repositoryID))
+	})
+}
+
+const updateLanguageCoverageTemporaryTableQuery = `
+CREATE TEMPORARY TABLE IF NOT EXISTS t_codeintel_language_coverage (
+	language text NOT NULL,
+	commit text NOT NULL,
+	total_bytes bigint NOT NULL,
+	total_lines bigint NOT NULL,
+	state text NOT NULL,
+	indexers text[] NOT NULL,
+	failure_reason text,
+	last_indexed_at timestamp with time zone
+) ON COMMIT DROP
+`
+
+const updateLanguageCoverageQuery = `
+WITH
+upserted AS (
+	INSERT INTO codeintel_language_coverage (repository_id, language, commit, total_bytes, total_lines, state, indexers, failure_reason, last_indexed_at, updated_at)
+	SELECT %s, t.language, t.commit, t.total_bytes, t.total_lines, t.state, t.indexers, t.failure_reason, t.last_indexed_at, NOW()
+	FROM t_codeintel_language_coverage t
+	ON CONFLICT (repository_id, language) DO UPDATE SET
+		commit = EXCLUDED.commit,
+		total_bytes = EXCLUDED.total_bytes,
+		total_lines = EXCLUDED.total_lines,
+		state = EXCLUDED.state,
+		indexers = EXCLUDED.indexers,
+		failure_reason = EXCLUDED.failure_reason,
+		last_indexed_at = EXCLUDED.last_indexed_at,
+		updated_at = EXCLUDED.updated_at
+	RETURNING 1
+)
+DELETE FROM codeintel_language_coverage
+WHERE
+	repository_id = %s AND
+	language NOT IN (SELECT t.language FROM t_codeintel_language_coverage t)
+`
+
+// GetLanguageCoverage returns the language coverage records matching the given options, along with
+// the total number of matching records. Only records of repositories visible to the current actor
+// are returned.
+func (s *store) GetLanguageCoverage(ctx context.Context, opts shared.GetLanguageCoverageOptions) (_ []shared.LanguageCoverage, totalCount int, err error) {
+	ctx, _, endObservation := s.operations.getLanguageCoverage.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", opts.RepositoryID),
+		attribute.String("language", opts.Language),
+		attribute.StringSlice("states", opts.States),
+		attribute.Int("limit", opts.Limit),
+		attribute.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := []*sqlf.Query{sqlf.Sprintf("repo.deleted_at IS NULL"), sqlf.Sprintf("repo.blocked IS NULL")}
+	if opts.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("c.repository_id = %s", opts.RepositoryID))
+	}
+	if opts.Language != "" {
+		conds = append(conds, sqlf.Sprintf("c.language = %s", opts.Language))
+	}
+	if len(opts.States) > 0 {
+		conds = append(conds, sqlf.Sprintf("c.state = ANY(%s)", pq.Array(opts.States)))
+	}
+
+	authzConds, err := database.AuthzQueryConds(ctx, database.NewDBWith(s.logger, s.db))
+	if err != nil {
+		return nil, 0, err
+	}
+	conds = append(conds, authzConds)
+
+	limitExpr := sqlf.Sprintf("")
+	if opts.Limit > 0 {
+		limitExpr = sqlf.Sprintf("LIMIT %s", opts.Limit)
+	}
+
+	return scanLanguageCoverage(s.db.Query(ctx, sqlf.Sprintf(
+		getLanguageCoverageQuery,
+		sqlf.Join(conds, " AND "),
+		limitExpr,
+		opts.Offset,
+	)))
+}
+
+const getLanguageCoverageQuery = `
+SELECT
+	c.repository_id,
+	repo.name,
+	c.language,
+	c.commit,
+	c.total_bytes,
+	c.total_lines,
+	c.state,
+	c.indexers,
+	c.failure_reason,
+	c.last_indexed_at,
+	c.updated_at,
+	COUNT(*) OVER() AS count
+FROM codeintel_language_coverage c
+JOIN repo ON repo.id = c.repository_id
+WHERE %s
+ORDER BY repo.name, c.total_bytes DESC, c.language
+%s
+OFFSET %s
+`
+
+var scanLanguageCoverage = basestore.NewSliceWithCountScanner(func(s dbutil.Scanner) (c shared.LanguageCoverage, count int, _ error) {
+	err := s.Scan(
+		&c.RepositoryID,
+		&c.RepositoryName,
+		&c.Language,
+		&c.Commit,
+		&c.TotalBytes,
+		&c.TotalLines,
+		&c.State,
+		pq.Array(&c.Indexers),
+		&c.FailureReason,
+		&c.LastIndexedAt,
+		&c.UpdatedAt,
+		&count,
+	)
+	return c, count, err
+})
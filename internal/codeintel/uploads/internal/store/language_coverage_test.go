@@ -0,0 +1,108 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestSetRepositoriesForCoverageScan(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	insertRepo(t, db, 50, "", false)
+	insertRepo(t, db, 51, "", false)
+	insertRepo(t, db, 52, "DELETED-52", false)
+
+	ctx := context.Background()
+
+	if repositoryIDs, err := store.SetRepositoriesForCoverageScan(ctx, time.Hour, 1); err != nil {
+		t.Fatalf("unexpected error selecting repositories: %s", err)
+	} else if diff := cmp.Diff([]int{50}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repository identifiers (-want +got):\n%s", diff)
+	}
+
+	// Repository 50 was scanned recently; deleted repository 52 is never selected
+	if repositoryIDs, err := store.SetRepositoriesForCoverageScan(ctx, time.Hour, 10); err != nil {
+		t.Fatalf("unexpected error selecting repositories: %s", err)
+	} else if diff := cmp.Diff([]int{51}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repository identifiers (-want +got):\n%s", diff)
+	}
+
+	if repositoryIDs, err := store.SetRepositoriesForCoverageScan(ctx, time.Hour, 10); err != nil {
+		t.Fatalf("unexpected error selecting repositories: %s", err)
+	} else if len(repositoryIDs) != 0 {
+		t.Errorf("unexpected repository identifiers: %v", repositoryIDs)
+	}
+}
+
+func TestUpdateLanguageCoverage(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	store := New(&observation.TestContext, db)
+
+	insertRepo(t, db, 50, "github.com/foo/bar", false)
+	insertRepo(t, db, 51, "github.com/foo/baz", false)
+
+	ctx := context.Background()
+	failureMessage := "exit status 1"
+	lastIndexedAt := time.Unix(1694950000, 0).UTC()
+
+	if err := store.UpdateLanguageCoverage(ctx, 50, []shared.LanguageCoverage{
+		{Language: "Go", Commit: makeCommit(1), TotalBytes: 5000, TotalLines: 200, State: shared.LanguageCoverageStateIndexed, Indexers: []string{"scip-go"}, LastIndexedAt: &lastIndexedAt},
+		{Language: "Python", Commit: makeCommit(1), TotalBytes: 2000, TotalLines: 80, State: shared.LanguageCoverageStateUnindexed, Indexers: []string{}},
+	}); err != nil {
+		t.Fatalf("unexpected error updating coverage: %s", err)
+	}
+	if err := store.UpdateLanguageCoverage(ctx, 51, []shared.LanguageCoverage{
+		{Language: "TypeScript", Commit: makeCommit(2), TotalBytes: 3000, TotalLines: 100, State: shared.LanguageCoverageStateFailed, Indexers: []string{"scip-typescript"}, FailureReason: &failureMessage},
+	}); err != nil {
+		t.Fatalf("unexpected error updating coverage: %s", err)
+	}
+
+	// Python is no longer part of the repository
+	if err := store.UpdateLanguageCoverage(ctx, 50, []shared.LanguageCoverage{
+		{Language: "Go", Commit: makeCommit(3), TotalBytes: 6000, TotalLines: 250, State: shared.LanguageCoverageStateStale, Indexers: []string{"scip-go"}, LastIndexedAt: &lastIndexedAt},
+	}); err != nil {
+		t.Fatalf("unexpected error updating coverage: %s", err)
+	}
+
+	expectedGo := shared.LanguageCoverage{RepositoryID: 50, RepositoryName: "github.com/foo/bar", Language: "Go", Commit: makeCommit(3), TotalBytes: 6000, TotalLines: 250, State: shared.LanguageCoverageStateStale, Indexers: []string{"scip-go"}, LastIndexedAt: &lastIndexedAt}
+	expectedTypeScript := shared.LanguageCoverage{RepositoryID: 51, RepositoryName: "github.com/foo/baz", Language: "TypeScript", Commit: makeCommit(2), TotalBytes: 3000, TotalLines: 100, State: shared.LanguageCoverageStateFailed, Indexers: []string{"scip-typescript"}, FailureReason: &failureMessage}
+
+	testCases := []struct {
+		opts               shared.GetLanguageCoverageOptions
+		expectedCoverage   []shared.LanguageCoverage
+		expectedTotalCount int
+	}{
+		{shared.GetLanguageCoverageOptions{}, []shared.LanguageCoverage{expectedGo, expectedTypeScript}, 2},
+		{shared.GetLanguageCoverageOptions{Limit: 1}, []shared.LanguageCoverage{expectedGo}, 2},
+		{shared.GetLanguageCoverageOptions{Limit: 1, Offset: 1}, []shared.LanguageCoverage{expectedTypeScript}, 2},
+		{shared.GetLanguageCoverageOptions{RepositoryID: 51}, []shared.LanguageCoverage{expectedTypeScript}, 1},
+		{shared.GetLanguageCoverageOptions{Language: "Go"}, []shared.LanguageCoverage{expectedGo}, 1},
+		{shared.GetLanguageCoverageOptions{States: []string{shared.LanguageCoverageStateFailed, shared.LanguageCoverageStateUnindexed}}, []shared.LanguageCoverage{expectedTypeScript}, 1},
+	}
+
+	for _, testCase := range testCases {
+		coverage, totalCount, err := store.GetLanguageCoverage(ctx, testCase.opts)
+		if err != nil {
+			t.Fatalf("unexpected error getting coverage: %s", err)
+		}
+		if totalCount != testCase.expectedTotalCount {
+			t.Errorf("unexpected total count for %+v. want=%d have=%d", testCase.opts, testCase.expectedTotalCount, totalCount)
+		}
+		if diff := cmp.Diff(testCase.expectedCoverage, coverage, cmpopts.IgnoreFields(shared.LanguageCoverage{}, "UpdatedAt")); diff != "" {
+			t.Errorf("unexpected coverage for %+v (-want +got):\n%s", testCase.opts, diff)
+		}
+	}
+}
@@ -47,6 +47,7 @@ type operations struct {
 	persistNearestUploadsLinks           *observation.Operation
 	persistUploadsVisibleAtTip           *observation.Operation
 	updateUploadRetention                *observation.Operation
+	getUploadsOverRetentionBudget        *observation.Operation
 	updateCommittedAt                    *observation.Operation
 	sourcedCommitsWithoutCommittedAt     *observation.Operation
 	deleteUploadsWithoutRepository       *observation.Operation
@@ -94,6 +95,11 @@ type operations struct {
 	reindexIndexes             *observation.Operation
 	processStaleSourcedCommits *observation.Operation
 	expireFailedRecords        *observation.Operation
+
+	// Language coverage
+	setRepositoriesForCoverageScan *observation.Operation
+	updateLanguageCoverage         *observation.Operation
+	getLanguageCoverage            *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -149,6 +155,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getVisibleUploadsMatchingMonikers:    op("GetVisibleUploadsMatchingMonikers"),
 		updateUploadsVisibleToCommits:        op("UpdateUploadsVisibleToCommits"),
 		updateUploadRetention:                op("UpdateUploadRetention"),
+		getUploadsOverRetentionBudget:        op("GetUploadsOverRetentionBudget"),
 		updateCommittedAt:                    op("UpdateCommittedAt"),
 		sourcedCommitsWithoutCommittedAt:     op("SourcedCommitsWithoutCommittedAt"),
 		deleteUploadsStuckUploading:          op("DeleteUploadsStuckUploading"),
@@ -204,5 +211,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 		repositoryIDsWithErrors:             op("RepositoryIDsWithErrors"),
 		numRepositoriesWithCodeIntelligence: op("NumRepositoriesWithCodeIntelligence"),
 		getRecentIndexesSummary:             op("GetRecentIndexesSummary"),
+
+		// Language coverage
+		setRepositoriesForCoverageScan: op("SetRepositoriesForCoverageScan"),
+		updateLanguageCoverage:         op("UpdateLanguageCoverage"),
+		getLanguageCoverage:            op("GetLanguageCoverage"),
 	}
 }
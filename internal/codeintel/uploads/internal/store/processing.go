@@ -44,6 +44,7 @@ func (s *store) InsertUpload(ctx context.Context, upload shared.Upload) (id int,
 			upload.AssociatedIndexID,
 			upload.ContentType,
 			upload.UncompressedSize,
+			upload.BaseUploadID,
 		),
 	))
 
@@ -63,8 +64,9 @@ INSERT INTO lsif_uploads (
 	upload_size,
 	associated_index_id,
 	content_type,
-	uncompressed_size
-) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+	uncompressed_size,
+	base_upload_id
+) VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 RETURNING id
 `
 
@@ -216,6 +218,7 @@ var uploadColumnsWithNullRank = []*sqlf.Query{
 	sqlf.Sprintf("u.should_reindex"),
 	sqlf.Sprintf("NULL"),
 	sqlf.Sprintf("u.uncompressed_size"),
+	sqlf.Sprintf("u.base_upload_id"),
 }
 
 var UploadWorkerStoreOptions = dbworkerstore.Options[shared.Upload]{
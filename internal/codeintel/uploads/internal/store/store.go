@@ -78,6 +78,7 @@ type Store interface {
 	GetLastUploadRetentionScanForRepository(ctx context.Context, repositoryID int) (*time.Time, error)
 	SetRepositoriesForRetentionScan(ctx context.Context, processDelay time.Duration, limit int) ([]int, error)
 	UpdateUploadRetention(ctx context.Context, protectedIDs, expiredIDs []int) error
+	GetUploadsOverRetentionBudget(ctx context.Context, opts shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
 	SoftDeleteExpiredUploads(ctx context.Context, batchSize int) (int, int, error)
 	SoftDeleteExpiredUploadsViaTraversal(ctx context.Context, maxTraversal int) (int, int, error)
 
@@ -97,6 +98,11 @@ type Store interface {
 	ExpireFailedRecords(ctx context.Context, batchSize int, failedIndexMaxAge time.Duration, now time.Time) (int, int, error)
 	ProcessSourcedCommits(ctx context.Context, minimumTimeSinceLastCheck time.Duration, commitResolverMaximumCommitLag time.Duration, limit int, f func(ctx context.Context, repositoryID int, repositoryName, commit string) (bool, error), now time.Time) (int, int, error)
 
+	// Language coverage
+	SetRepositoriesForCoverageScan(ctx context.Context, processDelay time.Duration, limit int) ([]int, error)
+	UpdateLanguageCoverage(ctx context.Context, repositoryID int, coverage []shared.LanguageCoverage) error
+	GetLanguageCoverage(ctx context.Context, opts shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+
 	// Misc
 	HasRepository(ctx context.Context, repositoryID int) (bool, error)
 	HasCommit(ctx context.Context, repositoryID int, commit string) (bool, error)
@@ -108,6 +108,9 @@ type MockStore struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *StoreGetIndexesByIDsFunc
+	// GetLanguageCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLanguageCoverage.
+	GetLanguageCoverageFunc *StoreGetLanguageCoverageFunc
 	// GetLastUploadRetentionScanForRepositoryFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetLastUploadRetentionScanForRepository.
@@ -142,6 +145,10 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetUploadsByIDsAllowDeleted.
 	GetUploadsByIDsAllowDeletedFunc *StoreGetUploadsByIDsAllowDeletedFunc
+	// GetUploadsOverRetentionBudgetFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUploadsOverRetentionBudget.
+	GetUploadsOverRetentionBudgetFunc *StoreGetUploadsOverRetentionBudgetFunc
 	// GetVisibleUploadsMatchingMonikersFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetVisibleUploadsMatchingMonikers.
@@ -203,6 +210,10 @@ type MockStore struct {
 	// RepositoryIDsWithErrorsFunc is an instance of a mock function object
 	// controlling the behavior of the method RepositoryIDsWithErrors.
 	RepositoryIDsWithErrorsFunc *StoreRepositoryIDsWithErrorsFunc
+	// SetRepositoriesForCoverageScanFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetRepositoriesForCoverageScan.
+	SetRepositoriesForCoverageScanFunc *StoreSetRepositoriesForCoverageScanFunc
 	// SetRepositoriesForRetentionScanFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// SetRepositoriesForRetentionScan.
@@ -224,6 +235,9 @@ type MockStore struct {
 	// UpdateCommittedAtFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateCommittedAt.
 	UpdateCommittedAtFunc *StoreUpdateCommittedAtFunc
+	// UpdateLanguageCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateLanguageCoverage.
+	UpdateLanguageCoverageFunc *StoreUpdateLanguageCoverageFunc
 	// UpdatePackageReferencesFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdatePackageReferences.
 	UpdatePackageReferencesFunc *StoreUpdatePackageReferencesFunc
@@ -364,6 +378,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) (r0 []shared.LanguageCoverage, r1 int, r2 error) {
+				return
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (r0 *time.Time, r1 error) {
 				return
@@ -414,6 +433,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) (r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+				return
+			},
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (r0 shared.PackageReferenceScanner, r1 int, r2 error) {
 				return
@@ -509,6 +533,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: func(context.Context, time.Duration, int) (r0 []int, r1 error) {
+				return
+			},
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: func(context.Context, time.Duration, int) (r0 []int, r1 error) {
 				return
@@ -539,6 +568,11 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: func(context.Context, int, []shared.LanguageCoverage) (r0 error) {
+				return
+			},
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: func(context.Context, int, []precise.PackageReference) (r0 error) {
 				return
@@ -691,6 +725,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetIndexesByIDs")
 			},
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+				panic("unexpected invocation of MockStore.GetLanguageCoverage")
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (*time.Time, error) {
 				panic("unexpected invocation of MockStore.GetLastUploadRetentionScanForRepository")
@@ -741,6 +780,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetUploadsByIDsAllowDeleted")
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+				panic("unexpected invocation of MockStore.GetUploadsOverRetentionBudget")
+			},
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (shared.PackageReferenceScanner, int, error) {
 				panic("unexpected invocation of MockStore.GetVisibleUploadsMatchingMonikers")
@@ -836,6 +880,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.RepositoryIDsWithErrors")
 			},
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: func(context.Context, time.Duration, int) ([]int, error) {
+				panic("unexpected invocation of MockStore.SetRepositoriesForCoverageScan")
+			},
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: func(context.Context, time.Duration, int) ([]int, error) {
 				panic("unexpected invocation of MockStore.SetRepositoriesForRetentionScan")
@@ -866,6 +915,11 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.UpdateCommittedAt")
 			},
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: func(context.Context, int, []shared.LanguageCoverage) error {
+				panic("unexpected invocation of MockStore.UpdateLanguageCoverage")
+			},
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: func(context.Context, int, []precise.PackageReference) error {
 				panic("unexpected invocation of MockStore.UpdatePackageReferences")
@@ -972,6 +1026,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetIndexesByIDsFunc: &StoreGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetLanguageCoverageFunc: &StoreGetLanguageCoverageFunc{
+			defaultHook: i.GetLanguageCoverage,
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &StoreGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: i.GetLastUploadRetentionScanForRepository,
 		},
@@ -1002,6 +1059,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetUploadsByIDsAllowDeletedFunc: &StoreGetUploadsByIDsAllowDeletedFunc{
 			defaultHook: i.GetUploadsByIDsAllowDeleted,
 		},
+		GetUploadsOverRetentionBudgetFunc: &StoreGetUploadsOverRetentionBudgetFunc{
+			defaultHook: i.GetUploadsOverRetentionBudget,
+		},
 		GetVisibleUploadsMatchingMonikersFunc: &StoreGetVisibleUploadsMatchingMonikersFunc{
 			defaultHook: i.GetVisibleUploadsMatchingMonikers,
 		},
@@ -1059,6 +1119,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		RepositoryIDsWithErrorsFunc: &StoreRepositoryIDsWithErrorsFunc{
 			defaultHook: i.RepositoryIDsWithErrors,
 		},
+		SetRepositoriesForCoverageScanFunc: &StoreSetRepositoriesForCoverageScanFunc{
+			defaultHook: i.SetRepositoriesForCoverageScan,
+		},
 		SetRepositoriesForRetentionScanFunc: &StoreSetRepositoriesForRetentionScanFunc{
 			defaultHook: i.SetRepositoriesForRetentionScan,
 		},
@@ -1077,6 +1140,9 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		UpdateCommittedAtFunc: &StoreUpdateCommittedAtFunc{
 			defaultHook: i.UpdateCommittedAt,
 		},
+		UpdateLanguageCoverageFunc: &StoreUpdateLanguageCoverageFunc{
+			defaultHook: i.UpdateLanguageCoverage,
+		},
 		UpdatePackageReferencesFunc: &StoreUpdatePackageReferencesFunc{
 			defaultHook: i.UpdatePackageReferences,
 		},
@@ -3669,6 +3735,117 @@ func (c StoreGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetLanguageCoverageFunc describes the behavior when the
+// GetLanguageCoverage method of the parent MockStore instance is invoked.
+type StoreGetLanguageCoverageFunc struct {
+	defaultHook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	hooks       []func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	history     []StoreGetLanguageCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLanguageCoverage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetLanguageCoverage(v0 context.Context, v1 shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	r0, r1, r2 := m.GetLanguageCoverageFunc.nextHook()(v0, v1)
+	m.GetLanguageCoverageFunc.appendCall(StoreGetLanguageCoverageFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetLanguageCoverage
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetLanguageCoverageFunc) SetDefaultHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLanguageCoverage method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetLanguageCoverageFunc) PushHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetLanguageCoverageFunc) SetDefaultReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetLanguageCoverageFunc) PushReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.PushHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *StoreGetLanguageCoverageFunc) nextHook() func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetLanguageCoverageFunc) appendCall(r0 StoreGetLanguageCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetLanguageCoverageFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetLanguageCoverageFunc) History() []StoreGetLanguageCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetLanguageCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetLanguageCoverageFuncCall is an object that describes an
+// invocation of method GetLanguageCoverage on an instance of MockStore.
+type StoreGetLanguageCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetLanguageCoverageOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.LanguageCoverage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetLanguageCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetLanguageCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // StoreGetLastUploadRetentionScanForRepositoryFunc describes the behavior
 // when the GetLastUploadRetentionScanForRepository method of the parent
 // MockStore instance is invoked.
@@ -4807,6 +4984,123 @@ func (c StoreGetUploadsByIDsAllowDeletedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetUploadsOverRetentionBudgetFunc describes the behavior when the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance is
+// invoked.
+type StoreGetUploadsOverRetentionBudgetFunc struct {
+	defaultHook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	hooks       []func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	history     []StoreGetUploadsOverRetentionBudgetFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadsOverRetentionBudget delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetUploadsOverRetentionBudget(v0 context.Context, v1 shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	r0, r1, r2, r3 := m.GetUploadsOverRetentionBudgetFunc.nextHook()(v0, v1)
+	m.GetUploadsOverRetentionBudgetFunc.appendCall(StoreGetUploadsOverRetentionBudgetFuncCall{v0, v1, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) SetDefaultHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadsOverRetentionBudget method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) PushHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) SetDefaultReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) PushReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.PushHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *StoreGetUploadsOverRetentionBudgetFunc) nextHook() func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetUploadsOverRetentionBudgetFunc) appendCall(r0 StoreGetUploadsOverRetentionBudgetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetUploadsOverRetentionBudgetFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetUploadsOverRetentionBudgetFunc) History() []StoreGetUploadsOverRetentionBudgetFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetUploadsOverRetentionBudgetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetUploadsOverRetentionBudgetFuncCall is an object that describes an
+// invocation of method GetUploadsOverRetentionBudget on an instance of
+// MockStore.
+type StoreGetUploadsOverRetentionBudgetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetUploadsOverRetentionBudgetOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RetentionBudgetCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 int64
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetUploadsOverRetentionBudgetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetUploadsOverRetentionBudgetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+}
+
 // StoreGetVisibleUploadsMatchingMonikersFunc describes the behavior when
 // the GetVisibleUploadsMatchingMonikers method of the parent MockStore
 // instance is invoked.
@@ -6901,37 +7195,37 @@ func (c StoreRepositoryIDsWithErrorsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// StoreSetRepositoriesForRetentionScanFunc describes the behavior when the
-// SetRepositoriesForRetentionScan method of the parent MockStore instance
-// is invoked.
-type StoreSetRepositoriesForRetentionScanFunc struct {
+// StoreSetRepositoriesForCoverageScanFunc describes the behavior when the
+// SetRepositoriesForCoverageScan method of the parent MockStore instance is
+// invoked.
+type StoreSetRepositoriesForCoverageScanFunc struct {
 	defaultHook func(context.Context, time.Duration, int) ([]int, error)
 	hooks       []func(context.Context, time.Duration, int) ([]int, error)
-	history     []StoreSetRepositoriesForRetentionScanFuncCall
+	history     []StoreSetRepositoriesForCoverageScanFuncCall
 	mutex       sync.Mutex
 }
 
-// SetRepositoriesForRetentionScan delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockStore) SetRepositoriesForRetentionScan(v0 context.Context, v1 time.Duration, v2 int) ([]int, error) {
-	r0, r1 := m.SetRepositoriesForRetentionScanFunc.nextHook()(v0, v1, v2)
-	m.SetRepositoriesForRetentionScanFunc.appendCall(StoreSetRepositoriesForRetentionScanFuncCall{v0, v1, v2, r0, r1})
+// SetRepositoriesForCoverageScan delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) SetRepositoriesForCoverageScan(v0 context.Context, v1 time.Duration, v2 int) ([]int, error) {
+	r0, r1 := m.SetRepositoriesForCoverageScanFunc.nextHook()(v0, v1, v2)
+	m.SetRepositoriesForCoverageScanFunc.appendCall(StoreSetRepositoriesForCoverageScanFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// SetRepositoriesForRetentionScan method of the parent MockStore instance
-// is invoked and the hook queue is empty.
-func (f *StoreSetRepositoriesForRetentionScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+// SetRepositoriesForCoverageScan method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreSetRepositoriesForCoverageScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetRepositoriesForRetentionScan method of the parent MockStore instance
+// SetRepositoriesForCoverageScan method of the parent MockStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *StoreSetRepositoriesForRetentionScanFunc) PushHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+func (f *StoreSetRepositoriesForCoverageScanFunc) PushHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6939,20 +7233,20 @@ func (f *StoreSetRepositoriesForRetentionScanFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreSetRepositoriesForRetentionScanFunc) SetDefaultReturn(r0 []int, r1 error) {
+func (f *StoreSetRepositoriesForCoverageScanFunc) SetDefaultReturn(r0 []int, r1 error) {
 	f.SetDefaultHook(func(context.Context, time.Duration, int) ([]int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreSetRepositoriesForRetentionScanFunc) PushReturn(r0 []int, r1 error) {
+func (f *StoreSetRepositoriesForCoverageScanFunc) PushReturn(r0 []int, r1 error) {
 	f.PushHook(func(context.Context, time.Duration, int) ([]int, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreSetRepositoriesForRetentionScanFunc) nextHook() func(context.Context, time.Duration, int) ([]int, error) {
+func (f *StoreSetRepositoriesForCoverageScanFunc) nextHook() func(context.Context, time.Duration, int) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6965,28 +7259,27 @@ func (f *StoreSetRepositoriesForRetentionScanFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *StoreSetRepositoriesForRetentionScanFunc) appendCall(r0 StoreSetRepositoriesForRetentionScanFuncCall) {
+func (f *StoreSetRepositoriesForCoverageScanFunc) appendCall(r0 StoreSetRepositoriesForCoverageScanFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// StoreSetRepositoriesForRetentionScanFuncCall objects describing the
-// invocations of this function.
-func (f *StoreSetRepositoriesForRetentionScanFunc) History() []StoreSetRepositoriesForRetentionScanFuncCall {
+// History returns a sequence of StoreSetRepositoriesForCoverageScanFuncCall
+// objects describing the invocations of this function.
+func (f *StoreSetRepositoriesForCoverageScanFunc) History() []StoreSetRepositoriesForCoverageScanFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreSetRepositoriesForRetentionScanFuncCall, len(f.history))
+	history := make([]StoreSetRepositoriesForCoverageScanFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreSetRepositoriesForRetentionScanFuncCall is an object that describes
-// an invocation of method SetRepositoriesForRetentionScan on an instance of
+// StoreSetRepositoriesForCoverageScanFuncCall is an object that describes
+// an invocation of method SetRepositoriesForCoverageScan on an instance of
 // MockStore.
-type StoreSetRepositoriesForRetentionScanFuncCall struct {
+type StoreSetRepositoriesForCoverageScanFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7006,36 +7299,151 @@ type StoreSetRepositoriesForRetentionScanFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreSetRepositoriesForRetentionScanFuncCall) Args() []interface{} {
+func (c StoreSetRepositoriesForCoverageScanFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreSetRepositoriesForRetentionScanFuncCall) Results() []interface{} {
+func (c StoreSetRepositoriesForCoverageScanFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreSetRepositoryAsDirtyFunc describes the behavior when the
-// SetRepositoryAsDirty method of the parent MockStore instance is invoked.
-type StoreSetRepositoryAsDirtyFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []StoreSetRepositoryAsDirtyFuncCall
+// StoreSetRepositoriesForRetentionScanFunc describes the behavior when the
+// SetRepositoriesForRetentionScan method of the parent MockStore instance
+// is invoked.
+type StoreSetRepositoriesForRetentionScanFunc struct {
+	defaultHook func(context.Context, time.Duration, int) ([]int, error)
+	hooks       []func(context.Context, time.Duration, int) ([]int, error)
+	history     []StoreSetRepositoriesForRetentionScanFuncCall
 	mutex       sync.Mutex
 }
 
-// SetRepositoryAsDirty delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockStore) SetRepositoryAsDirty(v0 context.Context, v1 int) error {
-	r0 := m.SetRepositoryAsDirtyFunc.nextHook()(v0, v1)
-	m.SetRepositoryAsDirtyFunc.appendCall(StoreSetRepositoryAsDirtyFuncCall{v0, v1, r0})
-	return r0
+// SetRepositoriesForRetentionScan delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockStore) SetRepositoriesForRetentionScan(v0 context.Context, v1 time.Duration, v2 int) ([]int, error) {
+	r0, r1 := m.SetRepositoriesForRetentionScanFunc.nextHook()(v0, v1, v2)
+	m.SetRepositoriesForRetentionScanFunc.appendCall(StoreSetRepositoriesForRetentionScanFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the SetRepositoryAsDirty
-// method of the parent MockStore instance is invoked and the hook queue is
-// empty.
+// SetDefaultHook sets function that is called when the
+// SetRepositoriesForRetentionScan method of the parent MockStore instance
+// is invoked and the hook queue is empty.
+func (f *StoreSetRepositoriesForRetentionScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetRepositoriesForRetentionScan method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreSetRepositoriesForRetentionScanFunc) PushHook(hook func(context.Context, time.Duration, int) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreSetRepositoriesForRetentionScanFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreSetRepositoriesForRetentionScanFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreSetRepositoriesForRetentionScanFunc) nextHook() func(context.Context, time.Duration, int) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreSetRepositoriesForRetentionScanFunc) appendCall(r0 StoreSetRepositoriesForRetentionScanFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// StoreSetRepositoriesForRetentionScanFuncCall objects describing the
+// invocations of this function.
+func (f *StoreSetRepositoriesForRetentionScanFunc) History() []StoreSetRepositoriesForRetentionScanFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreSetRepositoriesForRetentionScanFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreSetRepositoriesForRetentionScanFuncCall is an object that describes
+// an invocation of method SetRepositoriesForRetentionScan on an instance of
+// MockStore.
+type StoreSetRepositoriesForRetentionScanFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreSetRepositoriesForRetentionScanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreSetRepositoriesForRetentionScanFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreSetRepositoryAsDirtyFunc describes the behavior when the
+// SetRepositoryAsDirty method of the parent MockStore instance is invoked.
+type StoreSetRepositoryAsDirtyFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []StoreSetRepositoryAsDirtyFuncCall
+	mutex       sync.Mutex
+}
+
+// SetRepositoryAsDirty delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) SetRepositoryAsDirty(v0 context.Context, v1 int) error {
+	r0 := m.SetRepositoryAsDirtyFunc.nextHook()(v0, v1)
+	m.SetRepositoryAsDirtyFunc.appendCall(StoreSetRepositoryAsDirtyFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetRepositoryAsDirty
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
 func (f *StoreSetRepositoryAsDirtyFunc) SetDefaultHook(hook func(context.Context, int) error) {
 	f.defaultHook = hook
 }
@@ -7573,6 +7981,115 @@ func (c StoreUpdateCommittedAtFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreUpdateLanguageCoverageFunc describes the behavior when the
+// UpdateLanguageCoverage method of the parent MockStore instance is
+// invoked.
+type StoreUpdateLanguageCoverageFunc struct {
+	defaultHook func(context.Context, int, []shared.LanguageCoverage) error
+	hooks       []func(context.Context, int, []shared.LanguageCoverage) error
+	history     []StoreUpdateLanguageCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateLanguageCoverage delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) UpdateLanguageCoverage(v0 context.Context, v1 int, v2 []shared.LanguageCoverage) error {
+	r0 := m.UpdateLanguageCoverageFunc.nextHook()(v0, v1, v2)
+	m.UpdateLanguageCoverageFunc.appendCall(StoreUpdateLanguageCoverageFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateLanguageCoverage method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreUpdateLanguageCoverageFunc) SetDefaultHook(hook func(context.Context, int, []shared.LanguageCoverage) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateLanguageCoverage method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreUpdateLanguageCoverageFunc) PushHook(hook func(context.Context, int, []shared.LanguageCoverage) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpdateLanguageCoverageFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []shared.LanguageCoverage) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpdateLanguageCoverageFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []shared.LanguageCoverage) error {
+		return r0
+	})
+}
+
+func (f *StoreUpdateLanguageCoverageFunc) nextHook() func(context.Context, int, []shared.LanguageCoverage) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpdateLanguageCoverageFunc) appendCall(r0 StoreUpdateLanguageCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreUpdateLanguageCoverageFuncCall objects
+// describing the invocations of this function.
+func (f *StoreUpdateLanguageCoverageFunc) History() []StoreUpdateLanguageCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpdateLanguageCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpdateLanguageCoverageFuncCall is an object that describes an
+// invocation of method UpdateLanguageCoverage on an instance of MockStore.
+type StoreUpdateLanguageCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []shared.LanguageCoverage
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpdateLanguageCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpdateLanguageCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreUpdatePackageReferencesFunc describes the behavior when the
 // UpdatePackageReferences method of the parent MockStore instance is
 // invoked.
@@ -8399,6 +8916,9 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// DeleteUnreferencedDocuments.
 	DeleteUnreferencedDocumentsFunc *LSIFStoreDeleteUnreferencedDocumentsFunc
+	// GetDocumentPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDocumentPaths.
+	GetDocumentPathsFunc *LSIFStoreGetDocumentPathsFunc
 	// IDsWithMetaFunc is an instance of a mock function object controlling
 	// the behavior of the method IDsWithMeta.
 	IDsWithMetaFunc *LSIFStoreIDsWithMetaFunc
@@ -8419,6 +8939,9 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// ReconcileCandidatesWithTime.
 	ReconcileCandidatesWithTimeFunc *LSIFStoreReconcileCandidatesWithTimeFunc
+	// ScanDocumentsFunc is an instance of a mock function object
+	// controlling the behavior of the method ScanDocuments.
+	ScanDocumentsFunc *LSIFStoreScanDocumentsFunc
 	// WithTransactionFunc is an instance of a mock function object
 	// controlling the behavior of the method WithTransaction.
 	WithTransactionFunc *LSIFStoreWithTransactionFunc
@@ -8443,6 +8966,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) (r0 []string, r1 error) {
+				return
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) (r0 []int, r1 error) {
 				return
@@ -8473,6 +9001,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, func(path string, document *scip.Document) error) (r0 error) {
+				return
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) (r0 error) {
 				return
@@ -8500,6 +9033,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.DeleteUnreferencedDocuments")
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) ([]string, error) {
+				panic("unexpected invocation of MockLSIFStore.GetDocumentPaths")
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) ([]int, error) {
 				panic("unexpected invocation of MockLSIFStore.IDsWithMeta")
@@ -8530,6 +9068,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.ReconcileCandidatesWithTime")
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, func(path string, document *scip.Document) error) error {
+				panic("unexpected invocation of MockLSIFStore.ScanDocuments")
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) error {
 				panic("unexpected invocation of MockLSIFStore.WithTransaction")
@@ -8551,6 +9094,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		DeleteUnreferencedDocumentsFunc: &LSIFStoreDeleteUnreferencedDocumentsFunc{
 			defaultHook: i.DeleteUnreferencedDocuments,
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: i.GetDocumentPaths,
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: i.IDsWithMeta,
 		},
@@ -8569,6 +9115,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		ReconcileCandidatesWithTimeFunc: &LSIFStoreReconcileCandidatesWithTimeFunc{
 			defaultHook: i.ReconcileCandidatesWithTime,
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: i.ScanDocuments,
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: i.WithTransaction,
 		},
@@ -8921,6 +9470,114 @@ func (c LSIFStoreDeleteUnreferencedDocumentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// LSIFStoreGetDocumentPathsFunc describes the behavior when the
+// GetDocumentPaths method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreGetDocumentPathsFunc struct {
+	defaultHook func(context.Context, int) ([]string, error)
+	hooks       []func(context.Context, int) ([]string, error)
+	history     []LSIFStoreGetDocumentPathsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDocumentPaths delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) GetDocumentPaths(v0 context.Context, v1 int) ([]string, error) {
+	r0, r1 := m.GetDocumentPathsFunc.nextHook()(v0, v1)
+	m.GetDocumentPathsFunc.appendCall(LSIFStoreGetDocumentPathsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetDocumentPaths
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultHook(hook func(context.Context, int) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDocumentPaths method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreGetDocumentPathsFunc) PushHook(hook func(context.Context, int) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreGetDocumentPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreGetDocumentPathsFunc) nextHook() func(context.Context, int) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreGetDocumentPathsFunc) appendCall(r0 LSIFStoreGetDocumentPathsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreGetDocumentPathsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreGetDocumentPathsFunc) History() []LSIFStoreGetDocumentPathsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreGetDocumentPathsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreGetDocumentPathsFuncCall is an object that describes an
+// invocation of method GetDocumentPaths on an instance of MockLSIFStore.
+type LSIFStoreGetDocumentPathsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreGetDocumentPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreGetDocumentPathsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // LSIFStoreIDsWithMetaFunc describes the behavior when the IDsWithMeta
 // method of the parent MockLSIFStore instance is invoked.
 type LSIFStoreIDsWithMetaFunc struct {
@@ -9589,6 +10246,114 @@ func (c LSIFStoreReconcileCandidatesWithTimeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// LSIFStoreScanDocumentsFunc describes the behavior when the ScanDocuments
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreScanDocumentsFunc struct {
+	defaultHook func(context.Context, int, func(path string, document *scip.Document) error) error
+	hooks       []func(context.Context, int, func(path string, document *scip.Document) error) error
+	history     []LSIFStoreScanDocumentsFuncCall
+	mutex       sync.Mutex
+}
+
+// ScanDocuments delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) ScanDocuments(v0 context.Context, v1 int, v2 func(path string, document *scip.Document) error) error {
+	r0 := m.ScanDocumentsFunc.nextHook()(v0, v1, v2)
+	m.ScanDocumentsFunc.appendCall(LSIFStoreScanDocumentsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ScanDocuments method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultHook(hook func(context.Context, int, func(path string, document *scip.Document) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ScanDocuments method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreScanDocumentsFunc) PushHook(hook func(context.Context, int, func(path string, document *scip.Document) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, func(path string, document *scip.Document) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreScanDocumentsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, func(path string, document *scip.Document) error) error {
+		return r0
+	})
+}
+
+func (f *LSIFStoreScanDocumentsFunc) nextHook() func(context.Context, int, func(path string, document *scip.Document) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreScanDocumentsFunc) appendCall(r0 LSIFStoreScanDocumentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreScanDocumentsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreScanDocumentsFunc) History() []LSIFStoreScanDocumentsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreScanDocumentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreScanDocumentsFuncCall is an object that describes an invocation
+// of method ScanDocuments on an instance of MockLSIFStore.
+type LSIFStoreScanDocumentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(path string, document *scip.Document) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreScanDocumentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreScanDocumentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // LSIFStoreWithTransactionFunc describes the behavior when the
 // WithTransaction method of the parent MockLSIFStore instance is invoked.
 type LSIFStoreWithTransactionFunc struct {
@@ -243,3 +243,11 @@ func (s *Service) NumRepositoriesWithCodeIntelligence(ctx context.Context) (int,
 func (s *Service) RepositoryIDsWithErrors(ctx context.Context, offset, limit int) ([]uploadsshared.RepositoryWithCount, int, error) {
 	return s.store.RepositoryIDsWithErrors(ctx, offset, limit)
 }
+
+func (s *Service) GetUploadsOverRetentionBudget(ctx context.Context, opts shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	return s.store.GetUploadsOverRetentionBudget(ctx, opts)
+}
+
+func (s *Service) GetLanguageCoverage(ctx context.Context, opts shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	return s.store.GetLanguageCoverage(ctx, opts)
+}
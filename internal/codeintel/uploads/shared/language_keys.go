@@ -0,0 +1,34 @@
+package shared
+
+// languageKeys maps the names of languages detected by the inventory package to the language key
+// of the indexers that produce precise code intelligence for that language.
+var languageKeys = map[string]string{
+	"C":          "C++",
+	"C++":        "C++",
+	"C#":         "DotNet",
+	"F#":         "DotNet",
+	"Dart":       "Dart",
+	"Go":         "Go",
+	"Haskell":    "HIE",
+	"Jsonnet":    "Jsonnet",
+	"Java":       "JVM",
+	"Kotlin":     "JVM",
+	"Scala":      "JVM",
+	"OCaml":      "OCaml",
+	"PHP":        "PHP",
+	"Python":     "Python",
+	"Ruby":       "Ruby",
+	"Rust":       "Rust",
+	"HCL":        "Terraform",
+	"JavaScript": "TypeScript",
+	"TSX":        "TypeScript",
+	"TypeScript": "TypeScript",
+}
+
+// LanguageKeyForLanguage returns the language key of the indexers that support the given language
+// as named by the inventory package. If no known indexer supports the language, a false-valued
+// flag is returned.
+func LanguageKeyForLanguage(language string) (string, bool) {
+	key, ok := languageKeys[language]
+	return key, ok
+}
@@ -35,6 +35,7 @@ type Upload struct {
 	AssociatedIndexID *int
 	ContentType       string
 	ShouldReindex     bool
+	BaseUploadID      *int
 }
 
 func (u Upload) RecordID() int {
@@ -283,3 +284,58 @@ type UploadsWithRepositoryNamespace struct {
 	Indexer string
 	Uploads []Upload
 }
+
+// LanguageCoverage describes the precise code intelligence coverage of a single programming
+// language of the default branch of a repository.
+type LanguageCoverage struct {
+	RepositoryID   int
+	RepositoryName string
+	Language       string
+	Commit         string
+	TotalBytes     int64
+	TotalLines     int64
+	State          string
+	Indexers       []string
+	FailureReason  *string
+	LastIndexedAt  *time.Time
+	UpdatedAt      time.Time
+}
+
+const (
+	LanguageCoverageStateIndexed   = "indexed"
+	LanguageCoverageStateStale     = "stale"
+	LanguageCoverageStateFailed    = "failed"
+	LanguageCoverageStateUnindexed = "unindexed"
+)
+
+type GetLanguageCoverageOptions struct {
+	RepositoryID int
+	Language     string
+	States       []string
+	Limit        int
+	Offset       int
+}
+
+// RetentionBudgetCandidate is an upload that does not fit into the configured storage budget,
+// along with the facts used to rank its usefulness.
+type RetentionBudgetCandidate struct {
+	UploadID       int
+	RepositoryID   int
+	RepositoryName string
+	Commit         string
+	Root           string
+	Indexer        string
+	FinishedAt     *time.Time
+	Size           int64
+	VisibleAtTip   bool
+	ReferenceCount int
+}
+
+type GetUploadsOverRetentionBudgetOptions struct {
+	// RepositoryID restricts the returned candidates to a single repository. The global
+	// budget is still applied to the uploads of all repositories.
+	RepositoryID     int
+	GlobalBudget     int64
+	RepositoryBudget int64
+	Limit            int
+}
@@ -34,6 +34,8 @@ type UploadsService interface {
 	GetRecentIndexesSummary(ctx context.Context, repositoryID int) ([]uploadshared.IndexesWithRepositoryNamespace, error)
 	NumRepositoriesWithCodeIntelligence(ctx context.Context) (int, error)
 	RepositoryIDsWithErrors(ctx context.Context, offset, limit int) (_ []uploadshared.RepositoryWithCount, totalCount int, err error)
+	GetUploadsOverRetentionBudget(ctx context.Context, opts uploadshared.GetUploadsOverRetentionBudgetOptions) (_ []uploadshared.RetentionBudgetCandidate, totalCount int, totalSize int64, err error)
+	GetLanguageCoverage(ctx context.Context, opts uploadshared.GetLanguageCoverageOptions) (_ []uploadshared.LanguageCoverage, totalCount int, err error)
 }
 
 type AutoIndexingService interface {
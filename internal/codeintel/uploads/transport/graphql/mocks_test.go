@@ -49,6 +49,9 @@ type MockUploadsService struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *UploadsServiceGetIndexesByIDsFunc
+	// GetLanguageCoverageFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLanguageCoverage.
+	GetLanguageCoverageFunc *UploadsServiceGetLanguageCoverageFunc
 	// GetLastUploadRetentionScanForRepositoryFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetLastUploadRetentionScanForRepository.
@@ -68,6 +71,10 @@ type MockUploadsService struct {
 	// GetUploadsByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadsByIDs.
 	GetUploadsByIDsFunc *UploadsServiceGetUploadsByIDsFunc
+	// GetUploadsOverRetentionBudgetFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUploadsOverRetentionBudget.
+	GetUploadsOverRetentionBudgetFunc *UploadsServiceGetUploadsOverRetentionBudgetFunc
 	// NumRepositoriesWithCodeIntelligenceFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// NumRepositoriesWithCodeIntelligence.
@@ -143,6 +150,11 @@ func NewMockUploadsService() *MockUploadsService {
 				return
 			},
 		},
+		GetLanguageCoverageFunc: &UploadsServiceGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) (r0 []shared.LanguageCoverage, r1 int, r2 error) {
+				return
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &UploadsServiceGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (r0 *time.Time, r1 error) {
 				return
@@ -173,6 +185,11 @@ func NewMockUploadsService() *MockUploadsService {
 				return
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &UploadsServiceGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) (r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+				return
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &UploadsServiceNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (r0 int, r1 error) {
 				return
@@ -260,6 +277,11 @@ func NewStrictMockUploadsService() *MockUploadsService {
 				panic("unexpected invocation of MockUploadsService.GetIndexesByIDs")
 			},
 		},
+		GetLanguageCoverageFunc: &UploadsServiceGetLanguageCoverageFunc{
+			defaultHook: func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+				panic("unexpected invocation of MockUploadsService.GetLanguageCoverage")
+			},
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &UploadsServiceGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: func(context.Context, int) (*time.Time, error) {
 				panic("unexpected invocation of MockUploadsService.GetLastUploadRetentionScanForRepository")
@@ -290,6 +312,11 @@ func NewStrictMockUploadsService() *MockUploadsService {
 				panic("unexpected invocation of MockUploadsService.GetUploadsByIDs")
 			},
 		},
+		GetUploadsOverRetentionBudgetFunc: &UploadsServiceGetUploadsOverRetentionBudgetFunc{
+			defaultHook: func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+				panic("unexpected invocation of MockUploadsService.GetUploadsOverRetentionBudget")
+			},
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &UploadsServiceNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: func(context.Context) (int, error) {
 				panic("unexpected invocation of MockUploadsService.NumRepositoriesWithCodeIntelligence")
@@ -358,6 +385,9 @@ func NewMockUploadsServiceFrom(i UploadsService) *MockUploadsService {
 		GetIndexesByIDsFunc: &UploadsServiceGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetLanguageCoverageFunc: &UploadsServiceGetLanguageCoverageFunc{
+			defaultHook: i.GetLanguageCoverage,
+		},
 		GetLastUploadRetentionScanForRepositoryFunc: &UploadsServiceGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: i.GetLastUploadRetentionScanForRepository,
 		},
@@ -376,6 +406,9 @@ func NewMockUploadsServiceFrom(i UploadsService) *MockUploadsService {
 		GetUploadsByIDsFunc: &UploadsServiceGetUploadsByIDsFunc{
 			defaultHook: i.GetUploadsByIDs,
 		},
+		GetUploadsOverRetentionBudgetFunc: &UploadsServiceGetUploadsOverRetentionBudgetFunc{
+			defaultHook: i.GetUploadsOverRetentionBudget,
+		},
 		NumRepositoriesWithCodeIntelligenceFunc: &UploadsServiceNumRepositoriesWithCodeIntelligenceFunc{
 			defaultHook: i.NumRepositoriesWithCodeIntelligence,
 		},
@@ -1502,6 +1535,120 @@ func (c UploadsServiceGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// UploadsServiceGetLanguageCoverageFunc describes the behavior when the
+// GetLanguageCoverage method of the parent MockUploadsService instance is
+// invoked.
+type UploadsServiceGetLanguageCoverageFunc struct {
+	defaultHook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	hooks       []func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+	history     []UploadsServiceGetLanguageCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLanguageCoverage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadsService) GetLanguageCoverage(v0 context.Context, v1 shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	r0, r1, r2 := m.GetLanguageCoverageFunc.nextHook()(v0, v1)
+	m.GetLanguageCoverageFunc.appendCall(UploadsServiceGetLanguageCoverageFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetLanguageCoverage
+// method of the parent MockUploadsService instance is invoked and the hook
+// queue is empty.
+func (f *UploadsServiceGetLanguageCoverageFunc) SetDefaultHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLanguageCoverage method of the parent MockUploadsService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadsServiceGetLanguageCoverageFunc) PushHook(hook func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadsServiceGetLanguageCoverageFunc) SetDefaultReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadsServiceGetLanguageCoverageFunc) PushReturn(r0 []shared.LanguageCoverage, r1 int, r2 error) {
+	f.PushHook(func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *UploadsServiceGetLanguageCoverageFunc) nextHook() func(context.Context, shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadsServiceGetLanguageCoverageFunc) appendCall(r0 UploadsServiceGetLanguageCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadsServiceGetLanguageCoverageFuncCall
+// objects describing the invocations of this function.
+func (f *UploadsServiceGetLanguageCoverageFunc) History() []UploadsServiceGetLanguageCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadsServiceGetLanguageCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadsServiceGetLanguageCoverageFuncCall is an object that describes an
+// invocation of method GetLanguageCoverage on an instance of
+// MockUploadsService.
+type UploadsServiceGetLanguageCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetLanguageCoverageOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.LanguageCoverage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadsServiceGetLanguageCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadsServiceGetLanguageCoverageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // UploadsServiceGetLastUploadRetentionScanForRepositoryFunc describes the
 // behavior when the GetLastUploadRetentionScanForRepository method of the
 // parent MockUploadsService instance is invoked.
@@ -2180,6 +2327,124 @@ func (c UploadsServiceGetUploadsByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// UploadsServiceGetUploadsOverRetentionBudgetFunc describes the behavior
+// when the GetUploadsOverRetentionBudget method of the parent
+// MockUploadsService instance is invoked.
+type UploadsServiceGetUploadsOverRetentionBudgetFunc struct {
+	defaultHook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	hooks       []func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)
+	history     []UploadsServiceGetUploadsOverRetentionBudgetFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadsOverRetentionBudget delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockUploadsService) GetUploadsOverRetentionBudget(v0 context.Context, v1 shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	r0, r1, r2, r3 := m.GetUploadsOverRetentionBudgetFunc.nextHook()(v0, v1)
+	m.GetUploadsOverRetentionBudgetFunc.appendCall(UploadsServiceGetUploadsOverRetentionBudgetFuncCall{v0, v1, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUploadsOverRetentionBudget method of the parent MockUploadsService
+// instance is invoked and the hook queue is empty.
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) SetDefaultHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadsOverRetentionBudget method of the parent MockUploadsService
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) PushHook(hook func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) SetDefaultReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) PushReturn(r0 []shared.RetentionBudgetCandidate, r1 int, r2 int64, r3 error) {
+	f.PushHook(func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) nextHook() func(context.Context, shared.GetUploadsOverRetentionBudgetOptions) ([]shared.RetentionBudgetCandidate, int, int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) appendCall(r0 UploadsServiceGetUploadsOverRetentionBudgetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// UploadsServiceGetUploadsOverRetentionBudgetFuncCall objects describing
+// the invocations of this function.
+func (f *UploadsServiceGetUploadsOverRetentionBudgetFunc) History() []UploadsServiceGetUploadsOverRetentionBudgetFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadsServiceGetUploadsOverRetentionBudgetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadsServiceGetUploadsOverRetentionBudgetFuncCall is an object that
+// describes an invocation of method GetUploadsOverRetentionBudget on an
+// instance of MockUploadsService.
+type UploadsServiceGetUploadsOverRetentionBudgetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetUploadsOverRetentionBudgetOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RetentionBudgetCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 int64
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadsServiceGetUploadsOverRetentionBudgetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadsServiceGetUploadsOverRetentionBudgetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+}
+
 // UploadsServiceNumRepositoriesWithCodeIntelligenceFunc describes the
 // behavior when the NumRepositoriesWithCodeIntelligence method of the
 // parent MockUploadsService instance is invoked.
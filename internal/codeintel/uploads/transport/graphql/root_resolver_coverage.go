@@ -256,6 +256,97 @@ func (r *summaryResolver) RepositoriesWithConfiguration(ctx context.Context, arg
 	return resolverstubs.NewCursorWithTotalCountConnectionResolver(resolvers, endCursor, int32(totalCount)), nil
 }
 
+func (r *summaryResolver) LanguageCoverage(ctx context.Context, args *resolverstubs.LanguageCoverageArgs) (resolverstubs.CodeIntelLanguageCoverageConnectionResolver, error) {
+	pageSize := 25
+	if args.First != nil {
+		pageSize = int(*args.First)
+	}
+
+	offset := 0
+	if args.After != nil {
+		after, _ := strconv.Atoi(*args.After)
+		offset = after
+	}
+
+	opts := shared.GetLanguageCoverageOptions{
+		Limit:  pageSize,
+		Offset: offset,
+	}
+	if args.Repository != nil {
+		repositoryID, err := resolverstubs.UnmarshalID[int](*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+		opts.RepositoryID = repositoryID
+	}
+	if args.Language != nil {
+		opts.Language = *args.Language
+	}
+	if args.States != nil {
+		for _, state := range *args.States {
+			opts.States = append(opts.States, strings.ToLower(state))
+		}
+	}
+
+	coverage, totalCount, err := r.uploadsSvc.GetLanguageCoverage(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolvers []resolverstubs.CodeIntelLanguageCoverageResolver
+	for _, c := range coverage {
+		resolver, err := r.locationResolver.Repository(ctx, api.RepoID(c.RepositoryID))
+		if err != nil {
+			return nil, err
+		}
+
+		resolvers = append(resolvers, &codeIntelLanguageCoverageResolver{
+			repositoryResolver: resolver,
+			coverage:           c,
+		})
+	}
+
+	endCursor := ""
+	if newOffset := offset + pageSize; newOffset < totalCount {
+		endCursor = strconv.Itoa(newOffset)
+	}
+
+	return resolverstubs.NewCursorWithTotalCountConnectionResolver(resolvers, endCursor, int32(totalCount)), nil
+}
+
+//
+//
+
+type codeIntelLanguageCoverageResolver struct {
+	repositoryResolver resolverstubs.RepositoryResolver
+	coverage           shared.LanguageCoverage
+}
+
+func (r *codeIntelLanguageCoverageResolver) Repository() resolverstubs.RepositoryResolver {
+	return r.repositoryResolver
+}
+
+func (r *codeIntelLanguageCoverageResolver) Language() string { return r.coverage.Language }
+func (r *codeIntelLanguageCoverageResolver) Commit() string   { return r.coverage.Commit }
+func (r *codeIntelLanguageCoverageResolver) TotalBytes() float64 {
+	return float64(r.coverage.TotalBytes)
+}
+func (r *codeIntelLanguageCoverageResolver) TotalLines() int32  { return int32(r.coverage.TotalLines) }
+func (r *codeIntelLanguageCoverageResolver) State() string      { return strings.ToUpper(r.coverage.State) }
+func (r *codeIntelLanguageCoverageResolver) Indexers() []string { return r.coverage.Indexers }
+
+func (r *codeIntelLanguageCoverageResolver) FailureReason() *string {
+	return r.coverage.FailureReason
+}
+
+func (r *codeIntelLanguageCoverageResolver) LastIndexedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.coverage.LastIndexedAt)
+}
+
+func (r *codeIntelLanguageCoverageResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.coverage.UpdatedAt}
+}
+
 //
 //
 
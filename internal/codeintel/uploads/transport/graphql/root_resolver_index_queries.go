@@ -292,3 +292,118 @@ func (r *rootResolver) IndexerKeys(ctx context.Context, args *resolverstubs.Inde
 
 	return keys, nil
 }
+
+// 🚨 SECURITY: Only site admins may inspect the storage budget of code intelligence upload data
+func (r *rootResolver) PreviewPreciseIndexRetentionBudget(ctx context.Context, args *resolverstubs.PreviewPreciseIndexRetentionBudgetArgs) (_ resolverstubs.PreciseIndexRetentionBudgetPreviewResolver, err error) {
+	ctx, errTracer, endObservation := r.operations.previewPreciseIndexRetentionBudget.WithErrors(ctx, &err, observation.Args{})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	if err := r.siteAdminChecker.CheckCurrentUserIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	opts := uploadsshared.GetUploadsOverRetentionBudgetOptions{
+		Limit: DefaultPageSize,
+	}
+	if args.GlobalBudget != nil {
+		opts.GlobalBudget = int64(*args.GlobalBudget)
+	}
+	if args.RepositoryBudget != nil {
+		opts.RepositoryBudget = int64(*args.RepositoryBudget)
+	}
+	if opts.GlobalBudget <= 0 && opts.RepositoryBudget <= 0 {
+		return nil, errors.New("at least one positive budget must be supplied")
+	}
+	if args.Repository != nil {
+		v, err := resolverstubs.UnmarshalID[api.RepoID](*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.RepositoryID = int(v)
+	}
+	if args.First != nil {
+		opts.Limit = int(*args.First)
+	}
+
+	candidates, totalCount, totalSize, err := r.uploadSvc.GetUploadsOverRetentionBudget(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.UploadID)
+	}
+	uploads, err := r.uploadSvc.GetUploadsByIDs(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	uploadsByID := make(map[int]shared.Upload, len(uploads))
+	for _, upload := range uploads {
+		uploadsByID[upload.ID] = upload
+	}
+
+	// Create upload loader with data we already have, and pre-submit associated indexes from upload records
+	uploadLoader := r.uploadLoaderFactory.CreateWithInitialData(uploads)
+	indexLoader := r.indexLoaderFactory.Create()
+	PresubmitAssociatedIndexes(indexLoader, uploads...)
+
+	// No data to load for git data (yet)
+	locationResolver := r.locationResolverFactory.Create()
+
+	resolvers := make([]resolverstubs.PreciseIndexOverRetentionBudgetResolver, 0, len(candidates))
+	for _, candidate := range candidates {
+		upload, ok := uploadsByID[candidate.UploadID]
+		if !ok {
+			// Deleted since the candidates were ranked
+			continue
+		}
+
+		resolver, err := r.preciseIndexResolverFactory.Create(ctx, uploadLoader, indexLoader, locationResolver, errTracer, &upload, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resolvers = append(resolvers, &preciseIndexOverRetentionBudgetResolver{
+			index:     resolver,
+			candidate: candidate,
+		})
+	}
+
+	return &preciseIndexRetentionBudgetPreviewResolver{
+		nodes:      resolvers,
+		totalCount: totalCount,
+		totalSize:  totalSize,
+	}, nil
+}
+
+type preciseIndexRetentionBudgetPreviewResolver struct {
+	nodes      []resolverstubs.PreciseIndexOverRetentionBudgetResolver
+	totalCount int
+	totalSize  int64
+}
+
+func (r *preciseIndexRetentionBudgetPreviewResolver) Nodes() []resolverstubs.PreciseIndexOverRetentionBudgetResolver {
+	return r.nodes
+}
+
+func (r *preciseIndexRetentionBudgetPreviewResolver) TotalCount() int32  { return int32(r.totalCount) }
+func (r *preciseIndexRetentionBudgetPreviewResolver) TotalSize() float64 { return float64(r.totalSize) }
+
+type preciseIndexOverRetentionBudgetResolver struct {
+	index     resolverstubs.PreciseIndexResolver
+	candidate uploadsshared.RetentionBudgetCandidate
+}
+
+func (r *preciseIndexOverRetentionBudgetResolver) Index() resolverstubs.PreciseIndexResolver {
+	return r.index
+}
+
+func (r *preciseIndexOverRetentionBudgetResolver) Size() float64 { return float64(r.candidate.Size) }
+func (r *preciseIndexOverRetentionBudgetResolver) VisibleAtTip() bool {
+	return r.candidate.VisibleAtTip
+}
+func (r *preciseIndexOverRetentionBudgetResolver) ReferenceCount() int32 {
+	return int32(r.candidate.ReferenceCount)
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var coverageExportHeader = []string{
+	"repository",
+	"language",
+	"commit",
+	"total_bytes",
+	"total_lines",
+	"state",
+	"indexers",
+	"failure_reason",
+	"last_indexed_at",
+	"updated_at",
+}
+
+// newCoverageExportHandler returns a handler that downloads the precise code intelligence coverage
+// of the languages of all visible repositories as CSV, e.g.:
+//
+//	curl -H "Authorization: token $TOKEN" -OJ \
+//	    "$SRC_ENDPOINT/.api/codeintel/coverage/export?language=Go&state=failed&state=unindexed"
+//
+// The optional repository, language, and (repeatable) state parameters filter the exported records
+// the same way as the languageCoverage GraphQL field.
+func newCoverageExportHandler(logger log.Logger, db database.DB, svc CoverageService, operations *operations) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		query := r.URL.Query()
+		repositoryName, language, states := query.Get("repository"), query.Get("language"), query["state"]
+
+		ctx, _, endObservation := operations.exportCoverage.With(r.Context(), &err, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.String("repository", repositoryName),
+			attribute.String("language", language),
+			attribute.StringSlice("states", states),
+		}})
+		defer endObservation(1, observation.Args{})
+
+		opts := shared.GetLanguageCoverageOptions{Language: language}
+		for _, state := range states {
+			state = strings.ToLower(state)
+			if !isLanguageCoverageState(state) {
+				err = errors.Newf("unknown coverage state %q", state)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			opts.States = append(opts.States, state)
+		}
+
+		if repositoryName != "" {
+			// 🚨 SECURITY: The repository store enforces repository permissions
+			repo, err := db.Repos().GetByName(ctx, api.RepoName(repositoryName))
+			if err != nil {
+				if errcode.IsNotFound(err) {
+					http.Error(w, fmt.Sprintf("repository %q not found", repositoryName), http.StatusNotFound)
+				} else {
+					http.Error(w, "failed to resolve repository", http.StatusInternalServerError)
+				}
+				return
+			}
+			opts.RepositoryID = int(repo.ID)
+		}
+
+		// 🚨 SECURITY: Only coverage of repositories visible to the current user is returned
+		coverage, _, err := svc.GetLanguageCoverage(ctx, opts)
+		if err != nil {
+			http.Error(w, "failed to get language coverage", http.StatusInternalServerError)
+			return
+		}
+
+		var buf bytes.Buffer
+		if err = writeCoverageCSV(&buf, coverage); err != nil {
+			http.Error(w, "failed to write language coverage", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="codeintel-coverage.csv"`)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			logger.Error("failed to write response", log.Error(err))
+		}
+	})
+}
+
+func isLanguageCoverageState(state string) bool {
+	switch state {
+	case shared.LanguageCoverageStateIndexed, shared.LanguageCoverageStateStale, shared.LanguageCoverageStateFailed, shared.LanguageCoverageStateUnindexed:
+		return true
+	}
+
+	return false
+}
+
+func writeCoverageCSV(buf *bytes.Buffer, coverage []shared.LanguageCoverage) error {
+	cw := csv.NewWriter(buf)
+	if err := cw.Write(coverageExportHeader); err != nil {
+		return err
+	}
+
+	for _, c := range coverage {
+		failureReason := ""
+		if c.FailureReason != nil {
+			failureReason = *c.FailureReason
+		}
+		lastIndexedAt := ""
+		if c.LastIndexedAt != nil {
+			lastIndexedAt = c.LastIndexedAt.UTC().Format(time.RFC3339)
+		}
+
+		if err := cw.Write([]string{
+			c.RepositoryName,
+			c.Language,
+			c.Commit,
+			strconv.FormatInt(c.TotalBytes, 10),
+			strconv.FormatInt(c.TotalLines, 10),
+			c.State,
+			strings.Join(c.Indexers, " "),
+			failureReason,
+			lastIndexedAt,
+			c.UpdatedAt.UTC().Format(time.RFC3339),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeCoverageService struct {
+	opts shared.GetLanguageCoverageOptions
+}
+
+func (s *fakeCoverageService) GetLanguageCoverage(ctx context.Context, opts shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error) {
+	s.opts = opts
+
+	failureReason := "exit status 1"
+	lastIndexedAt := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2023, 9, 17, 12, 0, 0, 0, time.UTC)
+
+	coverage := []shared.LanguageCoverage{
+		{RepositoryName: "github.com/test/test", Language: "Go", Commit: "deadbeef", TotalBytes: 5000, TotalLines: 200, State: "indexed", Indexers: []string{"scip-go"}, LastIndexedAt: &lastIndexedAt, UpdatedAt: updatedAt},
+		{RepositoryName: "github.com/test/test", Language: "TypeScript", Commit: "deadbeef", TotalBytes: 3000, TotalLines: 100, State: "failed", Indexers: []string{"scip-typescript"}, FailureReason: &failureReason, UpdatedAt: updatedAt},
+	}
+	return coverage, len(coverage), nil
+}
+
+func TestCoverageExportHandler(t *testing.T) {
+	testCases := []struct {
+		name         string
+		query        string
+		statusCode   int
+		expectedOpts shared.GetLanguageCoverageOptions
+	}{
+		{name: "no filters", query: "", statusCode: http.StatusOK},
+		{name: "filters", query: "repository=github.com/test/test&language=Go&state=FAILED&state=unindexed", statusCode: http.StatusOK, expectedOpts: shared.GetLanguageCoverageOptions{RepositoryID: 42, Language: "Go", States: []string{"failed", "unindexed"}}},
+		{name: "unknown state", query: "state=partial", statusCode: http.StatusBadRequest},
+		{name: "unknown repository", query: "repository=github.com/test/private", statusCode: http.StatusNotFound},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repos := dbmocks.NewMockRepoStore()
+			repos.GetByNameFunc.SetDefaultHook(func(ctx context.Context, name api.RepoName) (*types.Repo, error) {
+				if name != "github.com/test/test" {
+					return nil, &database.RepoNotFoundErr{Name: name}
+				}
+				return &types.Repo{ID: 42, Name: name}, nil
+			})
+			db := dbmocks.NewMockDB()
+			db.ReposFunc.SetDefaultReturn(repos)
+
+			svc := &fakeCoverageService{}
+			handler := newCoverageExportHandler(logtest.Scoped(t), db, svc, newOperations(&observation.TestContext))
+
+			r := httptest.NewRequest("GET", "/.api/codeintel/coverage/export?"+testCase.query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != testCase.statusCode {
+				t.Fatalf("unexpected status code. want=%d have=%d (%s)", testCase.statusCode, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			if diff := cmp.Diff(testCase.expectedOpts, svc.opts); diff != "" {
+				t.Errorf("unexpected options (-want +got):\n%s", diff)
+			}
+
+			expectedBody := "" +
+				"repository,language,commit,total_bytes,total_lines,state,indexers,failure_reason,last_indexed_at,updated_at\n" +
+				"github.com/test/test,Go,deadbeef,5000,200,indexed,scip-go,,2023-09-01T12:00:00Z,2023-09-17T12:00:00Z\n" +
+				"github.com/test/test,TypeScript,deadbeef,3000,100,failed,scip-typescript,exit status 1,,2023-09-17T12:00:00Z\n"
+			if diff := cmp.Diff(expectedBody, w.Body.String()); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != "text/csv" {
+				t.Errorf("unexpected content type. want=%q have=%q", "text/csv", contentType)
+			}
+		})
+	}
+}
@@ -40,6 +40,17 @@ func newHandler(
 			return uploads.UploadMetadata{}, statusCode, err
 		}
 
+		// Ensure that the base upload of a partial index is an upload of the same repository,
+		// root, and indexer whose documents can be merged into this upload.
+		root := sanitizeRoot(getQuery(r, "root"))
+		indexer := getQuery(r, "indexerName")
+		baseUploadID := getQueryInt(r, "baseUploadId")
+		if baseUploadID != 0 {
+			if statusCode, err := ensureBaseUploadCompatible(ctx, dbStore, baseUploadID, repositoryID, root, indexer); err != nil {
+				return uploads.UploadMetadata{}, statusCode, err
+			}
+		}
+
 		contentType := r.Header.Get("Content-Type")
 		if contentType == "" {
 			contentType = "application/x-ndjson+lsif"
@@ -49,11 +60,12 @@ func newHandler(
 		return uploads.UploadMetadata{
 			RepositoryID:      repositoryID,
 			Commit:            commit,
-			Root:              sanitizeRoot(getQuery(r, "root")),
-			Indexer:           getQuery(r, "indexerName"),
+			Root:              root,
+			Indexer:           indexer,
 			IndexerVersion:    getQuery(r, "indexerVersion"),
 			AssociatedIndexID: getQueryInt(r, "associatedIndexId"),
 			ContentType:       contentType,
+			BaseUploadID:      baseUploadID,
 		}, 0, nil
 	}
 
@@ -104,3 +116,25 @@ func ensureRepoAndCommitExist(ctx context.Context, repoStore RepoStore, repoName
 
 	return int(repo.ID), 0, nil
 }
+
+func ensureBaseUploadCompatible(ctx context.Context, dbStore uploadhandler.DBStore[uploads.UploadMetadata], baseUploadID, repositoryID int, root, indexer string) (int, error) {
+	baseUpload, exists, err := dbStore.GetUploadByID(ctx, baseUploadID)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if !exists {
+		return http.StatusNotFound, errors.Errorf("unknown base upload %d", baseUploadID)
+	}
+
+	switch baseUpload.State {
+	case "uploading", "queued", "processing", "completed":
+	default:
+		return http.StatusBadRequest, errors.Errorf("base upload %d is in state %q", baseUploadID, baseUpload.State)
+	}
+
+	if baseUpload.Metadata.RepositoryID != repositoryID || baseUpload.Metadata.Root != root || baseUpload.Metadata.Indexer != indexer {
+		return http.StatusBadRequest, errors.Errorf("base upload %d must have the same repository, root, and indexer", baseUploadID)
+	}
+
+	return 0, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -11,3 +12,7 @@ type RepoStore interface {
 	GetByName(ctx context.Context, name api.RepoName) (*types.Repo, error)
 	ResolveRev(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error)
 }
+
+type CoverageService interface {
+	GetLanguageCoverage(ctx context.Context, opts shared.GetLanguageCoverageOptions) ([]shared.LanguageCoverage, int, error)
+}
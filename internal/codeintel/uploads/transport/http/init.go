@@ -20,6 +20,12 @@ var (
 	handler         http.Handler
 	handlerWithAuth http.Handler
 	handlerOnce     sync.Once
+
+	coverageExportHandler     http.Handler
+	coverageExportHandlerOnce sync.Once
+
+	handlerOperations     *operations
+	handlerOperationsOnce sync.Once
 )
 
 func GetHandler(svc *uploads.Service, db database.DB, gitserverClient gitserver.Client, uploadStore uploadstore.Store, withCodeHostAuthAuth bool) http.Handler {
@@ -31,7 +37,7 @@ func GetHandler(svc *uploads.Service, db database.DB, gitserverClient gitserver.
 
 		observationCtx := observation.NewContext(logger)
 
-		operations := newOperations(observationCtx)
+		operations := getOperations(logger)
 		uploadHandlerOperations := uploadhandler.NewOperations(observationCtx, "codeintel")
 
 		userStore := db.Users()
@@ -56,3 +62,27 @@ func GetHandler(svc *uploads.Service, db database.DB, gitserverClient gitserver.
 	}
 	return handler
 }
+
+// GetCoverageExportHandler returns the handler of the language coverage CSV export endpoint.
+func GetCoverageExportHandler(svc *uploads.Service, db database.DB) http.Handler {
+	coverageExportHandlerOnce.Do(func() {
+		logger := log.Scoped(
+			"uploads.coveragehandler",
+			"codeintel uploads coverage export http handler",
+		)
+
+		coverageExportHandler = newCoverageExportHandler(logger, db, svc, getOperations(logger))
+	})
+
+	return coverageExportHandler
+}
+
+// getOperations returns the operations shared by all handlers, as their metrics can only be
+// registered once.
+func getOperations(logger log.Logger) *operations {
+	handlerOperationsOnce.Do(func() {
+		handlerOperations = newOperations(observation.NewContext(logger))
+	})
+
+	return handlerOperations
+}
@@ -9,6 +9,7 @@ import (
 
 type operations struct {
 	authMiddleware *observation.Operation
+	exportCoverage *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -29,5 +30,6 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 	return &operations{
 		authMiddleware: op("authMiddleware"),
+		exportCoverage: op("exportCoverage"),
 	}
 }
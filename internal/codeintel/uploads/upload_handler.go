@@ -16,6 +16,7 @@ type UploadMetadata struct {
 	IndexerVersion    string
 	AssociatedIndexID int
 	ContentType       string
+	BaseUploadID      int
 }
 
 type uploadHandlerShim struct {
@@ -36,6 +37,11 @@ func (s *uploadHandlerShim) InsertUpload(ctx context.Context, upload uploadhandl
 		associatedIndexID = &upload.Metadata.AssociatedIndexID
 	}
 
+	var baseUploadID *int
+	if upload.Metadata.BaseUploadID != 0 {
+		baseUploadID = &upload.Metadata.BaseUploadID
+	}
+
 	return s.Store.InsertUpload(ctx, shared.Upload{
 		ID:                upload.ID,
 		State:             upload.State,
@@ -50,6 +56,7 @@ func (s *uploadHandlerShim) InsertUpload(ctx context.Context, upload uploadhandl
 		IndexerVersion:    upload.Metadata.IndexerVersion,
 		AssociatedIndexID: associatedIndexID,
 		ContentType:       upload.Metadata.ContentType,
+		BaseUploadID:      baseUploadID,
 	})
 }
 
@@ -81,6 +88,9 @@ func (s *uploadHandlerShim) GetUploadByID(ctx context.Context, uploadID int) (up
 	if upload.AssociatedIndexID != nil {
 		u.Metadata.AssociatedIndexID = *upload.AssociatedIndexID
 	}
+	if upload.BaseUploadID != nil {
+		u.Metadata.BaseUploadID = *upload.BaseUploadID
+	}
 
 	return u, true, nil
 }
@@ -2,7 +2,9 @@ package background
 
 import (
 	"net/url"
+	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -18,4 +20,32 @@ type actionArgs struct {
 	Query          string
 	Results        []*result.CommitMatch
 	IncludeResults bool
+
+	// Digest is set if the results were collected over several trigger runs
+	// for a monitor with a digest delivery schedule.
+	Digest *digest
+}
+
+// digest describes an action run that delivers the results of all trigger runs
+// since the previous digest of a monitor.
+type digest struct {
+	Schedule database.DeliverySchedule
+	// Runs is the number of trigger runs with results covered by the digest.
+	Runs int
+}
+
+func newDigest(m *database.ActionJobMetadata) *digest {
+	if !m.Digest {
+		return nil
+	}
+	return &digest{Schedule: m.DeliverySchedule, Runs: m.DigestRuns}
+}
+
+// Title returns the name of the digest, such as "Daily digest".
+func (d *digest) Title() string {
+	if d.Schedule == "" {
+		return "Digest"
+	}
+	s := string(d.Schedule)
+	return strings.ToUpper(s[:1]) + s[1:] + " digest"
 }
@@ -31,7 +31,7 @@ func SendEmailForNewSearchResult(ctx context.Context, db database.DB, userID int
 	if MockSendEmailForNewSearchResult != nil {
 		return MockSendEmailForNewSearchResult(ctx, db, userID, data)
 	}
-	return sendEmail(ctx, db, userID, newSearchResultsEmailTemplates, data)
+	return SendEmail(ctx, db, "code-monitor", userID, newSearchResultsEmailTemplates, data)
 }
 
 var (
@@ -43,7 +43,7 @@ var (
 )
 
 var newSearchResultsEmailTemplates = txemail.MustValidate(txtypes.Templates{
-	Subject: `{{ if .IsTest }}Test: {{ end }}{{.Priority}}Sourcegraph code monitor {{.Description}} detected {{.TotalCount}} new {{.ResultPluralized}}`,
+	Subject: `{{ if .IsTest }}Test: {{ end }}{{.Priority}}{{ with .Digest }}{{.}}: {{ end }}Sourcegraph code monitor {{.Description}} detected {{.TotalCount}} new {{.ResultPluralized}}`,
 	Text:    textTemplate,
 	HTML:    htmlTemplate,
 })
@@ -61,6 +61,10 @@ type TemplateDataNewSearchResults struct {
 	TruncatedResultPluralized string
 	DisplayMoreLink           bool
 	IsTest                    bool
+
+	// Digest is the title of the digest, such as "Daily digest", if the results
+	// were collected over several trigger runs.
+	Digest string
 }
 
 func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAction) (d *TemplateDataNewSearchResults, err error) {
@@ -79,9 +83,14 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
 
+	var digestTitle string
+	if args.Digest != nil {
+		digestTitle = args.Digest.Title()
+	}
+
 	displayResults := make([]*DisplayResult, len(truncatedResults))
 	for i, result := range truncatedResults {
-		displayResults[i] = toDisplayResult(result, args.ExternalURL)
+		displayResults[i] = toDisplayResult(result, args.ExternalURL, utmSourceEmail)
 	}
 
 	return &TemplateDataNewSearchResults{
@@ -96,6 +105,7 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 		ResultPluralized:          pluralize("result", totalCount),
 		TruncatedResultPluralized: pluralize("result", truncatedCount),
 		DisplayMoreLink:           args.IncludeResults && truncatedCount > 0,
+		Digest:                    digestTitle,
 	}, nil
 }
 
@@ -120,7 +130,9 @@ func NewTestTemplateDataForNewSearchResults(monitorDescription string) *Template
 	}
 }
 
-func sendEmail(ctx context.Context, db database.DB, userID int32, template txtypes.Templates, data any) error {
+// SendEmail sends the rendered template to the verified primary email address of
+// the user. source identifies the sending feature in the txemail metrics.
+func SendEmail(ctx context.Context, db database.DB, source string, userID int32, template txtypes.Templates, data any) error {
 	email, verified, err := db.UserEmails().GetPrimaryEmail(ctx, userID)
 	if err != nil {
 		if errcode.IsNotFound(err) {
@@ -132,7 +144,7 @@ func sendEmail(ctx context.Context, db database.DB, userID int32, template txtyp
 		return errors.Newf("unable to send email to user ID %d's unverified primary email address", userID)
 	}
 
-	if err := txemail.Send(ctx, "code-monitor", txtypes.Message{
+	if err := txemail.Send(ctx, source, txtypes.Message{
 		To:       []string{email},
 		Template: template,
 		Data:     data,
@@ -200,7 +212,7 @@ type DisplayResult struct {
 	Content    string
 }
 
-func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *DisplayResult {
+func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL, utmSource string) *DisplayResult {
 	resultType := "Message"
 	if result.DiffPreview != nil {
 		resultType = "Diff"
@@ -209,7 +221,7 @@ func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *Di
 	content := truncateMatchContent(result)
 	return &DisplayResult{
 		ResultType: resultType,
-		CommitURL:  getCommitURL(externalURL, string(result.Repo.Name), string(result.Commit.ID), utmSourceEmail),
+		CommitURL:  getCommitURL(externalURL, string(result.Repo.Name), string(result.Commit.ID), utmSource),
 		RepoName:   string(result.Repo.Name),
 		CommitID:   result.Commit.ID.Short(),
 		Content:    content,
@@ -185,4 +185,38 @@ func TestEmail(t *testing.T) {
 		})
 	})
 
+	t.Run("digest", func(t *testing.T) {
+		templateData := &TemplateDataNewSearchResults{
+			Priority:         "",
+			CodeMonitorURL:   "https://sourcegraph.com/your/code/monitor",
+			SearchURL:        "https://sourcegraph.com/search",
+			Description:      "My test monitor",
+			TotalCount:       2,
+			ResultPluralized: "results",
+			IncludeResults:   true,
+			TruncatedResults: []*DisplayResult{diffDisplayResultMock, commitDisplayResultMock},
+			Digest:           "Daily digest",
+		}
+
+		t.Run("html", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := template.Html.Execute(&buf, templateData)
+			require.NoError(t, err)
+			autogold.ExpectFile(t, autogold.Raw(buf.String()))
+		})
+
+		t.Run("text", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := template.Text.Execute(&buf, templateData)
+			require.NoError(t, err)
+			autogold.ExpectFile(t, autogold.Raw(buf.String()))
+		})
+
+		t.Run("subject", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := template.Subj.Execute(&buf, templateData)
+			require.NoError(t, err)
+			require.Equal(t, "Daily digest: Sourcegraph code monitor My test monitor detected 2 new results", buf.String())
+		})
+	})
 }
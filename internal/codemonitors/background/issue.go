@@ -0,0 +1,527 @@
+package background
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	ghauth "github.com/sourcegraph/sourcegraph/internal/extsvc/github/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/jira"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	searchresult "github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const utmSourceIssue = "code-monitor-issue"
+
+const defaultIssueTitleTemplate = `{{.MonitorDescription}}: new results in {{.Repository}}`
+
+// defaultIssueBodyTemplate renders Markdown, which GitHub and GitLab issues support.
+const defaultIssueBodyTemplate = `Sourcegraph code monitor [{{.MonitorDescription}}]({{.MonitorURL}}), owned by {{.MonitorOwnerName}}, detected {{.MatchCount}} new {{if eq .MatchCount 1}}match{{else}}matches{{end}} in ` + "`{{.Repository}}`" + `.
+{{range .Results}}
+**{{.ResultType}} match:** [{{.RepoName}}@{{.CommitID}}]({{.CommitURL}})
+
+` + "```" + `
+{{.Content}}` + "```" + `
+{{end}}{{if .TruncatedCount}}
+...and {{.TruncatedCount}} more.
+{{end}}
+[View results]({{.SearchURL}})
+`
+
+// defaultJiraIssueBodyTemplate renders Jira wiki markup, which Jira issues support.
+const defaultJiraIssueBodyTemplate = `Sourcegraph code monitor [{{.MonitorDescription}}|{{.MonitorURL}}], owned by {{.MonitorOwnerName}}, detected {{.MatchCount}} new {{if eq .MatchCount 1}}match{{else}}matches{{end}} in {{"{{"}}{{.Repository}}{{"}}"}}.
+{{range .Results}}
+*{{.ResultType}} match:* [{{.RepoName}}@{{.CommitID}}|{{.CommitURL}}]
+
+{noformat}
+{{.Content}}{noformat}
+{{end}}{{if .TruncatedCount}}
+...and {{.TruncatedCount}} more.
+{{end}}
+[View results|{{.SearchURL}}]
+`
+
+// IssueTemplateData is the data available to the title and body templates of an issue
+// action. A separate issue is opened for each repository with new results.
+type IssueTemplateData struct {
+	MonitorDescription string
+	MonitorOwnerName   string
+	MonitorURL         string
+	SearchURL          string
+
+	Repository string
+	MatchCount int
+
+	// Results holds up to 5 of the new results in the repository. It is empty unless the
+	// action is configured to include results.
+	Results        []*DisplayResult
+	TruncatedCount int
+}
+
+// issueTracker opens and comments on issues.
+type issueTracker interface {
+	// createIssue opens an issue and returns its ID on the tracker and its URL.
+	createIssue(ctx context.Context, title, body string) (externalID, url string, err error)
+	// isOpen returns whether the issue with the given ID is still open. Issues that were
+	// deleted are reported as not open.
+	isOpen(ctx context.Context, externalID string) (bool, error)
+	comment(ctx context.Context, externalID, body string) error
+}
+
+// issueTrackerFactory returns the tracker that the given issue action files issues about
+// the given repository in.
+type issueTrackerFactory func(ctx context.Context, action *database.IssueAction, repoID api.RepoID) (issueTracker, error)
+
+func (r *actionRunner) handleIssue(ctx context.Context, j *database.ActionJob) error {
+	// Issues are opened outside of a transaction: each opened issue is recorded as soon as
+	// it is created so that a retry after a partial failure comments on it instead of
+	// opening a duplicate.
+	m, err := getActionJobMetadata(ctx, r.CodeMonitorStore, j.ID)
+	if err != nil {
+		return err
+	}
+
+	a, err := r.GetIssueAction(ctx, *j.Issue)
+	if err != nil {
+		return errors.Wrap(err, "GetIssueAction")
+	}
+
+	externalURL, err := getExternalURL()
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          a.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          utmSourceIssue,
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		IncludeResults:     a.IncludeResults,
+		Digest:             newDigest(m),
+	}
+
+	db := database.NewDBWith(log.Scoped("handleIssue", ""), r.CodeMonitorStore)
+	return openOrUpdateIssues(ctx, r.CodeMonitorStore, a, args, newIssueTracker(db))
+}
+
+// openOrUpdateIssues files the results of a code monitor run in one issue per repository.
+// If the issue previously opened for a repository is still open, the results are added to it
+// as a comment instead.
+func openOrUpdateIssues(ctx context.Context, s database.CodeMonitorStore, action *database.IssueAction, args actionArgs, newTracker issueTrackerFactory) error {
+	titleTemplate, bodyTemplate, err := parseIssueTemplates(action)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, repoResults := range groupResultsByRepo(args.Results) {
+		repo := repoResults[0].Repo
+		data := newIssueTemplateData(args, repo.Name, repoResults)
+
+		if err := openOrUpdateIssue(ctx, s, action, repo.ID, titleTemplate, bodyTemplate, data, newTracker); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "repository %s", repo.Name))
+		}
+	}
+	return errs
+}
+
+func openOrUpdateIssue(
+	ctx context.Context,
+	s database.CodeMonitorStore,
+	action *database.IssueAction,
+	repoID api.RepoID,
+	titleTemplate, bodyTemplate *template.Template,
+	data *IssueTemplateData,
+	newTracker issueTrackerFactory,
+) error {
+	body, err := executeIssueTemplate(bodyTemplate, data)
+	if err != nil {
+		return err
+	}
+
+	tracker, err := newTracker(ctx, action, repoID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.GetIssueActionIssue(ctx, action.ID, repoID)
+	if err != nil {
+		return errors.Wrap(err, "GetIssueActionIssue")
+	}
+	if existing != nil {
+		open, err := tracker.isOpen(ctx, existing.ExternalID)
+		if err != nil {
+			return errors.Wrap(err, "checking issue state")
+		}
+		if open {
+			if err := tracker.comment(ctx, existing.ExternalID, body); err != nil {
+				return errors.Wrap(err, "commenting on issue")
+			}
+			return s.UpsertIssueActionIssue(ctx, action.ID, repoID, existing.ExternalID, existing.URL)
+		}
+	}
+
+	title, err := executeIssueTemplate(titleTemplate, data)
+	if err != nil {
+		return err
+	}
+	// Titles are single-line on every tracker.
+	title = strings.Join(strings.Fields(title), " ")
+
+	externalID, url, err := tracker.createIssue(ctx, title, body)
+	if err != nil {
+		return errors.Wrap(err, "creating issue")
+	}
+	return s.UpsertIssueActionIssue(ctx, action.ID, repoID, externalID, url)
+}
+
+// ValidateIssueTemplates returns an error if the title or body template of an issue action
+// cannot be parsed, or refers to data that is not available to issue templates.
+func ValidateIssueTemplates(titleTemplate, bodyTemplate string) error {
+	title, body, err := parseIssueTemplates(&database.IssueAction{TitleTemplate: titleTemplate, BodyTemplate: bodyTemplate})
+	if err != nil {
+		return err
+	}
+
+	// Field references are only checked on execution, so render the templates with
+	// sample data that exercises every field, including those of a result.
+	sample := &IssueTemplateData{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "alice",
+		MonitorURL:         "https://sourcegraph.com/code-monitoring/1",
+		SearchURL:          "https://sourcegraph.com/search",
+		Repository:         "github.com/sourcegraph/sourcegraph",
+		MatchCount:         1,
+		Results: []*DisplayResult{{
+			ResultType: "Diff",
+			CommitURL:  "https://sourcegraph.com/github.com/sourcegraph/sourcegraph/-/commit/abc",
+			RepoName:   "github.com/sourcegraph/sourcegraph",
+			CommitID:   "abc",
+			Content:    "sample",
+		}},
+	}
+	if _, err := executeIssueTemplate(title, sample); err != nil {
+		return err
+	}
+	_, err = executeIssueTemplate(body, sample)
+	return err
+}
+
+func parseIssueTemplates(action *database.IssueAction) (title, body *template.Template, err error) {
+	titleText := action.TitleTemplate
+	if titleText == "" {
+		titleText = defaultIssueTitleTemplate
+	}
+	bodyText := action.BodyTemplate
+	if bodyText == "" {
+		bodyText = defaultIssueBodyTemplate
+		if action.Tracker == database.IssueTrackerJira {
+			bodyText = defaultJiraIssueBodyTemplate
+		}
+	}
+
+	title, err = template.New("title").Option("missingkey=error").Parse(titleText)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing title template")
+	}
+	body, err = template.New("body").Option("missingkey=error").Parse(bodyText)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parsing body template")
+	}
+	return title, body, nil
+}
+
+func executeIssueTemplate(t *template.Template, data *IssueTemplateData) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", errors.Wrapf(err, "executing %s template", t.Name())
+	}
+	return b.String(), nil
+}
+
+// groupResultsByRepo groups results by repository, in the order in which each repository
+// first appears.
+func groupResultsByRepo(results []*searchresult.CommitMatch) [][]*searchresult.CommitMatch {
+	var groups [][]*searchresult.CommitMatch
+	indexes := map[api.RepoID]int{}
+	for _, res := range results {
+		i, ok := indexes[res.Repo.ID]
+		if !ok {
+			i = len(groups)
+			indexes[res.Repo.ID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], res)
+	}
+	return groups
+}
+
+func newIssueTemplateData(args actionArgs, repoName api.RepoName, results []*searchresult.CommitMatch) *IssueTemplateData {
+	truncatedResults, totalCount, truncatedCount := truncateResults(results, 5)
+
+	data := &IssueTemplateData{
+		MonitorDescription: args.MonitorDescription,
+		MonitorOwnerName:   args.MonitorOwnerName,
+		MonitorURL:         getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+		SearchURL:          getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		Repository:         string(repoName),
+		MatchCount:         totalCount,
+	}
+	if args.IncludeResults {
+		data.Results = make([]*DisplayResult, len(truncatedResults))
+		for i, result := range truncatedResults {
+			data.Results[i] = toDisplayResult(result, args.ExternalURL, args.UTMSource)
+		}
+		data.TruncatedCount = truncatedCount
+	}
+	return data
+}
+
+// newIssueTracker returns an issueTrackerFactory that files issues in Jira, or in GitHub or
+// GitLab using the credentials of the code host connection that the repository is synced
+// from.
+func newIssueTracker(db database.DB) issueTrackerFactory {
+	return func(ctx context.Context, action *database.IssueAction, repoID api.RepoID) (issueTracker, error) {
+		switch action.Tracker {
+		case database.IssueTrackerJira:
+			if action.JiraToken == nil {
+				return nil, errors.New("no Jira token configured")
+			}
+			token, err := action.JiraToken.Decrypt(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "decrypting Jira token")
+			}
+			client, err := jira.NewClient(action.JiraURL, action.JiraUsername, token, httpcli.ExternalDoer)
+			if err != nil {
+				return nil, err
+			}
+			return &jiraIssueTracker{client: client, action: action}, nil
+		case database.IssueTrackerCodeHost:
+			return newCodeHostIssueTracker(ctx, db, action, repoID)
+		default:
+			return nil, errors.Errorf("unknown issue tracker %q", action.Tracker)
+		}
+	}
+}
+
+// newCodeHostIssueTracker returns an issueTracker that files issues with the credentials of
+// the code host connection, which are usually those of a code host admin. Only site admins
+// may configure such actions, so the action is refused if it was last changed by a user who
+// is no longer a site admin.
+func newCodeHostIssueTracker(ctx context.Context, db database.DB, action *database.IssueAction, repoID api.RepoID) (issueTracker, error) {
+	changedBy, err := db.Users().GetByID(ctx, action.ChangedBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting user that last changed the action")
+	}
+	if !changedBy.SiteAdmin {
+		return nil, errors.Errorf("issue action %d was last changed by user %d, who is not a site admin", action.ID, action.ChangedBy)
+	}
+
+	repo, err := db.Repos().Get(ctx, repoID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting repository")
+	}
+
+	switch repo.ExternalRepo.ServiceType {
+	case extsvc.TypeGitHub:
+		meta, ok := repo.Metadata.(*github.Repository)
+		if !ok {
+			return nil, errors.Errorf("unexpected metadata %T for GitHub repository", repo.Metadata)
+		}
+		owner, name, err := github.SplitRepositoryNameWithOwner(meta.NameWithOwner)
+		if err != nil {
+			return nil, err
+		}
+
+		svc, cfg, err := repoExternalService(ctx, db, repo, extsvc.KindGitHub)
+		if err != nil {
+			return nil, err
+		}
+		conn := cfg.(*schema.GitHubConnection)
+		baseURL, err := url.Parse(conn.Url)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing GitHub URL")
+		}
+		apiURL, _ := github.APIRoot(baseURL)
+		auther, err := ghauth.FromConnection(ctx, conn, db.GitHubApps(), keyring.Default().GitHubAppKey)
+		if err != nil {
+			return nil, err
+		}
+
+		client := github.NewV3Client(log.Scoped("codeMonitorIssues", "GitHub client for code monitor issue actions"), svc.URN(), apiURL, auther, httpcli.ExternalDoer)
+		return &gitHubIssueTracker{client: client, owner: owner, name: name, labels: action.Labels}, nil
+
+	case extsvc.TypeGitLab:
+		project, ok := repo.Metadata.(*gitlab.Project)
+		if !ok {
+			return nil, errors.Errorf("unexpected metadata %T for GitLab repository", repo.Metadata)
+		}
+
+		svc, cfg, err := repoExternalService(ctx, db, repo, extsvc.KindGitLab)
+		if err != nil {
+			return nil, err
+		}
+		conn := cfg.(*schema.GitLabConnection)
+		baseURL, err := url.Parse(conn.Url)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing GitLab URL")
+		}
+
+		client := gitlab.NewClientProvider(svc.URN(), baseURL, httpcli.ExternalDoer).GetPATClient(conn.Token, "")
+		return &gitLabIssueTracker{client: client, project: project, labels: action.Labels}, nil
+
+	default:
+		return nil, errors.Errorf("opening issues on %s code hosts is not supported", repo.ExternalRepo.ServiceType)
+	}
+}
+
+// repoExternalService returns the first code host connection of the given kind that the
+// repository is synced from, along with its parsed configuration.
+func repoExternalService(ctx context.Context, db database.DB, repo *types.Repo, kind string) (*types.ExternalService, any, error) {
+	for _, id := range repo.ExternalServiceIDs() {
+		svc, err := db.ExternalServices().GetByID(ctx, id)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "getting code host connection")
+		}
+		if svc.Kind != kind {
+			continue
+		}
+		cfg, err := extsvc.ParseEncryptableConfig(ctx, svc.Kind, svc.Config)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "parsing code host connection config")
+		}
+		return svc, cfg, nil
+	}
+	return nil, nil, errors.Errorf("no %s code host connection found for repository %s", kind, repo.Name)
+}
+
+type gitHubIssueTracker struct {
+	client      *github.V3Client
+	owner, name string
+	labels      []string
+}
+
+func (t *gitHubIssueTracker) createIssue(ctx context.Context, title, body string) (string, string, error) {
+	issue, err := t.client.CreateIssue(ctx, t.owner, t.name, title, body, t.labels)
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.Itoa(issue.Number), issue.HTMLURL, nil
+}
+
+func (t *gitHubIssueTracker) isOpen(ctx context.Context, externalID string) (bool, error) {
+	number, err := strconv.Atoi(externalID)
+	if err != nil {
+		return false, err
+	}
+	issue, err := t.client.GetIssue(ctx, t.owner, t.name, number)
+	if err != nil {
+		if github.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return issue.State == github.IssueStateOpen, nil
+}
+
+func (t *gitHubIssueTracker) comment(ctx context.Context, externalID, body string) error {
+	number, err := strconv.Atoi(externalID)
+	if err != nil {
+		return err
+	}
+	return t.client.CreateIssueComment(ctx, t.owner, t.name, number, body)
+}
+
+type gitLabIssueTracker struct {
+	client  *gitlab.Client
+	project *gitlab.Project
+	labels  []string
+}
+
+func (t *gitLabIssueTracker) createIssue(ctx context.Context, title, body string) (string, string, error) {
+	issue, err := t.client.CreateIssue(ctx, t.project, gitlab.CreateIssueOpts{
+		Title:       title,
+		Description: body,
+		Labels:      strings.Join(t.labels, ","),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.Itoa(int(issue.IID)), issue.WebURL, nil
+}
+
+func (t *gitLabIssueTracker) isOpen(ctx context.Context, externalID string) (bool, error) {
+	iid, err := strconv.Atoi(externalID)
+	if err != nil {
+		return false, err
+	}
+	issue, err := t.client.GetIssue(ctx, t.project, gitlab.ID(iid))
+	if err != nil {
+		var e gitlab.HTTPError
+		if errors.As(err, &e) && e.Code() == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return issue.State == gitlab.IssueStateOpened, nil
+}
+
+func (t *gitLabIssueTracker) comment(ctx context.Context, externalID, body string) error {
+	iid, err := strconv.Atoi(externalID)
+	if err != nil {
+		return err
+	}
+	return t.client.CreateIssueNote(ctx, t.project, gitlab.ID(iid), body)
+}
+
+type jiraIssueTracker struct {
+	client *jira.Client
+	action *database.IssueAction
+}
+
+func (t *jiraIssueTracker) createIssue(ctx context.Context, title, body string) (string, string, error) {
+	issue, err := t.client.CreateIssue(ctx, jira.CreateIssueOpts{
+		ProjectKey:  t.action.JiraProjectKey,
+		IssueType:   t.action.JiraIssueType,
+		Summary:     title,
+		Description: body,
+		Labels:      t.action.Labels,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return issue.Key, t.client.BrowseURL(issue), nil
+}
+
+func (t *jiraIssueTracker) isOpen(ctx context.Context, externalID string) (bool, error) {
+	issue, err := t.client.GetIssue(ctx, externalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !issue.Done, nil
+}
+
+func (t *jiraIssueTracker) comment(ctx context.Context, externalID, body string) error {
+	return t.client.AddComment(ctx, externalID, body)
+}
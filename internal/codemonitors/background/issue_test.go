@@ -0,0 +1,162 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeIssue struct {
+	title, body string
+	open        bool
+	comments    []string
+}
+
+type fakeIssueTracker struct {
+	issues map[string]*fakeIssue
+}
+
+func (t *fakeIssueTracker) createIssue(_ context.Context, title, body string) (string, string, error) {
+	id := fmt.Sprint(len(t.issues) + 1)
+	t.issues[id] = &fakeIssue{title: title, body: body, open: true}
+	return id, "https://example.com/issues/" + id, nil
+}
+
+func (t *fakeIssueTracker) isOpen(_ context.Context, externalID string) (bool, error) {
+	issue, ok := t.issues[externalID]
+	return ok && issue.open, nil
+}
+
+func (t *fakeIssueTracker) comment(_ context.Context, externalID, body string) error {
+	t.issues[externalID].comments = append(t.issues[externalID].comments, body)
+	return nil
+}
+
+func TestOpenOrUpdateIssues(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	repo1 := types.MinimalRepo{ID: 1, Name: "github.com/test/one"}
+	repo2 := types.MinimalRepo{ID: 2, Name: "github.com/test/two"}
+	match := func(repo types.MinimalRepo, commit string) *result.CommitMatch {
+		m := diffResultMock
+		m.Repo = repo
+		m.Commit.ID = api.CommitID(commit)
+		return &m
+	}
+
+	args := actionArgs{
+		MonitorDescription: "Leaked credentials",
+		MonitorOwnerName:   "Camden Cheek",
+		MonitorID:          1,
+		ExternalURL:        eu,
+		UTMSource:          utmSourceIssue,
+		Query:              "repo:test BEGIN",
+		Results:            []*result.CommitMatch{match(repo1, "a"), match(repo2, "b"), match(repo1, "c")},
+		IncludeResults:     true,
+	}
+
+	// Tracked issues are stored in memory, keyed by repository.
+	newStore := func() (*dbmocks.MockCodeMonitorStore, map[api.RepoID]*database.IssueActionIssue) {
+		tracked := map[api.RepoID]*database.IssueActionIssue{}
+		s := dbmocks.NewMockCodeMonitorStore()
+		s.GetIssueActionIssueFunc.SetDefaultHook(func(_ context.Context, _ int64, repoID api.RepoID) (*database.IssueActionIssue, error) {
+			return tracked[repoID], nil
+		})
+		s.UpsertIssueActionIssueFunc.SetDefaultHook(func(_ context.Context, actionID int64, repoID api.RepoID, externalID, url string) error {
+			tracked[repoID] = &database.IssueActionIssue{IssueAction: actionID, RepoID: repoID, ExternalID: externalID, URL: url}
+			return nil
+		})
+		return s, tracked
+	}
+
+	t.Run("opens then comments", func(t *testing.T) {
+		s, tracked := newStore()
+		trackers := map[api.RepoID]*fakeIssueTracker{}
+		newTracker := func(_ context.Context, _ *database.IssueAction, repoID api.RepoID) (issueTracker, error) {
+			if trackers[repoID] == nil {
+				trackers[repoID] = &fakeIssueTracker{issues: map[string]*fakeIssue{}}
+			}
+			return trackers[repoID], nil
+		}
+		action := &database.IssueAction{ID: 1, Tracker: database.IssueTrackerCodeHost}
+
+		err := openOrUpdateIssues(context.Background(), s, action, args, newTracker)
+		require.NoError(t, err)
+		require.Len(t, trackers, 2)
+		require.Len(t, trackers[1].issues, 1)
+		require.Len(t, trackers[2].issues, 1)
+		require.Equal(t, "Leaked credentials: new results in github.com/test/one", trackers[1].issues["1"].title)
+		require.Equal(t, "Leaked credentials: new results in github.com/test/two", trackers[2].issues["1"].title)
+		require.Equal(t, "https://example.com/issues/1", tracked[1].URL)
+		autogold.ExpectFile(t, autogold.Raw(trackers[1].issues["1"].body))
+
+		// The issue in the second repository is closed, so a new one is opened.
+		trackers[2].issues["1"].open = false
+
+		err = openOrUpdateIssues(context.Background(), s, action, args, newTracker)
+		require.NoError(t, err)
+		require.Len(t, trackers[1].issues, 1)
+		require.Len(t, trackers[1].issues["1"].comments, 1)
+		require.Len(t, trackers[2].issues, 2)
+		require.Empty(t, trackers[2].issues["1"].comments)
+		require.Equal(t, "2", tracked[2].ExternalID)
+	})
+
+	t.Run("custom templates", func(t *testing.T) {
+		s, _ := newStore()
+		tracker := &fakeIssueTracker{issues: map[string]*fakeIssue{}}
+		newTracker := func(context.Context, *database.IssueAction, api.RepoID) (issueTracker, error) {
+			return tracker, nil
+		}
+		action := &database.IssueAction{
+			ID:            1,
+			Tracker:       database.IssueTrackerJira,
+			TitleTemplate: "[{{.Repository}}]\n{{.MatchCount}} new matches",
+			BodyTemplate:  "{{range .Results}}{{.CommitID}} {{end}}",
+		}
+
+		err := openOrUpdateIssues(context.Background(), s, action, args, newTracker)
+		require.NoError(t, err)
+		require.Equal(t, "[github.com/test/one] 4 new matches", tracker.issues["1"].title)
+		require.Equal(t, "a c ", tracker.issues["1"].body)
+		require.Equal(t, "[github.com/test/two] 2 new matches", tracker.issues["2"].title)
+	})
+
+	t.Run("default Jira body", func(t *testing.T) {
+		s, _ := newStore()
+		tracker := &fakeIssueTracker{issues: map[string]*fakeIssue{}}
+		newTracker := func(context.Context, *database.IssueAction, api.RepoID) (issueTracker, error) {
+			return tracker, nil
+		}
+		action := &database.IssueAction{ID: 1, Tracker: database.IssueTrackerJira}
+
+		withoutResults := args
+		withoutResults.IncludeResults = false
+		err := openOrUpdateIssues(context.Background(), s, action, withoutResults, newTracker)
+		require.NoError(t, err)
+		autogold.ExpectFile(t, autogold.Raw(tracker.issues["1"].body))
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		s, _ := newStore()
+		action := &database.IssueAction{ID: 1, TitleTemplate: "{{.Missing"}
+
+		err := openOrUpdateIssues(context.Background(), s, action, args, nil)
+		require.Error(t, err)
+		require.Error(t, ValidateIssueTemplates("{{.Missing", ""))
+		require.Error(t, ValidateIssueTemplates("{{.NoSuchField}}", ""))
+		require.Error(t, ValidateIssueTemplates("", "{{range .Results}}{{.NoSuchField}}{{end}}"))
+		require.NoError(t, ValidateIssueTemplates("", ""))
+	})
+}
@@ -17,7 +17,7 @@ import (
 )
 
 func sendSlackNotification(ctx context.Context, url string, args actionArgs) error {
-	return postSlackWebhook(ctx, httpcli.ExternalDoer, url, slackPayload(args))
+	return PostSlackWebhook(ctx, httpcli.ExternalDoer, url, slackPayload(args))
 }
 
 func slackPayload(args actionArgs) *slack.WebhookMessage {
@@ -27,15 +27,26 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
 
-	blocks := []slack.Block{
-		newMarkdownSection(fmt.Sprintf(
-			"%s's Sourcegraph Code monitor, *%s*, detected *%d* new matches.",
+	summary := fmt.Sprintf(
+		"%s's Sourcegraph Code monitor, *%s*, detected *%d* new matches.",
+		args.MonitorOwnerName,
+		args.MonitorDescription,
+		totalCount,
+	)
+	if args.Digest != nil {
+		summary = fmt.Sprintf(
+			"*%s*: %s's Sourcegraph Code monitor, *%s*, detected *%d* new matches in %d %s since the last digest.",
+			args.Digest.Title(),
 			args.MonitorOwnerName,
 			args.MonitorDescription,
 			totalCount,
-		)),
+			args.Digest.Runs,
+			pluralize("run", args.Digest.Runs),
+		)
 	}
 
+	blocks := []slack.Block{newMarkdownSection(summary)}
+
 	if args.IncludeResults {
 		for _, result := range truncatedResults {
 			resultType := "Message"
@@ -142,8 +153,12 @@ func truncateResults(results []*searchresult.CommitMatch, maxResults int) (_ []*
 	return output, totalCount, totalCount - outputCount
 }
 
+// PostSlackWebhook posts msg to the Slack incoming webhook at url. It is shared with
+// the other features that deliver notifications through Slack, such as code insights
+// alerts.
+//
 // adapted from slack.PostWebhookCustomHTTPContext
-func postSlackWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *slack.WebhookMessage) error {
+func PostSlackWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *slack.WebhookMessage) error {
 	raw, err := json.Marshal(msg)
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
@@ -189,5 +204,5 @@ func SendTestSlackWebhook(ctx context.Context, doer httpcli.Doer, description, u
 		),
 	}}}
 
-	return postSlackWebhook(ctx, doer, url, testMessage)
+	return PostSlackWebhook(ctx, doer, url, testMessage)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -45,7 +46,7 @@ func TestSlackWebhook(t *testing.T) {
 		defer s.Close()
 
 		client := s.Client()
-		err := postSlackWebhook(context.Background(), client, s.URL, slackPayload(action))
+		err := PostSlackWebhook(context.Background(), client, s.URL, slackPayload(action))
 		require.NoError(t, err)
 	})
 
@@ -59,7 +60,7 @@ func TestSlackWebhook(t *testing.T) {
 		defer s.Close()
 
 		client := s.Client()
-		err := postSlackWebhook(context.Background(), client, s.URL, slackPayload(action))
+		err := PostSlackWebhook(context.Background(), client, s.URL, slackPayload(action))
 		require.Error(t, err)
 	})
 
@@ -91,6 +92,13 @@ func TestSlackWebhook(t *testing.T) {
 	t.Run("golden without results", func(t *testing.T) {
 		autogold.ExpectFile(t, jsonSlackPayload(action))
 	})
+
+	t.Run("golden digest", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		actionCopy.Digest = &digest{Schedule: database.DeliveryHourly, Runs: 3}
+		autogold.ExpectFile(t, jsonSlackPayload(actionCopy))
+	})
 }
 
 func TestTriggerTestSlackWebhookAction(t *testing.T) {
@@ -30,7 +30,7 @@ var diffResultMock = result.CommitMatch{
 		}},
 	}}
 
-var diffDisplayResultMock = toDisplayResult(&diffResultMock, externalURLMock)
+var diffDisplayResultMock = toDisplayResult(&diffResultMock, externalURLMock, utmSourceEmail)
 
 var commitResultMock = result.CommitMatch{
 	Commit: gitdomain.Commit{
@@ -49,7 +49,7 @@ var commitResultMock = result.CommitMatch{
 	},
 }
 
-var commitDisplayResultMock = toDisplayResult(&commitResultMock, externalURLMock)
+var commitDisplayResultMock = toDisplayResult(&commitResultMock, externalURLMock, utmSourceEmail)
 
 var longCommitResultMock = result.CommitMatch{
 	Commit: gitdomain.Commit{
@@ -14,10 +14,12 @@ import (
 )
 
 func sendWebhookNotification(ctx context.Context, url string, args actionArgs) error {
-	return postWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
+	return PostWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
 }
 
-func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload webhookPayload) error {
+// PostWebhook posts payload as JSON to url. It is shared with the other features
+// that deliver notifications through webhooks, such as code insights alerts.
+func PostWebhook(ctx context.Context, doer httpcli.Doer, url string, payload any) error {
 	raw, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
@@ -53,7 +55,7 @@ func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description string,
 		MonitorDescription: description,
 		Query:              "test query",
 	}
-	return postWebhook(ctx, httpcli.ExternalDoer, u, generateWebhookPayload(args))
+	return PostWebhook(ctx, httpcli.ExternalDoer, u, generateWebhookPayload(args))
 }
 
 type webhookPayload struct {
@@ -61,6 +63,16 @@ type webhookPayload struct {
 	MonitorURL         string          `json:"monitorURL"`
 	Query              string          `json:"query"`
 	Results            []webhookResult `json:"results,omitempty"`
+	Digest             *webhookDigest  `json:"digest,omitempty"`
+}
+
+// webhookDigest is included in the payload if the results were collected over several
+// trigger runs for a monitor with a digest delivery schedule.
+type webhookDigest struct {
+	// Schedule is one of "hourly", "daily" or "weekly".
+	Schedule string `json:"schedule"`
+	// Runs is the number of trigger runs with results since the last digest.
+	Runs int `json:"runs"`
 }
 
 func generateWebhookPayload(args actionArgs) webhookPayload {
@@ -74,6 +86,13 @@ func generateWebhookPayload(args actionArgs) webhookPayload {
 		p.Results = generateResults(args.Results)
 	}
 
+	if args.Digest != nil {
+		p.Digest = &webhookDigest{
+			Schedule: string(args.Digest.Schedule),
+			Runs:     args.Digest.Runs,
+		}
+	}
+
 	return p
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -38,7 +39,7 @@ func TestWebhook(t *testing.T) {
 		defer s.Close()
 
 		client := s.Client()
-		err := postWebhook(context.Background(), client, s.URL, generateWebhookPayload(action))
+		err := PostWebhook(context.Background(), client, s.URL, generateWebhookPayload(action))
 		require.NoError(t, err)
 	})
 
@@ -54,6 +55,16 @@ func TestWebhook(t *testing.T) {
 		autogold.ExpectFile(t, autogold.Raw(j))
 	})
 
+	t.Run("golden digest", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.Digest = &digest{Schedule: database.DeliveryWeekly, Runs: 2}
+
+		j, err := json.Marshal(generateWebhookPayload(actionCopy))
+		require.NoError(t, err)
+
+		autogold.ExpectFile(t, autogold.Raw(j))
+	})
+
 	t.Run("error is returned", func(t *testing.T) {
 		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			b, err := io.ReadAll(r.Body)
@@ -64,7 +75,7 @@ func TestWebhook(t *testing.T) {
 		defer s.Close()
 
 		client := s.Client()
-		err := postWebhook(context.Background(), client, s.URL, generateWebhookPayload(action))
+		err := PostWebhook(context.Background(), client, s.URL, generateWebhookPayload(action))
 		require.Error(t, err)
 	})
 }
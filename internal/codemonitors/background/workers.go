@@ -60,6 +60,21 @@ func newTriggerQueryEnqueuer(ctx context.Context, store database.CodeMonitorStor
 	)
 }
 
+func newDigestEnqueuer(ctx context.Context, store database.CodeMonitorStore) goroutine.BackgroundRoutine {
+	enqueueDigests := goroutine.HandlerFunc(
+		func(ctx context.Context) error {
+			_, err := store.EnqueueDigestActionJobs(ctx)
+			return err
+		})
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		enqueueDigests,
+		goroutine.WithName("code_monitors.digest_enqueuer"),
+		goroutine.WithDescription("enqueues action jobs for code monitor digests that are due"),
+		goroutine.WithInterval(1*time.Minute),
+	)
+}
+
 func newTriggerQueryResetter(_ context.Context, observationCtx *observation.Context, s database.CodeMonitorStore, metrics codeMonitorsMetrics) *dbworker.Resetter[*database.TriggerJob] {
 	workerStore := createDBWorkerStoreForTriggerJobs(observationCtx, s)
 
@@ -179,7 +194,7 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	ctx = actor.WithActor(ctx, actor.FromUser(m.UserID))
 	ctx = featureflag.WithFlags(ctx, r.db.FeatureFlags())
 
-	results, searchErr := codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID)
+	results, lastMatches, searchErr := codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID)
 
 	// Log next_run and latest_result to table cm_queries.
 	newLatestResult := latestResultTime(q.LatestResult, results, searchErr)
@@ -193,14 +208,28 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 		return errors.Wrap(searchErr, "execute search")
 	}
 
+	// Record the results in the same transaction that moves the baseline of content
+	// queries forward, so that changes are not lost if recording them fails.
+	tx, err := cm.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
 	// Log the actual query we ran and whether we got any new results.
-	err = cm.UpdateTriggerJobWithResults(ctx, triggerJob.ID, q.QueryString, results)
+	err = tx.UpdateTriggerJobWithResults(ctx, triggerJob.ID, q.QueryString, results)
 	if err != nil {
 		return errors.Wrap(err, "UpdateTriggerJobWithResults")
 	}
 
-	if len(results) > 0 {
-		_, err := cm.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
+	if err := lastMatches.Save(ctx, tx, m.ID); err != nil {
+		return errors.Wrap(err, "LastMatches.Save")
+	}
+
+	// The results of monitors with a digest schedule stay with the trigger job until
+	// the digest enqueuer picks them up.
+	if len(results) > 0 && m.DeliverySchedule == database.DeliveryImmediate {
+		_, err := tx.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
 		if err != nil {
 			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
 		}
@@ -221,8 +250,10 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *databas
 		return errors.Wrap(r.handleWebhook(ctx, j), "Webhook")
 	case j.SlackWebhook != nil:
 		return errors.Wrap(r.handleSlackWebhook(ctx, j), "SlackWebhook")
+	case j.Issue != nil:
+		return errors.Wrap(r.handleIssue(ctx, j), "Issue")
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, or issue")
 	}
 }
 
@@ -233,9 +264,9 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	m, err := getActionJobMetadata(ctx, s, j.ID)
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return err
 	}
 
 	e, err := s.GetEmailAction(ctx, *j.Email)
@@ -262,6 +293,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
 		IncludeResults:     e.IncludeResults,
+		Digest:             newDigest(m),
 	}
 
 	data, err := NewTemplateDataForNewSearchResults(args, e)
@@ -291,9 +323,9 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob)
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	m, err := getActionJobMetadata(ctx, s, j.ID)
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return err
 	}
 
 	w, err := s.GetWebhookAction(ctx, *j.Webhook)
@@ -315,6 +347,7 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob)
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
 		IncludeResults:     w.IncludeResults,
+		Digest:             newDigest(m),
 	}
 
 	return sendWebhookNotification(ctx, w.URL, args)
@@ -327,9 +360,9 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	m, err := getActionJobMetadata(ctx, s, j.ID)
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return err
 	}
 
 	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
@@ -351,11 +384,40 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
 		IncludeResults:     w.IncludeResults,
+		Digest:             newDigest(m),
 	}
 
 	return sendSlackNotification(ctx, w.URL, args)
 }
 
+// getActionJobMetadata returns the metadata of an action job. A digest combines the
+// results of several trigger runs, which may have found the same match, so its
+// results are deduplicated.
+func getActionJobMetadata(ctx context.Context, s database.CodeMonitorStore, jobID int32) (*database.ActionJobMetadata, error) {
+	m, err := s.GetActionJobMetadata(ctx, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetActionJobMetadata")
+	}
+	if m.Digest {
+		m.Results = dedupeResults(m.Results)
+	}
+	return m, nil
+}
+
+// dedupeResults removes all but the first occurrence of each match from results.
+func dedupeResults(results []*result.CommitMatch) []*result.CommitMatch {
+	seen := make(map[result.Key]struct{}, len(results))
+	deduped := make([]*result.CommitMatch, 0, len(results))
+	for _, res := range results {
+		if _, ok := seen[res.Key()]; ok {
+			continue
+		}
+		seen[res.Key()] = struct{}{}
+		deduped = append(deduped, res)
+	}
+	return deduped
+}
+
 type StatusCodeError struct {
 	Code   int
 	Status string
@@ -15,6 +15,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
+func TestDedupeResults(t *testing.T) {
+	newerDiff := diffResultMock
+	results := []*result.CommitMatch{&newerDiff, &commitResultMock, &diffResultMock, &commitResultMock}
+	require.Equal(t, []*result.CommitMatch{&newerDiff, &commitResultMock}, dedupeResults(results))
+}
+
 func TestActionRunner(t *testing.T) {
 	logger := logtest.Scoped(t)
 	tests := []struct {
@@ -0,0 +1,294 @@
+package codemonitors
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var ErrContentMonitorLimitHit = errors.New("code monitor query matched too many results to detect changes reliably. Narrow the query or add count:all")
+
+// repoMatches holds the fingerprints of the matches of a content query in a single repository.
+type repoMatches struct {
+	repo         types.MinimalRepo
+	commit       api.CommitID
+	fingerprints map[string]struct{}
+}
+
+// searchContent runs a query over file contents and returns the fingerprints of its matches
+// grouped by repository. Repositories that could not be searched are reported separately so
+// that their previous matches are not mistaken for removed ones.
+func searchContent(ctx context.Context, clients job.RuntimeClients, planJob job.Job) (_ map[api.RepoID]*repoMatches, unsearched map[api.RepoID]struct{}, err error) {
+	agg := streaming.NewAggregatingStream()
+	_, err = planJob.Run(ctx, clients, agg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if agg.Stats.IsLimitHit {
+		return nil, nil, errcode.MakeNonRetryable(ErrContentMonitorLimitHit)
+	}
+
+	unsearched = map[api.RepoID]struct{}{}
+	agg.Stats.Status.Filter(search.RepoStatusCloning|search.RepoStatusMissing|search.RepoStatusTimedout, func(id api.RepoID) {
+		unsearched[id] = struct{}{}
+	})
+
+	matches := map[api.RepoID]*repoMatches{}
+	for _, res := range agg.Results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			return nil, nil, errcode.MakeNonRetryable(errors.Errorf("expected search to only return file matches, but got type %T", res))
+		}
+
+		rm, ok := matches[fm.Repo.ID]
+		if !ok {
+			rm = &repoMatches{repo: fm.Repo, commit: fm.CommitID, fingerprints: map[string]struct{}{}}
+			matches[fm.Repo.ID] = rm
+		}
+		for _, fingerprint := range fileMatchFingerprints(fm) {
+			rm.fingerprints[fingerprint] = struct{}{}
+		}
+	}
+
+	return matches, unsearched, nil
+}
+
+// LastMatches holds the fingerprints of the current matches of a content query in each repository
+// whose set of matches changed since the previous run of a code monitor.
+type LastMatches map[api.RepoID][]string
+
+// Save stores the fingerprints as the baseline for the subsequent runs of the code monitor. It must
+// only be called once the results of the run have been recorded, otherwise the changes reported by
+// the run would be lost if recording them fails.
+func (m LastMatches) Save(ctx context.Context, cm database.CodeMonitorStore, monitorID int64) error {
+	for repoID, fingerprints := range m {
+		if err := cm.UpsertLastMatches(ctx, monitorID, repoID, fingerprints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchContentChanges runs a query over file contents and compares its matches with the matches
+// of the previous run of the code monitor. Each repository whose set of matches changed yields a
+// single commit match at the searched commit, with a diff preview listing the matches that appeared
+// (+) or disappeared (-). The current matches of these repositories are returned so that they can
+// be stored for the next run.
+func searchContentChanges(ctx context.Context, db database.DB, clients job.RuntimeClients, planJob job.Job, monitorID int64) ([]*result.CommitMatch, LastMatches, error) {
+	lastMatches, err := db.CodeMonitors().GetLastMatches(ctx, monitorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches, unsearched, err := searchContent(ctx, clients, planJob)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Repositories that no longer have any matches only appear in the previous snapshot
+	for repoID, fingerprints := range lastMatches {
+		if _, ok := matches[repoID]; ok || len(fingerprints) == 0 {
+			continue
+		}
+		if _, ok := unsearched[repoID]; ok {
+			continue
+		}
+
+		rm, err := resolveRepoMatches(ctx, db, clients.Gitserver, repoID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rm == nil {
+			continue
+		}
+		matches[repoID] = rm
+	}
+
+	var results []*result.CommitMatch
+	newLastMatches := LastMatches{}
+	for repoID, rm := range matches {
+		current := make([]string, 0, len(rm.fingerprints))
+		for fingerprint := range rm.fingerprints {
+			current = append(current, fingerprint)
+		}
+		sort.Strings(current)
+
+		added, removed := diffFingerprints(lastMatches[repoID], current)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		newLastMatches[repoID] = current
+		results = append(results, contentChangeMatch(rm.repo, rm.commit, added, removed))
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Repo.Name < results[j].Repo.Name })
+	return results, newLastMatches, nil
+}
+
+// snapshotContent stores the current matches of a content query as the baseline for the
+// subsequent runs of the code monitor.
+func snapshotContent(ctx context.Context, db database.DB, clients job.RuntimeClients, planJob job.Job, monitorID int64) error {
+	matches, _, err := searchContent(ctx, clients, planJob)
+	if err != nil {
+		return err
+	}
+
+	cm := db.CodeMonitors()
+	if err := cm.DeleteLastMatches(ctx, monitorID); err != nil {
+		return err
+	}
+
+	for repoID, rm := range matches {
+		current := make([]string, 0, len(rm.fingerprints))
+		for fingerprint := range rm.fingerprints {
+			current = append(current, fingerprint)
+		}
+		sort.Strings(current)
+
+		if err := cm.UpsertLastMatches(ctx, monitorID, repoID, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveRepoMatches returns an empty set of matches at the tip of the default branch of the
+// given repository, or nil if the repository no longer exists or is empty.
+func resolveRepoMatches(ctx context.Context, db database.DB, gs gitserver.Client, repoID api.RepoID) (*repoMatches, error) {
+	repo, err := db.Repos().Get(ctx, repoID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	commit, err := gs.ResolveRevision(ctx, repo.Name, "", gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &repoMatches{
+		repo:         types.MinimalRepo{ID: repo.ID, Name: repo.Name},
+		commit:       commit,
+		fingerprints: map[string]struct{}{},
+	}, nil
+}
+
+// fileMatchFingerprints returns a fingerprint for each matched line, symbol, or path of the
+// given file match. A fingerprint is the file path and the matched content separated by a
+// newline. Line numbers are deliberately omitted so that unrelated edits which shift a match
+// within its file are not reported as changes.
+func fileMatchFingerprints(fm *result.FileMatch) []string {
+	var fingerprints []string
+	for _, lm := range fm.ChunkMatches.AsLineMatches() {
+		if len(lm.OffsetAndLengths) == 0 {
+			continue
+		}
+		fingerprints = append(fingerprints, fm.Path+"\n"+strings.TrimSpace(lm.Preview))
+	}
+	for _, sm := range fm.Symbols {
+		fingerprints = append(fingerprints, fm.Path+"\n"+strings.ToLower(sm.Symbol.Kind)+" "+sm.Symbol.Name)
+	}
+	if fm.IsPathMatch() {
+		fingerprints = append(fingerprints, fm.Path+"\n"+fm.Path)
+	}
+	return fingerprints
+}
+
+// diffFingerprints returns the fingerprints of current absent from previous, and the
+// fingerprints of previous absent from current.
+func diffFingerprints(previous, current []string) (added, removed []string) {
+	previousSet := make(map[string]struct{}, len(previous))
+	for _, fingerprint := range previous {
+		previousSet[fingerprint] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, fingerprint := range current {
+		currentSet[fingerprint] = struct{}{}
+	}
+
+	for _, fingerprint := range current {
+		if _, ok := previousSet[fingerprint]; !ok {
+			added = append(added, fingerprint)
+		}
+	}
+	for _, fingerprint := range previous {
+		if _, ok := currentSet[fingerprint]; !ok {
+			removed = append(removed, fingerprint)
+		}
+	}
+	return added, removed
+}
+
+// contentChangeMatch renders the changed matches of a repository as a commit match so that they
+// can be stored and delivered by the existing code monitor actions. The diff preview contains a
+// section per file, listing the matches that disappeared (-) and the matches that appeared (+).
+func contentChangeMatch(repo types.MinimalRepo, commit api.CommitID, added, removed []string) *result.CommitMatch {
+	type change struct {
+		prefix  string
+		content string
+	}
+	changesByPath := map[string][]change{}
+	for _, fingerprint := range removed {
+		path, content, _ := strings.Cut(fingerprint, "\n")
+		changesByPath[path] = append(changesByPath[path], change{"-", content})
+	}
+	for _, fingerprint := range added {
+		path, content, _ := strings.Cut(fingerprint, "\n")
+		changesByPath[path] = append(changesByPath[path], change{"+", content})
+	}
+
+	paths := make([]string, 0, len(changesByPath))
+	for path := range changesByPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var (
+		b      strings.Builder
+		ranges result.Ranges
+		line   int
+	)
+	for _, path := range paths {
+		b.WriteString(path + " " + path + "\n")
+		line++
+
+		for _, c := range changesByPath[path] {
+			start := b.Len() + len(c.prefix)
+			b.WriteString(c.prefix + c.content + "\n")
+			ranges = append(ranges, result.Range{
+				Start: result.Location{Offset: start, Line: line, Column: 1},
+				End:   result.Location{Offset: start + len(c.content), Line: line, Column: 1 + utf8.RuneCountInString(c.content)},
+			})
+			line++
+		}
+	}
+
+	return &result.CommitMatch{
+		Commit: gitdomain.Commit{ID: commit},
+		Repo:   repo,
+		DiffPreview: &result.MatchedString{
+			Content:       b.String(),
+			MatchedRanges: ranges,
+		},
+	}
+}
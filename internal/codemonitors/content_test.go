@@ -0,0 +1,103 @@
+package codemonitors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestFileMatchFingerprints(t *testing.T) {
+	t.Parallel()
+
+	t.Run("content matches", func(t *testing.T) {
+		fm := &result.FileMatch{
+			File: result.File{Path: "main.go"},
+			ChunkMatches: result.ChunkMatches{{
+				Content:      "import \"unsafe\"\n\n\tp := unsafe.Pointer(x)",
+				ContentStart: result.Location{Line: 2},
+				Ranges: result.Ranges{
+					{Start: result.Location{Offset: 8, Line: 2, Column: 8}, End: result.Location{Offset: 14, Line: 2, Column: 14}},
+					{Start: result.Location{Offset: 23, Line: 4, Column: 6}, End: result.Location{Offset: 29, Line: 4, Column: 12}},
+				},
+			}},
+		}
+
+		require.Equal(t, []string{"main.go\nimport \"unsafe\"", "main.go\np := unsafe.Pointer(x)"}, fileMatchFingerprints(fm))
+	})
+
+	t.Run("symbol matches", func(t *testing.T) {
+		fm := &result.FileMatch{
+			File:    result.File{Path: "main.go"},
+			Symbols: []*result.SymbolMatch{{Symbol: result.Symbol{Name: "LegacyClient", Kind: "STRUCT"}}},
+		}
+
+		require.Equal(t, []string{"main.go\nstruct LegacyClient"}, fileMatchFingerprints(fm))
+	})
+
+	t.Run("path matches", func(t *testing.T) {
+		fm := &result.FileMatch{File: result.File{Path: "vendor/legacy/client.go"}}
+
+		require.Equal(t, []string{"vendor/legacy/client.go\nvendor/legacy/client.go"}, fileMatchFingerprints(fm))
+	})
+}
+
+func TestDiffFingerprints(t *testing.T) {
+	t.Parallel()
+
+	added, removed := diffFingerprints(
+		[]string{"a.go\nfoo()", "b.go\nbar()"},
+		[]string{"a.go\nfoo()", "c.go\nbaz()"},
+	)
+	require.Equal(t, []string{"c.go\nbaz()"}, added)
+	require.Equal(t, []string{"b.go\nbar()"}, removed)
+
+	added, removed = diffFingerprints(nil, []string{"a.go\nfoo()"})
+	require.Equal(t, []string{"a.go\nfoo()"}, added)
+	require.Empty(t, removed)
+
+	added, removed = diffFingerprints([]string{"a.go\nfoo()"}, []string{"a.go\nfoo()"})
+	require.Empty(t, added)
+	require.Empty(t, removed)
+}
+
+func TestContentChangeMatch(t *testing.T) {
+	t.Parallel()
+
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/test/test"}
+	match := contentChangeMatch(repo, api.CommitID("deadbeef"), []string{"b.go\nnew()", "a.go\nadded()"}, []string{"a.go\nremoved()"})
+
+	require.Equal(t, repo, match.Repo)
+	require.Equal(t, api.CommitID("deadbeef"), match.Commit.ID)
+	require.Nil(t, match.MessagePreview)
+	require.Equal(t, "a.go a.go\n-removed()\n+added()\nb.go b.go\n+new()\n", match.DiffPreview.Content)
+	require.Equal(t, result.Ranges{
+		{Start: result.Location{Offset: 11, Line: 1, Column: 1}, End: result.Location{Offset: 20, Line: 1, Column: 10}},
+		{Start: result.Location{Offset: 22, Line: 2, Column: 1}, End: result.Location{Offset: 29, Line: 2, Column: 8}},
+		{Start: result.Location{Offset: 41, Line: 4, Column: 1}, End: result.Location{Offset: 46, Line: 4, Column: 6}},
+	}, match.DiffPreview.MatchedRanges)
+	require.Equal(t, 3, match.ResultCount())
+
+	for _, r := range match.DiffPreview.MatchedRanges {
+		require.NotContains(t, match.DiffPreview.Content[r.Start.Offset:r.End.Offset], "\n")
+	}
+}
+
+func TestLastMatchesSave(t *testing.T) {
+	t.Parallel()
+
+	cm := dbmocks.NewMockCodeMonitorStore()
+	lastMatches := LastMatches{1: {"a.go\nfoo()"}}
+	require.NoError(t, lastMatches.Save(context.Background(), cm, 42))
+
+	calls := cm.UpsertLastMatchesFunc.History()
+	require.Len(t, calls, 1)
+	require.Equal(t, int64(42), calls[0].Arg1)
+	require.Equal(t, api.RepoID(1), calls[0].Arg2)
+	require.Equal(t, []string{"a.go\nfoo()"}, calls[0].Arg3)
+}
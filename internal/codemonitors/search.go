@@ -23,7 +23,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func Search(ctx context.Context, logger log.Logger, db database.DB, query string, monitorID int64) (_ []*result.CommitMatch, err error) {
+// Search runs the query of a code monitor and returns the results that are new since the previous
+// run. Commit and diff queries only search the commits added since the previous run. Any other query
+// is searched in full and each repository whose set of matches changed yields a commit match at the
+// searched commit that lists the matches that appeared or disappeared. The current matches of these
+// repositories are returned as well, and must be saved once the results have been recorded.
+func Search(ctx context.Context, logger log.Logger, db database.DB, query string, monitorID int64) (_ []*result.CommitMatch, _ LastMatches, err error) {
 	searchClient := client.New(logger, db)
 	inputs, err := searchClient.Plan(
 		ctx,
@@ -34,14 +39,20 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, query string
 		search.Streaming,
 	)
 	if err != nil {
-		return nil, errcode.MakeNonRetryable(err)
+		return nil, nil, errcode.MakeNonRetryable(err)
 	}
 
 	// Inline job creation so we can mutate the commit job before running it
 	clients := searchClient.JobClients()
 	planJob, err := jobutil.NewPlanJob(inputs, inputs.Plan)
 	if err != nil {
-		return nil, errcode.MakeNonRetryable(err)
+		return nil, nil, errcode.MakeNonRetryable(err)
+	}
+
+	// Queries that do not search commits or diffs are monitored by comparing the
+	// set of their matches with the set found by the previous run.
+	if !job.HasDescendent[*commit.SearchJob](planJob) {
+		return searchContentChanges(ctx, db, clients, planJob, monitorID)
 	}
 
 	hook := func(ctx context.Context, db database.DB, gs commit.GitserverClient, args *gitprotocol.SearchRequest, repoID api.RepoID, doSearch commit.DoSearchFunc) error {
@@ -49,31 +60,32 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, query string
 	}
 	planJob, err = addCodeMonitorHook(planJob, hook)
 	if err != nil {
-		return nil, errcode.MakeNonRetryable(err)
+		return nil, nil, errcode.MakeNonRetryable(err)
 	}
 
 	// Execute the search
 	agg := streaming.NewAggregatingStream()
 	_, err = planJob.Run(ctx, clients, agg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	results := make([]*result.CommitMatch, len(agg.Results))
 	for i, res := range agg.Results {
 		cm, ok := res.(*result.CommitMatch)
 		if !ok {
-			return nil, errors.Errorf("expected search to only return commit matches, but got type %T", res)
+			return nil, nil, errors.Errorf("expected search to only return commit matches, but got type %T", res)
 		}
 		results[i] = cm
 	}
 
-	return results, nil
+	return results, nil, nil
 }
 
 // Snapshot runs a dummy search that just saves the current state of the searched repos in the database.
 // On subsequent runs, this allows us to treat all new repos or sets of args as something new that should
-// be searched from the beginning.
+// be searched from the beginning. For queries over file contents, the current set of matches is saved
+// instead, so that only matches that appear or disappear afterwards are reported.
 func Snapshot(ctx context.Context, logger log.Logger, db database.DB, query string, monitorID int64) error {
 	searchClient := client.New(logger, db)
 	inputs, err := searchClient.Plan(
@@ -94,6 +106,10 @@ func Snapshot(ctx context.Context, logger log.Logger, db database.DB, query stri
 		return err
 	}
 
+	if !job.HasDescendent[*commit.SearchJob](planJob) {
+		return snapshotContent(ctx, db, clients, planJob, monitorID)
+	}
+
 	hook := func(ctx context.Context, db database.DB, gs commit.GitserverClient, args *gitprotocol.SearchRequest, repoID api.RepoID, _ commit.DoSearchFunc) error {
 		return snapshotHook(ctx, db, gs, args, monitorID, repoID)
 	}
@@ -146,7 +162,7 @@ func addCodeMonitorHook(in job.Job, hook commit.CodeMonitorHook) (_ job.Job, err
 		default:
 			if len(j.Children()) == 0 {
 				if err == nil {
-					err = errors.New("all branches of query must be of type:diff or type:commit. If you have an AND/OR operator in your query, ensure that both sides have type:commit or type:diff.")
+					err = errors.New("all branches of a commit query must be of type:diff or type:commit. If you have an AND/OR operator in your query, ensure that both sides have type:commit or type:diff.")
 				}
 			}
 			return j
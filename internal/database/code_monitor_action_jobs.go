@@ -18,8 +18,13 @@ type ActionJob struct {
 	Email        *int64
 	Webhook      *int64
 	SlackWebhook *int64
+	Issue        *int64
 	TriggerEvent int32
 
+	// DigestAfterTriggerEvent is set if the job delivers a digest, which covers the
+	// results of the trigger jobs after it up to and including TriggerEvent.
+	DigestAfterTriggerEvent *int32
+
 	// Fields demanded by any dbworker.
 	State          string
 	FailureMessage *string
@@ -47,6 +52,13 @@ type ActionJobMetadata struct {
 
 	// The query with after: filter.
 	Query string
+
+	// DeliverySchedule is the delivery schedule of the monitor. For digests, Results
+	// holds the results of all DigestRuns trigger runs covered by the digest, newest
+	// first, and may contain the same match more than once.
+	DeliverySchedule DeliverySchedule
+	Digest           bool
+	DigestRuns       int
 }
 
 // ActionJobColumns is the list of db columns used to populate an ActionJob struct.
@@ -56,7 +68,9 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.email"),
 	sqlf.Sprintf("cm_action_jobs.webhook"),
 	sqlf.Sprintf("cm_action_jobs.slack_webhook"),
+	sqlf.Sprintf("cm_action_jobs.issue"),
 	sqlf.Sprintf("cm_action_jobs.trigger_event"),
+	sqlf.Sprintf("cm_action_jobs.digest_after_trigger_event"),
 	sqlf.Sprintf("cm_action_jobs.state"),
 	sqlf.Sprintf("cm_action_jobs.failure_message"),
 	sqlf.Sprintf("cm_action_jobs.started_at"),
@@ -87,6 +101,10 @@ type ListActionJobsOpts struct {
 	// the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
+	// IssueActionID, if set, will filter to only actions jobs that are executing
+	// the given issue action. Refers to cm_issue_actions(id)
+	IssueActionID *int
+
 	// First, if defined, limits the operation to only the first n results
 	First *int
 
@@ -110,6 +128,9 @@ func (o ListActionJobsOpts) Conds() *sqlf.Query {
 	if o.SlackWebhookID != nil {
 		conds = append(conds, sqlf.Sprintf("slack_webhook = %s", *o.SlackWebhookID))
 	}
+	if o.IssueActionID != nil {
+		conds = append(conds, sqlf.Sprintf("issue = %s", *o.IssueActionID))
+	}
 	if o.After != nil {
 		conds = append(conds, sqlf.Sprintf("id > %s", *o.After))
 	}
@@ -197,14 +218,25 @@ WITH due_emails AS (
 	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
 	WHERE state = 'queued'
 		OR state = 'processing'
+), due_issues AS (
+	SELECT id
+	FROM cm_issue_actions
+	WHERE monitor = %s
+		AND enabled = true
+	EXCEPT
+	SELECT DISTINCT issue as id FROM cm_action_jobs
+	WHERE state = 'queued'
+		OR state = 'processing'
 )
-INSERT INTO cm_action_jobs (email, webhook, slack_webhook, trigger_event)
-SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, issue, trigger_event)
+SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+UNION
+SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_webhooks
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_slack_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_slack_webhooks
-ORDER BY 1, 2, 3
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_issues
+ORDER BY 1, 2, 3, 4
 RETURNING %s
 `
 
@@ -214,9 +246,77 @@ func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, moni
 		monitorID,
 		monitorID,
 		monitorID,
+		monitorID,
 		triggerJobID,
 		triggerJobID,
 		triggerJobID,
+		triggerJobID,
+		sqlf.Join(ActionJobColumns, ","),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanActionJobs(rows)
+}
+
+const enqueueDigestActionJobsFmtStr = `
+WITH candidates AS (
+	SELECT id, COALESCE(last_digest_trigger_job, 0) AS after
+	FROM cm_monitors
+	WHERE enabled = true
+		AND delivery_schedule <> 'immediate'
+		AND (
+			last_digest_at IS NULL
+			OR last_digest_at <= %s::timestamptz - CASE delivery_schedule
+				WHEN 'hourly' THEN interval '1 hour'
+				WHEN 'daily' THEN interval '1 day'
+				WHEN 'weekly' THEN interval '1 week'
+			END
+		)
+	FOR UPDATE SKIP LOCKED
+), due AS (
+	SELECT candidates.id AS monitor, candidates.after, MAX(ctj.id) AS trigger_event
+	FROM candidates
+	JOIN cm_queries cq ON cq.monitor = candidates.id
+	JOIN cm_trigger_jobs ctj ON ctj.query = cq.id
+	WHERE ctj.id > candidates.after
+		AND ctj.state = 'completed'
+		AND jsonb_array_length(ctj.search_results) > 0
+	GROUP BY candidates.id, candidates.after
+), updated AS (
+	UPDATE cm_monitors
+	SET last_digest_at = %s,
+		last_digest_trigger_job = due.trigger_event
+	FROM due
+	WHERE cm_monitors.id = due.monitor
+)
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, issue, trigger_event, digest_after_trigger_event)
+SELECT a.id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), due.trigger_event, due.after
+FROM cm_emails a JOIN due ON a.monitor = due.monitor WHERE a.enabled = true
+UNION
+SELECT CAST(NULL AS BIGINT), a.id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), due.trigger_event, due.after
+FROM cm_webhooks a JOIN due ON a.monitor = due.monitor WHERE a.enabled = true
+UNION
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), a.id, CAST(NULL AS BIGINT), due.trigger_event, due.after
+FROM cm_slack_webhooks a JOIN due ON a.monitor = due.monitor WHERE a.enabled = true
+UNION
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), a.id, due.trigger_event, due.after
+FROM cm_issue_actions a JOIN due ON a.monitor = due.monitor WHERE a.enabled = true
+ORDER BY 1, 2, 3, 4
+RETURNING %s
+`
+
+// EnqueueDigestActionJobs enqueues action jobs for the monitors with a digest delivery
+// schedule whose next digest is due and who have new results since their last digest.
+// A digest is due once the period of the schedule has passed since the last digest.
+func (s *codeMonitorStore) EnqueueDigestActionJobs(ctx context.Context) ([]*ActionJob, error) {
+	now := s.Now()
+	q := sqlf.Sprintf(
+		enqueueDigestActionJobsFmtStr,
+		now,
+		now,
 		sqlf.Join(ActionJobColumns, ","),
 	)
 	rows, err := s.Query(ctx, q)
@@ -233,7 +333,11 @@ SELECT
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
-	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
+	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END,
+	cm.delivery_schedule,
+	ctj.query,
+	caj.trigger_event,
+	caj.digest_after_trigger_event
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
 INNER JOIN cm_queries cq on cq.id = ctj.query
@@ -242,19 +346,55 @@ INNER JOIN users on cm.namespace_user_id = users.id
 WHERE caj.id = %s
 `
 
+const getDigestResultsFmtStr = `
+SELECT search_results
+FROM cm_trigger_jobs
+WHERE query = %s
+	AND id > %s
+	AND id <= %s
+	AND jsonb_array_length(search_results) > 0
+ORDER BY id DESC
+`
+
 // GetActionJobMetada returns the set of fields needed to execute all action jobs
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var (
+		resultsJSON  []byte
+		queryID      int64
+		triggerEvent int32
+		digestAfter  *int32
+	)
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName, &m.DeliverySchedule, &queryID, &triggerEvent, &digestAfter)
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
+	if digestAfter == nil {
+		if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	m.Digest = true
+	rows, err := s.Query(ctx, sqlf.Sprintf(getDigestResultsFmtStr, queryID, *digestAfter, triggerEvent))
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	defer rows.Close()
+	for rows.Next() {
+		var runResults []*result.CommitMatch
+		if err := rows.Scan(&resultsJSON); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(resultsJSON, &runResults); err != nil {
+			return nil, err
+		}
+		m.Results = append(m.Results, runResults...)
+		m.DigestRuns++
+	}
+	return m, rows.Err()
 }
 
 const actionJobForIDFmtStr = `
@@ -288,7 +428,9 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.Email,
 		&aj.Webhook,
 		&aj.SlackWebhook,
+		&aj.Issue,
 		&aj.TriggerEvent,
+		&aj.DigestAfterTriggerEvent,
 		&aj.State,
 		&aj.FailureMessage,
 		&aj.StartedAt,
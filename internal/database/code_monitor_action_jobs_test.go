@@ -69,10 +69,80 @@ func TestGetActionJobMetadata(t *testing.T) {
 		Results:     wantResults,
 		MonitorID:   fixtures.monitor.ID,
 		OwnerName:   userName,
+
+		DeliverySchedule: DeliveryImmediate,
 	}
 	require.Equal(t, want, got)
 }
 
+func TestEnqueueDigestActionJobs(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, userID, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+	ts := &TestStore{s}
+
+	now := s.Now()
+	runTrigger := func(numResults int) int32 {
+		t.Helper()
+		triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, triggerJobs, 1)
+		err = s.UpdateTriggerJobWithResults(ctx, triggerJobs[0].ID, testQuery, make([]*result.CommitMatch, numResults))
+		require.NoError(t, err)
+		err = ts.SetJobStatus(ctx, TriggerJobs, Completed, int(triggerJobs[0].ID))
+		require.NoError(t, err)
+		return triggerJobs[0].ID
+	}
+
+	// Results found before the monitor switches to a digest were already delivered.
+	delivered := runTrigger(1)
+	m, err := s.UpdateMonitor(userCTX, fixtures.monitor.ID, MonitorArgs{
+		Description:      testDescription,
+		Enabled:          true,
+		NamespaceUserID:  &userID,
+		DeliverySchedule: DeliveryDaily,
+	})
+	require.NoError(t, err)
+	require.Equal(t, DeliveryDaily, m.DeliverySchedule)
+
+	runTrigger(2)
+	runTrigger(0)
+	last := runTrigger(3)
+
+	actionJobs, err := s.EnqueueDigestActionJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 2)
+	require.Equal(t, &fixtures.emails[0].ID, actionJobs[0].Email)
+	require.Equal(t, last, actionJobs[0].TriggerEvent)
+	require.Equal(t, &delivered, actionJobs[0].DigestAfterTriggerEvent)
+
+	got, err := s.GetActionJobMetadata(ctx, actionJobs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, DeliveryDaily, got.DeliverySchedule)
+	require.True(t, got.Digest)
+	require.Equal(t, 2, got.DigestRuns)
+	require.Len(t, got.Results, 5)
+
+	// The next digest is not due before a day has passed.
+	next := runTrigger(1)
+	actionJobs, err = s.EnqueueDigestActionJobs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, actionJobs)
+
+	s.now = func() time.Time { return now.Add(25 * time.Hour) }
+	actionJobs, err = s.EnqueueDigestActionJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 2)
+	require.Equal(t, next, actionJobs[0].TriggerEvent)
+	require.Equal(t, &last, actionJobs[0].DigestAfterTriggerEvent)
+
+	// Nothing is enqueued without new results.
+	s.now = func() time.Time { return now.Add(50 * time.Hour) }
+	actionJobs, err = s.EnqueueDigestActionJobs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, actionJobs)
+}
+
 func TestScanActionJob(t *testing.T) {
 	ctx, db, s := newTestStore(t)
 	_, _, userCTX := newTestUser(ctx, t, db)
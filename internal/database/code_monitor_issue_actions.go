@@ -0,0 +1,432 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// IssueTracker is where an issue action files its issues.
+type IssueTracker string
+
+const (
+	// IssueTrackerCodeHost files issues in the repository with results on its code host.
+	// GitHub and GitLab are supported.
+	IssueTrackerCodeHost IssueTracker = "codehost"
+	// IssueTrackerJira files issues in a Jira project.
+	IssueTrackerJira IssueTracker = "jira"
+)
+
+type IssueAction struct {
+	ID             int64
+	Monitor        int64
+	Enabled        bool
+	IncludeResults bool
+	Tracker        IssueTracker
+	TitleTemplate  string
+	BodyTemplate   string
+	Labels         []string
+
+	JiraURL        string
+	JiraProjectKey string
+	JiraIssueType  string
+	JiraUsername   string
+	// JiraToken is the token used to authenticate against Jira, which is encrypted with
+	// the code monitor key if one is configured. It is nil unless the action files issues
+	// in Jira.
+	JiraToken *encryption.Encryptable
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+type IssueActionArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	Tracker        IssueTracker
+	TitleTemplate  string
+	BodyTemplate   string
+	Labels         []string
+
+	JiraURL        string
+	JiraProjectKey string
+	JiraIssueType  string
+	JiraUsername   string
+	// JiraToken is the token used to authenticate against Jira. When updating an action,
+	// a nil token keeps the token that is already stored.
+	JiraToken *string
+}
+
+const updateIssueActionQuery = `
+UPDATE cm_issue_actions
+SET enabled = %s,
+	include_results = %s,
+	tracker = %s,
+	title_template = %s,
+	body_template = %s,
+	labels = %s,
+	jira_url = %s,
+	jira_project_key = %s,
+	jira_issue_type = %s,
+	jira_username = %s,
+	jira_token = CASE WHEN %s = 'jira' THEN COALESCE(%s, jira_token) END,
+	jira_token_key_id = CASE WHEN %s = 'jira' THEN COALESCE(%s, jira_token_key_id) END,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_issue_actions.monitor
+			AND %s
+	)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateIssueAction(ctx context.Context, id int64, args *IssueActionArgs) (*IssueAction, error) {
+	a := actor.FromContext(ctx)
+
+	user, err := a.User(ctx, s.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	jira, err := s.jiraColumns(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		updateIssueActionQuery,
+		args.Enabled,
+		args.IncludeResults,
+		args.Tracker,
+		args.TitleTemplate,
+		args.BodyTemplate,
+		pq.Array(nonNilLabels(args.Labels)),
+		jira.url,
+		jira.projectKey,
+		jira.issueType,
+		jira.username,
+		args.Tracker,
+		jira.token,
+		args.Tracker,
+		jira.tokenKeyID,
+		a.UID,
+		s.Now(),
+		id,
+		namespaceScopeQuery(user),
+		sqlf.Join(issueActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row, s.getEncryptionKey())
+}
+
+const createIssueActionQuery = `
+INSERT INTO cm_issue_actions
+(monitor, enabled, include_results, tracker, title_template, body_template, labels, jira_url, jira_project_key, jira_issue_type, jira_username, jira_token, jira_token_key_id, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateIssueAction(ctx context.Context, monitorID int64, args *IssueActionArgs) (*IssueAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	jira, err := s.jiraColumns(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		createIssueActionQuery,
+		monitorID,
+		args.Enabled,
+		args.IncludeResults,
+		args.Tracker,
+		args.TitleTemplate,
+		args.BodyTemplate,
+		pq.Array(nonNilLabels(args.Labels)),
+		jira.url,
+		jira.projectKey,
+		jira.issueType,
+		jira.username,
+		jira.token,
+		jira.tokenKeyID,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(issueActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row, s.getEncryptionKey())
+}
+
+type issueActionJiraColumns struct {
+	url, projectKey, issueType, username, token, tokenKeyID *string
+}
+
+// jiraColumns returns the values of the Jira-specific columns of an issue action, which
+// are NULL unless the action files issues in Jira. The token is encrypted if a code
+// monitor key is configured; its key ID is empty if it is stored unencrypted.
+func (s *codeMonitorStore) jiraColumns(ctx context.Context, args *IssueActionArgs) (issueActionJiraColumns, error) {
+	if args.Tracker != IssueTrackerJira {
+		return issueActionJiraColumns{}, nil
+	}
+
+	columns := issueActionJiraColumns{
+		url:        dbutil.NullStringColumn(args.JiraURL),
+		projectKey: dbutil.NullStringColumn(args.JiraProjectKey),
+		issueType:  dbutil.NullStringColumn(args.JiraIssueType),
+		username:   dbutil.NullStringColumn(args.JiraUsername),
+	}
+	if args.JiraToken != nil {
+		token, keyID, err := encryption.MaybeEncrypt(ctx, s.getEncryptionKey(), *args.JiraToken)
+		if err != nil {
+			return issueActionJiraColumns{}, errors.Wrap(err, "encrypting Jira token")
+		}
+		columns.token = &token
+		columns.tokenKeyID = &keyID
+	}
+	return columns, nil
+}
+
+func (s *codeMonitorStore) getEncryptionKey() encryption.Key {
+	if s.key != nil {
+		return s.key
+	}
+	return keyring.Default().CodeMonitorKey
+}
+
+func nonNilLabels(labels []string) []string {
+	// Appease non-null constraint on column
+	if labels == nil {
+		return []string{}
+	}
+	return labels
+}
+
+const deleteIssueActionQuery = `
+DELETE FROM cm_issue_actions
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteIssueActions(ctx context.Context, monitorID int64, issueActionIDs ...int64) error {
+	if len(issueActionIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(issueActionIDs))
+	for _, ids := range issueActionIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteIssueActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countIssueActionsQuery = `
+SELECT COUNT(*)
+FROM cm_issue_actions
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountIssueActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countIssueActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getIssueActionQuery = `
+SELECT %s -- IssueActionColumns
+FROM cm_issue_actions
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetIssueAction(ctx context.Context, id int64) (*IssueAction, error) {
+	q := sqlf.Sprintf(
+		getIssueActionQuery,
+		sqlf.Join(issueActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanIssueAction(row, s.getEncryptionKey())
+}
+
+const listIssueActionsQuery = `
+SELECT %s -- IssueActionColumns
+FROM cm_issue_actions
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListIssueActions(ctx context.Context, opts ListActionsOpts) ([]*IssueAction, error) {
+	q := sqlf.Sprintf(
+		listIssueActionsQuery,
+		sqlf.Join(issueActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanIssueActions(rows, s.getEncryptionKey())
+}
+
+// issueActionColumns is the set of columns in the cm_issue_actions table
+// This must be kept in sync with scanIssueAction
+var issueActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_issue_actions.id"),
+	sqlf.Sprintf("cm_issue_actions.monitor"),
+	sqlf.Sprintf("cm_issue_actions.enabled"),
+	sqlf.Sprintf("cm_issue_actions.include_results"),
+	sqlf.Sprintf("cm_issue_actions.tracker"),
+	sqlf.Sprintf("cm_issue_actions.title_template"),
+	sqlf.Sprintf("cm_issue_actions.body_template"),
+	sqlf.Sprintf("cm_issue_actions.labels"),
+	sqlf.Sprintf("cm_issue_actions.jira_url"),
+	sqlf.Sprintf("cm_issue_actions.jira_project_key"),
+	sqlf.Sprintf("cm_issue_actions.jira_issue_type"),
+	sqlf.Sprintf("cm_issue_actions.jira_username"),
+	sqlf.Sprintf("cm_issue_actions.jira_token"),
+	sqlf.Sprintf("cm_issue_actions.jira_token_key_id"),
+	sqlf.Sprintf("cm_issue_actions.created_by"),
+	sqlf.Sprintf("cm_issue_actions.created_at"),
+	sqlf.Sprintf("cm_issue_actions.changed_by"),
+	sqlf.Sprintf("cm_issue_actions.changed_at"),
+}
+
+func scanIssueActions(rows *sql.Rows, key encryption.Key) ([]*IssueAction, error) {
+	var as []*IssueAction
+	for rows.Next() {
+		a, err := scanIssueAction(rows, key)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, rows.Err()
+}
+
+// scanIssueAction scans an IssueAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with issueActionColumns.
+func scanIssueAction(scanner dbutil.Scanner, key encryption.Key) (*IssueAction, error) {
+	var (
+		a          IssueAction
+		jiraToken  string
+		tokenKeyID string
+	)
+	err := scanner.Scan(
+		&a.ID,
+		&a.Monitor,
+		&a.Enabled,
+		&a.IncludeResults,
+		&a.Tracker,
+		&a.TitleTemplate,
+		&a.BodyTemplate,
+		pq.Array(&a.Labels),
+		&dbutil.NullString{S: &a.JiraURL},
+		&dbutil.NullString{S: &a.JiraProjectKey},
+		&dbutil.NullString{S: &a.JiraIssueType},
+		&dbutil.NullString{S: &a.JiraUsername},
+		&dbutil.NullString{S: &jiraToken},
+		&dbutil.NullString{S: &tokenKeyID},
+		&a.CreatedBy,
+		&a.CreatedAt,
+		&a.ChangedBy,
+		&a.ChangedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if jiraToken != "" {
+		if tokenKeyID == "" {
+			a.JiraToken = encryption.NewUnencrypted(jiraToken)
+		} else {
+			a.JiraToken = encryption.NewEncrypted(jiraToken, tokenKeyID, key)
+		}
+	}
+	return &a, nil
+}
+
+// IssueActionIssue is the most recent issue opened by an issue action for a repository.
+// While it is open, new results in the repository are added to it as comments instead
+// of opening another issue.
+type IssueActionIssue struct {
+	IssueAction int64
+	RepoID      api.RepoID
+	// ExternalID identifies the issue on its tracker: the issue number on GitHub, the
+	// project-scoped issue ID on GitLab, or the issue key on Jira.
+	ExternalID string
+	URL        string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+const getIssueActionIssueQuery = `
+SELECT issue_action, repo_id, external_id, url, created_at, updated_at
+FROM cm_issue_action_issues
+WHERE issue_action = %s
+	AND repo_id = %s
+`
+
+// GetIssueActionIssue returns the most recent issue opened by the given issue action for
+// the given repository, or nil if no issue has been opened yet.
+func (s *codeMonitorStore) GetIssueActionIssue(ctx context.Context, issueActionID int64, repoID api.RepoID) (*IssueActionIssue, error) {
+	var i IssueActionIssue
+	err := s.QueryRow(ctx, sqlf.Sprintf(getIssueActionIssueQuery, issueActionID, int64(repoID))).Scan(
+		&i.IssueAction,
+		&i.RepoID,
+		&i.ExternalID,
+		&i.URL,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &i, nil
+}
+
+const upsertIssueActionIssueQuery = `
+INSERT INTO cm_issue_action_issues (issue_action, repo_id, external_id, url, created_at, updated_at)
+VALUES (%s, %s, %s, %s, %s, %s)
+ON CONFLICT (issue_action, repo_id) DO UPDATE
+SET external_id = EXCLUDED.external_id,
+	url = EXCLUDED.url,
+	created_at = CASE WHEN cm_issue_action_issues.external_id = EXCLUDED.external_id THEN cm_issue_action_issues.created_at ELSE EXCLUDED.created_at END,
+	updated_at = EXCLUDED.updated_at
+`
+
+// UpsertIssueActionIssue records the issue that the given issue action last opened or
+// commented on for the given repository.
+func (s *codeMonitorStore) UpsertIssueActionIssue(ctx context.Context, issueActionID int64, repoID api.RepoID, externalID, url string) error {
+	now := s.Now()
+	return s.Exec(ctx, sqlf.Sprintf(upsertIssueActionIssueQuery, issueActionID, int64(repoID), externalID, url, now, now))
+}
@@ -0,0 +1,288 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestCodeMonitorStoreIssueActions(t *testing.T) {
+	ctx := context.Background()
+	codeHostArgs := &IssueActionArgs{
+		Enabled:       true,
+		Tracker:       IssueTrackerCodeHost,
+		TitleTemplate: "New results for {{.Repository}}",
+		Labels:        []string{"security"},
+	}
+	jiraArgs := &IssueActionArgs{
+		Enabled:        true,
+		Tracker:        IssueTrackerJira,
+		JiraURL:        "https://example.atlassian.net",
+		JiraProjectKey: "SEC",
+		JiraIssueType:  "Bug",
+		JiraUsername:   "alice@example.com",
+		JiraToken:      pointers.Ptr("token"),
+	}
+
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+		require.Equal(t, []string{"security"}, action.Labels)
+		require.Equal(t, "", action.JiraURL)
+
+		got, err := s.GetIssueAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, jiraArgs)
+		require.NoError(t, err)
+		require.Equal(t, "token", decryptJiraToken(t, action))
+
+		// Omitting the token keeps the stored one.
+		updatedArgs := *jiraArgs
+		updatedArgs.Enabled = false
+		updatedArgs.JiraProjectKey = "OPS"
+		updatedArgs.JiraToken = nil
+		updated, err := s.UpdateIssueAction(ctx, action.ID, &updatedArgs)
+		require.NoError(t, err)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, "OPS", updated.JiraProjectKey)
+		require.Equal(t, "token", decryptJiraToken(t, updated))
+
+		got, err := s.GetIssueAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+
+		// Switching to the code host tracker clears the Jira settings.
+		updated, err = s.UpdateIssueAction(ctx, action.ID, codeHostArgs)
+		require.NoError(t, err)
+		require.Equal(t, IssueTrackerCodeHost, updated.Tracker)
+		require.Equal(t, "", updated.JiraURL)
+		require.Nil(t, updated.JiraToken)
+	})
+
+	t.Run("EncryptedJiraToken", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		s.key = et.TestKey{}
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, jiraArgs)
+		require.NoError(t, err)
+		require.Equal(t, "token", decryptJiraToken(t, action))
+
+		var rawToken, keyID string
+		err = s.QueryRow(ctx, sqlf.Sprintf("SELECT jira_token, jira_token_key_id FROM cm_issue_actions WHERE id = %s", action.ID)).Scan(&rawToken, &keyID)
+		require.NoError(t, err)
+		require.NotEqual(t, "token", rawToken)
+		require.NotEmpty(t, keyID)
+
+		// Updating the token encrypts the new one.
+		updatedArgs := *jiraArgs
+		updatedArgs.JiraToken = pointers.Ptr("rotated")
+		updated, err := s.UpdateIssueAction(ctx, action.ID, &updatedArgs)
+		require.NoError(t, err)
+		require.Equal(t, "rotated", decryptJiraToken(t, updated))
+	})
+
+	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+
+		_, err := s.UpdateIssueAction(ctx, 383838, codeHostArgs)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action1, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		action2, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, jiraArgs)
+		require.NoError(t, err)
+
+		err = s.DeleteIssueActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetIssueAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetIssueAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("CountListCreate", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		count, err := s.CountIssueActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateIssueAction(ctx, fixtures.monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		_, err = s.CreateIssueAction(ctx, fixtures.monitor.ID, jiraArgs)
+		require.NoError(t, err)
+
+		count, err = s.CountIssueActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		actions, err := s.ListIssueActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+
+		first := 1
+		actions, err = s.ListIssueActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+	})
+
+	t.Run("EnqueueActionJobs", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateIssueAction(ctx, fixtures.monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, triggerJobs, 1)
+
+		actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobs[0].ID)
+		require.NoError(t, err)
+
+		var issueJobs int
+		for _, job := range actionJobs {
+			if job.Issue != nil {
+				require.Equal(t, action.ID, *job.Issue)
+				issueJobs++
+			}
+		}
+		require.Equal(t, 1, issueJobs)
+
+		count, err := s.CountActionJobs(ctx, ListActionJobsOpts{IssueActionID: pointers.Ptr(int(action.ID))})
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("TrackedIssues", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		fixtures := populateCodeMonitorFixtures(t, db)
+		ctx := actor.WithActor(ctx, actor.FromUser(fixtures.User.ID))
+		s := db.CodeMonitors()
+
+		action, err := s.CreateIssueAction(ctx, fixtures.Monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		issue, err := s.GetIssueActionIssue(ctx, action.ID, fixtures.Repo.ID)
+		require.NoError(t, err)
+		require.Nil(t, issue)
+
+		err = s.UpsertIssueActionIssue(ctx, action.ID, fixtures.Repo.ID, "42", "https://github.com/sourcegraph/sourcegraph/issues/42")
+		require.NoError(t, err)
+
+		issue, err = s.GetIssueActionIssue(ctx, action.ID, fixtures.Repo.ID)
+		require.NoError(t, err)
+		require.Equal(t, "42", issue.ExternalID)
+		require.Equal(t, "https://github.com/sourcegraph/sourcegraph/issues/42", issue.URL)
+
+		err = s.UpsertIssueActionIssue(ctx, action.ID, fixtures.Repo.ID, "43", "https://github.com/sourcegraph/sourcegraph/issues/43")
+		require.NoError(t, err)
+
+		issue, err = s.GetIssueActionIssue(ctx, action.ID, fixtures.Repo.ID)
+		require.NoError(t, err)
+		require.Equal(t, "43", issue.ExternalID)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		uid3 := insertTestUser(ctx, t, db, "u3", true)
+		ctx3 := actor.WithActor(ctx, actor.FromUser(uid3))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+
+		ia, err := s.CreateIssueAction(ctx1, fixtures.monitor.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateIssueAction(ctx1, ia.ID, codeHostArgs)
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateIssueAction(ctx2, ia.ID, codeHostArgs)
+		require.Error(t, err)
+
+		// User3 can update it
+		_, err = s.UpdateIssueAction(ctx3, ia.ID, jiraArgs)
+		require.NoError(t, err)
+
+		ia, err = s.GetIssueAction(ctx1, ia.ID)
+		require.NoError(t, err)
+		require.Equal(t, IssueTrackerJira, ia.Tracker)
+	})
+}
+
+func decryptJiraToken(t *testing.T, action *IssueAction) string {
+	t.Helper()
+
+	require.NotNil(t, action.JiraToken)
+	token, err := action.JiraToken.Decrypt(context.Background())
+	require.NoError(t, err)
+	return token
+}
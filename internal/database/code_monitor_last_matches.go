@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+func (s *codeMonitorStore) UpsertLastMatches(ctx context.Context, monitorID int64, repoID api.RepoID, fingerprints []string) error {
+	rawQuery := `
+	INSERT INTO cm_last_matches (monitor_id, repo_id, fingerprints)
+	VALUES (%s, %s, %s)
+	ON CONFLICT (monitor_id, repo_id) DO UPDATE
+	SET fingerprints = %s
+	`
+
+	// Appease non-null constraint on column
+	if fingerprints == nil {
+		fingerprints = []string{}
+	}
+	q := sqlf.Sprintf(rawQuery, monitorID, int64(repoID), pq.StringArray(fingerprints), pq.StringArray(fingerprints))
+	return s.Exec(ctx, q)
+}
+
+func (s *codeMonitorStore) GetLastMatches(ctx context.Context, monitorID int64) (_ map[api.RepoID][]string, err error) {
+	rawQuery := `
+	SELECT repo_id, fingerprints
+	FROM cm_last_matches
+	WHERE monitor_id = %s
+	`
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(rawQuery, monitorID))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	lastMatches := map[api.RepoID][]string{}
+	for rows.Next() {
+		var (
+			repoID       int32
+			fingerprints []string
+		)
+		if err := rows.Scan(&repoID, (*pq.StringArray)(&fingerprints)); err != nil {
+			return nil, err
+		}
+		lastMatches[api.RepoID(repoID)] = fingerprints
+	}
+	return lastMatches, nil
+}
+
+func (s *codeMonitorStore) DeleteLastMatches(ctx context.Context, monitorID int64) error {
+	rawQuery := `
+	DELETE FROM cm_last_matches
+	WHERE monitor_id = %s
+	`
+
+	return s.Exec(ctx, sqlf.Sprintf(rawQuery, monitorID))
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreLastMatches(t *testing.T) {
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	t.Run("insert get upsert get delete", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		db := NewDB(logger, dbtest.NewDB(logger, t))
+		fixtures := populateCodeMonitorFixtures(t, db)
+		cm := db.CodeMonitors()
+
+		// Insert
+		insertLastMatches := []string{"a.go\nfoo()", "b.go\nbar()"}
+		err := cm.UpsertLastMatches(ctx, fixtures.Monitor.ID, fixtures.Repo.ID, insertLastMatches)
+		require.NoError(t, err)
+
+		// Get
+		lastMatches, err := cm.GetLastMatches(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[api.RepoID][]string{fixtures.Repo.ID: insertLastMatches}, lastMatches)
+
+		// Update with nil matches
+		err = cm.UpsertLastMatches(ctx, fixtures.Monitor.ID, fixtures.Repo.ID, nil)
+		require.NoError(t, err)
+
+		// Get
+		lastMatches, err = cm.GetLastMatches(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[api.RepoID][]string{fixtures.Repo.ID: {}}, lastMatches)
+
+		// Delete
+		err = cm.DeleteLastMatches(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+
+		lastMatches, err = cm.GetLastMatches(ctx, fixtures.Monitor.ID)
+		require.NoError(t, err)
+		require.Empty(t, lastMatches)
+	})
+}
@@ -11,15 +11,29 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
+// DeliverySchedule determines how often the actions of a code monitor run.
+type DeliverySchedule string
+
+const (
+	// DeliveryImmediate runs the actions after every trigger run with new results.
+	DeliveryImmediate DeliverySchedule = "immediate"
+	// DeliveryHourly, DeliveryDaily and DeliveryWeekly run the actions at most once per
+	// period, with a digest of the results of all trigger runs since the last digest.
+	DeliveryHourly DeliverySchedule = "hourly"
+	DeliveryDaily  DeliverySchedule = "daily"
+	DeliveryWeekly DeliverySchedule = "weekly"
+)
+
 type Monitor struct {
-	ID          int64
-	CreatedBy   int32
-	CreatedAt   time.Time
-	ChangedBy   int32
-	ChangedAt   time.Time
-	Description string
-	Enabled     bool
-	UserID      int32
+	ID               int64
+	CreatedBy        int32
+	CreatedAt        time.Time
+	ChangedBy        int32
+	ChangedAt        time.Time
+	Description      string
+	Enabled          bool
+	UserID           int32
+	DeliverySchedule DeliverySchedule
 }
 
 // monitorColumns are the columns needed to fill out a Monitor.
@@ -33,6 +47,7 @@ var monitorColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_monitors.description"),
 	sqlf.Sprintf("cm_monitors.enabled"),
 	sqlf.Sprintf("cm_monitors.namespace_user_id"),
+	sqlf.Sprintf("cm_monitors.delivery_schedule"),
 }
 
 type MonitorArgs struct {
@@ -40,18 +55,26 @@ type MonitorArgs struct {
 	Enabled         bool
 	NamespaceUserID *int32
 	NamespaceOrgID  *int32
+
+	// DeliverySchedule defaults to DeliveryImmediate when creating a monitor, and
+	// is left unchanged when updating a monitor, if empty.
+	DeliverySchedule DeliverySchedule
 }
 
 const insertCodeMonitorFmtStr = `
 INSERT INTO cm_monitors
-(created_at, created_by, changed_at, changed_by, description, enabled, namespace_user_id, namespace_org_id)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(created_at, created_by, changed_at, changed_by, description, enabled, namespace_user_id, namespace_org_id, delivery_schedule)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s -- monitorColumns
 `
 
 func (s *codeMonitorStore) CreateMonitor(ctx context.Context, args MonitorArgs) (*Monitor, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
+	deliverySchedule := args.DeliverySchedule
+	if deliverySchedule == "" {
+		deliverySchedule = DeliveryImmediate
+	}
 	q := sqlf.Sprintf(
 		insertCodeMonitorFmtStr,
 		now,
@@ -62,6 +85,7 @@ func (s *codeMonitorStore) CreateMonitor(ctx context.Context, args MonitorArgs)
 		args.Enabled,
 		args.NamespaceUserID,
 		args.NamespaceOrgID,
+		deliverySchedule,
 		sqlf.Join(monitorColumns, ", "),
 	)
 
@@ -69,12 +93,21 @@ func (s *codeMonitorStore) CreateMonitor(ctx context.Context, args MonitorArgs)
 	return scanMonitor(row)
 }
 
+// When a monitor switches from immediate delivery to a digest, the results of earlier trigger
+// runs have already been delivered, so its first digest starts after the latest trigger run.
 const updateCodeMonitorFmtStr = `
 UPDATE cm_monitors
 SET description = %s,
 	enabled = %s,
 	namespace_user_id = %s,
 	namespace_org_id = %s,
+	last_digest_trigger_job = CASE WHEN delivery_schedule <> 'immediate' THEN last_digest_trigger_job ELSE (
+		SELECT MAX(cm_trigger_jobs.id)
+		FROM cm_trigger_jobs
+		JOIN cm_queries ON cm_queries.id = cm_trigger_jobs.query
+		WHERE cm_queries.monitor = cm_monitors.id
+	) END,
+	delivery_schedule = COALESCE(NULLIF(%s, ''), delivery_schedule),
 	changed_by = %s,
 	changed_at = %s
 WHERE
@@ -92,6 +125,7 @@ func (s *codeMonitorStore) UpdateMonitor(ctx context.Context, id int64, args Mon
 		args.Enabled,
 		args.NamespaceUserID,
 		args.NamespaceOrgID,
+		args.DeliverySchedule,
 		a.UID,
 		s.Now(),
 		id,
@@ -245,6 +279,7 @@ func scanMonitor(scanner dbutil.Scanner) (*Monitor, error) {
 		&m.Description,
 		&m.Enabled,
 		&m.UserID,
+		&m.DeliverySchedule,
 	)
 	return m, err
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -73,6 +74,18 @@ type CodeMonitorStore interface {
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateIssueAction(_ context.Context, id int64, _ *IssueActionArgs) (*IssueAction, error)
+	CreateIssueAction(ctx context.Context, monitorID int64, _ *IssueActionArgs) (*IssueAction, error)
+	DeleteIssueActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountIssueActions(ctx context.Context, monitorID int64) (int, error)
+	GetIssueAction(ctx context.Context, id int64) (*IssueAction, error)
+	ListIssueActions(context.Context, ListActionsOpts) ([]*IssueAction, error)
+
+	// GetIssueActionIssue and UpsertIssueActionIssue track the issue that an issue action
+	// last opened for each repository, so that later results are added to it as comments.
+	GetIssueActionIssue(ctx context.Context, issueActionID int64, repoID api.RepoID) (*IssueActionIssue, error)
+	UpsertIssueActionIssue(ctx context.Context, issueActionID int64, repoID api.RepoID, externalID, url string) error
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -83,6 +96,7 @@ type CodeMonitorStore interface {
 	GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error)
 	GetActionJob(ctx context.Context, jobID int32) (*ActionJob, error)
 	EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJob int32) ([]*ActionJob, error)
+	EnqueueDigestActionJobs(context.Context) ([]*ActionJob, error)
 
 	// HasAnyLastSearched returns whether there have ever been any repo-aware code monitor
 	// searches executed for this code monitor. This should only be needed during the transition
@@ -91,6 +105,13 @@ type CodeMonitorStore interface {
 	HasAnyLastSearched(ctx context.Context, monitorID int64) (bool, error)
 	UpsertLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID, lastSearched []string) error
 	GetLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID) ([]string, error)
+
+	// UpsertLastMatches, GetLastMatches, and DeleteLastMatches manage the fingerprints of the
+	// matches found by the previous run of a code monitor whose query searches file contents
+	// rather than commits or diffs.
+	UpsertLastMatches(ctx context.Context, monitorID int64, repoID api.RepoID, fingerprints []string) error
+	GetLastMatches(ctx context.Context, monitorID int64) (map[api.RepoID][]string, error)
+	DeleteLastMatches(ctx context.Context, monitorID int64) error
 }
 
 // codeMonitorStore exposes methods to read and write codemonitors domain models
@@ -99,6 +120,9 @@ type codeMonitorStore struct {
 	*basestore.Store
 	userStore UserStore
 	now       func() time.Time
+	// key encrypts the Jira tokens of issue actions. If nil, the code monitor key of the
+	// default keyring is used.
+	key encryption.Key
 }
 
 var _ CodeMonitorStore = (*codeMonitorStore)(nil)
@@ -132,7 +156,7 @@ func (s *codeMonitorStore) Transact(ctx context.Context) (CodeMonitorStore, erro
 	if err != nil {
 		return nil, err
 	}
-	return &codeMonitorStore{Store: txBase, now: s.now}, nil
+	return &codeMonitorStore{Store: txBase, now: s.now, key: s.key}, nil
 }
 
 type JobTable int
@@ -48,6 +48,7 @@ type DB interface {
 	OutboundWebhookLogs(encryption.Key) OutboundWebhookLogStore
 	OwnershipStats() OwnershipStatsStore
 	RecentContributionSignals() RecentContributionSignalStore
+	RecentReviewSignals() RecentReviewSignalStore
 	Perms() PermsStore
 	Permissions() PermissionStore
 	PermissionSyncJobs() PermissionSyncJobStore
@@ -237,6 +238,10 @@ func (d *db) RecentContributionSignals() RecentContributionSignalStore {
 	return RecentContributionSignalStoreWith(d.Store)
 }
 
+func (d *db) RecentReviewSignals() RecentReviewSignalStore {
+	return RecentReviewSignalStoreWith(d.Store)
+}
+
 func (d *db) Permissions() PermissionStore {
 	return PermissionsWith(d.Store)
 }
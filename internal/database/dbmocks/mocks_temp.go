@@ -6213,6 +6213,9 @@ type MockCodeMonitorStore struct {
 	// CountActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountActionJobs.
 	CountActionJobsFunc *CodeMonitorStoreCountActionJobsFunc
+	// CountIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountIssueActions.
+	CountIssueActionsFunc *CodeMonitorStoreCountIssueActionsFunc
 	// CountMonitorsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountMonitors.
 	CountMonitorsFunc *CodeMonitorStoreCountMonitorsFunc
@@ -6231,6 +6234,9 @@ type MockCodeMonitorStore struct {
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
+	// CreateIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateIssueAction.
+	CreateIssueActionFunc *CodeMonitorStoreCreateIssueActionFunc
 	// CreateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateMonitor.
 	CreateMonitorFunc *CodeMonitorStoreCreateMonitorFunc
@@ -6249,6 +6255,12 @@ type MockCodeMonitorStore struct {
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
+	// DeleteIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteIssueActions.
+	DeleteIssueActionsFunc *CodeMonitorStoreDeleteIssueActionsFunc
+	// DeleteLastMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteLastMatches.
+	DeleteLastMatchesFunc *CodeMonitorStoreDeleteLastMatchesFunc
 	// DeleteMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteMonitor.
 	DeleteMonitorFunc *CodeMonitorStoreDeleteMonitorFunc
@@ -6272,6 +6284,9 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// EnqueueActionJobsForMonitor.
 	EnqueueActionJobsForMonitorFunc *CodeMonitorStoreEnqueueActionJobsForMonitorFunc
+	// EnqueueDigestActionJobsFunc is an instance of a mock function object
+	// controlling the behavior of the method EnqueueDigestActionJobs.
+	EnqueueDigestActionJobsFunc *CodeMonitorStoreEnqueueDigestActionJobsFunc
 	// EnqueueQueryTriggerJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method EnqueueQueryTriggerJobs.
 	EnqueueQueryTriggerJobsFunc *CodeMonitorStoreEnqueueQueryTriggerJobsFunc
@@ -6287,6 +6302,15 @@ type MockCodeMonitorStore struct {
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
+	// GetIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIssueAction.
+	GetIssueActionFunc *CodeMonitorStoreGetIssueActionFunc
+	// GetIssueActionIssueFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIssueActionIssue.
+	GetIssueActionIssueFunc *CodeMonitorStoreGetIssueActionIssueFunc
+	// GetLastMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLastMatches.
+	GetLastMatchesFunc *CodeMonitorStoreGetLastMatchesFunc
 	// GetLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method GetLastSearched.
 	GetLastSearchedFunc *CodeMonitorStoreGetLastSearchedFunc
@@ -6318,6 +6342,9 @@ type MockCodeMonitorStore struct {
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
+	// ListIssueActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListIssueActions.
+	ListIssueActionsFunc *CodeMonitorStoreListIssueActionsFunc
 	// ListMonitorsFunc is an instance of a mock function object controlling
 	// the behavior of the method ListMonitors.
 	ListMonitorsFunc *CodeMonitorStoreListMonitorsFunc
@@ -6349,6 +6376,9 @@ type MockCodeMonitorStore struct {
 	// UpdateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateEmailAction.
 	UpdateEmailActionFunc *CodeMonitorStoreUpdateEmailActionFunc
+	// UpdateIssueActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateIssueAction.
+	UpdateIssueActionFunc *CodeMonitorStoreUpdateIssueActionFunc
 	// UpdateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateMonitor.
 	UpdateMonitorFunc *CodeMonitorStoreUpdateMonitorFunc
@@ -6368,6 +6398,12 @@ type MockCodeMonitorStore struct {
 	// UpdateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateWebhookAction.
 	UpdateWebhookActionFunc *CodeMonitorStoreUpdateWebhookActionFunc
+	// UpsertIssueActionIssueFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertIssueActionIssue.
+	UpsertIssueActionIssueFunc *CodeMonitorStoreUpsertIssueActionIssueFunc
+	// UpsertLastMatchesFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertLastMatches.
+	UpsertLastMatchesFunc *CodeMonitorStoreUpsertLastMatchesFunc
 	// UpsertLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertLastSearched.
 	UpsertLastSearchedFunc *CodeMonitorStoreUpsertLastSearchedFunc
@@ -6388,6 +6424,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (r0 int32, r1 error) {
 				return
@@ -6418,6 +6459,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: func(context.Context, int64, *database.IssueActionArgs) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, database.MonitorArgs) (r0 *database.Monitor, r1 error) {
 				return
@@ -6448,6 +6494,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
+		DeleteLastMatchesFunc: &CodeMonitorStoreDeleteLastMatchesFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
+				return
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) (r0 error) {
 				return
@@ -6483,6 +6539,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		EnqueueDigestActionJobsFunc: &CodeMonitorStoreEnqueueDigestActionJobsFunc{
+			defaultHook: func(context.Context) (r0 []*database.ActionJob, r1 error) {
+				return
+			},
+		},
 		EnqueueQueryTriggerJobsFunc: &CodeMonitorStoreEnqueueQueryTriggerJobsFunc{
 			defaultHook: func(context.Context) (r0 []*database.TriggerJob, r1 error) {
 				return
@@ -6508,6 +6569,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
+		GetIssueActionIssueFunc: &CodeMonitorStoreGetIssueActionIssueFunc{
+			defaultHook: func(context.Context, int64, api.RepoID) (r0 *database.IssueActionIssue, r1 error) {
+				return
+			},
+		},
+		GetLastMatchesFunc: &CodeMonitorStoreGetLastMatchesFunc{
+			defaultHook: func(context.Context, int64) (r0 map[api.RepoID][]string, r1 error) {
+				return
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) (r0 []string, r1 error) {
 				return
@@ -6558,6 +6634,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.IssueAction, r1 error) {
+				return
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) (r0 []*database.Monitor, r1 error) {
 				return
@@ -6608,6 +6689,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: func(context.Context, int64, *database.IssueActionArgs) (r0 *database.IssueAction, r1 error) {
+				return
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, database.MonitorArgs) (r0 *database.Monitor, r1 error) {
 				return
@@ -6638,6 +6724,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpsertIssueActionIssueFunc: &CodeMonitorStoreUpsertIssueActionIssueFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, string, string) (r0 error) {
+				return
+			},
+		},
+		UpsertLastMatchesFunc: &CodeMonitorStoreUpsertLastMatchesFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, []string) (r0 error) {
+				return
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) (r0 error) {
 				return
@@ -6660,6 +6756,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountActionJobs")
 			},
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountIssueActions")
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (int32, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountMonitors")
@@ -6690,6 +6791,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
 			},
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateIssueAction")
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, database.MonitorArgs) (*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateMonitor")
@@ -6720,6 +6826,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
 			},
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteIssueActions")
+			},
+		},
+		DeleteLastMatchesFunc: &CodeMonitorStoreDeleteLastMatchesFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteLastMatches")
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteMonitor")
@@ -6755,6 +6871,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.EnqueueActionJobsForMonitor")
 			},
 		},
+		EnqueueDigestActionJobsFunc: &CodeMonitorStoreEnqueueDigestActionJobsFunc{
+			defaultHook: func(context.Context) ([]*database.ActionJob, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.EnqueueDigestActionJobs")
+			},
+		},
 		EnqueueQueryTriggerJobsFunc: &CodeMonitorStoreEnqueueQueryTriggerJobsFunc{
 			defaultHook: func(context.Context) ([]*database.TriggerJob, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.EnqueueQueryTriggerJobs")
@@ -6780,6 +6901,21 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
 			},
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: func(context.Context, int64) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetIssueAction")
+			},
+		},
+		GetIssueActionIssueFunc: &CodeMonitorStoreGetIssueActionIssueFunc{
+			defaultHook: func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetIssueActionIssue")
+			},
+		},
+		GetLastMatchesFunc: &CodeMonitorStoreGetLastMatchesFunc{
+			defaultHook: func(context.Context, int64) (map[api.RepoID][]string, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetLastMatches")
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) ([]string, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetLastSearched")
@@ -6830,6 +6966,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
 			},
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListIssueActions")
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, database.ListMonitorsOpts) ([]*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListMonitors")
@@ -6880,6 +7021,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateEmailAction")
 			},
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateIssueAction")
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, database.MonitorArgs) (*database.Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateMonitor")
@@ -6910,6 +7056,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
+		UpsertIssueActionIssueFunc: &CodeMonitorStoreUpsertIssueActionIssueFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, string, string) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertIssueActionIssue")
+			},
+		},
+		UpsertLastMatchesFunc: &CodeMonitorStoreUpsertLastMatchesFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, []string) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastMatches")
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearched")
@@ -6929,6 +7085,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountActionJobsFunc: &CodeMonitorStoreCountActionJobsFunc{
 			defaultHook: i.CountActionJobs,
 		},
+		CountIssueActionsFunc: &CodeMonitorStoreCountIssueActionsFunc{
+			defaultHook: i.CountIssueActions,
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: i.CountMonitors,
 		},
@@ -6947,6 +7106,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
+		CreateIssueActionFunc: &CodeMonitorStoreCreateIssueActionFunc{
+			defaultHook: i.CreateIssueAction,
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: i.CreateMonitor,
 		},
@@ -6965,6 +7127,12 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
+		DeleteIssueActionsFunc: &CodeMonitorStoreDeleteIssueActionsFunc{
+			defaultHook: i.DeleteIssueActions,
+		},
+		DeleteLastMatchesFunc: &CodeMonitorStoreDeleteLastMatchesFunc{
+			defaultHook: i.DeleteLastMatches,
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: i.DeleteMonitor,
 		},
@@ -6986,6 +7154,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		EnqueueActionJobsForMonitorFunc: &CodeMonitorStoreEnqueueActionJobsForMonitorFunc{
 			defaultHook: i.EnqueueActionJobsForMonitor,
 		},
+		EnqueueDigestActionJobsFunc: &CodeMonitorStoreEnqueueDigestActionJobsFunc{
+			defaultHook: i.EnqueueDigestActionJobs,
+		},
 		EnqueueQueryTriggerJobsFunc: &CodeMonitorStoreEnqueueQueryTriggerJobsFunc{
 			defaultHook: i.EnqueueQueryTriggerJobs,
 		},
@@ -7001,6 +7172,15 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
+		GetIssueActionFunc: &CodeMonitorStoreGetIssueActionFunc{
+			defaultHook: i.GetIssueAction,
+		},
+		GetIssueActionIssueFunc: &CodeMonitorStoreGetIssueActionIssueFunc{
+			defaultHook: i.GetIssueActionIssue,
+		},
+		GetLastMatchesFunc: &CodeMonitorStoreGetLastMatchesFunc{
+			defaultHook: i.GetLastMatches,
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: i.GetLastSearched,
 		},
@@ -7031,6 +7211,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
+		ListIssueActionsFunc: &CodeMonitorStoreListIssueActionsFunc{
+			defaultHook: i.ListIssueActions,
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: i.ListMonitors,
 		},
@@ -7061,6 +7244,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: i.UpdateEmailAction,
 		},
+		UpdateIssueActionFunc: &CodeMonitorStoreUpdateIssueActionFunc{
+			defaultHook: i.UpdateIssueAction,
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: i.UpdateMonitor,
 		},
@@ -7079,6 +7265,12 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
 			defaultHook: i.UpdateWebhookAction,
 		},
+		UpsertIssueActionIssueFunc: &CodeMonitorStoreUpsertIssueActionIssueFunc{
+			defaultHook: i.UpsertIssueActionIssue,
+		},
+		UpsertLastMatchesFunc: &CodeMonitorStoreUpsertLastMatchesFunc{
+			defaultHook: i.UpsertLastMatches,
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: i.UpsertLastSearched,
 		},
@@ -7295,6 +7487,117 @@ func (c CodeMonitorStoreCountActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountIssueActionsFunc describes the behavior when the
+// CountIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCountIssueActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountIssueActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountIssueActionsFunc.nextHook()(v0, v1)
+	m.CountIssueActionsFunc.appendCall(CodeMonitorStoreCountIssueActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCountIssueActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCountIssueActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountIssueActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountIssueActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountIssueActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountIssueActionsFunc) appendCall(r0 CodeMonitorStoreCountIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCountIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCountIssueActionsFunc) History() []CodeMonitorStoreCountIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountIssueActionsFuncCall is an object that describes an
+// invocation of method CountIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCountIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountIssueActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountMonitorsFunc describes the behavior when the
 // CountMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -7965,6 +8268,120 @@ func (c CodeMonitorStoreCreateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateIssueActionFunc describes the behavior when the
+// CreateIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreateIssueActionFunc struct {
+	defaultHook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)
+	history     []CodeMonitorStoreCreateIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateIssueAction(v0 context.Context, v1 int64, v2 *database.IssueActionArgs) (*database.IssueAction, error) {
+	r0, r1 := m.CreateIssueActionFunc.nextHook()(v0, v1, v2)
+	m.CreateIssueActionFunc.appendCall(CodeMonitorStoreCreateIssueActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreateIssueActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateIssueAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreateIssueActionFunc) PushHook(hook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateIssueActionFunc) nextHook() func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateIssueActionFunc) appendCall(r0 CodeMonitorStoreCreateIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCreateIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCreateIssueActionFunc) History() []CodeMonitorStoreCreateIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateIssueActionFuncCall is an object that describes an
+// invocation of method CreateIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreateIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.IssueActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateMonitorFunc describes the behavior when the
 // CreateMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8658,6 +9075,232 @@ func (c CodeMonitorStoreDeleteEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteIssueActionsFunc describes the behavior when the
+// DeleteIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreDeleteIssueActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteIssueActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteIssueActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteIssueActionsFunc.appendCall(CodeMonitorStoreDeleteIssueActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) appendCall(r0 CodeMonitorStoreDeleteIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreDeleteIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreDeleteIssueActionsFunc) History() []CodeMonitorStoreDeleteIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteIssueActionsFuncCall is an object that describes an
+// invocation of method DeleteIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreDeleteIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteIssueActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreDeleteLastMatchesFunc describes the behavior when the
+// DeleteLastMatches method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreDeleteLastMatchesFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []CodeMonitorStoreDeleteLastMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteLastMatches delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteLastMatches(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteLastMatchesFunc.nextHook()(v0, v1)
+	m.DeleteLastMatchesFunc.appendCall(CodeMonitorStoreDeleteLastMatchesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteLastMatches
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteLastMatches method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) PushHook(hook func(context.Context, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) nextHook() func(context.Context, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) appendCall(r0 CodeMonitorStoreDeleteLastMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreDeleteLastMatchesFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreDeleteLastMatchesFunc) History() []CodeMonitorStoreDeleteLastMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteLastMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteLastMatchesFuncCall is an object that describes an
+// invocation of method DeleteLastMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreDeleteLastMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreDeleteLastMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteLastMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteMonitorFunc describes the behavior when the
 // DeleteMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -9437,6 +10080,115 @@ func (c CodeMonitorStoreEnqueueActionJobsForMonitorFuncCall) Results() []interfa
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreEnqueueDigestActionJobsFunc describes the behavior when
+// the EnqueueDigestActionJobs method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreEnqueueDigestActionJobsFunc struct {
+	defaultHook func(context.Context) ([]*database.ActionJob, error)
+	hooks       []func(context.Context) ([]*database.ActionJob, error)
+	history     []CodeMonitorStoreEnqueueDigestActionJobsFuncCall
+	mutex       sync.Mutex
+}
+
+// EnqueueDigestActionJobs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) EnqueueDigestActionJobs(v0 context.Context) ([]*database.ActionJob, error) {
+	r0, r1 := m.EnqueueDigestActionJobsFunc.nextHook()(v0)
+	m.EnqueueDigestActionJobsFunc.appendCall(CodeMonitorStoreEnqueueDigestActionJobsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// EnqueueDigestActionJobs method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) SetDefaultHook(hook func(context.Context) ([]*database.ActionJob, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnqueueDigestActionJobs method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) PushHook(hook func(context.Context) ([]*database.ActionJob, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) SetDefaultReturn(r0 []*database.ActionJob, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]*database.ActionJob, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) PushReturn(r0 []*database.ActionJob, r1 error) {
+	f.PushHook(func(context.Context) ([]*database.ActionJob, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) nextHook() func(context.Context) ([]*database.ActionJob, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) appendCall(r0 CodeMonitorStoreEnqueueDigestActionJobsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreEnqueueDigestActionJobsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreEnqueueDigestActionJobsFunc) History() []CodeMonitorStoreEnqueueDigestActionJobsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreEnqueueDigestActionJobsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreEnqueueDigestActionJobsFuncCall is an object that
+// describes an invocation of method EnqueueDigestActionJobs on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreEnqueueDigestActionJobsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.ActionJob
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreEnqueueDigestActionJobsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreEnqueueDigestActionJobsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreEnqueueQueryTriggerJobsFunc describes the behavior when
 // the EnqueueQueryTriggerJobs method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -9982,6 +10734,340 @@ func (c CodeMonitorStoreGetEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetIssueActionFunc describes the behavior when the
+// GetIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetIssueActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64) (*database.IssueAction, error)
+	history     []CodeMonitorStoreGetIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetIssueAction(v0 context.Context, v1 int64) (*database.IssueAction, error) {
+	r0, r1 := m.GetIssueActionFunc.nextHook()(v0, v1)
+	m.GetIssueActionFunc.appendCall(CodeMonitorStoreGetIssueActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetIssueActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIssueAction method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetIssueActionFunc) PushHook(hook func(context.Context, int64) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetIssueActionFunc) nextHook() func(context.Context, int64) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetIssueActionFunc) appendCall(r0 CodeMonitorStoreGetIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetIssueActionFunc) History() []CodeMonitorStoreGetIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetIssueActionFuncCall is an object that describes an
+// invocation of method GetIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetIssueActionIssueFunc describes the behavior when the
+// GetIssueActionIssue method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetIssueActionIssueFunc struct {
+	defaultHook func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error)
+	hooks       []func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error)
+	history     []CodeMonitorStoreGetIssueActionIssueFuncCall
+	mutex       sync.Mutex
+}
+
+// GetIssueActionIssue delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetIssueActionIssue(v0 context.Context, v1 int64, v2 api.RepoID) (*database.IssueActionIssue, error) {
+	r0, r1 := m.GetIssueActionIssueFunc.nextHook()(v0, v1, v2)
+	m.GetIssueActionIssueFunc.appendCall(CodeMonitorStoreGetIssueActionIssueFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetIssueActionIssue
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetIssueActionIssue method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) PushHook(hook func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) SetDefaultReturn(r0 *database.IssueActionIssue, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) PushReturn(r0 *database.IssueActionIssue, r1 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) nextHook() func(context.Context, int64, api.RepoID) (*database.IssueActionIssue, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) appendCall(r0 CodeMonitorStoreGetIssueActionIssueFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetIssueActionIssueFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetIssueActionIssueFunc) History() []CodeMonitorStoreGetIssueActionIssueFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetIssueActionIssueFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetIssueActionIssueFuncCall is an object that describes
+// an invocation of method GetIssueActionIssue on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetIssueActionIssueFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueActionIssue
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionIssueFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetIssueActionIssueFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetLastMatchesFunc describes the behavior when the
+// GetLastMatches method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetLastMatchesFunc struct {
+	defaultHook func(context.Context, int64) (map[api.RepoID][]string, error)
+	hooks       []func(context.Context, int64) (map[api.RepoID][]string, error)
+	history     []CodeMonitorStoreGetLastMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLastMatches delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetLastMatches(v0 context.Context, v1 int64) (map[api.RepoID][]string, error) {
+	r0, r1 := m.GetLastMatchesFunc.nextHook()(v0, v1)
+	m.GetLastMatchesFunc.appendCall(CodeMonitorStoreGetLastMatchesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetLastMatches
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetLastMatchesFunc) SetDefaultHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLastMatches method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetLastMatchesFunc) PushHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetLastMatchesFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetLastMatchesFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.PushHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetLastMatchesFunc) nextHook() func(context.Context, int64) (map[api.RepoID][]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetLastMatchesFunc) appendCall(r0 CodeMonitorStoreGetLastMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetLastMatchesFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetLastMatchesFunc) History() []CodeMonitorStoreGetLastMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetLastMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetLastMatchesFuncCall is an object that describes an
+// invocation of method GetLastMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetLastMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoID][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetLastMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetLastMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetLastSearchedFunc describes the behavior when the
 // GetLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -11082,6 +12168,117 @@ func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListIssueActionsFunc describes the behavior when the
+// ListIssueActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreListIssueActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)
+	history     []CodeMonitorStoreListIssueActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListIssueActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListIssueActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.IssueAction, error) {
+	r0, r1 := m.ListIssueActionsFunc.nextHook()(v0, v1)
+	m.ListIssueActionsFunc.appendCall(CodeMonitorStoreListIssueActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListIssueActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreListIssueActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListIssueActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListIssueActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListIssueActionsFunc) SetDefaultReturn(r0 []*database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListIssueActionsFunc) PushReturn(r0 []*database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListIssueActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListIssueActionsFunc) appendCall(r0 CodeMonitorStoreListIssueActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListIssueActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListIssueActionsFunc) History() []CodeMonitorStoreListIssueActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListIssueActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListIssueActionsFuncCall is an object that describes an
+// invocation of method ListIssueActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListIssueActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListIssueActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListIssueActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListMonitorsFunc describes the behavior when the
 // ListMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12178,6 +13375,120 @@ func (c CodeMonitorStoreUpdateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateIssueActionFunc describes the behavior when the
+// UpdateIssueAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpdateIssueActionFunc struct {
+	defaultHook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)
+	hooks       []func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)
+	history     []CodeMonitorStoreUpdateIssueActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateIssueAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateIssueAction(v0 context.Context, v1 int64, v2 *database.IssueActionArgs) (*database.IssueAction, error) {
+	r0, r1 := m.UpdateIssueActionFunc.nextHook()(v0, v1, v2)
+	m.UpdateIssueActionFunc.appendCall(CodeMonitorStoreUpdateIssueActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UpdateIssueAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) SetDefaultHook(hook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateIssueAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) PushHook(hook func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) SetDefaultReturn(r0 *database.IssueAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) PushReturn(r0 *database.IssueAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateIssueActionFunc) nextHook() func(context.Context, int64, *database.IssueActionArgs) (*database.IssueAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateIssueActionFunc) appendCall(r0 CodeMonitorStoreUpdateIssueActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpdateIssueActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateIssueActionFunc) History() []CodeMonitorStoreUpdateIssueActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateIssueActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateIssueActionFuncCall is an object that describes an
+// invocation of method UpdateIssueAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpdateIssueActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *database.IssueActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.IssueAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateIssueActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateIssueActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateMonitorFunc describes the behavior when the
 // UpdateMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12873,6 +14184,238 @@ func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpsertIssueActionIssueFunc describes the behavior when
+// the UpsertIssueActionIssue method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpsertIssueActionIssueFunc struct {
+	defaultHook func(context.Context, int64, api.RepoID, string, string) error
+	hooks       []func(context.Context, int64, api.RepoID, string, string) error
+	history     []CodeMonitorStoreUpsertIssueActionIssueFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertIssueActionIssue delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertIssueActionIssue(v0 context.Context, v1 int64, v2 api.RepoID, v3 string, v4 string) error {
+	r0 := m.UpsertIssueActionIssueFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpsertIssueActionIssueFunc.appendCall(CodeMonitorStoreUpsertIssueActionIssueFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertIssueActionIssue method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID, string, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertIssueActionIssue method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) PushHook(hook func(context.Context, int64, api.RepoID, string, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID, string, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID, string, string) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) nextHook() func(context.Context, int64, api.RepoID, string, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) appendCall(r0 CodeMonitorStoreUpsertIssueActionIssueFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpsertIssueActionIssueFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpsertIssueActionIssueFunc) History() []CodeMonitorStoreUpsertIssueActionIssueFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertIssueActionIssueFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertIssueActionIssueFuncCall is an object that
+// describes an invocation of method UpsertIssueActionIssue on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpsertIssueActionIssueFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertIssueActionIssueFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertIssueActionIssueFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreUpsertLastMatchesFunc describes the behavior when the
+// UpsertLastMatches method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpsertLastMatchesFunc struct {
+	defaultHook func(context.Context, int64, api.RepoID, []string) error
+	hooks       []func(context.Context, int64, api.RepoID, []string) error
+	history     []CodeMonitorStoreUpsertLastMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertLastMatches delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertLastMatches(v0 context.Context, v1 int64, v2 api.RepoID, v3 []string) error {
+	r0 := m.UpsertLastMatchesFunc.nextHook()(v0, v1, v2, v3)
+	m.UpsertLastMatchesFunc.appendCall(CodeMonitorStoreUpsertLastMatchesFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpsertLastMatches
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID, []string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertLastMatches method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) PushHook(hook func(context.Context, int64, api.RepoID, []string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID, []string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID, []string) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) nextHook() func(context.Context, int64, api.RepoID, []string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) appendCall(r0 CodeMonitorStoreUpsertLastMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpsertLastMatchesFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpsertLastMatchesFunc) History() []CodeMonitorStoreUpsertLastMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertLastMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertLastMatchesFuncCall is an object that describes an
+// invocation of method UpsertLastMatches on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpsertLastMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpsertLastSearchedFunc describes the behavior when the
 // UpsertLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -15016,6 +16559,9 @@ type MockDB struct {
 	// object controlling the behavior of the method
 	// RecentContributionSignals.
 	RecentContributionSignalsFunc *DBRecentContributionSignalsFunc
+	// RecentReviewSignalsFunc is an instance of a mock function object
+	// controlling the behavior of the method RecentReviewSignals.
+	RecentReviewSignalsFunc *DBRecentReviewSignalsFunc
 	// RecentViewSignalFunc is an instance of a mock function object
 	// controlling the behavior of the method RecentViewSignal.
 	RecentViewSignalFunc *DBRecentViewSignalFunc
@@ -15297,6 +16843,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: func() (r0 database.RecentReviewSignalStore) {
+				return
+			},
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: func() (r0 database.RecentViewSignalStore) {
 				return
@@ -15629,6 +17180,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.RecentContributionSignals")
 			},
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: func() database.RecentReviewSignalStore {
+				panic("unexpected invocation of MockDB.RecentReviewSignals")
+			},
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: func() database.RecentViewSignalStore {
 				panic("unexpected invocation of MockDB.RecentViewSignal")
@@ -15881,6 +17437,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		RecentContributionSignalsFunc: &DBRecentContributionSignalsFunc{
 			defaultHook: i.RecentContributionSignals,
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: i.RecentReviewSignals,
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: i.RecentViewSignal,
 		},
@@ -19967,6 +21526,105 @@ func (c DBRecentContributionSignalsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRecentReviewSignalsFunc describes the behavior when the
+// RecentReviewSignals method of the parent MockDB instance is invoked.
+type DBRecentReviewSignalsFunc struct {
+	defaultHook func() database.RecentReviewSignalStore
+	hooks       []func() database.RecentReviewSignalStore
+	history     []DBRecentReviewSignalsFuncCall
+	mutex       sync.Mutex
+}
+
+// RecentReviewSignals delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) RecentReviewSignals() database.RecentReviewSignalStore {
+	r0 := m.RecentReviewSignalsFunc.nextHook()()
+	m.RecentReviewSignalsFunc.appendCall(DBRecentReviewSignalsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RecentReviewSignals
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBRecentReviewSignalsFunc) SetDefaultHook(hook func() database.RecentReviewSignalStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecentReviewSignals method of the parent MockDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBRecentReviewSignalsFunc) PushHook(hook func() database.RecentReviewSignalStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRecentReviewSignalsFunc) SetDefaultReturn(r0 database.RecentReviewSignalStore) {
+	f.SetDefaultHook(func() database.RecentReviewSignalStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRecentReviewSignalsFunc) PushReturn(r0 database.RecentReviewSignalStore) {
+	f.PushHook(func() database.RecentReviewSignalStore {
+		return r0
+	})
+}
+
+func (f *DBRecentReviewSignalsFunc) nextHook() func() database.RecentReviewSignalStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRecentReviewSignalsFunc) appendCall(r0 DBRecentReviewSignalsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRecentReviewSignalsFuncCall objects
+// describing the invocations of this function.
+func (f *DBRecentReviewSignalsFunc) History() []DBRecentReviewSignalsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRecentReviewSignalsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRecentReviewSignalsFuncCall is an object that describes an invocation of
+// method RecentReviewSignals on an instance of MockDB.
+type DBRecentReviewSignalsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RecentReviewSignalStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRecentReviewSignalsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRecentReviewSignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBRecentViewSignalFunc describes the behavior when the RecentViewSignal
 // method of the parent MockDB instance is invoked.
 type DBRecentViewSignalFunc struct {
@@ -58035,6 +59693,670 @@ func (c RecentContributionSignalStoreWithTransactFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// MockRecentReviewSignalStore is a mock implementation of the
+// RecentReviewSignalStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRecentReviewSignalStore struct {
+	// AddReviewFunc is an instance of a mock function object controlling the
+	// behavior of the method AddReview.
+	AddReviewFunc *RecentReviewSignalStoreAddReviewFunc
+	// ClearSignalsFunc is an instance of a mock function object controlling
+	// the behavior of the method ClearSignals.
+	ClearSignalsFunc *RecentReviewSignalStoreClearSignalsFunc
+	// FindRecentReviewersFunc is an instance of a mock function object
+	// controlling the behavior of the method FindRecentReviewers.
+	FindRecentReviewersFunc *RecentReviewSignalStoreFindRecentReviewersFunc
+	// ListRecentReviewersForRepoFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListRecentReviewersForRepo.
+	ListRecentReviewersForRepoFunc *RecentReviewSignalStoreListRecentReviewersForRepoFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RecentReviewSignalStoreWithTransactFunc
+}
+
+// NewMockRecentReviewSignalStore creates a new mock of the
+// RecentReviewSignalStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockRecentReviewSignalStore() *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: func(context.Context, database.Review) (r0 error) {
+				return
+			},
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 error) {
+				return
+			},
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (r0 []database.RecentReviewerSummary, r1 error) {
+				return
+			},
+		},
+		ListRecentReviewersForRepoFunc: &RecentReviewSignalStoreListRecentReviewersForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 []*database.RecentReviewerSummary, r1 error) {
+				return
+			},
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentReviewSignalStore) error) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRecentReviewSignalStore creates a new mock of the
+// RecentReviewSignalStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRecentReviewSignalStore() *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: func(context.Context, database.Review) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.AddReview")
+			},
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.ClearSignals")
+			},
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+				panic("unexpected invocation of MockRecentReviewSignalStore.FindRecentReviewers")
+			},
+		},
+		ListRecentReviewersForRepoFunc: &RecentReviewSignalStoreListRecentReviewersForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error) {
+				panic("unexpected invocation of MockRecentReviewSignalStore.ListRecentReviewersForRepo")
+			},
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.WithTransact")
+			},
+		},
+	}
+}
+
+// NewMockRecentReviewSignalStoreFrom creates a new mock of the
+// MockRecentReviewSignalStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRecentReviewSignalStoreFrom(i database.RecentReviewSignalStore) *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: i.AddReview,
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: i.ClearSignals,
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: i.FindRecentReviewers,
+		},
+		ListRecentReviewersForRepoFunc: &RecentReviewSignalStoreListRecentReviewersForRepoFunc{
+			defaultHook: i.ListRecentReviewersForRepo,
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
+	}
+}
+
+// RecentReviewSignalStoreAddReviewFunc describes the behavior when the
+// AddReview method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreAddReviewFunc struct {
+	defaultHook func(context.Context, database.Review) error
+	hooks       []func(context.Context, database.Review) error
+	history     []RecentReviewSignalStoreAddReviewFuncCall
+	mutex       sync.Mutex
+}
+
+// AddReview delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) AddReview(v0 context.Context, v1 database.Review) error {
+	r0 := m.AddReviewFunc.nextHook()(v0, v1)
+	m.AddReviewFunc.appendCall(RecentReviewSignalStoreAddReviewFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AddReview method of
+// the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreAddReviewFunc) SetDefaultHook(hook func(context.Context, database.Review) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddReview method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreAddReviewFunc) PushHook(hook func(context.Context, database.Review) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreAddReviewFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.Review) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreAddReviewFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.Review) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreAddReviewFunc) nextHook() func(context.Context, database.Review) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreAddReviewFunc) appendCall(r0 RecentReviewSignalStoreAddReviewFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreAddReviewFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreAddReviewFunc) History() []RecentReviewSignalStoreAddReviewFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreAddReviewFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreAddReviewFuncCall is an object that describes an
+// invocation of method AddReview on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreAddReviewFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.Review
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreAddReviewFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreAddReviewFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RecentReviewSignalStoreClearSignalsFunc describes the behavior when the
+// ClearSignals method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreClearSignalsFunc struct {
+	defaultHook func(context.Context, api.RepoID) error
+	hooks       []func(context.Context, api.RepoID) error
+	history     []RecentReviewSignalStoreClearSignalsFuncCall
+	mutex       sync.Mutex
+}
+
+// ClearSignals delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) ClearSignals(v0 context.Context, v1 api.RepoID) error {
+	r0 := m.ClearSignalsFunc.nextHook()(v0, v1)
+	m.ClearSignalsFunc.appendCall(RecentReviewSignalStoreClearSignalsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ClearSignals method
+// of the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreClearSignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ClearSignals method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreClearSignalsFunc) PushHook(hook func(context.Context, api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreClearSignalsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreClearSignalsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreClearSignalsFunc) nextHook() func(context.Context, api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreClearSignalsFunc) appendCall(r0 RecentReviewSignalStoreClearSignalsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreClearSignalsFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreClearSignalsFunc) History() []RecentReviewSignalStoreClearSignalsFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreClearSignalsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreClearSignalsFuncCall is an object that describes an
+// invocation of method ClearSignals on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreClearSignalsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreClearSignalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreClearSignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RecentReviewSignalStoreFindRecentReviewersFunc describes the behavior when
+// the FindRecentReviewers method of the parent MockRecentReviewSignalStore
+// instance is invoked.
+type RecentReviewSignalStoreFindRecentReviewersFunc struct {
+	defaultHook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)
+	hooks       []func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)
+	history     []RecentReviewSignalStoreFindRecentReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// FindRecentReviewers delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) FindRecentReviewers(v0 context.Context, v1 api.RepoID, v2 string) ([]database.RecentReviewerSummary, error) {
+	r0, r1 := m.FindRecentReviewersFunc.nextHook()(v0, v1, v2)
+	m.FindRecentReviewersFunc.appendCall(RecentReviewSignalStoreFindRecentReviewersFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindRecentReviewers
+// method of the parent MockRecentReviewSignalStore instance is invoked and
+// the hook queue is empty.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindRecentReviewers method of the parent MockRecentReviewSignalStore
+// instance invokes the hook at the front of the queue and discards it. After
+// the queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) PushHook(hook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) SetDefaultReturn(r0 []database.RecentReviewerSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) PushReturn(r0 []database.RecentReviewerSummary, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) nextHook() func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) appendCall(r0 RecentReviewSignalStoreFindRecentReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecentReviewSignalStoreFindRecentReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) History() []RecentReviewSignalStoreFindRecentReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreFindRecentReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreFindRecentReviewersFuncCall is an object that
+// describes an invocation of method FindRecentReviewers on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreFindRecentReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []database.RecentReviewerSummary
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreFindRecentReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreFindRecentReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RecentReviewSignalStoreListRecentReviewersForRepoFunc describes the
+// behavior when the ListRecentReviewersForRepo method of the parent
+// MockRecentReviewSignalStore instance is invoked.
+type RecentReviewSignalStoreListRecentReviewersForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error)
+	hooks       []func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error)
+	history     []RecentReviewSignalStoreListRecentReviewersForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRecentReviewersForRepo delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) ListRecentReviewersForRepo(v0 context.Context, v1 api.RepoID) ([]*database.RecentReviewerSummary, error) {
+	r0, r1 := m.ListRecentReviewersForRepoFunc.nextHook()(v0, v1)
+	m.ListRecentReviewersForRepoFunc.appendCall(RecentReviewSignalStoreListRecentReviewersForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListRecentReviewersForRepo method of the parent
+// MockRecentReviewSignalStore instance is invoked and the hook queue is
+// empty.
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRecentReviewersForRepo method of the parent
+// MockRecentReviewSignalStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook function
+// is invoked for any future action.
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) PushHook(hook func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) SetDefaultReturn(r0 []*database.RecentReviewerSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) PushReturn(r0 []*database.RecentReviewerSummary, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) nextHook() func(context.Context, api.RepoID) ([]*database.RecentReviewerSummary, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) appendCall(r0 RecentReviewSignalStoreListRecentReviewersForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecentReviewSignalStoreListRecentReviewersForRepoFuncCall objects
+// describing the invocations of this function.
+func (f *RecentReviewSignalStoreListRecentReviewersForRepoFunc) History() []RecentReviewSignalStoreListRecentReviewersForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreListRecentReviewersForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreListRecentReviewersForRepoFuncCall is an object
+// that describes an invocation of method ListRecentReviewersForRepo on an
+// instance of MockRecentReviewSignalStore.
+type RecentReviewSignalStoreListRecentReviewersForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.RecentReviewerSummary
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreListRecentReviewersForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreListRecentReviewersForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RecentReviewSignalStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(store database.RecentReviewSignalStore) error) error
+	hooks       []func(context.Context, func(store database.RecentReviewSignalStore) error) error
+	history     []RecentReviewSignalStoreWithTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) WithTransact(v0 context.Context, v1 func(store database.RecentReviewSignalStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RecentReviewSignalStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(store database.RecentReviewSignalStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreWithTransactFunc) PushHook(hook func(context.Context, func(store database.RecentReviewSignalStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreWithTransactFunc) nextHook() func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreWithTransactFunc) appendCall(r0 RecentReviewSignalStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreWithTransactFunc) History() []RecentReviewSignalStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(store database.RecentReviewSignalStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockRecentViewSignalStore is a mock implementation of the
 // RecentViewSignalStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
@@ -23,9 +23,11 @@ var EncryptionConfigs = []EncryptionConfig{
 	userExternalAccountsEncryptionConfig,
 	userCredentialsEncryptionConfig,
 	batchChangesSiteCredentialsEncryptionConfig,
+	batchChangesCommitSigningKeysEncryptionConfig,
 	webhooklogsEncryptionConfig,
 	executorSecretsEncryptionConfig,
 	outboundWebhooksEncryptionConfig,
+	codeMonitorIssueActionsEncryptionConfig,
 }
 
 var externalServicesEncryptionConfig = EncryptionConfig{
@@ -70,6 +72,17 @@ var batchChangesSiteCredentialsEncryptionConfig = EncryptionConfig{
 	Limit:               5,
 }
 
+var batchChangesCommitSigningKeysEncryptionConfig = EncryptionConfig{
+	TableName:           "batch_changes_commit_signing_keys",
+	IDFieldName:         "id",
+	KeyIDFieldName:      "encryption_key_id",
+	EncryptedFieldNames: []string{"credential"},
+	UpdateAsBytes:       true,
+	Scan:                basestore.NewMapScanner(scanEncryptedBytea),
+	Key:                 func() encryption.Key { return keyring.Default().BatchChangesCredentialKey },
+	Limit:               5,
+}
+
 var webhooklogsEncryptionConfig = EncryptionConfig{
 	TableName:           "webhook_logs",
 	IDFieldName:         "id",
@@ -101,6 +114,16 @@ var outboundWebhooksEncryptionConfig = EncryptionConfig{
 	Limit:               5,
 }
 
+var codeMonitorIssueActionsEncryptionConfig = EncryptionConfig{
+	TableName:           "cm_issue_actions",
+	IDFieldName:         "id",
+	KeyIDFieldName:      "jira_token_key_id",
+	EncryptedFieldNames: []string{"jira_token"},
+	Scan:                basestore.NewMapScanner(scanEncryptedString),
+	Key:                 func() encryption.Key { return keyring.Default().CodeMonitorKey },
+	Limit:               5,
+}
+
 func scanEncryptedString(scanner dbutil.Scanner) (id int, e Encrypted, err error) {
 	e.Values = make([]string, 1)
 	err = scanner.Scan(&id, &e.KeyID, &e.Values[0])
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type RecentReviewSignalStore interface {
+	AddReview(ctx context.Context, review Review) error
+	FindRecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]RecentReviewerSummary, error)
+	ListRecentReviewersForRepo(ctx context.Context, repoID api.RepoID) ([]*RecentReviewerSummary, error)
+	ClearSignals(ctx context.Context, repoID api.RepoID) error
+	WithTransact(context.Context, func(store RecentReviewSignalStore) error) error
+}
+
+func RecentReviewSignalStoreWith(other basestore.ShareableStore) RecentReviewSignalStore {
+	return &recentReviewSignalStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// Review is an approval of a merged changeset (pull request, merge request)
+// given by a reviewer on the code host.
+type Review struct {
+	RepoID api.RepoID
+	// ReviewerHandle is the username of the reviewer on the code host.
+	ReviewerHandle string
+	// ReviewerEmail is the email of the reviewer, if the code host exposes it.
+	ReviewerEmail string
+	// FilesChanged are the paths of the files changed by the reviewed changeset.
+	FilesChanged []string
+}
+
+type RecentReviewerSummary struct {
+	// FilePath is only set by ListRecentReviewersForRepo.
+	FilePath       string
+	ReviewerHandle string
+	ReviewerEmail  string
+	ReviewCount    int
+}
+
+type recentReviewSignalStore struct {
+	*basestore.Store
+}
+
+func (s *recentReviewSignalStore) WithTransact(ctx context.Context, f func(store RecentReviewSignalStore) error) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		return f(RecentReviewSignalStoreWith(tx))
+	})
+}
+
+const upsertRecentReviewFmtstr = `
+	INSERT INTO own_aggregate_recent_review (reviewer_handle, reviewer_email, reviewed_file_path_id, reviews_count)
+	VALUES (%s, %s, %s, 1)
+	ON CONFLICT(reviewed_file_path_id, reviewer_handle)
+	DO UPDATE SET
+		reviews_count = own_aggregate_recent_review.reviews_count + 1,
+		reviewer_email = CASE
+			WHEN EXCLUDED.reviewer_email = '' THEN own_aggregate_recent_review.reviewer_email
+			ELSE EXCLUDED.reviewer_email
+		END
+`
+
+// AddReview counts the given review for every file changed by the reviewed
+// changeset, as well as for all of their ancestor directories up to the
+// repository root.
+//
+// Unlike the recent contributions aggregate, a review is counted at most once
+// for each path: a review touching 10 files within `dir` counts as a single
+// review of `dir`.
+func (s *recentReviewSignalStore) AddReview(ctx context.Context, review Review) error {
+	if review.ReviewerHandle == "" {
+		return errors.New("reviewer handle must not be empty")
+	}
+	pathIDs, err := ensureRepoPaths(ctx, s.Store, reviewedPaths(review.FilesChanged), review.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "cannot insert repo paths")
+	}
+	for _, pathID := range pathIDs {
+		q := sqlf.Sprintf(upsertRecentReviewFmtstr, review.ReviewerHandle, review.ReviewerEmail, pathID)
+		if err := s.Exec(ctx, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reviewedPaths returns given files along with all of their ancestor
+// directories and the repository root (empty path), without duplicates.
+func reviewedPaths(files []string) []string {
+	seen := map[string]struct{}{"": {}}
+	for _, file := range files {
+		for p := file; p != "." && p != "/"; p = path.Dir(p) {
+			seen[p] = struct{}{}
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+const findRecentReviewersFmtstr = `
+	SELECT r.reviewer_handle, r.reviewer_email, r.reviews_count
+	FROM own_aggregate_recent_review AS r
+	INNER JOIN repo_paths AS p
+	ON p.id = r.reviewed_file_path_id
+	WHERE p.repo_id = %s
+	AND p.absolute_path = %s
+	ORDER BY 3 DESC, 1
+`
+
+// FindRecentReviewers returns all recent reviewers for given `repoID` and `path`.
+// Empty string `path` designates repo root (so all reviews for the whole repo).
+func (s *recentReviewSignalStore) FindRecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]RecentReviewerSummary, error) {
+	q := sqlf.Sprintf(findRecentReviewersFmtstr, repoID, path)
+
+	reviewersScanner := basestore.NewSliceScanner(func(scanner dbutil.Scanner) (RecentReviewerSummary, error) {
+		var rrs RecentReviewerSummary
+		if err := scanner.Scan(&rrs.ReviewerHandle, &rrs.ReviewerEmail, &rrs.ReviewCount); err != nil {
+			return RecentReviewerSummary{}, err
+		}
+		return rrs, nil
+	})
+
+	return reviewersScanner(s.Query(ctx, q))
+}
+
+const listRecentReviewersForRepoFmtstr = `
+	SELECT p.absolute_path, r.reviewer_handle, r.reviewer_email, r.reviews_count
+	FROM own_aggregate_recent_review AS r
+	INNER JOIN repo_paths AS p
+	ON p.id = r.reviewed_file_path_id
+	WHERE p.repo_id = %s
+	ORDER BY 1, 4 DESC, 2
+`
+
+// ListRecentReviewersForRepo returns recent reviewers of all the paths within
+// given repo.
+func (s *recentReviewSignalStore) ListRecentReviewersForRepo(ctx context.Context, repoID api.RepoID) ([]*RecentReviewerSummary, error) {
+	q := sqlf.Sprintf(listRecentReviewersForRepoFmtstr, repoID)
+
+	reviewersScanner := basestore.NewSliceScanner(func(scanner dbutil.Scanner) (*RecentReviewerSummary, error) {
+		var rrs RecentReviewerSummary
+		if err := scanner.Scan(&rrs.FilePath, &rrs.ReviewerHandle, &rrs.ReviewerEmail, &rrs.ReviewCount); err != nil {
+			return nil, err
+		}
+		return &rrs, nil
+	})
+
+	return reviewersScanner(s.Query(ctx, q))
+}
+
+const clearRecentReviewSignalsFmtstr = `
+	WITH rps AS (
+		SELECT id FROM repo_paths WHERE repo_id = %s
+	)
+	DELETE FROM own_aggregate_recent_review
+	WHERE reviewed_file_path_id IN (SELECT * FROM rps)
+`
+
+func (s *recentReviewSignalStore) ClearSignals(ctx context.Context, repoID api.RepoID) error {
+	return s.Exec(ctx, sqlf.Sprintf(clearRecentReviewSignalsFmtstr, repoID))
+}
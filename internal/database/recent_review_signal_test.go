@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRecentReviewSignalStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	store := RecentReviewSignalStoreWith(db)
+
+	ctx := context.Background()
+	repo := mustCreate(ctx, t, db, &types.Repo{Name: "a/b"})
+	otherRepo := mustCreate(ctx, t, db, &types.Repo{Name: "a/c"})
+
+	for _, review := range []Review{
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "alice",
+			FilesChanged:   []string{"file1.txt", "dir/file2.txt"},
+		},
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "alice",
+			ReviewerEmail:  "alice@example.com",
+			FilesChanged:   []string{"file1.txt", "dir/file3.txt", "dir/subdir/file.txt"},
+		},
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "bob",
+			FilesChanged:   []string{"dir/file2.txt"},
+		},
+		{
+			RepoID:         otherRepo.ID,
+			ReviewerHandle: "bob",
+			FilesChanged:   []string{"file1.txt"},
+		},
+	} {
+		require.NoError(t, store.AddReview(ctx, review))
+	}
+
+	for p, w := range map[string][]RecentReviewerSummary{
+		"dir": {
+			// A review touching multiple files within the directory is counted once.
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 2},
+			{ReviewerHandle: "bob", ReviewCount: 1},
+		},
+		"dir/subdir/file.txt": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 1},
+		},
+		"file1.txt": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 2},
+		},
+		"": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 2},
+			{ReviewerHandle: "bob", ReviewCount: 1},
+		},
+	} {
+		path := p
+		want := w
+		t.Run(path, func(t *testing.T) {
+			got, err := store.FindRecentReviewers(ctx, repo.ID, path)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	t.Run("list for repo", func(t *testing.T) {
+		got, err := store.ListRecentReviewersForRepo(ctx, otherRepo.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []*RecentReviewerSummary{
+			{FilePath: "", ReviewerHandle: "bob", ReviewCount: 1},
+			{FilePath: "file1.txt", ReviewerHandle: "bob", ReviewCount: 1},
+		}, got)
+	})
+
+	t.Run("clear signals", func(t *testing.T) {
+		require.NoError(t, store.ClearSignals(ctx, repo.ID))
+		got, err := store.FindRecentReviewers(ctx, repo.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		// Signals of other repos are left intact.
+		got, err = store.FindRecentReviewers(ctx, otherRepo.ID, "")
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("empty handle", func(t *testing.T) {
+		err := store.AddReview(ctx, Review{RepoID: repo.ID, FilesChanged: []string{"file1.txt"}})
+		assert.Error(t, err)
+	})
+}
@@ -822,6 +822,64 @@ func (c *Client) LoadPullRequestBuildStatuses(ctx context.Context, pr *PullReque
 	return nil
 }
 
+// MergedPullRequests returns a page of the pull requests merged in the given
+// repository, newest first.
+func (c *Client) MergedPullRequests(ctx context.Context, repo *Repo, pageToken *PageToken) ([]*PullRequest, *PageToken, error) {
+	if repo.Slug == "" {
+		return nil, nil, errors.New("repository slug empty")
+	}
+	if repo.Project == nil || repo.Project.Key == "" {
+		return nil, nil, errors.New("project key empty")
+	}
+
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", repo.Project.Key, repo.Slug)
+	qry := url.Values{
+		"state": []string{"MERGED"},
+		"order": []string{"NEWEST"},
+	}
+
+	var prs []*PullRequest
+	next, err := c.page(ctx, path, qry, pageToken, &prs)
+	return prs, next, err
+}
+
+// PullRequestChangedFiles returns the paths of the files changed by the given
+// pull request.
+func (c *Client) PullRequestChangedFiles(ctx context.Context, pr *PullRequest) (_ []string, err error) {
+	if pr.ToRef.Repository.Slug == "" {
+		return nil, errors.New("repository slug empty")
+	}
+
+	if pr.ToRef.Repository.Project.Key == "" {
+		return nil, errors.New("project key empty")
+	}
+
+	path := fmt.Sprintf(
+		"rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/changes",
+		pr.ToRef.Repository.Project.Key,
+		pr.ToRef.Repository.Slug,
+		pr.ID,
+	)
+
+	t := &PageToken{Limit: 1000}
+
+	var paths []string
+	for t.HasMore() {
+		var page []struct {
+			Path struct {
+				ToString string `json:"toString"`
+			} `json:"path"`
+		}
+		if t, err = c.page(ctx, path, nil, t, &page); err != nil {
+			return nil, err
+		}
+		for _, change := range page {
+			paths = append(paths, change.Path.ToString)
+		}
+	}
+	return paths, nil
+}
+
 // ProjectRepos returns all repos of a project with a given projectKey
 func (c *Client) ProjectRepos(ctx context.Context, projectKey string) (repos []*Repo, err error) {
 	if projectKey == "" {
@@ -862,6 +862,63 @@ func (c *V3Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return &updatedRef, nil
 }
 
+// Issue is a GitHub issue, as returned by the REST API.
+type Issue struct {
+	ID      int64  `json:"id"`
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// IssueStateOpen is the state of an issue that has not been closed.
+const IssueStateOpen = "open"
+
+// CreateIssue creates an issue in the given repository.
+//
+// API docs: https://docs.github.com/en/rest/issues/issues#create-an-issue
+func (c *V3Client) CreateIssue(ctx context.Context, owner, repo, title, body string, labels []string) (*Issue, error) {
+	payload := struct {
+		Title  string   `json:"title"`
+		Body   string   `json:"body,omitempty"`
+		Labels []string `json:"labels,omitempty"`
+	}{Title: title, Body: body, Labels: labels}
+
+	var issue Issue
+	if _, err := c.post(ctx, "repos/"+owner+"/"+repo+"/issues", payload, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// GetIssue gets the issue with the given number in the given repository.
+//
+// API docs: https://docs.github.com/en/rest/issues/issues#get-an-issue
+func (c *V3Client) GetIssue(ctx context.Context, owner, repo string, number int) (*Issue, error) {
+	var issue Issue
+	if _, err := c.get(ctx, fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number), &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// CreateIssueComment comments on the issue with the given number in the given repository.
+//
+// API docs: https://docs.github.com/en/rest/issues/comments#create-an-issue-comment
+func (c *V3Client) CreateIssueComment(ctx context.Context, owner, repo string, number int, body string) error {
+	payload := struct {
+		Body string `json:"body"`
+	}{Body: body}
+
+	var comment struct {
+		ID int64 `json:"id"`
+	}
+	if _, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/comments", owner, repo, number), payload, &comment); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetAppInstallation gets information of a GitHub App installation.
 //
 // API docs: https://docs.github.com/en/rest/reference/apps#get-an-installation-for-the-authenticated-app
@@ -1332,3 +1332,66 @@ func TestListPublicRepositories(t *testing.T) {
 		assert.Equal(t, "2", repositories[1].ID)
 	})
 }
+
+func TestV3Client_Issues(t *testing.T) {
+	ctx := context.Background()
+	rcache.SetupForTest(t)
+
+	type request struct {
+		Method string
+		Path   string
+		Body   map[string]any
+	}
+	var requests []request
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{Method: r.Method, Path: r.URL.Path}
+		if r.Body != nil && r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+		}
+		requests = append(requests, req)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/sourcegraph/sourcegraph/issues/42/comments":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/sourcegraph/sourcegraph/issues":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 100, "number": 42, "title": "title", "state": "open", "html_url": "https://github.com/sourcegraph/sourcegraph/issues/42"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/sourcegraph/sourcegraph/issues/42":
+			_, _ = w.Write([]byte(`{"id": 100, "number": 42, "title": "title", "state": "closed", "html_url": "https://github.com/sourcegraph/sourcegraph/issues/42"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	}))
+	t.Cleanup(testServer.Close)
+
+	uri, _ := url.Parse(testServer.URL)
+	cli := NewV3Client(logtest.Scoped(t), "Test", uri, gheToken, testServer.Client())
+
+	issue, err := cli.CreateIssue(ctx, "sourcegraph", "sourcegraph", "title", "body", []string{"code-monitor"})
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{ID: 100, Number: 42, Title: "title", State: IssueStateOpen, HTMLURL: "https://github.com/sourcegraph/sourcegraph/issues/42"}, issue)
+
+	issue, err = cli.GetIssue(ctx, "sourcegraph", "sourcegraph", 42)
+	require.NoError(t, err)
+	assert.Equal(t, "closed", issue.State)
+
+	require.NoError(t, cli.CreateIssueComment(ctx, "sourcegraph", "sourcegraph", 42, "comment"))
+
+	_, err = cli.GetIssue(ctx, "sourcegraph", "sourcegraph", 43)
+	assert.True(t, IsNotFound(err))
+
+	want := []request{
+		{Method: "POST", Path: "/repos/sourcegraph/sourcegraph/issues", Body: map[string]any{"title": "title", "body": "body", "labels": []any{"code-monitor"}}},
+		{Method: "GET", Path: "/repos/sourcegraph/sourcegraph/issues/42"},
+		{Method: "POST", Path: "/repos/sourcegraph/sourcegraph/issues/42/comments", Body: map[string]any{"body": "comment"}},
+		{Method: "GET", Path: "/repos/sourcegraph/sourcegraph/issues/43"},
+	}
+	if diff := cmp.Diff(want, requests); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+}
@@ -794,6 +794,111 @@ func (c *V4Client) RecentCommitters(ctx context.Context, params *RecentCommitter
 	return &result.Repository.DefaultBranchRef.Target.History, nil
 }
 
+type MergedPullRequestsParams struct {
+	// Repository name
+	Name string
+	// Repository owner
+	Owner string
+	// After is the cursor to paginate from.
+	After Cursor
+	// First is the page size. Default to 50 if left zero.
+	First int
+}
+
+type MergedPullRequestsResults struct {
+	Nodes []struct {
+		Number    int64
+		MergedAt  time.Time
+		UpdatedAt time.Time
+		Files     struct {
+			Nodes []struct {
+				Path string
+			}
+		}
+		Reviews struct {
+			Nodes []struct {
+				Author struct {
+					Login string
+					Email string
+				}
+			}
+		}
+	}
+	PageInfo struct {
+		HasNextPage bool
+		EndCursor   Cursor
+	}
+}
+
+// MergedPullRequests lists merged pull requests for a repository, most recently
+// updated first, along with the files they changed and their approving reviews.
+// Only the first 100 changed files of each pull request are returned.
+func (c *V4Client) MergedPullRequests(ctx context.Context, params *MergedPullRequestsParams) (*MergedPullRequestsResults, error) {
+	if params.First == 0 {
+		params.First = 50
+	}
+
+	query := `
+	  query($name: String!, $owner: String!, $after: String, $first: Int!) {
+		repository(name: $name, owner: $owner) {
+		  pullRequests(states: MERGED, after: $after, first: $first, orderBy: {field: UPDATED_AT, direction: DESC}) {
+			pageInfo { hasNextPage, endCursor }
+			nodes {
+			  number
+			  mergedAt
+			  updatedAt
+			  files(first: 100) {
+				nodes {
+				  path
+				}
+			  }
+			  reviews(states: APPROVED, first: 50) {
+				nodes {
+				  author {
+					login
+					... on User {
+					  email
+					}
+				  }
+				}
+			  }
+			}
+		  }
+		}
+	  }
+	`
+
+	vars := map[string]any{
+		"name":  params.Name,
+		"owner": params.Owner,
+		"first": params.First,
+	}
+	if params.After != "" {
+		vars["after"] = params.After
+	}
+
+	var result struct {
+		Repository struct {
+			PullRequests MergedPullRequestsResults
+		}
+	}
+	err := c.requestGraphQL(ctx, query, vars, &result)
+	if err != nil {
+		var e graphqlErrors
+		if errors.As(err, &e) {
+			for _, err2 := range e {
+				if err2.Type == graphqlErrTypeNotFound {
+					c.log.Warn("MergedPullRequests: GitHub repository not found")
+					continue
+				}
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	return &result.Repository.PullRequests, nil
+}
+
 type Release struct {
 	TagName      string
 	IsDraft      bool
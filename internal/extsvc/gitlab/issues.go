@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Issue is a GitLab issue.
+type Issue struct {
+	ID     ID     `json:"id"`
+	IID    ID     `json:"iid"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	WebURL string `json:"web_url"`
+}
+
+// IssueStateOpened is the state of an issue that has not been closed.
+const IssueStateOpened = "opened"
+
+type CreateIssueOpts struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// Labels is a comma-separated list of label names.
+	Labels string `json:"labels,omitempty"`
+}
+
+// CreateIssue creates an issue in the given project.
+//
+// API docs: https://docs.gitlab.com/ee/api/issues.html#new-issue
+func (c *Client) CreateIssue(ctx context.Context, project *Project, opts CreateIssueOpts) (*Issue, error) {
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling options")
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("projects/%d/issues", project.ID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to create an issue")
+	}
+
+	resp := &Issue{}
+	if _, code, err := c.do(ctx, req, resp); err != nil {
+		return nil, errors.Wrap(errcode.MaybeMakeNonRetryable(code, err), "sending request to create an issue")
+	}
+
+	return resp, nil
+}
+
+// GetIssue returns the issue of the given project with the given project-scoped ID.
+//
+// API docs: https://docs.gitlab.com/ee/api/issues.html#single-project-issue
+func (c *Client) GetIssue(ctx context.Context, project *Project, iid ID) (*Issue, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/issues/%d", project.ID, iid), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get an issue")
+	}
+
+	resp := &Issue{}
+	if _, _, err := c.do(ctx, req, resp); err != nil {
+		var e HTTPError
+		if errors.As(err, &e) && e.Code() == http.StatusNotFound && strings.Contains(e.Message(), "Project Not Found") {
+			err = ErrProjectNotFound
+		}
+		return nil, errors.Wrap(err, "sending request to get an issue")
+	}
+
+	return resp, nil
+}
+
+// CreateIssueNote comments on the issue of the given project with the given project-scoped ID.
+//
+// API docs: https://docs.gitlab.com/ee/api/notes.html#create-new-issue-note
+func (c *Client) CreateIssueNote(ctx context.Context, project *Project, iid ID, body string) error {
+	data, err := json.Marshal(struct {
+		Body string `json:"body"`
+	}{Body: body})
+	if err != nil {
+		return errors.Wrap(err, "marshalling payload")
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("projects/%d/issues/%d/notes", project.ID, iid), bytes.NewBuffer(data))
+	if err != nil {
+		return errors.Wrap(err, "creating request to comment on an issue")
+	}
+
+	var resp struct {
+		ID int32 `json:"id"`
+	}
+	if _, code, err := c.do(ctx, req, &resp); err != nil {
+		return errors.Wrap(errcode.MaybeMakeNonRetryable(code, err), "sending request to comment on an issue")
+	}
+
+	return nil
+}
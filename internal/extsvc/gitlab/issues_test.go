@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recordingHTTPClient struct {
+	requests     []*http.Request
+	bodies       []string
+	responseBody string
+}
+
+func (c *recordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	c.requests = append(c.requests, req)
+	c.bodies = append(c.bodies, body)
+
+	return (&mockHTTPResponseBody{responseBody: c.responseBody}).Do(req)
+}
+
+func TestCreateIssue(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{ProjectCommon: ProjectCommon{ID: 1}}
+
+	client := newTestClient(t)
+	httpClient := &recordingHTTPClient{responseBody: `{"id": 100, "iid": 7, "title": "Banned API", "state": "opened", "web_url": "https://example.com/a/b/-/issues/7"}`}
+	client.httpClient = httpClient
+
+	issue, err := client.CreateIssue(ctx, project, CreateIssueOpts{Title: "Banned API", Description: "body", Labels: "security,monitor"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &Issue{ID: 100, IID: 7, Title: "Banned API", State: IssueStateOpened, WebURL: "https://example.com/a/b/-/issues/7"}
+	if diff := cmp.Diff(want, issue); diff != "" {
+		t.Errorf("unexpected issue (-want +got):\n%s", diff)
+	}
+
+	if have, want := httpClient.requests[0].URL.Path, "/projects/1/issues"; have != want {
+		t.Errorf("unexpected path: have=%q want=%q", have, want)
+	}
+	if have, want := httpClient.bodies[0], `{"title":"Banned API","description":"body","labels":"security,monitor"}`; have != want {
+		t.Errorf("unexpected body: have=%q want=%q", have, want)
+	}
+}
+
+func TestGetIssue(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{ProjectCommon: ProjectCommon{ID: 1}}
+
+	t.Run("found", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{responseBody: `{"id": 100, "iid": 7, "state": "closed"}`}
+
+		issue, err := client.GetIssue(ctx, project, 7)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if issue.State != "closed" {
+			t.Errorf("unexpected state: %q", issue.State)
+		}
+	})
+
+	t.Run("error status code", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPEmptyResponse{http.StatusNotFound}
+
+		if _, err := client.GetIssue(ctx, project, 7); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+}
+
+func TestCreateIssueNote(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{ProjectCommon: ProjectCommon{ID: 1}}
+
+	client := newTestClient(t)
+	httpClient := &recordingHTTPClient{responseBody: `{"id": 3}`}
+	client.httpClient = httpClient
+
+	if err := client.CreateIssueNote(ctx, project, 7, "more results"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if have, want := httpClient.requests[0].URL.Path, "/projects/1/issues/7/notes"; have != want {
+		t.Errorf("unexpected path: have=%q want=%q", have, want)
+	}
+	if have, want := httpClient.bodies[0], `{"body":"more results"}`; have != want {
+		t.Errorf("unexpected body: have=%q want=%q", have, want)
+	}
+}
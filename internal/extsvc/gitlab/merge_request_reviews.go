@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListMergedMergeRequests retrieves the merge requests of the given project
+// that were merged and last updated after the given time, most recently
+// updated first. As the merge requests are paginated, a function is returned
+// that may be invoked to return the next page of results. An empty slice and a
+// nil error indicates that all pages have been returned.
+func (c *Client) ListMergedMergeRequests(ctx context.Context, project *Project, updatedAfter time.Time) func() ([]*MergeRequest, error) {
+	baseURL := fmt.Sprintf("projects/%d/merge_requests", project.ID)
+	currentPage := "1"
+	return func() ([]*MergeRequest, error) {
+		page := []*MergeRequest{}
+
+		// If there aren't any further pages, we'll return the empty slice we
+		// just created.
+		if currentPage == "" {
+			return page, nil
+		}
+
+		q := make(url.Values)
+		q.Add("state", string(MergeRequestStateMerged))
+		q.Add("updated_after", updatedAfter.UTC().Format(time.RFC3339))
+		q.Add("order_by", "updated_at")
+		q.Add("sort", "desc")
+		q.Add("page", currentPage)
+		u := &url.URL{Path: baseURL, RawQuery: q.Encode()}
+
+		req, err := http.NewRequest("GET", u.String(), nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating merge requests request")
+		}
+
+		header, _, err := c.do(ctx, req, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "requesting merge requests page")
+		}
+
+		// If there's another page, this will be a page number. If there's not, then
+		// this will be an empty string, and we can detect that next iteration
+		// to short circuit.
+		currentPage = header.Get("X-Next-Page")
+
+		return page, nil
+	}
+}
+
+// GetMergeRequestApprovers returns the users that approved the given merge
+// request.
+func (c *Client) GetMergeRequestApprovers(ctx context.Context, project *Project, iid ID) ([]User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/merge_requests/%d/approvals", project.ID, iid), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get merge request approvals")
+	}
+
+	var resp struct {
+		ApprovedBy []struct {
+			User User `json:"user"`
+		} `json:"approved_by"`
+	}
+	if _, _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, errors.Wrap(err, "sending request to get merge request approvals")
+	}
+
+	approvers := make([]User, 0, len(resp.ApprovedBy))
+	for _, a := range resp.ApprovedBy {
+		approvers = append(approvers, a.User)
+	}
+	return approvers, nil
+}
+
+// GetMergeRequestChangedFiles returns the paths of the files changed by the
+// given merge request. For renamed files, the new path is returned.
+func (c *Client) GetMergeRequestChangedFiles(ctx context.Context, project *Project, iid ID) ([]string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/merge_requests/%d/changes", project.ID, iid), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get merge request changes")
+	}
+
+	var resp struct {
+		Changes []struct {
+			NewPath string `json:"new_path"`
+		} `json:"changes"`
+	}
+	if _, _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, errors.Wrap(err, "sending request to get merge request changes")
+	}
+
+	paths := make([]string, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		paths = append(paths, change.NewPath)
+	}
+	return paths, nil
+}
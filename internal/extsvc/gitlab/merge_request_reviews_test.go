@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListMergedMergeRequests(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{}
+
+	client := newTestClient(t)
+	mock := &mockHTTPResponseBody{
+		header:       http.Header{"X-Next-Page": []string{"2"}},
+		responseBody: `[{"iid": 1, "state": "merged"}, {"iid": 2, "state": "merged"}]`,
+	}
+	client.httpClient = mock
+
+	it := client.ListMergedMergeRequests(ctx, project, time.Now().Add(-time.Hour))
+	mrs, err := it()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	var iids []ID
+	for _, mr := range mrs {
+		iids = append(iids, mr.IID)
+	}
+	if diff := cmp.Diff([]ID{1, 2}, iids); diff != "" {
+		t.Errorf("unexpected merge requests: %s", diff)
+	}
+
+	// The second page is the last one.
+	mock.header = nil
+	if _, err := it(); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	mrs, err = it()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(mrs) != 0 {
+		t.Errorf("expected no more merge requests, got %+v", mrs)
+	}
+	if mock.count != 2 {
+		t.Errorf("expected 2 requests, got %d", mock.count)
+	}
+}
+
+func TestGetMergeRequestApprovers(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{}
+
+	t.Run("error status code", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPEmptyResponse{http.StatusNotFound}
+
+		if _, err := client.GetMergeRequestApprovers(ctx, project, 42); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{
+			responseBody: `{"approved_by": [{"user": {"id": 1, "username": "alice"}}, {"user": {"id": 2, "username": "bob"}}]}`,
+		}
+
+		approvers, err := client.GetMergeRequestApprovers(ctx, project, 42)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		want := []User{{ID: 1, Username: "alice"}, {ID: 2, Username: "bob"}}
+		if diff := cmp.Diff(want, approvers); diff != "" {
+			t.Errorf("unexpected approvers: %s", diff)
+		}
+	})
+}
+
+func TestGetMergeRequestChangedFiles(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{}
+
+	client := newTestClient(t)
+	client.httpClient = &mockHTTPResponseBody{
+		responseBody: `{"changes": [{"old_path": "a.go", "new_path": "a.go"}, {"old_path": "old/b.go", "new_path": "new/b.go"}]}`,
+	}
+
+	paths, err := client.GetMergeRequestChangedFiles(ctx, project, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if diff := cmp.Diff([]string{"a.go", "new/b.go"}, paths); diff != "" {
+		t.Errorf("unexpected paths: %s", diff)
+	}
+}
@@ -0,0 +1,219 @@
+// Package jira implements a minimal client for the Jira REST API, covering the endpoints
+// needed to file and follow up on issues.
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Client accesses a Jira instance via the REST API (version 2), which is supported by both
+// Jira Cloud and Jira Server/Data Center.
+type Client struct {
+	// URL is the base URL of the Jira instance.
+	URL *url.URL
+
+	username string
+	token    string
+
+	httpClient httpcli.Doer
+}
+
+// NewClient returns a Jira API client for the instance at baseURL. If username is set, requests
+// are authenticated with basic authentication using the username and the API token (Jira Cloud).
+// Otherwise, the token is sent as a bearer personal access token (Jira Server/Data Center). If a
+// nil httpClient is provided, httpcli.ExternalDoer will be used.
+func NewClient(baseURL, username, token string, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Jira URL")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("invalid Jira URL %q", baseURL)
+	}
+	if u.Path == "" || u.Path[len(u.Path)-1] != '/' {
+		u.Path += "/"
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	return &Client{
+		URL:        u,
+		username:   username,
+		token:      token,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Issue is a Jira issue.
+type Issue struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+	// Done is true if the status of the issue belongs to the "done" status category.
+	Done bool `json:"-"`
+}
+
+// BrowseURL returns the URL of the web page of the issue.
+func (c *Client) BrowseURL(issue *Issue) string {
+	return c.URL.ResolveReference(&url.URL{Path: "browse/" + issue.Key}).String()
+}
+
+// CreateIssueOpts are the fields of a new issue.
+type CreateIssueOpts struct {
+	ProjectKey  string
+	IssueType   string
+	Summary     string
+	Description string
+	Labels      []string
+}
+
+// CreateIssue creates an issue.
+//
+// API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-post
+func (c *Client) CreateIssue(ctx context.Context, opts CreateIssueOpts) (*Issue, error) {
+	type named struct {
+		Key  string `json:"key,omitempty"`
+		Name string `json:"name,omitempty"`
+	}
+	payload := struct {
+		Fields struct {
+			Project     named    `json:"project"`
+			IssueType   named    `json:"issuetype"`
+			Summary     string   `json:"summary"`
+			Description string   `json:"description,omitempty"`
+			Labels      []string `json:"labels,omitempty"`
+		} `json:"fields"`
+	}{}
+	payload.Fields.Project.Key = opts.ProjectKey
+	payload.Fields.IssueType.Name = opts.IssueType
+	payload.Fields.Summary = opts.Summary
+	payload.Fields.Description = opts.Description
+	payload.Fields.Labels = opts.Labels
+
+	var issue Issue
+	if err := c.do(ctx, http.MethodPost, "rest/api/2/issue", payload, &issue); err != nil {
+		return nil, errors.Wrap(err, "creating issue")
+	}
+	return &issue, nil
+}
+
+// GetIssue returns the issue with the given key.
+//
+// API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-get
+func (c *Client) GetIssue(ctx context.Context, key string) (*Issue, error) {
+	var resp struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields struct {
+			Status struct {
+				StatusCategory struct {
+					Key string `json:"key"`
+				} `json:"statusCategory"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := c.do(ctx, http.MethodGet, "rest/api/2/issue/"+url.PathEscape(key)+"?fields=status", nil, &resp); err != nil {
+		return nil, errors.Wrap(err, "getting issue")
+	}
+	return &Issue{
+		ID:   resp.ID,
+		Key:  resp.Key,
+		Done: resp.Fields.Status.StatusCategory.Key == "done",
+	}, nil
+}
+
+// AddComment comments on the issue with the given key.
+//
+// API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-post
+func (c *Client) AddComment(ctx context.Context, key, body string) error {
+	payload := struct {
+		Body string `json:"body"`
+	}{Body: body}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, "rest/api/2/issue/"+url.PathEscape(key)+"/comment", payload, &resp); err != nil {
+		return errors.Wrap(err, "adding comment")
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, result any) error {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "marshalling payload")
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.URL.ResolveReference(ref).String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.token)
+	} else if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	return json.Unmarshal(bs, result)
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Jira API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
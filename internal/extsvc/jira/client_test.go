@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	type request struct {
+		Method        string
+		Path          string
+		Authorization string
+		Body          map[string]any
+	}
+	var requests []request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{Method: r.Method, Path: r.URL.RequestURI(), Authorization: r.Header.Get("Authorization")}
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+		}
+		requests = append(requests, req)
+
+		switch r.URL.RequestURI() {
+		case "/jira/rest/api/2/issue":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "10000", "key": "SEC-1", "self": "https://example.com/rest/api/2/issue/10000"}`))
+		case "/jira/rest/api/2/issue/SEC-1?fields=status":
+			_, _ = w.Write([]byte(`{"id": "10000", "key": "SEC-1", "fields": {"status": {"statusCategory": {"key": "done"}}}}`))
+		case "/jira/rest/api/2/issue/SEC-1/comment":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "10001"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Issue does not exist or you do not have permission to see it."]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(srv.URL+"/jira", "alice@example.com", "token", srv.Client())
+	require.NoError(t, err)
+
+	issue, err := cli.CreateIssue(ctx, CreateIssueOpts{
+		ProjectKey:  "SEC",
+		IssueType:   "Bug",
+		Summary:     "summary",
+		Description: "description",
+		Labels:      []string{"code-monitor"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{ID: "10000", Key: "SEC-1"}, issue)
+	assert.Equal(t, srv.URL+"/jira/browse/SEC-1", cli.BrowseURL(issue))
+
+	issue, err = cli.GetIssue(ctx, "SEC-1")
+	require.NoError(t, err)
+	assert.True(t, issue.Done)
+
+	require.NoError(t, cli.AddComment(ctx, "SEC-1", "comment"))
+
+	_, err = cli.GetIssue(ctx, "SEC-2")
+	assert.True(t, errcode.IsNotFound(err))
+
+	basicAuth := "Basic YWxpY2VAZXhhbXBsZS5jb206dG9rZW4="
+	want := []request{
+		{
+			Method:        "POST",
+			Path:          "/jira/rest/api/2/issue",
+			Authorization: basicAuth,
+			Body: map[string]any{"fields": map[string]any{
+				"project":     map[string]any{"key": "SEC"},
+				"issuetype":   map[string]any{"name": "Bug"},
+				"summary":     "summary",
+				"description": "description",
+				"labels":      []any{"code-monitor"},
+			}},
+		},
+		{Method: "GET", Path: "/jira/rest/api/2/issue/SEC-1?fields=status", Authorization: basicAuth},
+		{Method: "POST", Path: "/jira/rest/api/2/issue/SEC-1/comment", Authorization: basicAuth, Body: map[string]any{"body": "comment"}},
+		{Method: "GET", Path: "/jira/rest/api/2/issue/SEC-2?fields=status", Authorization: basicAuth},
+	}
+	if diff := cmp.Diff(want, requests); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient("not a url", "", "token", nil)
+	assert.Error(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"id": "10000", "key": "SEC-1", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(srv.URL, "", "token", srv.Client())
+	require.NoError(t, err)
+
+	issue, err := cli.GetIssue(context.Background(), "SEC-1")
+	require.NoError(t, err)
+	assert.False(t, issue.Done)
+}
@@ -179,6 +179,7 @@ func TestClient_CreateCommitFromPatchRequest_ProtoRoundTrip(t *testing.T) {
 				AuthorEmail string
 				Date        fuzzTime
 			},
+			signingKey *protocol.CommitSigningKey,
 
 			pushConfig *protocol.PushConfig,
 			gitApplyArgs []string,
@@ -194,6 +195,7 @@ func TestClient_CreateCommitFromPatchRequest_ProtoRoundTrip(t *testing.T) {
 					AuthorName:  commitInfo.AuthorName,
 					AuthorEmail: commitInfo.AuthorEmail,
 					Date:        time.Time(commitInfo.Date),
+					SigningKey:  signingKey,
 				},
 				Push:         pushConfig,
 				PushRef:      pushRef,
@@ -0,0 +1,259 @@
+// Package commitsigning signs git commit objects with GPG or SSH keys.
+//
+// Signing happens in process rather than by shelling out to gpg or
+// ssh-keygen, so that private keys never have to be written to disk or
+// imported into a keyring on gitserver.
+package commitsigning
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Format is the signature format produced by a Signer.
+type Format string
+
+const (
+	FormatGPG Format = "gpg"
+	FormatSSH Format = "ssh"
+)
+
+// Valid returns true if f is a known signature format.
+func (f Format) Valid() bool {
+	return f == FormatGPG || f == FormatSSH
+}
+
+// Signer produces detached signatures with a private key.
+type Signer interface {
+	// Format returns the signature format produced by the signer.
+	Format() Format
+	// PublicKey returns the public half of the signing key, in the form code
+	// hosts expect it when registering a signing key: an ASCII armored public
+	// key block for GPG, and an authorized_keys line for SSH.
+	PublicKey() string
+	// Fingerprint returns the fingerprint of the signing key.
+	Fingerprint() string
+	// Sign returns an ASCII armored detached signature of payload.
+	Sign(payload []byte) ([]byte, error)
+}
+
+// NewSigner parses the given private key and returns a Signer for it. The
+// passphrase is only required if the private key is encrypted.
+func NewSigner(format Format, privateKey, passphrase string) (Signer, error) {
+	switch format {
+	case FormatGPG:
+		return newGPGSigner(privateKey, passphrase)
+	case FormatSSH:
+		return newSSHSigner(privateKey, passphrase)
+	default:
+		return nil, errors.Errorf("unknown signature format %q", format)
+	}
+}
+
+// SignCommit signs the given raw commit object, as printed by
+// `git cat-file commit`, and returns the commit object with the signature
+// added as a gpgsig header. The returned object can be written to the object
+// database with `git hash-object -t commit -w --stdin`.
+func SignCommit(signer Signer, commit []byte) ([]byte, error) {
+	// The header of a commit object is separated from the message by the
+	// first empty line.
+	end := bytes.Index(commit, []byte("\n\n"))
+	if end < 0 {
+		return nil, errors.New("malformed commit object: no message separator")
+	}
+	header := commit[:end+1]
+	for _, line := range bytes.Split(header, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("gpgsig ")) {
+			return nil, errors.New("commit is already signed")
+		}
+	}
+
+	sig, err := signer.Sign(commit)
+	if err != nil {
+		return nil, errors.Wrap(err, "signing commit")
+	}
+
+	// Multi-line header values are continued with lines starting with a
+	// single space.
+	var signed bytes.Buffer
+	signed.Write(header)
+	signed.WriteString("gpgsig ")
+	signed.WriteString(strings.ReplaceAll(strings.TrimRight(string(sig), "\n"), "\n", "\n "))
+	signed.WriteString("\n")
+	signed.Write(commit[end+1:])
+	return signed.Bytes(), nil
+}
+
+type gpgSigner struct {
+	entity *openpgp.Entity
+}
+
+func newGPGSigner(privateKey, passphrase string) (*gpgSigner, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(privateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing GPG private key")
+	}
+	if len(entities) != 1 {
+		return nil, errors.Errorf("expected exactly one GPG key, got %d", len(entities))
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey == nil {
+		return nil, errors.New("GPG key does not contain a private key")
+	}
+	if entity.PrivateKey.Encrypted {
+		if passphrase == "" {
+			return nil, errors.New("GPG private key is encrypted, but no passphrase was given")
+		}
+		if err := entity.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+			return nil, errors.Wrap(err, "decrypting GPG private key")
+		}
+	}
+
+	return &gpgSigner{entity: entity}, nil
+}
+
+func (s *gpgSigner) Format() Format { return FormatGPG }
+
+func (s *gpgSigner) PublicKey() string {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return ""
+	}
+	if err := s.entity.Serialize(w); err != nil {
+		return ""
+	}
+	if err := w.Close(); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+func (s *gpgSigner) Fingerprint() string {
+	return fmt.Sprintf("%X", s.entity.PrimaryKey.Fingerprint)
+}
+
+func (s *gpgSigner) Sign(payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&buf, s.entity, bytes.NewReader(payload), nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+func newSSHSigner(privateKey, passphrase string) (*sshSigner, error) {
+	var (
+		key interface{}
+		err error
+	)
+	if passphrase != "" {
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	} else {
+		key, err = ssh.ParseRawPrivateKey([]byte(privateKey))
+	}
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, errors.New("SSH private key is encrypted, but no passphrase was given")
+		}
+		return nil, errors.Wrap(err, "parsing SSH private key")
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating SSH signer")
+	}
+	return &sshSigner{signer: signer}, nil
+}
+
+func (s *sshSigner) Format() Format { return FormatSSH }
+
+func (s *sshSigner) PublicKey() string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(s.signer.PublicKey())))
+}
+
+func (s *sshSigner) Fingerprint() string {
+	return ssh.FingerprintSHA256(s.signer.PublicKey())
+}
+
+const (
+	// sshsigMagic, sshsigVersion, sshsigNamespace and sshsigHashAlgorithm
+	// are the parameters of the signature format described in
+	// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig,
+	// matching what `ssh-keygen -Y sign -n git` produces.
+	sshsigMagic         = "SSHSIG"
+	sshsigVersion       = 1
+	sshsigNamespace     = "git"
+	sshsigHashAlgorithm = "sha512"
+)
+
+func (s *sshSigner) Sign(payload []byte) ([]byte, error) {
+	h := sha512.Sum512(payload)
+	signedData := sshsigMagic + string(ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          string
+	}{
+		Namespace:     sshsigNamespace,
+		HashAlgorithm: sshsigHashAlgorithm,
+		Hash:          string(h[:]),
+	}))
+
+	var (
+		sig *ssh.Signature
+		err error
+	)
+	// RSA keys default to SHA-1 signatures, which OpenSSH refuses to verify.
+	if as, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		sig, err = as.SignWithAlgorithm(rand.Reader, []byte(signedData), ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, []byte(signedData))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blob := sshsigMagic + string(ssh.Marshal(struct {
+		Version       uint32
+		PublicKey     string
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     string
+	}{
+		Version:       sshsigVersion,
+		PublicKey:     string(s.signer.PublicKey().Marshal()),
+		Namespace:     sshsigNamespace,
+		HashAlgorithm: sshsigHashAlgorithm,
+		Signature:     string(ssh.Marshal(sig)),
+	}))
+
+	// ssh-keygen wraps the base64 encoded signature at 70 columns.
+	encoded := base64.StdEncoding.EncodeToString([]byte(blob))
+	var buf bytes.Buffer
+	buf.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		buf.WriteString(encoded[:70])
+		buf.WriteString("\n")
+		encoded = encoded[70:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\n-----END SSH SIGNATURE-----\n")
+	return buf.Bytes(), nil
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/commitsigning"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -692,6 +693,9 @@ type PatchCommitInfo struct {
 	CommitterName  string
 	CommitterEmail string
 	Date           time.Time
+	// SigningKey, if set, is used to sign the commit. If nil, the commit is
+	// not signed.
+	SigningKey *CommitSigningKey
 }
 
 func (p *PatchCommitInfo) ToProto() *proto.PatchCommitInfo {
@@ -702,11 +706,12 @@ func (p *PatchCommitInfo) ToProto() *proto.PatchCommitInfo {
 		CommitterName:  p.CommitterName,
 		CommitterEmail: p.CommitterEmail,
 		Date:           timestamppb.New(p.Date),
+		SigningKey:     p.SigningKey.ToProto(),
 	}
 }
 
 func PatchCommitInfoFromProto(p *proto.PatchCommitInfo) PatchCommitInfo {
-	return PatchCommitInfo{
+	info := PatchCommitInfo{
 		Messages:       p.GetMessages(),
 		AuthorName:     p.GetAuthorName(),
 		AuthorEmail:    p.GetAuthorEmail(),
@@ -714,6 +719,48 @@ func PatchCommitInfoFromProto(p *proto.PatchCommitInfo) PatchCommitInfo {
 		CommitterEmail: p.GetCommitterEmail(),
 		Date:           p.GetDate().AsTime(),
 	}
+
+	if sk := p.GetSigningKey(); sk != nil {
+		info.SigningKey = &CommitSigningKey{}
+		info.SigningKey.FromProto(sk)
+	}
+
+	return info
+}
+
+// CommitSigningKey provides the private key used to sign a commit created from
+// a patch.
+type CommitSigningKey struct {
+	// Format is the signature format to produce.
+	Format commitsigning.Format
+
+	// PrivateKey is the ASCII armored GPG private key or the PEM encoded SSH
+	// private key used to sign the commit.
+	PrivateKey string
+
+	// Passphrase is the passphrase to decrypt the private key, if it is
+	// encrypted.
+	Passphrase string
+}
+
+func (k *CommitSigningKey) ToProto() *proto.CommitSigningKey {
+	if k == nil {
+		return nil
+	}
+
+	return &proto.CommitSigningKey{
+		Format:     string(k.Format),
+		PrivateKey: k.PrivateKey,
+		Passphrase: k.Passphrase,
+	}
+}
+
+func (k *CommitSigningKey) FromProto(p *proto.CommitSigningKey) {
+	*k = CommitSigningKey{
+		Format:     commitsigning.Format(p.GetFormat()),
+		PrivateKey: p.GetPrivateKey(),
+		Passphrase: p.GetPassphrase(),
+	}
 }
 
 // PushConfig provides the configuration required to push one or more commits to
@@ -2,6 +2,7 @@ package aggregation
 
 import (
 	"context"
+	"path"
 	"sync"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	sApi "github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
@@ -168,12 +172,110 @@ func countRepoMetadata(r result.Match, repo *sTypes.Repo) (map[MatchKey]int, err
 	return matches, nil
 }
 
-func GetCountFuncForMode(query, patternType string, mode types.SearchAggregationMode) (AggregationCountFunc, error) {
+// countLanguage groups file matches by the language of the file, as detected from its name.
+func countLanguage(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	filePath := matchPath(r)
+	if filePath == "" {
+		return nil, nil
+	}
+	language, _ := inventory.GetLanguageByFilename(filePath)
+	if language == "" {
+		return nil, nil
+	}
+	return map[MatchKey]int{{
+		RepoID: int32(r.RepoName().ID),
+		Repo:   string(r.RepoName().Name),
+		Group:  language,
+	}: r.ResultCount()}, nil
+}
+
+func countFileExtension(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	extension := path.Ext(matchPath(r))
+	if extension == "" {
+		return nil, nil
+	}
+	return map[MatchKey]int{{
+		RepoID: int32(r.RepoName().ID),
+		Repo:   string(r.RepoName().Name),
+		Group:  extension,
+	}: r.ResultCount()}, nil
+}
+
+func matchPath(r result.Match) string {
+	if match, ok := r.(*result.FileMatch); ok {
+		return match.Path
+	}
+	return ""
+}
+
+// countDateBucket groups commit and diff matches by the month of their author date.
+func countDateBucket(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+	match, ok := r.(*result.CommitMatch)
+	if !ok || match.Commit.Author.Date.IsZero() {
+		return nil, nil
+	}
+	return map[MatchKey]int{{
+		RepoID: int32(r.RepoName().ID),
+		Repo:   string(r.RepoName().Name),
+		Group:  match.Commit.Author.Date.UTC().Format(types.DATE_BUCKET_LAYOUT),
+	}: r.ResultCount()}, nil
+}
+
+// countOwnersFunc returns a count function that groups file matches by their owners, as
+// resolved from the CODEOWNERS ruleset of their repository. Files without owners are not counted.
+func countOwnersFunc(ctx context.Context, ownService own.Service) AggregationCountFunc {
+	type rulesetKey struct {
+		repo   api.RepoID
+		commit api.CommitID
+	}
+	// Count functions are called under the lock of the aggregator, so the cache needs no lock.
+	rulesets := map[rulesetKey]*codeowners.Ruleset{}
+
+	return func(r result.Match, _ *sTypes.Repo) (map[MatchKey]int, error) {
+		match, ok := r.(*result.FileMatch)
+		if !ok {
+			return nil, nil
+		}
+		key := rulesetKey{repo: match.Repo.ID, commit: match.CommitID}
+		ruleset, ok := rulesets[key]
+		if !ok {
+			var err error
+			ruleset, err = ownService.RulesetForRepo(ctx, match.Repo.Name, match.Repo.ID, match.CommitID)
+			if err != nil {
+				return nil, errors.Wrap(err, "RulesetForRepo")
+			}
+			rulesets[key] = ruleset
+		}
+		if ruleset == nil {
+			return nil, nil
+		}
+
+		matches := map[MatchKey]int{}
+		for _, owner := range ruleset.Match(match.Path).GetOwner() {
+			group := owner.GetEmail()
+			if handle := owner.GetHandle(); handle != "" {
+				group = "@" + handle
+			}
+			if group == "" {
+				continue
+			}
+			matches[MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: group}] = r.ResultCount()
+		}
+		return matches, nil
+	}
+}
+
+// GetCountFuncForMode returns the function that counts the matches of the given aggregation mode.
+// The owner service is only used to resolve owners in the OWNER aggregation mode.
+func GetCountFuncForMode(ctx context.Context, ownService own.Service, query, patternType string, mode types.SearchAggregationMode) (AggregationCountFunc, error) {
 	modeCountTypes := map[types.SearchAggregationMode]AggregationCountFunc{
-		types.REPO_AGGREGATION_MODE:          countRepo,
-		types.PATH_AGGREGATION_MODE:          countPath,
-		types.AUTHOR_AGGREGATION_MODE:        countAuthor,
-		types.REPO_METADATA_AGGREGATION_MODE: countRepoMetadata,
+		types.REPO_AGGREGATION_MODE:           countRepo,
+		types.PATH_AGGREGATION_MODE:           countPath,
+		types.AUTHOR_AGGREGATION_MODE:         countAuthor,
+		types.REPO_METADATA_AGGREGATION_MODE:  countRepoMetadata,
+		types.LANGUAGE_AGGREGATION_MODE:       countLanguage,
+		types.FILE_EXTENSION_AGGREGATION_MODE: countFileExtension,
+		types.DATE_BUCKET_AGGREGATION_MODE:    countDateBucket,
 	}
 
 	if mode == types.CAPTURE_GROUP_AGGREGATION_MODE {
@@ -183,6 +285,9 @@ func GetCountFuncForMode(query, patternType string, mode types.SearchAggregation
 		}
 		modeCountTypes[types.CAPTURE_GROUP_AGGREGATION_MODE] = captureGroupsCount
 	}
+	if mode == types.OWNER_AGGREGATION_MODE {
+		modeCountTypes[types.OWNER_AGGREGATION_MODE] = countOwnersFunc(ctx, ownService)
+	}
 
 	modeCountFunc, ok := modeCountTypes[mode]
 	if !ok {
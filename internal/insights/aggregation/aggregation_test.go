@@ -2,6 +2,7 @@ package aggregation
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	dTypes "github.com/sourcegraph/sourcegraph/internal/types"
@@ -102,7 +105,7 @@ func commitMatch(repo, author string, date time.Time, repoID, numRanges int32, c
 
 	return &result.CommitMatch{
 		Commit: gitdomain.Commit{
-			Author:    gitdomain.Signature{Name: author},
+			Author:    gitdomain.Signature{Name: author, Date: date},
 			Committer: &gitdomain.Signature{},
 			Message:   gitdomain.Message(content),
 		},
@@ -260,7 +263,7 @@ func TestRepoAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", tc.mode)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -330,7 +333,7 @@ func TestAuthorAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", tc.mode)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -438,7 +441,7 @@ func TestPathAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", tc.mode)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -649,7 +652,7 @@ func TestCaptureGroupAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), nil, tc.query, "regexp", tc.mode)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -703,7 +706,7 @@ func TestRepoMetadataAggregation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, _ := GetCountFuncForMode("", "", tc.mode)
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", tc.mode)
 			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, db)
 			sra.Send(tc.searchEvent)
 			tc.want.Equal(t, aggregator.results)
@@ -736,7 +739,7 @@ func TestAggregationCancelation(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			aggregator := testAggregator{results: make(map[string]int)}
-			countFunc, err := GetCountFuncForMode(tc.query, "regexp", tc.mode)
+			countFunc, err := GetCountFuncForMode(context.Background(), nil, tc.query, "regexp", tc.mode)
 			if err != nil {
 				t.Errorf("expected test not to error, got %v", err)
 				t.FailNow()
@@ -753,3 +756,147 @@ func TestAggregationCancelation(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguageAndFileExtensionAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        types.SearchAggregationMode
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No language for commit match",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{commitMatch("myRepo", "Author A", sampleDate, 1, 2, "a")},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"counts by language",
+			types.LANGUAGE_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "cmd/main.go", 1, "a", "b"),
+					contentMatch("myRepo", "web/index.tsx", 1, "a"),
+					pathMatch("myRepo", "web/app.ts", 1),
+					symbolMatch("myRepo2", "lib/lib.go", 2, "a"),
+					pathMatch("myRepo2", "LICENSE", 2),
+				},
+			},
+			autogold.Expect(map[string]int{"Go": 3, "TypeScript": 2}),
+		},
+		{
+			"counts by file extension",
+			types.FILE_EXTENSION_AGGREGATION_MODE,
+			streaming.SearchEvent{
+				Results: []result.Match{
+					contentMatch("myRepo", "cmd/main.go", 1, "a", "b"),
+					contentMatch("myRepo", "web/index.tsx", 1, "a"),
+					pathMatch("myRepo", "web/app.ts", 1),
+					pathMatch("myRepo2", "LICENSE", 2),
+				},
+			},
+			autogold.Expect(map[string]int{".go": 2, ".ts": 1, ".tsx": 1}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", tc.mode)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, tc.mode, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+func TestDateBucketAggregation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		searchEvent streaming.SearchEvent
+		want        autogold.Value
+	}{
+		{
+			"No date bucket for content match",
+			streaming.SearchEvent{
+				Results: []result.Match{contentMatch("myRepo", "file.go", 1, "a", "b")},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+		{
+			"counts by month of the author date",
+			streaming.SearchEvent{
+				Results: []result.Match{
+					commitMatch("repoA", "Author A", sampleDate, 1, 2, "a"),
+					commitMatch("repoA", "Author B", sampleDate.AddDate(0, 0, 29), 1, 2, "a"),
+					commitMatch("repoB", "Author B", sampleDate.AddDate(0, 1, 0), 2, 2, "a"),
+					commitMatch("repoB", "Author C", sampleDate.AddDate(1, 0, 0), 2, 2, "a"),
+				},
+			},
+			autogold.Expect(map[string]int{"2022-04": 4, "2022-05": 2, "2023-04": 2}),
+		},
+		{
+			"diff matches without date are not counted",
+			streaming.SearchEvent{
+				Results: []result.Match{diffMatch("myRepo", "author-a", 1)},
+			},
+			autogold.Expect(map[string]int{}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator := testAggregator{results: make(map[string]int)}
+			countFunc, _ := GetCountFuncForMode(context.Background(), nil, "", "", types.DATE_BUCKET_AGGREGATION_MODE)
+			sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, types.DATE_BUCKET_AGGREGATION_MODE, nil)
+			sra.Send(tc.searchEvent)
+			tc.want.Equal(t, aggregator.results)
+		})
+	}
+}
+
+type fakeOwnService struct {
+	own.Service
+	codeowners map[api.RepoName]string
+	calls      int
+}
+
+func (s *fakeOwnService) RulesetForRepo(_ context.Context, repoName api.RepoName, repoID api.RepoID, _ api.CommitID) (*codeowners.Ruleset, error) {
+	s.calls++
+	content, ok := s.codeowners[repoName]
+	if !ok {
+		return nil, nil
+	}
+	file, err := codeowners.Parse(strings.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	return codeowners.NewRuleset(codeowners.IngestedRulesetSource{ID: int32(repoID)}, file), nil
+}
+
+func TestOwnerAggregation(t *testing.T) {
+	ownService := &fakeOwnService{codeowners: map[api.RepoName]string{
+		"myRepo": "*.go @sourcegraph/backend\n/docs/ @alice docs@example.com\n",
+	}}
+
+	aggregator := testAggregator{results: make(map[string]int)}
+	countFunc, err := GetCountFuncForMode(context.Background(), ownService, "", "", types.OWNER_AGGREGATION_MODE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc, types.OWNER_AGGREGATION_MODE, nil)
+	sra.Send(streaming.SearchEvent{
+		Results: []result.Match{
+			contentMatch("myRepo", "cmd/main.go", 1, "a", "b"),
+			contentMatch("myRepo", "docs/index.md", 1, "a"),
+			pathMatch("myRepo", "README.md", 1),
+			pathMatch("unownedRepo", "main.go", 2),
+			commitMatch("myRepo", "Author A", sampleDate, 1, 2, "a"),
+		},
+	})
+
+	autogold.Expect(map[string]int{"@alice": 1, "@sourcegraph/backend": 2, "docs@example.com": 1}).Equal(t, aggregator.results)
+	if ownService.calls != 2 {
+		t.Errorf("expected the ruleset of each repository to be fetched once, got %d calls", ownService.calls)
+	}
+}
@@ -0,0 +1,216 @@
+package alerts
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// minBaselinePoints is the minimum number of points the rolling baseline of an anomaly rule
+// needs before the rule is evaluated. With fewer points the standard deviation is meaningless.
+const minBaselinePoints = 3
+
+// Point is the value of an insight series at a recording time, aggregated over all repositories
+// and capture group values.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Result describes why an alert rule fired.
+type Result struct {
+	// Point is the most recent point of the series, which the rule fired for.
+	Point Point
+	// Baseline is the value that Point was compared against: the threshold, the value N intervals
+	// ago, or the mean of the rolling baseline.
+	Baseline float64
+	Message  string
+}
+
+// Validate returns an error if the rule cannot be evaluated.
+func Validate(rule types.InsightSeriesAlertRule) error {
+	switch rule.Kind {
+	case types.AlertThreshold:
+		if rule.Direction == types.AlertEither {
+			return errors.New("threshold alert rules must fire either above or below the threshold")
+		}
+	case types.AlertPercentChange, types.AlertAnomaly:
+		if rule.Threshold <= 0 {
+			return errors.Newf("%s alert rules require a positive threshold", rule.Kind)
+		}
+	default:
+		return errors.Newf("unknown alert rule kind %q", rule.Kind)
+	}
+
+	switch rule.Direction {
+	case types.AlertAbove, types.AlertBelow, types.AlertEither:
+	default:
+		return errors.Newf("unknown alert rule direction %q", rule.Direction)
+	}
+
+	if rule.Intervals < 1 {
+		return errors.New("alert rules require at least one interval")
+	}
+	if rule.Kind == types.AlertAnomaly && rule.Intervals < minBaselinePoints {
+		return errors.Newf("anomaly alert rules require a baseline of at least %d intervals", minBaselinePoints)
+	}
+	return nil
+}
+
+// Evaluate evaluates the rule against the most recent of the given points, which must be sorted
+// by time in ascending order. It returns nil if the rule does not fire.
+//
+// Threshold rules only fire when the series crosses the threshold, so that a series that stays
+// above (or below) the threshold does not notify after every snapshot. Percent change and anomaly
+// rules fire whenever the most recent point satisfies them.
+func Evaluate(rule types.InsightSeriesAlertRule, points []Point) *Result {
+	if len(points) == 0 {
+		return nil
+	}
+	latest := points[len(points)-1]
+
+	switch rule.Kind {
+	case types.AlertThreshold:
+		if !beyond(rule.Direction, latest.Value, rule.Threshold) {
+			return nil
+		}
+		if len(points) > 1 && beyond(rule.Direction, points[len(points)-2].Value, rule.Threshold) {
+			return nil
+		}
+		return &Result{
+			Point:    latest,
+			Baseline: rule.Threshold,
+			Message:  fmt.Sprintf("The series value %s is %s the threshold of %s.", formatValue(latest.Value), rule.Direction, formatValue(rule.Threshold)),
+		}
+
+	case types.AlertPercentChange:
+		if len(points) <= rule.Intervals {
+			return nil
+		}
+		previous := points[len(points)-1-rule.Intervals]
+		if previous.Value == 0 {
+			// A change from zero has no meaningful percentage.
+			return nil
+		}
+		change := (latest.Value - previous.Value) / math.Abs(previous.Value) * 100
+		if !exceeds(rule.Direction, change, rule.Threshold) {
+			return nil
+		}
+		return &Result{
+			Point:    latest,
+			Baseline: previous.Value,
+			Message: fmt.Sprintf("The series value changed by %+.1f%% over the last %d %s, from %s to %s.",
+				change, rule.Intervals, pluralize("interval", rule.Intervals), formatValue(previous.Value), formatValue(latest.Value)),
+		}
+
+	case types.AlertAnomaly:
+		if len(points) <= minBaselinePoints {
+			return nil
+		}
+		start := len(points) - 1 - rule.Intervals
+		if start < 0 {
+			start = 0
+		}
+		baseline := points[start : len(points)-1]
+		mean, stddev := meanAndStddev(baseline)
+
+		var deviations float64
+		switch {
+		case stddev > 0:
+			deviations = (latest.Value - mean) / stddev
+		case latest.Value == mean:
+			return nil
+		default:
+			// Any deviation from a perfectly flat baseline is an anomaly.
+			deviations = math.Copysign(math.Inf(1), latest.Value-mean)
+		}
+		if !exceeds(rule.Direction, deviations, rule.Threshold) {
+			return nil
+		}
+		return &Result{
+			Point:    latest,
+			Baseline: mean,
+			Message: fmt.Sprintf("The series value %s is %s standard deviations %s the mean of %s over the last %d %s.",
+				formatValue(latest.Value), formatDeviations(deviations), directionOf(deviations), formatValue(mean), len(baseline), pluralize("interval", len(baseline))),
+		}
+	}
+
+	return nil
+}
+
+// aggregatePoints sums the series points of each recording time over their capture group
+// values, and returns them sorted by time in ascending order.
+func aggregatePoints(seriesPoints []store.SeriesPoint) []Point {
+	byTime := make(map[time.Time]float64, len(seriesPoints))
+	for _, p := range seriesPoints {
+		byTime[p.Time.UTC()] += p.Value
+	}
+	points := make([]Point, 0, len(byTime))
+	for t, v := range byTime {
+		points = append(points, Point{Time: t, Value: v})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Time.Before(points[j].Time) })
+	return points
+}
+
+// beyond returns true if value is strictly above or below the threshold.
+func beyond(direction types.AlertDirection, value, threshold float64) bool {
+	if direction == types.AlertBelow {
+		return value < threshold
+	}
+	return value > threshold
+}
+
+// exceeds returns true if the signed magnitude reaches the threshold in the given direction.
+func exceeds(direction types.AlertDirection, magnitude, threshold float64) bool {
+	switch direction {
+	case types.AlertAbove:
+		return magnitude >= threshold
+	case types.AlertBelow:
+		return magnitude <= -threshold
+	default:
+		return math.Abs(magnitude) >= threshold
+	}
+}
+
+func meanAndStddev(points []Point) (mean, stddev float64) {
+	for _, p := range points {
+		mean += p.Value
+	}
+	mean /= float64(len(points))
+	for _, p := range points {
+		stddev += (p.Value - mean) * (p.Value - mean)
+	}
+	return mean, math.Sqrt(stddev / float64(len(points)))
+}
+
+func directionOf(deviations float64) types.AlertDirection {
+	if deviations < 0 {
+		return types.AlertBelow
+	}
+	return types.AlertAbove
+}
+
+func formatDeviations(deviations float64) string {
+	if math.IsInf(deviations, 0) {
+		return "infinitely many"
+	}
+	return fmt.Sprintf("%.1f", math.Abs(deviations))
+}
+
+func formatValue(v float64) string {
+	return fmt.Sprintf("%g", v)
+}
+
+// Only works for simple plurals (eg. interval/intervals)
+func pluralize(word string, count int) string {
+	if count == 1 {
+		return word
+	}
+	return word + "s"
+}
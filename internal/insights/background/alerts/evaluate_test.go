@@ -0,0 +1,173 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func pointsOf(values ...float64) []Point {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	points := make([]Point, len(values))
+	for i, v := range values {
+		points[i] = Point{Time: start.AddDate(0, 0, i), Value: v}
+	}
+	return points
+}
+
+func TestEvaluate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rule        types.InsightSeriesAlertRule
+		points      []Point
+		wantFire    bool
+		wantMessage string
+	}{
+		{
+			name:        "threshold crossed above",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertThreshold, Direction: types.AlertAbove, Threshold: 100},
+			points:      pointsOf(80, 90, 120),
+			wantFire:    true,
+			wantMessage: "The series value 120 is above the threshold of 100.",
+		},
+		{
+			name:   "threshold already exceeded",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertThreshold, Direction: types.AlertAbove, Threshold: 100},
+			points: pointsOf(80, 110, 120),
+		},
+		{
+			name:        "threshold crossed below on first point",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertThreshold, Direction: types.AlertBelow, Threshold: 10},
+			points:      pointsOf(3),
+			wantFire:    true,
+			wantMessage: "The series value 3 is below the threshold of 10.",
+		},
+		{
+			name:        "percent change above",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertPercentChange, Direction: types.AlertAbove, Threshold: 50, Intervals: 2},
+			points:      pointsOf(1, 100, 120, 160),
+			wantFire:    true,
+			wantMessage: "The series value changed by +60.0% over the last 2 intervals, from 100 to 160.",
+		},
+		{
+			name:   "percent change in the wrong direction",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertPercentChange, Direction: types.AlertAbove, Threshold: 50, Intervals: 1},
+			points: pointsOf(100, 20),
+		},
+		{
+			name:        "percent change either direction",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertPercentChange, Direction: types.AlertEither, Threshold: 50, Intervals: 1},
+			points:      pointsOf(100, 20),
+			wantFire:    true,
+			wantMessage: "The series value changed by -80.0% over the last 1 interval, from 100 to 20.",
+		},
+		{
+			name:   "percent change without enough points",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertPercentChange, Direction: types.AlertEither, Threshold: 10, Intervals: 3},
+			points: pointsOf(100, 200, 300),
+		},
+		{
+			name:   "percent change from zero",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertPercentChange, Direction: types.AlertEither, Threshold: 10, Intervals: 1},
+			points: pointsOf(0, 300),
+		},
+		{
+			name:        "anomaly above the rolling baseline",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertAnomaly, Direction: types.AlertEither, Threshold: 3, Intervals: 4},
+			points:      pointsOf(1000, 10, 12, 10, 12, 20),
+			wantFire:    true,
+			wantMessage: "The series value 20 is 9.0 standard deviations above the mean of 11 over the last 4 intervals.",
+		},
+		{
+			name:   "no anomaly within the rolling baseline",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertAnomaly, Direction: types.AlertEither, Threshold: 3, Intervals: 4},
+			points: pointsOf(10, 12, 10, 12, 13),
+		},
+		{
+			name:        "anomaly against a flat baseline",
+			rule:        types.InsightSeriesAlertRule{Kind: types.AlertAnomaly, Direction: types.AlertBelow, Threshold: 2, Intervals: 3},
+			points:      pointsOf(5, 5, 5, 4),
+			wantFire:    true,
+			wantMessage: "The series value 4 is infinitely many standard deviations below the mean of 5 over the last 3 intervals.",
+		},
+		{
+			name:   "anomaly without enough baseline points",
+			rule:   types.InsightSeriesAlertRule{Kind: types.AlertAnomaly, Direction: types.AlertEither, Threshold: 1, Intervals: 3},
+			points: pointsOf(5, 5, 100),
+		},
+		{
+			name: "no points",
+			rule: types.InsightSeriesAlertRule{Kind: types.AlertThreshold, Direction: types.AlertAbove, Threshold: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Evaluate(tc.rule, tc.points)
+			if !tc.wantFire {
+				if result != nil {
+					t.Fatalf("expected rule not to fire, got %+v", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("expected rule to fire")
+			}
+			if result.Point != tc.points[len(tc.points)-1] {
+				t.Errorf("unexpected point: %+v", result.Point)
+			}
+			if result.Message != tc.wantMessage {
+				t.Errorf("unexpected message:\nwant: %s\n got: %s", tc.wantMessage, result.Message)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []types.InsightSeriesAlertRule{
+		{Kind: types.AlertThreshold, Direction: types.AlertBelow, Threshold: 0, Intervals: 1},
+		{Kind: types.AlertPercentChange, Direction: types.AlertEither, Threshold: 25, Intervals: 4},
+		{Kind: types.AlertAnomaly, Direction: types.AlertAbove, Threshold: 3, Intervals: 12},
+	}
+	for _, rule := range valid {
+		if err := Validate(rule); err != nil {
+			t.Errorf("unexpected error for %+v: %s", rule, err)
+		}
+	}
+
+	invalid := []types.InsightSeriesAlertRule{
+		{Kind: types.AlertThreshold, Direction: types.AlertEither, Threshold: 10, Intervals: 1},
+		{Kind: types.AlertPercentChange, Direction: types.AlertAbove, Threshold: 0, Intervals: 1},
+		{Kind: types.AlertAnomaly, Direction: types.AlertAbove, Threshold: 3, Intervals: 2},
+		{Kind: "unknown", Direction: types.AlertAbove, Threshold: 3, Intervals: 1},
+		{Kind: types.AlertThreshold, Direction: "sideways", Threshold: 3, Intervals: 1},
+	}
+	for _, rule := range invalid {
+		if err := Validate(rule); err == nil {
+			t.Errorf("expected error for %+v", rule)
+		}
+	}
+}
+
+func TestAggregatePoints(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 0, 1)
+	got := aggregatePoints([]store.SeriesPoint{
+		{Time: t2, Value: 4, Capture: pointers.Ptr("b")},
+		{Time: t1, Value: 1, Capture: pointers.Ptr("a")},
+		{Time: t2, Value: 3, Capture: pointers.Ptr("a")},
+		{Time: t1, Value: 2, Capture: pointers.Ptr("b")},
+	})
+	want := []Point{{Time: t1, Value: 3}, {Time: t2, Value: 7}}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("point %d: want %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
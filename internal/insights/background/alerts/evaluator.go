@@ -0,0 +1,94 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SeriesPointsStore is the subset of the code insights store that is needed to load the points
+// of a series.
+type SeriesPointsStore interface {
+	SeriesPoints(ctx context.Context, opts store.SeriesPointsOpts) ([]store.SeriesPoint, error)
+}
+
+// Evaluator evaluates the alert rules of an insight series, notifies through the channels of
+// the rules that fire and records them in the alert history of the series.
+type Evaluator struct {
+	alertStore  store.AlertStore
+	seriesStore SeriesPointsStore
+	notifier    notifier
+	insightsURL func() string
+	logger      log.Logger
+}
+
+func NewEvaluator(mainAppDB database.DB, insightsDB database.InsightsDB, logger log.Logger) *Evaluator {
+	return &Evaluator{
+		alertStore:  store.NewAlertStore(insightsDB),
+		seriesStore: store.New(insightsDB, store.NewInsightPermissionStore(mainAppDB)),
+		notifier:    newChannelNotifier(mainAppDB),
+		insightsURL: insightsURL,
+		logger:      logger,
+	}
+}
+
+// EvaluateSeries evaluates the enabled alert rules of the series against its current points. It
+// is called after each snapshot of the series has been recorded.
+func (e *Evaluator) EvaluateSeries(ctx context.Context, series *types.InsightSeries) error {
+	rules, err := e.alertStore.GetAlertRules(ctx, store.AlertRuleQueryArgs{InsightSeriesID: series.ID, EnabledOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "GetAlertRules")
+	}
+
+	var errs error
+	for _, rule := range rules {
+		if err := e.evaluateRule(ctx, series, rule); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "alert rule %d", rule.ID))
+		}
+	}
+	return errs
+}
+
+func (e *Evaluator) evaluateRule(ctx context.Context, series *types.InsightSeries, rule types.InsightSeriesAlertRule) error {
+	if err := Validate(rule); err != nil {
+		return err
+	}
+
+	// 🚨 SECURITY: The points are loaded as the user that created the rule, so that the values that
+	// are sent to its notification channels only include repositories that user can see.
+	seriesPoints, err := e.seriesStore.SeriesPoints(actor.WithActor(ctx, actor.FromUser(rule.CreatedBy)), store.SeriesPointsOpts{
+		SeriesID: &series.SeriesID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "SeriesPoints")
+	}
+
+	result := Evaluate(rule, aggregatePoints(seriesPoints))
+	if result == nil {
+		return nil
+	}
+
+	var deliveryError *string
+	if err := e.notifier.Notify(ctx, alert{Rule: rule, Series: series, Result: result, InsightsURL: e.insightsURL()}); err != nil {
+		e.logger.Warn("failed to deliver insights alert", log.Int("alertRuleID", rule.ID), log.String("seriesID", series.SeriesID), log.Error(err))
+		msg := err.Error()
+		deliveryError = &msg
+	}
+
+	_, err = e.alertStore.RecordAlertEvent(ctx, types.InsightSeriesAlertEvent{
+		AlertRuleID:     rule.ID,
+		InsightSeriesID: series.ID,
+		PointTime:       result.Point.Time,
+		Value:           result.Point.Value,
+		Baseline:        result.Baseline,
+		Message:         result.Message,
+		DeliveryError:   deliveryError,
+	})
+	return errors.Wrap(err, "RecordAlertEvent")
+}
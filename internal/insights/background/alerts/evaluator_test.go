@@ -0,0 +1,128 @@
+package alerts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeAlertStore struct {
+	store.AlertStore
+	rules  []types.InsightSeriesAlertRule
+	events []types.InsightSeriesAlertEvent
+}
+
+func (s *fakeAlertStore) GetAlertRules(_ context.Context, args store.AlertRuleQueryArgs) ([]types.InsightSeriesAlertRule, error) {
+	var rules []types.InsightSeriesAlertRule
+	for _, rule := range s.rules {
+		if rule.InsightSeriesID == args.InsightSeriesID && (rule.Enabled || !args.EnabledOnly) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (s *fakeAlertStore) RecordAlertEvent(_ context.Context, event types.InsightSeriesAlertEvent) (types.InsightSeriesAlertEvent, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+type fakeSeriesStore struct {
+	points []store.SeriesPoint
+	actors []int32
+}
+
+func (s *fakeSeriesStore) SeriesPoints(ctx context.Context, _ store.SeriesPointsOpts) ([]store.SeriesPoint, error) {
+	s.actors = append(s.actors, actor.FromContext(ctx).UID)
+	return s.points, nil
+}
+
+type fakeNotifier struct {
+	alerts []alert
+	err    error
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, a alert) error {
+	n.alerts = append(n.alerts, a)
+	return n.err
+}
+
+func TestEvaluator(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	series := &types.InsightSeries{ID: 1, SeriesID: "series-1", Query: "TODO"}
+	seriesStore := &fakeSeriesStore{points: []store.SeriesPoint{
+		{SeriesID: "series-1", Time: start, Value: 90},
+		{SeriesID: "series-1", Time: start.AddDate(0, 0, 1), Value: 130},
+	}}
+
+	newEvaluator := func(alertStore *fakeAlertStore, notifier *fakeNotifier) *Evaluator {
+		return &Evaluator{
+			alertStore:  alertStore,
+			seriesStore: seriesStore,
+			notifier:    notifier,
+			insightsURL: func() string { return "https://sourcegraph.test/insights/dashboards/all" },
+			logger:      logtest.Scoped(t),
+		}
+	}
+
+	t.Run("fired rules are delivered and recorded", func(t *testing.T) {
+		alertStore := &fakeAlertStore{rules: []types.InsightSeriesAlertRule{
+			{ID: 1, InsightSeriesID: 1, Kind: types.AlertThreshold, Direction: types.AlertAbove, Threshold: 100, Intervals: 1, CreatedBy: 7, Enabled: true},
+			{ID: 2, InsightSeriesID: 1, Kind: types.AlertThreshold, Direction: types.AlertBelow, Threshold: 100, Intervals: 1, CreatedBy: 7, Enabled: true},
+			{ID: 3, InsightSeriesID: 1, Kind: types.AlertThreshold, Direction: types.AlertAbove, Threshold: 10, Intervals: 1, CreatedBy: 7, Enabled: false},
+		}}
+		notifier := &fakeNotifier{}
+
+		if err := newEvaluator(alertStore, notifier).EvaluateSeries(context.Background(), series); err != nil {
+			t.Fatal(err)
+		}
+		if len(notifier.alerts) != 1 || notifier.alerts[0].Rule.ID != 1 {
+			t.Fatalf("unexpected alerts: %+v", notifier.alerts)
+		}
+		if len(alertStore.events) != 1 {
+			t.Fatalf("unexpected events: %+v", alertStore.events)
+		}
+		event := alertStore.events[0]
+		if event.AlertRuleID != 1 || event.Value != 130 || event.Baseline != 100 || event.DeliveryError != nil {
+			t.Errorf("unexpected event: %+v", event)
+		}
+		for _, uid := range seriesStore.actors {
+			if uid != 7 {
+				t.Errorf("expected points to be loaded as the rule creator, got actor %d", uid)
+			}
+		}
+	})
+
+	t.Run("delivery errors are recorded in the history", func(t *testing.T) {
+		alertStore := &fakeAlertStore{rules: []types.InsightSeriesAlertRule{
+			{ID: 1, InsightSeriesID: 1, Kind: types.AlertPercentChange, Direction: types.AlertAbove, Threshold: 20, Intervals: 1, CreatedBy: 7, Enabled: true},
+		}}
+		notifier := &fakeNotifier{err: errors.New("webhook: 500 Internal Server Error")}
+
+		if err := newEvaluator(alertStore, notifier).EvaluateSeries(context.Background(), series); err != nil {
+			t.Fatal(err)
+		}
+		if len(alertStore.events) != 1 {
+			t.Fatalf("unexpected events: %+v", alertStore.events)
+		}
+		if got := alertStore.events[0].DeliveryError; got == nil || *got != "webhook: 500 Internal Server Error" {
+			t.Errorf("unexpected delivery error: %v", got)
+		}
+	})
+
+	t.Run("invalid rules are reported", func(t *testing.T) {
+		alertStore := &fakeAlertStore{rules: []types.InsightSeriesAlertRule{
+			{ID: 1, InsightSeriesID: 1, Kind: types.AlertAnomaly, Direction: types.AlertAbove, Threshold: 3, Intervals: 1, CreatedBy: 7, Enabled: true},
+		}}
+		if err := newEvaluator(alertStore, &fakeNotifier{}).EvaluateSeries(context.Background(), series); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
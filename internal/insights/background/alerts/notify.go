@@ -0,0 +1,171 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	cmbackground "github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// alertSource identifies alert notifications in the email metrics and in the UTM source of links.
+const alertSource = "code-insights-alert"
+
+// alert is everything the notification channels need to know about a fired rule.
+type alert struct {
+	Rule   types.InsightSeriesAlertRule
+	Series *types.InsightSeries
+	Result *Result
+	// InsightsURL links to the insights of the instance.
+	InsightsURL string
+}
+
+// notifier delivers fired alerts through the channels configured on their rule.
+type notifier interface {
+	Notify(ctx context.Context, a alert) error
+}
+
+// channelNotifier delivers alerts through the same email, Slack and webhook channels that code
+// monitors use.
+type channelNotifier struct {
+	db   database.DB
+	doer httpcli.Doer
+}
+
+func newChannelNotifier(db database.DB) *channelNotifier {
+	return &channelNotifier{db: db, doer: httpcli.ExternalDoer}
+}
+
+func (n *channelNotifier) Notify(ctx context.Context, a alert) error {
+	var errs error
+	if a.Rule.Email {
+		if err := cmbackground.SendEmail(ctx, n.db, alertSource, a.Rule.CreatedBy, alertEmailTemplates, newEmailData(a)); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "email"))
+		}
+	}
+	if a.Rule.SlackWebhookURL != nil {
+		if err := cmbackground.PostSlackWebhook(ctx, n.doer, *a.Rule.SlackWebhookURL, slackPayload(a)); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "Slack webhook"))
+		}
+	}
+	if a.Rule.WebhookURL != nil {
+		if err := cmbackground.PostWebhook(ctx, n.doer, *a.Rule.WebhookURL, generateWebhookPayload(a)); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "webhook"))
+		}
+	}
+	return errs
+}
+
+var alertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Sourcegraph code insights alert: {{.Message}}`,
+	Text: `
+The code insights series with the query {{.Query}} triggered a {{.Kind}} alert.
+
+{{.Message}}
+
+View your code insights: {{.InsightsURL}}
+
+__
+You are receiving this notification because you created an alert rule on a code insights series.
+`,
+	HTML: `
+<p>The code insights series with the query <code>{{.Query}}</code> triggered a {{.Kind}} alert.</p>
+
+<p>{{.Message}}</p>
+
+<p><a href="{{.InsightsURL}}">View your code insights</a></p>
+
+<p>You are receiving this notification because you created an alert rule on a code insights series.</p>
+`,
+})
+
+type emailData struct {
+	Query       string
+	Kind        string
+	Message     string
+	InsightsURL string
+}
+
+func newEmailData(a alert) emailData {
+	return emailData{
+		Query:       a.Series.Query,
+		Kind:        kindTitle(a.Rule.Kind),
+		Message:     a.Result.Message,
+		InsightsURL: a.InsightsURL,
+	}
+}
+
+func slackPayload(a alert) *slack.WebhookMessage {
+	newMarkdownSection := func(s string) slack.Block {
+		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
+	}
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: []slack.Block{
+		newMarkdownSection(fmt.Sprintf("The Sourcegraph code insights series `%s` triggered a *%s* alert.", a.Series.Query, kindTitle(a.Rule.Kind))),
+		newMarkdownSection(a.Result.Message),
+		newMarkdownSection(fmt.Sprintf("<%s|View your code insights>", a.InsightsURL)),
+	}}}
+}
+
+type webhookPayload struct {
+	SeriesID    string    `json:"seriesID"`
+	Query       string    `json:"query"`
+	Kind        string    `json:"kind"`
+	Direction   string    `json:"direction"`
+	Threshold   float64   `json:"threshold"`
+	Intervals   int       `json:"intervals,omitempty"`
+	PointTime   time.Time `json:"pointTime"`
+	Value       float64   `json:"value"`
+	Baseline    float64   `json:"baseline"`
+	Message     string    `json:"message"`
+	InsightsURL string    `json:"insightsURL"`
+}
+
+func generateWebhookPayload(a alert) webhookPayload {
+	p := webhookPayload{
+		SeriesID:    a.Series.SeriesID,
+		Query:       a.Series.Query,
+		Kind:        string(a.Rule.Kind),
+		Direction:   string(a.Rule.Direction),
+		Threshold:   a.Rule.Threshold,
+		PointTime:   a.Result.Point.Time,
+		Value:       a.Result.Point.Value,
+		Baseline:    a.Result.Baseline,
+		Message:     a.Result.Message,
+		InsightsURL: a.InsightsURL,
+	}
+	if a.Rule.Kind != types.AlertThreshold {
+		p.Intervals = a.Rule.Intervals
+	}
+	return p
+}
+
+func kindTitle(kind types.AlertKind) string {
+	switch kind {
+	case types.AlertPercentChange:
+		return "percent change"
+	case types.AlertAnomaly:
+		return "anomaly"
+	default:
+		return "threshold"
+	}
+}
+
+func insightsURL() string {
+	externalURL, err := url.Parse(conf.ExternalURL())
+	if err != nil {
+		return ""
+	}
+	u := externalURL.ResolveReference(&url.URL{Path: "insights/dashboards/all"})
+	u.RawQuery = url.Values{"utm_source": []string{alertSource}}.Encode()
+	return u.String()
+}
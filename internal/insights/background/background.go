@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	internalGitserver "github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/alerts"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/limiter"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/pings"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
@@ -134,11 +135,13 @@ func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainApp
 
 	workerStore := queryrunner.CreateDBWorkerStore(observationCtx, workerBaseStore)
 	seachQueryLimiter := limiter.SearchQueryRate()
+	// Alert rules are evaluated by the query runner after each snapshot it records.
+	alertEvaluator := alerts.NewEvaluator(mainAppDB, insightsDB, logger.Scoped("alerts.Evaluator", "evaluates insight series alert rules"))
 
 	return []goroutine.BackgroundRoutine{
 		// Register the query-runner worker and resetter, which executes search queries and records
 		// results to the insights DB.
-		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker", ""), workerStore, insightsStore, repoStore, queryRunnerWorkerMetrics, seachQueryLimiter),
+		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker", ""), workerStore, insightsStore, repoStore, queryRunnerWorkerMetrics, seachQueryLimiter, alertEvaluator),
 		queryrunner.NewResetter(ctx, logger.Scoped("queryrunner.Resetter", ""), workerStore, queryRunnerResetterMetrics),
 		queryrunner.NewCleaner(ctx, observationCtx, workerBaseStore),
 	}
@@ -145,6 +145,13 @@ func (ie *InsightEnqueuer) EnqueueSingle(
 	var err error
 
 	basicQuery := querybuilder.BasicQuery(series.Query)
+	if series.GenerationMethod == types.DependencyVersions {
+		dependencyQuery, err := querybuilder.ParseDependencyQuery(series.Query)
+		if err != nil {
+			return errors.Wrapf(err, "ParseDependencyQuery series_id:%s", seriesID)
+		}
+		basicQuery = dependencyQuery.SearchQuery()
+	}
 	var modifiedQuery querybuilder.BasicQuery
 	var finalQuery string
 
@@ -121,6 +121,7 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
     "RecordTime": null,
     "PersistMode": "record",
     "DependentFrames": null,
+    "Revision": "",
     "Cost": 500,
     "Priority": 10,
     "ID": 0,
@@ -139,6 +140,7 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
     "RecordTime": null,
     "PersistMode": "record",
     "DependentFrames": null,
+    "Revision": "",
     "Cost": 500,
     "Priority": 10,
     "ID": 0,
@@ -157,6 +159,7 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
     "RecordTime": null,
     "PersistMode": "snapshot",
     "DependentFrames": null,
+    "Revision": "",
     "Cost": 500,
     "Priority": 10,
     "ID": 0,
@@ -175,6 +178,7 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
     "RecordTime": null,
     "PersistMode": "snapshot",
     "DependentFrames": null,
+    "Revision": "",
     "Cost": 500,
     "Priority": 10,
     "ID": 0,
@@ -189,3 +193,36 @@ func Test_discoverAndEnqueueInsights(t *testing.T) {
   }
 ]`).Equal(t, string(enqueuedJSON))
 }
+
+func TestEnqueueDependencyVersionsSeries(t *testing.T) {
+	ctx := context.Background()
+	var enqueued []*queryrunner.Job
+	ie := NewInsightEnqueuer(time.Now, basestore.NewWithHandle(dbmocks.NewMockDB().Handle()), logtest.Scoped(t))
+	ie.enqueueQueryRunnerJob = func(ctx context.Context, job *queryrunner.Job) error {
+		enqueued = append(enqueued, job)
+		return nil
+	}
+	stamp := func(ctx context.Context, series types.InsightSeries) (types.InsightSeries, error) {
+		return series, nil
+	}
+
+	series := types.InsightSeries{
+		ID:               1,
+		SeriesID:         "series1",
+		Query:            "go:github.com/sourcegraph/log",
+		Repositories:     []string{"github.com/sourcegraph/sourcegraph"},
+		GenerationMethod: types.DependencyVersions,
+	}
+	if err := ie.EnqueueSingle(ctx, series, store.RecordMode, stamp); err != nil {
+		t.Fatal(err)
+	}
+	if len(enqueued) != 1 {
+		t.Fatalf("expected 1 job to be enqueued, got %d", len(enqueued))
+	}
+	autogold.Expect("fork:yes archived:yes patterntype:literal file:(^|/)(go\\.mod)$ select:repo count:99999999 github.com/sourcegraph/log repo:^(github\\.com/sourcegraph/sourcegraph)$").Equal(t, enqueued[0].SearchQuery)
+
+	series.Query = "github.com/sourcegraph/log"
+	if err := ie.EnqueueSingle(ctx, series, store.RecordMode, stamp); err == nil {
+		t.Error("expected an error for a dependency query without a package scheme")
+	}
+}
@@ -10,8 +10,12 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/lockfiles"
+	dependenciesshared "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/insights/discovery"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
@@ -57,9 +61,10 @@ func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
 	}
 
 	return map[types.GenerationMethod]InsightsHandler{
-		types.MappingCompute: makeMappingComputeHandler(computeTextExtraSearch),
-		types.SearchCompute:  makeComputeHandler(computeSearchStream),
-		types.Search:         makeSearchHandler(searchStream),
+		types.MappingCompute:     makeMappingComputeHandler(computeTextExtraSearch),
+		types.SearchCompute:      makeComputeHandler(computeSearchStream),
+		types.Search:             makeSearchHandler(searchStream),
+		types.DependencyVersions: makeDependencyVersionsHandler(searchStream, listDependencies),
 	}
 
 }
@@ -89,6 +94,22 @@ func toRecording(record *SearchJob, value float64, recordTime time.Time, repoNam
 
 type streamComputeProvider func(context.Context, string) (*streaming.ComputeTabulationResult, error)
 type streamSearchProvider func(context.Context, string) (*streaming.TabulationResult, error)
+type dependencyProvider func(ctx context.Context, repo api.RepoName, revision string, scheme string) ([]dependenciesshared.MinimialVersionedPackageRepo, error)
+
+// listDependencies returns the package references in the lockfiles of a repository at a
+// revision, or at the head of the default branch if no revision is given.
+func listDependencies(ctx context.Context, repo api.RepoName, revision string, scheme string) ([]dependenciesshared.MinimialVersionedPackageRepo, error) {
+	client := gitserver.NewClient()
+	commit := api.CommitID(revision)
+	if revision == "" {
+		var err error
+		commit, err = client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "ResolveRevision")
+		}
+	}
+	return lockfiles.ListDependencies(ctx, client, repo, commit, scheme)
+}
 
 func generateComputeRecordingsStream(ctx context.Context, job *SearchJob, recordTime time.Time, provider streamComputeProvider, logger log.Logger) (_ []store.RecordSeriesPointArgs, err error) {
 	streamResults, err := provider(ctx, job.SearchQuery)
@@ -172,6 +193,68 @@ func generateSearchRecordingsStream(ctx context.Context, job *SearchJob, recordT
 	return recordings, nil
 }
 
+// generateDependencyRecordingsStream records, for each repository that references the package of
+// the series in a lockfile, one point per referenced version with the version as capture.
+func generateDependencyRecordingsStream(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time, searchProvider streamSearchProvider, dependencyProvider dependencyProvider, logger log.Logger) ([]store.RecordSeriesPointArgs, error) {
+	dependencyQuery, err := querybuilder.ParseDependencyQuery(series.Query)
+	if err != nil {
+		return nil, errors.Wrap(err, "ParseDependencyQuery")
+	}
+
+	// The search only narrows down the repositories whose lockfiles mention the package name,
+	// the versions are read from the lockfiles themselves.
+	tabulationResult, err := searchProvider(ctx, job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+	tr := *tabulationResult
+	if len(tr.Errors) > 0 {
+		return nil, classifiedError(tr.Errors, types.DependencyVersions)
+	}
+	if tr.DidTimeout {
+		return nil, SearchTimeoutError
+	}
+	if len(tr.Alerts) > 0 {
+		return nil, errors.Errorf("streaming search: alerts: %v", tr.Alerts)
+	}
+
+	checker := authz.DefaultSubRepoPermsChecker
+	var recordings []store.RecordSeriesPointArgs
+
+	for _, match := range tr.RepoCounts {
+		repoID := api.RepoID(match.RepositoryID)
+		subRepoEnabled, subRepoErr := authz.SubRepoEnabledForRepoID(ctx, checker, repoID)
+		if subRepoErr != nil {
+			logger.Error("sub-repo permissions check errored", log.String("seriesID", job.SeriesID), log.String("repo", match.RepositoryName), log.Error(subRepoErr))
+			continue
+		}
+		if subRepoEnabled {
+			continue
+		}
+
+		deps, err := dependencyProvider(ctx, api.RepoName(match.RepositoryName), job.Revision, dependencyQuery.Scheme)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing dependencies of %s", match.RepositoryName)
+		}
+
+		// A repository is counted once per version, no matter how many of its lockfiles reference it.
+		versions := map[string]struct{}{}
+		for _, dep := range deps {
+			if string(dep.Name) != dependencyQuery.Name {
+				continue
+			}
+			if _, ok := versions[dep.Version]; ok {
+				continue
+			}
+			versions[dep.Version] = struct{}{}
+			version := dep.Version
+			recordings = append(recordings, toRecording(job, 1, recordTime, match.RepositoryName, repoID, &version)...)
+		}
+	}
+
+	return recordings, nil
+}
+
 func makeSearchHandler(provider streamSearchProvider) InsightsHandler {
 	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
 		recordings, err := generateSearchRecordingsStream(ctx, job, recordTime, provider, log.Scoped("SearchRecordingsGenerator", ""))
@@ -205,6 +288,16 @@ func makeMappingComputeHandler(provider streamComputeProvider) InsightsHandler {
 	}
 }
 
+func makeDependencyVersionsHandler(searchProvider streamSearchProvider, dependencyProvider dependencyProvider) InsightsHandler {
+	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
+		recordings, err := generateDependencyRecordingsStream(ctx, job, series, recordTime, searchProvider, dependencyProvider, log.Scoped("DependencyVersionsRecordingsGenerator", ""))
+		if err != nil {
+			return nil, errors.Wrapf(err, "dependencyVersionsHandler")
+		}
+		return recordings, nil
+	}
+}
+
 func (r *workHandler) persistRecordings(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordings []store.RecordSeriesPointArgs, recordTime time.Time) (err error) {
 	tx, err := r.insightsStore.Transact(ctx)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	dependenciesshared "github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/store"
@@ -792,6 +793,103 @@ func TestFilterRecordsingsByRepo(t *testing.T) {
 	}
 }
 
+func TestGenerateDependencyRecordingsStream(t *testing.T) {
+	date := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	series := &types.InsightSeries{SeriesID: "testseries1", Query: "npm:react", GenerationMethod: types.DependencyVersions}
+
+	searched := func(context.Context, string) (*streaming.TabulationResult, error) {
+		return &streaming.TabulationResult{
+			RepoCounts: map[string]*streaming.SearchMatch{
+				"github.com/sourcegraph/sourcegraph": {RepositoryID: 11, RepositoryName: "github.com/sourcegraph/sourcegraph", MatchCount: 1},
+				"github.com/sourcegraph/about":       {RepositoryID: 12, RepositoryName: "github.com/sourcegraph/about", MatchCount: 1},
+			},
+			TotalCount: 2,
+		}, nil
+	}
+	var revisions []string
+	listed := func(ctx context.Context, repo api.RepoName, revision string, scheme string) ([]dependenciesshared.MinimialVersionedPackageRepo, error) {
+		revisions = append(revisions, fmt.Sprintf("%s@%s %s", repo, revision, scheme))
+		if repo == "github.com/sourcegraph/about" {
+			return []dependenciesshared.MinimialVersionedPackageRepo{
+				{Scheme: "npm", Name: "react", Version: "17.0.2"},
+			}, nil
+		}
+		return []dependenciesshared.MinimialVersionedPackageRepo{
+			{Scheme: "npm", Name: "react", Version: "18.2.0"},
+			{Scheme: "npm", Name: "react", Version: "17.0.2"},
+			// A second lockfile referencing the same version does not count twice.
+			{Scheme: "npm", Name: "react", Version: "18.2.0"},
+			{Scheme: "npm", Name: "react-dom", Version: "18.2.0"},
+		}, nil
+	}
+
+	t.Run("dependency versions job", func(t *testing.T) {
+		revisions = nil
+		job := SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: "searchit",
+			RecordTime:  &date,
+			PersistMode: "record",
+			Revision:    "abc",
+		}
+
+		recordings, err := generateDependencyRecordingsStream(context.Background(), &job, series, date, searched, listed, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{
+			"github.com/sourcegraph/about 12 2021-12-01 00:00:00 +0000 UTC 17.0.2 1.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC 17.0.2 1.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC 18.2.0 1.000000",
+		}).Equal(t, stringify(recordings))
+
+		sort.Strings(revisions)
+		autogold.Expect([]string{
+			"github.com/sourcegraph/about@abc npm",
+			"github.com/sourcegraph/sourcegraph@abc npm",
+		}).Equal(t, revisions)
+	})
+
+	t.Run("dependency versions job with dependent frames", func(t *testing.T) {
+		dependentDate := date.AddDate(0, 0, -7)
+		job := SearchJob{
+			SeriesID:        "testseries1",
+			SearchQuery:     "searchit",
+			RecordTime:      &date,
+			PersistMode:     "record",
+			DependentFrames: []time.Time{dependentDate},
+		}
+
+		recordings, err := generateDependencyRecordingsStream(context.Background(), &job, series, date, searched, listed, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(recordings) != 6 {
+			t.Errorf("expected 6 recordings for 3 versions in 2 frames, got %d", len(recordings))
+		}
+	})
+
+	t.Run("invalid dependency query", func(t *testing.T) {
+		job := SearchJob{SeriesID: "testseries1", SearchQuery: "searchit", RecordTime: &date, PersistMode: "record"}
+		invalid := &types.InsightSeries{SeriesID: "testseries1", Query: "react", GenerationMethod: types.DependencyVersions}
+
+		if _, err := generateDependencyRecordingsStream(context.Background(), &job, invalid, date, searched, listed, logtest.Scoped(t)); err == nil {
+			t.Error("expected an error for a query without a package scheme")
+		}
+	})
+
+	t.Run("dependency listing error", func(t *testing.T) {
+		job := SearchJob{SeriesID: "testseries1", SearchQuery: "searchit", RecordTime: &date, PersistMode: "record"}
+		failing := func(context.Context, api.RepoName, string, string) ([]dependenciesshared.MinimialVersionedPackageRepo, error) {
+			return nil, errors.New("gitserver unavailable")
+		}
+
+		if _, err := generateDependencyRecordingsStream(context.Background(), &job, series, date, searched, failing, logtest.Scoped(t)); err == nil {
+			t.Error("expected an error when the lockfiles cannot be read")
+		}
+	})
+}
+
 // stringify will turn the results of the recording worker into a slice of strings to easily compare golden test files against using autogold
 func stringify(recordings []store.RecordSeriesPointArgs) []string {
 	stringified := make([]string, 0, len(recordings))
@@ -36,6 +36,13 @@ type workHandler struct {
 	seriesCache map[string]*types.InsightSeries
 
 	searchHandlers map[types.GenerationMethod]InsightsHandler
+
+	alertEvaluator AlertEvaluator
+}
+
+// AlertEvaluator evaluates the alert rules of a series after a snapshot of it was recorded.
+type AlertEvaluator interface {
+	EvaluateSeries(ctx context.Context, series *types.InsightSeries) error
 }
 
 type InsightsHandler func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error)
@@ -142,7 +149,17 @@ func (r *workHandler) Handle(ctx context.Context, logger log.Logger, record *Job
 		return err
 	}
 
-	return r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime)
+	if err := r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime); err != nil {
+		return err
+	}
+
+	if r.alertEvaluator != nil && job.PersistMode == string(store.SnapshotMode) {
+		// Alerts are best effort: failing the job would only re-run the search.
+		if err := r.alertEvaluator.EvaluateSeries(ctx, series); err != nil {
+			logger.Error("failed to evaluate insights alert rules", log.String("seriesID", series.SeriesID), log.Error(err))
+		}
+	}
+	return nil
 }
 
 func TranslateIncompleteReasons(err error) store.IncompleteReason {
@@ -39,7 +39,7 @@ import (
 
 // NewWorker returns a worker that will execute search queries and insert information about the
 // results into the code insights database.
-func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter) *workerutil.Worker[*Job] {
+func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter, alertEvaluator AlertEvaluator) *workerutil.Worker[*Job] {
 	numHandlers := conf.Get().InsightsQueryWorkerConcurrency
 	if numHandlers <= 0 {
 		// Default concurrency is set to 5.
@@ -77,6 +77,7 @@ func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreE
 		metadadataStore: store.NewInsightStoreWith(insightsStore),
 		seriesCache:     sharedCache,
 		searchHandlers:  GetSearchHandlers(),
+		alertEvaluator:  alertEvaluator,
 		logger:          log.Scoped("insights.queryRunner.Handler", ""),
 	}, options)
 }
@@ -436,6 +437,9 @@ type SearchJob struct {
 	RecordTime      *time.Time
 	PersistMode     string
 	DependentFrames []time.Time
+	// Revision is the commit a backfill job samples. It is not persisted, jobs dequeued
+	// by the worker always sample the default branch.
+	Revision string
 }
 
 type Job struct {
@@ -220,6 +220,15 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 	return func(ctx context.Context, bctx *buildSeriesContext) (err error, job *queryrunner.SearchJob, preempted []store.RecordSeriesPointArgs) {
 		logger.Debug("making search job")
 		rawQuery := bctx.series.Query
+		if bctx.series.GenerationMethod == types.DependencyVersions {
+			// Dependency versions series store the package rather than a search query. The
+			// search for its lockfiles selects the repositories to read lockfiles from.
+			dependencyQuery, parseErr := querybuilder.ParseDependencyQuery(rawQuery)
+			if parseErr != nil {
+				return errors.Wrap(parseErr, "ParseDependencyQuery"), nil, nil
+			}
+			rawQuery = dependencyQuery.SearchQuery().String()
+		}
 		containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
 		if err != nil {
 			return err, nil, nil
@@ -285,6 +294,7 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 			RecordTime:      &bctx.execution.RecordingTime,
 			PersistMode:     string(store.RecordMode),
 			DependentFrames: bctx.execution.SharedRecordings,
+			Revision:        revision,
 		}
 		return err, job, preempted
 	}
@@ -189,6 +189,20 @@ func TestMakeSearchJobs(t *testing.T) {
 		Repo:        &itypes.MinimalRepo{ID: api.RepoID(1), Name: api.RepoName("testrepo")},
 	}
 
+	backfillReqDependencyQuery := &BackfillRequest{
+		Series: &types.InsightSeries{
+			ID:                  1,
+			SeriesID:            "abc",
+			Query:               "npm:react",
+			CreatedAt:           createdDate,
+			SampleIntervalUnit:  string(types.Week),
+			SampleIntervalValue: 1,
+			GenerationMethod:    types.DependencyVersions,
+		},
+		SampleTimes: sampleTimes,
+		Repo:        &itypes.MinimalRepo{ID: api.RepoID(1), Name: api.RepoName("testrepo")},
+	}
+
 	basicCommitClient := newFakeCommitClient(&firstCommit, recentCommits)
 	// used to simulate a single call to recent commits failing
 	recentsErrorAfter := func(times int, commits []*gitdomain.Commit) func(ctx context.Context, repoName api.RepoName, target time.Time, revision string) ([]*gitdomain.Commit, error) {
@@ -307,6 +321,22 @@ func TestMakeSearchJobs(t *testing.T) {
 		{
 			name:         "Query with repo: in it",
 			commitClient: basicCommitClient, backfillReq: backfillReqRepoQuery, workers: 1, want: autogold.Expect([]string{"error occurred: false"})},
+		{
+			name:         "Dependency versions series",
+			commitClient: newFakeCommitClient(&recentFirstCommit, recentCommits), backfillReq: backfillReqDependencyQuery, workers: 1, want: autogold.Expect([]string{
+				"job recordtime:2022-04-01T01:00:00Z query:fork:no archived:no patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 react repo:^testrepo$@1",
+				"job recordtime:2022-03-25T01:00:00Z query:fork:no archived:no patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 react repo:^testrepo$@1",
+				"job recordtime:2022-03-18T01:00:00Z query:fork:no archived:no patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 react repo:^testrepo$@1",
+				"job recordtime:2022-03-11T01:00:00Z query:fork:no archived:no patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 react repo:^testrepo$@1",
+				"error occurred: false",
+			})},
+		{
+			name:         "Invalid dependency query",
+			commitClient: basicCommitClient, backfillReq: &BackfillRequest{
+				Series:      &types.InsightSeries{SeriesID: "abc", Query: "react", GenerationMethod: types.DependencyVersions},
+				SampleTimes: sampleTimes,
+				Repo:        &itypes.MinimalRepo{ID: api.RepoID(1), Name: api.RepoName("testrepo")},
+			}, workers: 1, want: autogold.Expect([]string{"error occurred: true"})},
 	}
 
 	for _, tc := range testCases {
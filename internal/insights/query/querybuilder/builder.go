@@ -359,13 +359,39 @@ func IsSingleRepoQuery(query BasicQuery) (bool, error) {
 }
 
 func AddAuthorFilter(query BasicQuery, author string) (BasicQuery, error) {
+	return addCommitDiffFilters(query, searchquery.Parameter{
+		Field:      searchquery.FieldAuthor,
+		Value:      buildFilterText(author),
+		Negated:    false,
+		Annotation: searchquery.Annotation{},
+	})
+}
+
+// AddDateBucketFilter limits a commit or diff search to the month of the given date bucket,
+// formatted with types.DATE_BUCKET_LAYOUT. Note that the after: and before: filters compare the
+// committer date of commits, which only differs from their author date for commits that were
+// rebased or cherry-picked.
+func AddDateBucketFilter(query BasicQuery, bucket string) (BasicQuery, error) {
+	start, err := time.Parse(types.DATE_BUCKET_LAYOUT, bucket)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid date bucket")
+	}
+	const dateLayout = "2006-01-02"
+	return addCommitDiffFilters(query,
+		searchquery.Parameter{Field: searchquery.FieldAfter, Value: start.Format(dateLayout)},
+		searchquery.Parameter{Field: searchquery.FieldBefore, Value: start.AddDate(0, 1, 0).Format(dateLayout)},
+	)
+}
+
+// addCommitDiffFilters adds the given parameters to the commit and diff searches of the query.
+func addCommitDiffFilters(query BasicQuery, parameters ...searchquery.Parameter) (BasicQuery, error) {
 	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
 	if err != nil {
 		return "", err
 	}
 
 	mutatedQuery := searchquery.MapPlan(plan, func(basic searchquery.Basic) searchquery.Basic {
-		modified := make([]searchquery.Parameter, 0, len(basic.Parameters)+1)
+		modified := make([]searchquery.Parameter, 0, len(basic.Parameters)+len(parameters))
 		isCommitDiffType := false
 		for _, parameter := range basic.Parameters {
 			modified = append(modified, parameter)
@@ -374,15 +400,10 @@ func AddAuthorFilter(query BasicQuery, author string) (BasicQuery, error) {
 			}
 		}
 		if !isCommitDiffType {
-			// we can't modify this plan to accept an author so return the original input
+			// we can't modify this plan to accept commit filters so return the original input
 			return basic
 		}
-		modified = append(modified, searchquery.Parameter{
-			Field:      searchquery.FieldAuthor,
-			Value:      buildFilterText(author),
-			Negated:    false,
-			Annotation: searchquery.Annotation{},
-		})
+		modified = append(modified, parameters...)
 		return basic.MapParameters(modified)
 	})
 
@@ -397,6 +418,22 @@ func AddFileFilter(query BasicQuery, file string) (BasicQuery, error) {
 	return addFilterSimple(query, searchquery.FieldFile, file)
 }
 
+// AddLanguageFilter adds a lang: filter for a language name as detected by the inventory package.
+func AddLanguageFilter(query BasicQuery, language string) (BasicQuery, error) {
+	// lang: filters match language aliases, in which spaces are replaced with dashes.
+	return addFilter(query, searchquery.FieldLang, strings.ReplaceAll(language, " ", "-"), false)
+}
+
+// AddFileExtensionFilter adds a file: filter for files with the given extension, such as ".go".
+func AddFileExtensionFilter(query BasicQuery, extension string) (BasicQuery, error) {
+	return addFilter(query, searchquery.FieldFile, regexp.QuoteMeta(extension)+"$", false)
+}
+
+// AddOwnerFilter adds a file:has.owner() filter for the given owner handle or email.
+func AddOwnerFilter(query BasicQuery, owner string) (BasicQuery, error) {
+	return addFilter(query, searchquery.FieldFile, fmt.Sprintf("has.owner(%s)", owner), false)
+}
+
 func AddRepoMetadataFilter(query BasicQuery, repoMeta string) (BasicQuery, error) {
 	if repoMeta == types.NO_REPO_METADATA_TEXT {
 		return query, errors.New("Can't search for no metadata key")
@@ -437,6 +474,11 @@ func buildFilterText(raw string) string {
 }
 
 func AddFilter(query BasicQuery, field, value string, negated bool) (BasicQuery, error) {
+	return addFilter(query, field, buildFilterText(value), negated)
+}
+
+// addFilter adds a filter with the given value to the query, without escaping it.
+func addFilter(query BasicQuery, field, value string, negated bool) (BasicQuery, error) {
 	plan, err := searchquery.Pipeline(searchquery.Init(string(query), searchquery.SearchTypeLiteral))
 	if err != nil {
 		return "", err
@@ -447,7 +489,7 @@ func AddFilter(query BasicQuery, field, value string, negated bool) (BasicQuery,
 		modified = append(modified, basic.Parameters...)
 		modified = append(modified, searchquery.Parameter{
 			Field:      field,
-			Value:      buildFilterText(value),
+			Value:      value,
 			Negated:    negated,
 			Annotation: searchquery.Annotation{},
 		})
@@ -891,3 +891,91 @@ func TestPointDiffQuery(t *testing.T) {
 		})
 	}
 }
+
+func Test_addDateBucketFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		bucket string
+		want   autogold.Value
+	}{
+		{
+			name:   "commit search",
+			input:  "insights type:commit",
+			bucket: "2023-05",
+			want:   autogold.Expect(BasicQuery("type:commit after:2023-05-01 before:2023-06-01 insights")),
+		},
+		{
+			name:   "bucket at the end of the year",
+			input:  "insights type:diff",
+			bucket: "2022-12",
+			want:   autogold.Expect(BasicQuery("type:diff after:2022-12-01 before:2023-01-01 insights")),
+		},
+		{
+			name:   "invalid adding to repo search - should return input",
+			input:  "myquery repo:myrepo type:repo",
+			bucket: "2023-05",
+			want:   autogold.Expect(BasicQuery("repo:myrepo type:repo myquery")),
+		},
+		{
+			name:   "invalid bucket",
+			input:  "insights type:commit",
+			bucket: "May 2023",
+			want:   autogold.Expect(`invalid date bucket: parsing time "May 2023" as "2006-01": cannot parse "May 2023" as "2006"`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := AddDateBucketFilter(BasicQuery(test.input), test.bucket)
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
+
+func Test_addLanguageFileExtensionAndOwnerFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		modify func(BasicQuery) (BasicQuery, error)
+		want   autogold.Value
+	}{
+		{
+			name:   "language",
+			input:  "myquery repo:supergreat",
+			modify: func(q BasicQuery) (BasicQuery, error) { return AddLanguageFilter(q, "Go") },
+			want:   autogold.Expect(BasicQuery("repo:supergreat lang:Go myquery")),
+		},
+		{
+			name:   "language with whitespace in name",
+			input:  "myquery",
+			modify: func(q BasicQuery) (BasicQuery, error) { return AddLanguageFilter(q, "Protocol Buffer") },
+			want:   autogold.Expect(BasicQuery("lang:Protocol-Buffer myquery")),
+		},
+		{
+			name:   "file extension",
+			input:  "myquery",
+			modify: func(q BasicQuery) (BasicQuery, error) { return AddFileExtensionFilter(q, ".go") },
+			want:   autogold.Expect(BasicQuery("file:\\.go$ myquery")),
+		},
+		{
+			name:   "owner",
+			input:  "(myquery repo:supergreat) or (big repo:asdf)",
+			modify: func(q BasicQuery) (BasicQuery, error) { return AddOwnerFilter(q, "@sourcegraph/search") },
+			want:   autogold.Expect(BasicQuery("(repo:supergreat file:has.owner(@sourcegraph/search) myquery OR repo:asdf file:has.owner(@sourcegraph/search) big)")),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.modify(BasicQuery(test.input))
+			if err != nil {
+				test.want.Equal(t, err.Error())
+			} else {
+				test.want.Equal(t, got)
+			}
+		})
+	}
+}
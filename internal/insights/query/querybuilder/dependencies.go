@@ -0,0 +1,48 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies/lockfiles"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DependencyQuery is the query of a dependency versions series. It identifies a package
+// as `<scheme>:<name>`, e.g. `npm:react` or `go:github.com/sourcegraph/log`.
+type DependencyQuery struct {
+	Scheme string
+	Name   string
+}
+
+// ParseDependencyQuery parses the query of a dependency versions series.
+func ParseDependencyQuery(rawQuery string) (DependencyQuery, error) {
+	scheme, name, ok := strings.Cut(strings.TrimSpace(rawQuery), ":")
+	if !ok || scheme == "" || name == "" {
+		return DependencyQuery{}, errors.Newf("dependency query %q must have the form <scheme>:<package name>", rawQuery)
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return DependencyQuery{}, errors.Newf("dependency query %q must not contain whitespace", rawQuery)
+	}
+	if !lockfiles.IsSupportedScheme(scheme) {
+		return DependencyQuery{}, errors.Newf("package scheme %q is not supported by dependency queries", scheme)
+	}
+	return DependencyQuery{Scheme: scheme, Name: name}, nil
+}
+
+// SearchQuery returns a search query for the repositories with a lockfile that mentions
+// the package. It is used to narrow down the repositories whose lockfiles are read.
+func (q DependencyQuery) SearchQuery() BasicQuery {
+	filenames := lockfiles.Filenames(q.Scheme)
+	for i, name := range filenames {
+		filenames[i] = regexp.QuoteMeta(name)
+	}
+	return BasicQuery(fmt.Sprintf("file:(^|/)(%s)$ select:repo %s", strings.Join(filenames, "|"), q.Name))
+}
+
+// String returns the dependency query in its stored form.
+func (q DependencyQuery) String() string {
+	return q.Scheme + ":" + q.Name
+}
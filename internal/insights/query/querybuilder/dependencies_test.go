@@ -0,0 +1,61 @@
+package querybuilder
+
+import (
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+)
+
+func TestParseDependencyQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    DependencyQuery
+		wantErr bool
+	}{
+		{name: "npm package", input: "npm:react", want: DependencyQuery{Scheme: "npm", Name: "react"}},
+		{name: "scoped npm package", input: " npm:@types/node ", want: DependencyQuery{Scheme: "npm", Name: "@types/node"}},
+		{name: "go module", input: "go:github.com/sourcegraph/log", want: DependencyQuery{Scheme: "go", Name: "github.com/sourcegraph/log"}},
+		{name: "missing scheme", input: "react", wantErr: true},
+		{name: "missing name", input: "npm:", wantErr: true},
+		{name: "whitespace", input: "npm:react lodash", wantErr: true},
+		{name: "unsupported scheme", input: "semanticdb:maven/junit/junit", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseDependencyQuery(test.input)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != test.want {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDependencyQuerySearchQuery(t *testing.T) {
+	dependencyQuery := DependencyQuery{Scheme: "npm", Name: "@types/node"}
+
+	t.Run("global", func(t *testing.T) {
+		got, err := GlobalQuery(dependencyQuery.SearchQuery(), CodeInsightsQueryDefaults(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect(BasicQuery("fork:no archived:no patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 @types/node")).Equal(t, got)
+	})
+
+	t.Run("single repo", func(t *testing.T) {
+		got, err := SingleRepoQuery(dependencyQuery.SearchQuery(), "github.com/sourcegraph/sourcegraph", "abc", CodeInsightsQueryDefaults(false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect(BasicQuery("fork:yes archived:yes patterntype:literal file:(^|/)(package-lock\\.json|yarn\\.lock)$ select:repo count:99999999 @types/node repo:^github\\.com/sourcegraph/sourcegraph$@abc")).Equal(t, got)
+	})
+}
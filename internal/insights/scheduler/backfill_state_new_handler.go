@@ -174,6 +174,18 @@ func (h *newBackfillHandler) Handle(ctx context.Context, logger log.Logger, job
 }
 
 func parseQuery(series types.InsightSeries) (query.Plan, error) {
+	if series.GenerationMethod == types.DependencyVersions {
+		dependencyQuery, err := querybuilder.ParseDependencyQuery(series.Query)
+		if err != nil {
+			return nil, errors.Wrap(err, "ParseDependencyQuery")
+		}
+		plan, err := querybuilder.ParseQuery(dependencyQuery.SearchQuery().String(), "literal")
+		if err != nil {
+			return nil, errors.Wrap(err, "ParseQuery")
+		}
+		return plan, nil
+	}
+
 	if series.GeneratedFromCaptureGroups {
 		seriesQuery, err := compute.Parse(series.Query)
 		if err != nil {
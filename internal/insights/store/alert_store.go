@@ -0,0 +1,254 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AlertStore persists the alert rules of insight series and the history of the alerts they fired.
+type AlertStore interface {
+	CreateAlertRule(ctx context.Context, rule types.InsightSeriesAlertRule) (types.InsightSeriesAlertRule, error)
+	GetAlertRules(ctx context.Context, args AlertRuleQueryArgs) ([]types.InsightSeriesAlertRule, error)
+	SetAlertRuleEnabled(ctx context.Context, id int, enabled bool) error
+	DeleteAlertRule(ctx context.Context, id int) error
+	RecordAlertEvent(ctx context.Context, event types.InsightSeriesAlertEvent) (types.InsightSeriesAlertEvent, error)
+	GetAlertEvents(ctx context.Context, args AlertEventQueryArgs) ([]types.InsightSeriesAlertEvent, error)
+}
+
+var _ AlertStore = &DBAlertStore{}
+
+type DBAlertStore struct {
+	*basestore.Store
+	Now func() time.Time
+}
+
+// NewAlertStore returns a new DBAlertStore backed by the given Postgres db.
+func NewAlertStore(db edb.InsightsDB) *DBAlertStore {
+	return &DBAlertStore{Store: basestore.NewWithHandle(db.Handle()), Now: time.Now}
+}
+
+// With creates a new DBAlertStore with the given basestore. Shareable store as the underlying basestore.Store.
+// Needed to implement the basestore.Store interface
+func (s *DBAlertStore) With(other basestore.ShareableStore) *DBAlertStore {
+	return &DBAlertStore{Store: s.Store.With(other), Now: s.Now}
+}
+
+func (s *DBAlertStore) Transact(ctx context.Context) (*DBAlertStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &DBAlertStore{Store: txBase, Now: s.Now}, err
+}
+
+type AlertRuleQueryArgs struct {
+	IDs             []int
+	InsightSeriesID int
+	// EnabledOnly restricts the results to the rules that are evaluated after each snapshot.
+	EnabledOnly bool
+}
+
+func (s *DBAlertStore) CreateAlertRule(ctx context.Context, rule types.InsightSeriesAlertRule) (_ types.InsightSeriesAlertRule, err error) {
+	if rule.Intervals < 1 {
+		rule.Intervals = 1
+	}
+	if rule.Direction == "" {
+		rule.Direction = types.AlertAbove
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(createAlertRuleSql,
+		rule.InsightSeriesID,
+		rule.Kind,
+		rule.Direction,
+		rule.Threshold,
+		rule.Intervals,
+		rule.CreatedBy,
+		rule.Email,
+		rule.SlackWebhookURL,
+		rule.WebhookURL,
+		rule.Enabled,
+		s.Now(),
+	))
+	if err = row.Scan(&rule.ID, &rule.CreatedAt); err != nil {
+		return types.InsightSeriesAlertRule{}, errors.Wrap(err, "CreateAlertRule")
+	}
+	return rule, nil
+}
+
+const createAlertRuleSql = `
+INSERT INTO insight_series_alert_rules (series_id, kind, direction, threshold, intervals, created_by, email, slack_webhook_url, webhook_url, enabled, created_at)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id, created_at;
+`
+
+func (s *DBAlertStore) GetAlertRules(ctx context.Context, args AlertRuleQueryArgs) (_ []types.InsightSeriesAlertRule, err error) {
+	preds := make([]*sqlf.Query, 0, 3)
+	if len(args.IDs) > 0 {
+		elems := make([]*sqlf.Query, 0, len(args.IDs))
+		for _, id := range args.IDs {
+			elems = append(elems, sqlf.Sprintf("%s", id))
+		}
+		preds = append(preds, sqlf.Sprintf("id IN (%s)", sqlf.Join(elems, ",")))
+	}
+	if args.InsightSeriesID > 0 {
+		preds = append(preds, sqlf.Sprintf("series_id = %s", args.InsightSeriesID))
+	}
+	if args.EnabledOnly {
+		preds = append(preds, sqlf.Sprintf("enabled"))
+	}
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(getAlertRulesSql, sqlf.Join(preds, "\n AND ")))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	results := make([]types.InsightSeriesAlertRule, 0)
+	for rows.Next() {
+		var (
+			rule            types.InsightSeriesAlertRule
+			lastTriggeredAt sql.NullTime
+		)
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.InsightSeriesID,
+			&rule.Kind,
+			&rule.Direction,
+			&rule.Threshold,
+			&rule.Intervals,
+			&rule.CreatedBy,
+			&rule.Email,
+			&rule.SlackWebhookURL,
+			&rule.WebhookURL,
+			&rule.Enabled,
+			&rule.CreatedAt,
+			&lastTriggeredAt,
+		); err != nil {
+			return nil, err
+		}
+		if lastTriggeredAt.Valid {
+			rule.LastTriggeredAt = &lastTriggeredAt.Time
+		}
+		results = append(results, rule)
+	}
+	return results, rows.Err()
+}
+
+const getAlertRulesSql = `
+SELECT id, series_id, kind, direction, threshold, intervals, created_by, email, slack_webhook_url, webhook_url, enabled, created_at, last_triggered_at
+FROM insight_series_alert_rules
+WHERE %s
+ORDER BY id;
+`
+
+func (s *DBAlertStore) SetAlertRuleEnabled(ctx context.Context, id int, enabled bool) error {
+	return s.Exec(ctx, sqlf.Sprintf("UPDATE insight_series_alert_rules SET enabled = %s WHERE id = %s", enabled, id))
+}
+
+// DeleteAlertRule deletes the alert rule along with its alert history.
+func (s *DBAlertStore) DeleteAlertRule(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM insight_series_alert_rules WHERE id = %s", id))
+}
+
+// RecordAlertEvent appends the event to the alert history of its series and stamps the rule that fired.
+func (s *DBAlertStore) RecordAlertEvent(ctx context.Context, event types.InsightSeriesAlertEvent) (_ types.InsightSeriesAlertEvent, err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return types.InsightSeriesAlertEvent{}, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if event.TriggeredAt.IsZero() {
+		event.TriggeredAt = s.Now()
+	}
+	row := tx.QueryRow(ctx, sqlf.Sprintf(recordAlertEventSql,
+		event.AlertRuleID,
+		event.InsightSeriesID,
+		event.PointTime,
+		event.Value,
+		event.Baseline,
+		event.Message,
+		event.DeliveryError,
+		event.TriggeredAt,
+	))
+	if err = row.Scan(&event.ID); err != nil {
+		return types.InsightSeriesAlertEvent{}, errors.Wrap(err, "RecordAlertEvent")
+	}
+
+	if err = tx.Exec(ctx, sqlf.Sprintf("UPDATE insight_series_alert_rules SET last_triggered_at = %s WHERE id = %s", event.TriggeredAt, event.AlertRuleID)); err != nil {
+		return types.InsightSeriesAlertEvent{}, errors.Wrap(err, "UpdateLastTriggeredAt")
+	}
+	return event, nil
+}
+
+const recordAlertEventSql = `
+INSERT INTO insight_series_alert_events (alert_rule_id, series_id, point_time, value, baseline, message, delivery_error, triggered_at)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id;
+`
+
+type AlertEventQueryArgs struct {
+	AlertRuleID     int
+	InsightSeriesID int
+	// Limit is the number of most recent events to return, if non-zero.
+	Limit int
+}
+
+// GetAlertEvents returns the alert history matching args, most recent first.
+func (s *DBAlertStore) GetAlertEvents(ctx context.Context, args AlertEventQueryArgs) (_ []types.InsightSeriesAlertEvent, err error) {
+	preds := make([]*sqlf.Query, 0, 2)
+	if args.AlertRuleID > 0 {
+		preds = append(preds, sqlf.Sprintf("alert_rule_id = %s", args.AlertRuleID))
+	}
+	if args.InsightSeriesID > 0 {
+		preds = append(preds, sqlf.Sprintf("series_id = %s", args.InsightSeriesID))
+	}
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+	limit := sqlf.Sprintf("")
+	if args.Limit > 0 {
+		limit = sqlf.Sprintf("LIMIT %s", args.Limit)
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(getAlertEventsSql, sqlf.Join(preds, "\n AND "), limit))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	results := make([]types.InsightSeriesAlertEvent, 0)
+	for rows.Next() {
+		var event types.InsightSeriesAlertEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.AlertRuleID,
+			&event.InsightSeriesID,
+			&event.PointTime,
+			&event.Value,
+			&event.Baseline,
+			&event.Message,
+			&event.DeliveryError,
+			&event.TriggeredAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, event)
+	}
+	return results, rows.Err()
+}
+
+const getAlertEventsSql = `
+SELECT id, alert_rule_id, series_id, point_time, value, baseline, message, delivery_error, triggered_at
+FROM insight_series_alert_events
+WHERE %s
+ORDER BY triggered_at DESC, id DESC
+%s;
+`
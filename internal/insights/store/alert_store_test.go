@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestAlertStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	insightsDB := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+	now := time.Date(2023, 9, 21, 10, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	_, err := insightsDB.ExecContext(ctx, `INSERT INTO insight_series (id, series_id, query, created_at, oldest_historical_at, last_recorded_at,
+		next_recording_after, last_snapshot_at, next_snapshot_after, deleted_at, generation_method)
+		VALUES (1, 'series-id-1', 'query-1', $1, $1, $1, $1, $1, $1, null, 'search'),
+			   (2, 'series-id-2', 'query-2', $1, $1, $1, $1, $1, $1, null, 'search')`, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewAlertStore(insightsDB)
+	store.Now = func() time.Time { return now }
+
+	threshold, err := store.CreateAlertRule(ctx, types.InsightSeriesAlertRule{
+		InsightSeriesID: 1,
+		Kind:            types.AlertThreshold,
+		Threshold:       100,
+		CreatedBy:       1,
+		Email:           true,
+		Enabled:         true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if threshold.Direction != types.AlertAbove || threshold.Intervals != 1 {
+		t.Errorf("unexpected defaults: direction=%q intervals=%d", threshold.Direction, threshold.Intervals)
+	}
+	anomaly, err := store.CreateAlertRule(ctx, types.InsightSeriesAlertRule{
+		InsightSeriesID: 1,
+		Kind:            types.AlertAnomaly,
+		Direction:       types.AlertEither,
+		Threshold:       3,
+		Intervals:       12,
+		CreatedBy:       1,
+		WebhookURL:      pointers.Ptr("https://example.com/hook"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.CreateAlertRule(ctx, types.InsightSeriesAlertRule{
+		InsightSeriesID: 2,
+		Kind:            types.AlertPercentChange,
+		Threshold:       50,
+		Intervals:       4,
+		CreatedBy:       2,
+		SlackWebhookURL: pointers.Ptr("https://hooks.slack.com/services/x"),
+		Enabled:         true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("enabled rules of a series", func(t *testing.T) {
+		got, err := store.GetAlertRules(ctx, AlertRuleQueryArgs{InsightSeriesID: 1, EnabledOnly: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || got[0].ID != threshold.ID || !got[0].Email {
+			t.Errorf("unexpected rules: %+v", got)
+		}
+	})
+
+	t.Run("enable rule", func(t *testing.T) {
+		if err := store.SetAlertRuleEnabled(ctx, anomaly.ID, true); err != nil {
+			t.Fatal(err)
+		}
+		got, err := store.GetAlertRules(ctx, AlertRuleQueryArgs{IDs: []int{anomaly.ID}, EnabledOnly: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || got[0].Intervals != 12 || got[0].WebhookURL == nil || *got[0].WebhookURL != "https://example.com/hook" {
+			t.Errorf("unexpected rules: %+v", got)
+		}
+	})
+
+	t.Run("record alert events", func(t *testing.T) {
+		for i, value := range []float64{120, 150} {
+			if _, err := store.RecordAlertEvent(ctx, types.InsightSeriesAlertEvent{
+				AlertRuleID:     threshold.ID,
+				InsightSeriesID: 1,
+				PointTime:       now.Add(time.Duration(i) * time.Hour),
+				Value:           value,
+				Baseline:        100,
+				Message:         "above threshold",
+				TriggeredAt:     now.Add(time.Duration(i) * time.Hour),
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		events, err := store.GetAlertEvents(ctx, AlertEventQueryArgs{InsightSeriesID: 1, Limit: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 || events[0].Value != 150 {
+			t.Errorf("expected the most recent event, got %+v", events)
+		}
+
+		rules, err := store.GetAlertRules(ctx, AlertRuleQueryArgs{IDs: []int{threshold.ID}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rules[0].LastTriggeredAt == nil || !rules[0].LastTriggeredAt.Equal(now.Add(time.Hour)) {
+			t.Errorf("unexpected last triggered at: %v", rules[0].LastTriggeredAt)
+		}
+	})
+
+	t.Run("delete rule with its history", func(t *testing.T) {
+		if err := store.DeleteAlertRule(ctx, threshold.ID); err != nil {
+			t.Fatal(err)
+		}
+		events, err := store.GetAlertEvents(ctx, AlertEventQueryArgs{AlertRuleID: threshold.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 0 {
+			t.Errorf("expected no events, got %+v", events)
+		}
+	})
+}
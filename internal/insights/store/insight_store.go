@@ -820,6 +820,8 @@ type MatchSeriesArgs struct {
 	StepIntervalValue         int
 	GenerateFromCaptureGroups bool
 	GroupBy                   *string
+	// GenerationMethod is matched only if set.
+	GenerationMethod types.GenerationMethod
 }
 
 func (s *InsightStore) FindMatchingSeries(ctx context.Context, args MatchSeriesArgs) (_ types.InsightSeries, found bool, _ error) {
@@ -827,9 +829,13 @@ func (s *InsightStore) FindMatchingSeries(ctx context.Context, args MatchSeriesA
 	if args.GroupBy != nil {
 		groupByClause = sqlf.Sprintf("group_by = %s", *args.GroupBy)
 	}
+	generationMethodClause := sqlf.Sprintf("TRUE")
+	if args.GenerationMethod != "" {
+		generationMethodClause = sqlf.Sprintf("generation_method = %s", args.GenerationMethod)
+	}
 	where := sqlf.Sprintf(
-		"(repositories = '{}' OR repositories is NULL) AND query = %s AND sample_interval_unit = %s AND sample_interval_value = %s AND generated_from_capture_groups = %s AND %s",
-		args.Query, args.StepIntervalUnit, args.StepIntervalValue, args.GenerateFromCaptureGroups, groupByClause,
+		"(repositories = '{}' OR repositories is NULL) AND query = %s AND sample_interval_unit = %s AND sample_interval_value = %s AND generated_from_capture_groups = %s AND %s AND %s",
+		args.Query, args.StepIntervalUnit, args.StepIntervalValue, args.GenerateFromCaptureGroups, groupByClause, generationMethodClause,
 	)
 
 	q := sqlf.Sprintf(getInsightDataSeriesSql, where)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
 	searchquery "github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
 	sctypes "github.com/sourcegraph/sourcegraph/internal/types"
@@ -62,3 +63,21 @@ func (h *SearchContextHandler) UnwrapSearchContexts(ctx context.Context, rawCont
 	}
 	return include, exclude, nil
 }
+
+// DefaultRepoFilters returns the repository regexes of the default filters of an insight view,
+// including those of its default search contexts. Consumers reading the series points of a
+// view apply them so that they show the same data as the view does.
+func (h *SearchContextHandler) DefaultRepoFilters(ctx context.Context, viewSeries types.InsightViewSeries) (include, exclude []string, err error) {
+	if viewSeries.DefaultFilterIncludeRepoRegex != nil {
+		include = append(include, *viewSeries.DefaultFilterIncludeRepoRegex)
+	}
+	if viewSeries.DefaultFilterExcludeRepoRegex != nil {
+		exclude = append(exclude, *viewSeries.DefaultFilterExcludeRepoRegex)
+	}
+
+	inc, exc, err := h.UnwrapSearchContexts(ctx, viewSeries.DefaultFilterSearchContexts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "search context error")
+	}
+	return append(include, inc...), append(exclude, exc...), nil
+}
@@ -896,13 +896,26 @@ type ExportOpts struct {
 	ExcludeRepoRegex    []string
 }
 
-func (s *Store) GetAllDataForInsightViewID(ctx context.Context, opts ExportOpts) (_ []SeriesPointForExport, err error) {
+func (s *Store) GetAllDataForInsightViewID(ctx context.Context, opts ExportOpts) ([]SeriesPointForExport, error) {
+	var results []SeriesPointForExport
+	if err := s.StreamAllDataForInsightViewID(ctx, opts, func(point SeriesPointForExport) error {
+		results = append(results, point)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// StreamAllDataForInsightViewID calls fn for every exported point of the insight view, in the same
+// order as GetAllDataForInsightViewID, without holding all points in memory.
+func (s *Store) StreamAllDataForInsightViewID(ctx context.Context, opts ExportOpts, fn func(SeriesPointForExport) error) (err error) {
 	// 🚨 SECURITY: this function will only be called if the insight with the given insightViewId is visible given
 	// this user context. This is similar to how `SeriesPoints` works.
 	// We enforce repo permissions here as we store repository data at this level.
 	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "GetUnauthorizedRepoIDs")
+		return errors.Wrap(err, "GetUnauthorizedRepoIDs")
 	}
 	excludedRepoIDs := make([]*sqlf.Query, 0)
 	for _, repoID := range denylist {
@@ -939,11 +952,10 @@ func (s *Store) GetAllDataForInsightViewID(ctx context.Context, opts ExportOpts)
 
 	tx, err := s.Transact(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { err = tx.Done(err) }()
 
-	var results []SeriesPointForExport
 	exportScanner := func(sc scanner) error {
 		var tmp SeriesPointForExport
 		if err = sc.Scan(
@@ -961,22 +973,21 @@ func (s *Store) GetAllDataForInsightViewID(ctx context.Context, opts ExportOpts)
 		if tmp.Capture != nil {
 			tmp.SeriesLabel = *tmp.Capture
 		}
-		results = append(results, tmp)
-		return nil
+		return fn(tmp)
 	}
 
 	formattedPreds := sqlf.Join(preds, "AND")
-	// start with the oldest archived points and add them to the results
+	// start with the oldest archived points
 	if err := tx.query(ctx, sqlf.Sprintf(exportCodeInsightsDataSql, quote(recordingTimesTableArchive), quote(recordingTableArchive), opts.InsightViewUniqueID, formattedPreds), exportScanner); err != nil {
-		return nil, errors.Wrap(err, "fetching archived code insights data")
+		return errors.Wrap(err, "fetching archived code insights data")
 	}
 	// then add live points
 	// we join both series points tables
 	if err := tx.query(ctx, sqlf.Sprintf(exportCodeInsightsDataSql, quote(recordingTimesTable), quote("(select * from series_points union all select * from series_points_snapshots)"), opts.InsightViewUniqueID, formattedPreds), exportScanner); err != nil {
-		return nil, errors.Wrap(err, "fetching code insights data")
+		return errors.Wrap(err, "fetching code insights data")
 	}
 
-	return results, nil
+	return nil
 }
 
 const exportCodeInsightsDataSql = `
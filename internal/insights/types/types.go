@@ -131,6 +131,9 @@ const (
 	SearchCompute  GenerationMethod = "search-compute"
 	LanguageStats  GenerationMethod = "language-stats"
 	MappingCompute GenerationMethod = "mapping-compute"
+	// DependencyVersions series record, for each version of a package, the repositories
+	// whose lockfiles reference that version.
+	DependencyVersions GenerationMethod = "dependency-versions"
 )
 
 type Dashboard struct {
@@ -206,17 +209,77 @@ type RecordingTime struct {
 	Snapshot  bool
 }
 
+// InsightSeriesAlertRule is a rule that is evaluated against the points of an insight series
+// after each snapshot, and which notifies through the configured channels when it fires.
+type InsightSeriesAlertRule struct {
+	ID              int
+	InsightSeriesID int // references insight_series(id)
+	Kind            AlertKind
+	Direction       AlertDirection
+	// Threshold is the absolute value for AlertThreshold, the percentage for AlertPercentChange
+	// and the number of standard deviations from the baseline for AlertAnomaly.
+	Threshold float64
+	// Intervals is the number of recordings to compare against for AlertPercentChange, and the size
+	// of the rolling baseline for AlertAnomaly. It is unused for AlertThreshold.
+	Intervals int
+	CreatedBy int32
+	// Email is whether to email the user that created the rule when it fires.
+	Email           bool
+	SlackWebhookURL *string
+	WebhookURL      *string
+	Enabled         bool
+	CreatedAt       time.Time
+	LastTriggeredAt *time.Time
+}
+
+// AlertKind is the kind of evaluation an InsightSeriesAlertRule performs. This is effectively an enum of values.
+type AlertKind string
+
+const (
+	AlertThreshold     AlertKind = "threshold"
+	AlertPercentChange AlertKind = "percent_change"
+	AlertAnomaly       AlertKind = "anomaly"
+)
+
+// AlertDirection is the direction in which a series value has to move for an alert rule to fire.
+type AlertDirection string
+
+const (
+	AlertAbove  AlertDirection = "above"
+	AlertBelow  AlertDirection = "below"
+	AlertEither AlertDirection = "either"
+)
+
+// InsightSeriesAlertEvent is an entry in the alert history of an insight series.
+type InsightSeriesAlertEvent struct {
+	ID              int
+	AlertRuleID     int // references insight_series_alert_rules(id)
+	InsightSeriesID int // references insight_series(id)
+	PointTime       time.Time
+	Value           float64
+	// Baseline is the value that Value was compared against: the threshold, the value N intervals
+	// ago, or the mean of the rolling baseline.
+	Baseline      float64
+	Message       string
+	DeliveryError *string
+	TriggeredAt   time.Time
+}
+
 type SearchAggregationMode string
 
 const (
-	REPO_AGGREGATION_MODE          SearchAggregationMode = "REPO"
-	PATH_AGGREGATION_MODE          SearchAggregationMode = "PATH"
-	AUTHOR_AGGREGATION_MODE        SearchAggregationMode = "AUTHOR"
-	CAPTURE_GROUP_AGGREGATION_MODE SearchAggregationMode = "CAPTURE_GROUP"
-	REPO_METADATA_AGGREGATION_MODE SearchAggregationMode = "REPO_METADATA"
+	REPO_AGGREGATION_MODE           SearchAggregationMode = "REPO"
+	PATH_AGGREGATION_MODE           SearchAggregationMode = "PATH"
+	AUTHOR_AGGREGATION_MODE         SearchAggregationMode = "AUTHOR"
+	CAPTURE_GROUP_AGGREGATION_MODE  SearchAggregationMode = "CAPTURE_GROUP"
+	REPO_METADATA_AGGREGATION_MODE  SearchAggregationMode = "REPO_METADATA"
+	LANGUAGE_AGGREGATION_MODE       SearchAggregationMode = "LANGUAGE"
+	FILE_EXTENSION_AGGREGATION_MODE SearchAggregationMode = "FILE_EXTENSION"
+	DATE_BUCKET_AGGREGATION_MODE    SearchAggregationMode = "DATE_BUCKET"
+	OWNER_AGGREGATION_MODE          SearchAggregationMode = "OWNER"
 )
 
-var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE}
+var SearchAggregationModes = []SearchAggregationMode{REPO_AGGREGATION_MODE, PATH_AGGREGATION_MODE, AUTHOR_AGGREGATION_MODE, CAPTURE_GROUP_AGGREGATION_MODE, REPO_METADATA_AGGREGATION_MODE, LANGUAGE_AGGREGATION_MODE, FILE_EXTENSION_AGGREGATION_MODE, DATE_BUCKET_AGGREGATION_MODE, OWNER_AGGREGATION_MODE}
 
 type AggregationNotAvailableReasonType string
 
@@ -230,4 +293,7 @@ const (
 
 const (
 	NO_REPO_METADATA_TEXT = "No metadata"
+	// DATE_BUCKET_LAYOUT is the time layout of the groups of the DATE_BUCKET aggregation mode, which
+	// buckets commits by the month of their author date.
+	DATE_BUCKET_LAYOUT = "2006-01"
 )
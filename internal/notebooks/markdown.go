@@ -0,0 +1,364 @@
+package notebooks
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// The Markdown format of notebooks stores every block that is not a Markdown block in a fenced code
+// block, with an info string that identifies the block type. Query blocks use the same fence as the
+// Markdown export of the notebook editor, and file and symbol blocks contain the URL of the file or
+// symbol, so that the format can be read and reviewed like any other Markdown document. Markdown
+// blocks are stored as they are, unless they would be imported differently, e.g. because they
+// contain a notebook code block or consist of the URL of a file. Those are stored in a fenced code
+// block as well.
+const (
+	markdownFenceInfo   = "sourcegraph markdown"
+	queryFenceInfo      = "sourcegraph"
+	fileFenceInfo       = "sourcegraph file"
+	symbolFenceInfo     = "sourcegraph symbol"
+	referencesFenceInfo = "sourcegraph references"
+	insightFenceInfo    = "sourcegraph insight"
+	computeFenceInfo    = "sourcegraph compute"
+
+	// markdownBlockSeparator separates consecutive Markdown blocks. It is an HTML comment, so that it
+	// is not visible when the document is rendered.
+	markdownBlockSeparator = "<!-- notebook-block -->"
+
+	defaultSymbolLineContext = 3
+)
+
+var fenceInfoBlockTypes = map[string]NotebookBlockType{
+	markdownFenceInfo:   NotebookMarkdownBlockType,
+	queryFenceInfo:      NotebookQueryBlockType,
+	fileFenceInfo:       NotebookFileBlockType,
+	symbolFenceInfo:     NotebookSymbolBlockType,
+	referencesFenceInfo: NotebookReferencesBlockType,
+	insightFenceInfo:    NotebookInsightBlockType,
+	computeFenceInfo:    NotebookComputeBlockType,
+}
+
+// ExportMarkdown exports the blocks of a notebook to the Markdown format of notebooks. Unlike
+// RenderMarkdown, the export contains the input of every block, so that importing it with
+// ImportMarkdown results in the same blocks, except for their IDs.
+func ExportMarkdown(blocks NotebookBlocks, externalURL string) string {
+	exported := make([]string, 0, len(blocks))
+	previousType := NotebookBlockType("")
+	for _, block := range blocks {
+		markdown := strings.TrimRight(exportBlock(block, externalURL), " \t\n")
+		if markdown == "" {
+			continue
+		}
+		if block.Type == NotebookMarkdownBlockType && previousType == NotebookMarkdownBlockType {
+			exported = append(exported, markdownBlockSeparator)
+		}
+		exported = append(exported, markdown)
+		previousType = block.Type
+	}
+	return strings.Join(exported, "\n\n") + "\n"
+}
+
+func exportBlock(block NotebookBlock, externalURL string) string {
+	switch block.Type {
+	case NotebookMarkdownBlockType:
+		text := block.MarkdownInput.Text
+		if !importsAsMarkdownBlock(text) {
+			return codeFence(markdownFenceInfo, text)
+		}
+		return text
+	case NotebookQueryBlockType:
+		return codeFence(queryFenceInfo, block.QueryInput.Text)
+	case NotebookFileBlockType:
+		input := block.FileInput
+		return codeFence(fileFenceInfo, blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, fileLineParameter(input.LineRange)))
+	case NotebookSymbolBlockType:
+		input := block.SymbolInput
+		symbolParameters := url.Values{}
+		symbolParameters.Set("symbolName", input.SymbolName)
+		symbolParameters.Set("symbolContainerName", input.SymbolContainerName)
+		symbolParameters.Set("symbolKind", input.SymbolKind)
+		symbolParameters.Set("lineContext", fmt.Sprint(input.LineContext))
+		return codeFence(symbolFenceInfo, blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, "")+"#"+symbolParameters.Encode())
+	case NotebookReferencesBlockType:
+		input := block.ReferencesInput
+		symbolParameters := url.Values{}
+		symbolParameters.Set("symbolName", input.SymbolName)
+		symbolURL := blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, fmt.Sprintf("L%d:%d", input.Line+1, input.Character+1))
+		return codeFence(referencesFenceInfo, symbolURL+"#"+symbolParameters.Encode())
+	case NotebookInsightBlockType:
+		insightParameters := url.Values{}
+		insightParameters.Set("insightViewId", block.InsightInput.InsightViewID)
+		insightParameters.Set("seriesId", block.InsightInput.SeriesID)
+		return codeFence(insightFenceInfo, insightParameters.Encode())
+	case NotebookComputeBlockType:
+		return codeFence(computeFenceInfo, block.ComputeInput.Text)
+	}
+	return ""
+}
+
+var (
+	fenceOpeningRegex  = lazyregexp.New("^ {0,3}(`{3,}|~{3,})(.*)$")
+	lineParameterRegex = lazyregexp.New(`(?:^|&)L(\d+)(?::(\d+))?(?:-(\d+)(?::\d+)?)?(?:&|$)`)
+)
+
+// ImportMarkdown imports the blocks of a notebook from a document in the Markdown format of
+// notebooks. Documents exported by the notebook editor are supported as well: a paragraph that only
+// consists of the URL of a file or symbol is imported as a file or symbol block. Every imported block
+// gets a new ID.
+func ImportMarkdown(markdown string) (NotebookBlocks, error) {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+
+	blocks := NotebookBlocks{}
+	var markdownLines []string
+	addMarkdownBlock := func() {
+		text := strings.TrimRight(strings.TrimLeft(strings.Join(markdownLines, "\n"), "\n"), " \t\n")
+		if text != "" {
+			blocks = append(blocks, NotebookBlock{ID: uuid.NewString(), Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: text}})
+		}
+		markdownLines = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if match := fenceOpeningRegex.FindStringSubmatch(line); match != nil && !(match[1][0] == '`' && strings.Contains(match[2], "`")) {
+			fence, info := match[1], strings.Join(strings.Fields(match[2]), " ")
+			// An unclosed code block extends to the end of the document.
+			end := i + 1
+			for end < len(lines) && !isFenceClosing(lines[end], fence) {
+				end++
+			}
+			blockEnd := end + 1
+			if end == len(lines) {
+				blockEnd = end
+			}
+
+			blockType, ok := fenceInfoBlockTypes[info]
+			if !ok {
+				// Other code blocks are part of Markdown blocks.
+				markdownLines = append(markdownLines, lines[i:blockEnd]...)
+				i = end
+				continue
+			}
+
+			addMarkdownBlock()
+			block, err := importBlock(blockType, strings.Join(lines[i+1:end], "\n"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s block on line %d", blockType, i+1)
+			}
+			blocks = append(blocks, *block)
+			i = end
+			continue
+		}
+
+		if strings.TrimSpace(line) == markdownBlockSeparator {
+			addMarkdownBlock()
+			continue
+		}
+
+		if isBlobURLParagraph(lines, i) {
+			addMarkdownBlock()
+			blockType := NotebookFileBlockType
+			if u, err := url.Parse(strings.TrimSpace(line)); err == nil && hasSymbolParameters(u) {
+				blockType = NotebookSymbolBlockType
+			}
+			block, err := importBlock(blockType, strings.TrimSpace(line))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s block on line %d", blockType, i+1)
+			}
+			blocks = append(blocks, *block)
+			continue
+		}
+
+		markdownLines = append(markdownLines, line)
+	}
+	addMarkdownBlock()
+
+	return blocks, nil
+}
+
+// importsAsMarkdownBlock returns true if the given Markdown text is imported as a single Markdown
+// block with the same text, and does not affect the blocks that follow it.
+func importsAsMarkdownBlock(text string) bool {
+	text = strings.TrimRight(text, " \t\n")
+	if text == "" {
+		return true
+	}
+
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		match := fenceOpeningRegex.FindStringSubmatch(lines[i])
+		if match == nil || (match[1][0] == '`' && strings.Contains(match[2], "`")) {
+			continue
+		}
+		end := i + 1
+		for end < len(lines) && !isFenceClosing(lines[end], match[1]) {
+			end++
+		}
+		if end == len(lines) {
+			// An unclosed code block would extend to the blocks that follow.
+			return false
+		}
+		i = end
+	}
+
+	blocks, err := ImportMarkdown(text)
+	return err == nil && len(blocks) == 1 && blocks[0].Type == NotebookMarkdownBlockType && blocks[0].MarkdownInput.Text == text
+}
+
+func isFenceClosing(line, fence string) bool {
+	trimmed := strings.TrimRight(line, " \t")
+	indentation := len(trimmed) - len(strings.TrimLeft(trimmed, " "))
+	if indentation > 3 {
+		return false
+	}
+	trimmed = trimmed[indentation:]
+	return len(trimmed) >= len(fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// isBlobURLParagraph returns true if the line at the given index is a paragraph on its own that
+// only consists of the URL of a file.
+func isBlobURLParagraph(lines []string, i int) bool {
+	line := strings.TrimSpace(lines[i])
+	if !(strings.HasPrefix(line, "http://") || strings.HasPrefix(line, "https://")) || strings.ContainsAny(line, " \t") || !strings.Contains(line, "/-/blob/") {
+		return false
+	}
+	return (i == 0 || strings.TrimSpace(lines[i-1]) == "") && (i == len(lines)-1 || strings.TrimSpace(lines[i+1]) == "")
+}
+
+func hasSymbolParameters(u *url.URL) bool {
+	symbolParameters, err := url.ParseQuery(u.Fragment)
+	return err == nil && symbolParameters.Get("symbolName") != ""
+}
+
+func importBlock(blockType NotebookBlockType, content string) (*NotebookBlock, error) {
+	block := &NotebookBlock{ID: uuid.NewString(), Type: blockType}
+	switch blockType {
+	case NotebookMarkdownBlockType:
+		block.MarkdownInput = &NotebookMarkdownBlockInput{Text: content}
+	case NotebookQueryBlockType:
+		block.QueryInput = &NotebookQueryBlockInput{Text: content}
+	case NotebookFileBlockType:
+		location, err := parseBlobURL(content)
+		if err != nil {
+			return nil, err
+		}
+		var lineRange *LineRange
+		if location.line > 0 {
+			lineRange = &LineRange{StartLine: location.line - 1, EndLine: location.line}
+			if location.endLine > 0 {
+				lineRange.EndLine = location.endLine
+			}
+		}
+		block.FileInput = &NotebookFileBlockInput{
+			RepositoryName: location.repositoryName,
+			FilePath:       location.filePath,
+			Revision:       location.revision,
+			LineRange:      lineRange,
+		}
+	case NotebookSymbolBlockType:
+		location, err := parseBlobURL(content)
+		if err != nil {
+			return nil, err
+		}
+		lineContext := int32(defaultSymbolLineContext)
+		if value, err := strconv.ParseInt(location.fragment.Get("lineContext"), 10, 32); err == nil {
+			lineContext = int32(value)
+		}
+		block.SymbolInput = &NotebookSymbolBlockInput{
+			RepositoryName:      location.repositoryName,
+			FilePath:            location.filePath,
+			Revision:            location.revision,
+			LineContext:         lineContext,
+			SymbolName:          location.fragment.Get("symbolName"),
+			SymbolContainerName: location.fragment.Get("symbolContainerName"),
+			SymbolKind:          location.fragment.Get("symbolKind"),
+		}
+	case NotebookReferencesBlockType:
+		location, err := parseBlobURL(content)
+		if err != nil {
+			return nil, err
+		}
+		if location.line == 0 || location.character == 0 {
+			return nil, errors.New("the URL of a references block must contain the position of the symbol")
+		}
+		block.ReferencesInput = &NotebookReferencesBlockInput{
+			RepositoryName: location.repositoryName,
+			FilePath:       location.filePath,
+			Revision:       location.revision,
+			Line:           location.line - 1,
+			Character:      location.character - 1,
+			SymbolName:     location.fragment.Get("symbolName"),
+		}
+	case NotebookInsightBlockType:
+		insightParameters, err := url.ParseQuery(strings.TrimSpace(content))
+		if err != nil {
+			return nil, err
+		}
+		block.InsightInput = &NotebookInsightBlockInput{
+			InsightViewID: insightParameters.Get("insightViewId"),
+			SeriesID:      insightParameters.Get("seriesId"),
+		}
+	case NotebookComputeBlockType:
+		block.ComputeInput = &NotebookComputeBlockInput{Text: content}
+	}
+	return block, nil
+}
+
+type blobLocation struct {
+	repositoryName string
+	revision       *string
+	filePath       string
+
+	// line, character and endLine are 1-based, and 0 if they are not part of the URL.
+	line      int32
+	character int32
+	endLine   int32
+
+	fragment url.Values
+}
+
+// parseBlobURL parses the URL of a file in the format of the web app. The host of the URL is
+// ignored, so that notebooks can be moved between instances.
+func parseBlobURL(rawURL string) (*blobLocation, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, err
+	}
+	repoRevision, filePath, ok := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/-/blob/")
+	if !ok || repoRevision == "" || filePath == "" {
+		return nil, errors.Newf("%q is not the URL of a file", rawURL)
+	}
+
+	location := &blobLocation{filePath: filePath}
+	if repositoryName, revision, ok := strings.Cut(repoRevision, "@"); ok {
+		location.repositoryName = repositoryName
+		location.revision = &revision
+	} else {
+		location.repositoryName = repoRevision
+	}
+
+	if match := lineParameterRegex.FindStringSubmatch(u.RawQuery); match != nil {
+		location.line = parseInt32(match[1])
+		location.character = parseInt32(match[2])
+		location.endLine = parseInt32(match[3])
+	}
+
+	location.fragment, err = url.ParseQuery(u.Fragment)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func parseInt32(value string) int32 {
+	parsed, _ := strconv.ParseInt(value, 10, 32)
+	return int32(parsed)
+}
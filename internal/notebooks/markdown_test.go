@@ -0,0 +1,117 @@
+package notebooks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hexops/autogold/v2"
+)
+
+func markdownFixtureBlocks() NotebookBlocks {
+	revision := "main"
+	return NotebookBlocks{
+		{ID: "1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "# Title\n\n```go\nfunc main() {}\n```"}},
+		{ID: "2", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "Second paragraph"}},
+		{ID: "3", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "repo:a content:\"```\""}},
+		{ID: "4", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "github.com/a/b", FilePath: "dir/file name.go", Revision: &revision, LineRange: &LineRange{StartLine: 0, EndLine: 10}}},
+		{ID: "5", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "github.com/a/b", FilePath: "README.md"}},
+		{ID: "6", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", LineContext: 3, SymbolName: "main", SymbolContainerName: "pkg", SymbolKind: "FUNCTION"}},
+		{ID: "7", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", Revision: &revision, Line: 4, Character: 5, SymbolName: "main"}},
+		{ID: "8", Type: NotebookInsightBlockType, InsightInput: &NotebookInsightBlockInput{InsightViewID: "aW5zaWdodA==", SeriesID: "s"}},
+		{ID: "9", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{Text: "content:output(func (\\w+) -> $1)"}},
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	got := ExportMarkdown(markdownFixtureBlocks(), "https://sourcegraph.test")
+	autogold.Expect("# Title\n\n```go\nfunc main() {}\n```\n\n<!-- notebook-block -->\n\nSecond paragraph\n\n````sourcegraph\nrepo:a content:\"```\"\n````\n\n```sourcegraph file\nhttps://sourcegraph.test/github.com/a/b@main/-/blob/dir/file%20name.go?L1-10\n```\n\n```sourcegraph file\nhttps://sourcegraph.test/github.com/a/b/-/blob/README.md\n```\n\n```sourcegraph symbol\nhttps://sourcegraph.test/github.com/a/b/-/blob/main.go#lineContext=3&symbolContainerName=pkg&symbolKind=FUNCTION&symbolName=main\n```\n\n```sourcegraph references\nhttps://sourcegraph.test/github.com/a/b@main/-/blob/main.go?L5:6#symbolName=main\n```\n\n```sourcegraph insight\ninsightViewId=aW5zaWdodA%3D%3D&seriesId=s\n```\n\n```sourcegraph compute\ncontent:output(func (\\w+) -> $1)\n```\n").Equal(t, got)
+}
+
+func TestImportMarkdown(t *testing.T) {
+	ignoreIDs := cmpopts.IgnoreFields(NotebookBlock{}, "ID")
+
+	t.Run("round trip", func(t *testing.T) {
+		blocks := markdownFixtureBlocks()
+		got, err := ImportMarkdown(ExportMarkdown(blocks, "https://sourcegraph.test"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(blocks, got, ignoreIDs); diff != "" {
+			t.Fatalf("unexpected blocks (-want +got):\n%s", diff)
+		}
+		for _, block := range got {
+			if block.ID == "" {
+				t.Fatal("expected imported block to have an ID")
+			}
+		}
+	})
+
+	t.Run("round trip of Markdown blocks with notebook syntax", func(t *testing.T) {
+		for _, text := range []string{
+			"Run this query:\n\n```sourcegraph\nrepo:a b\n```",
+			"https://sourcegraph.test/github.com/a/b/-/blob/main.go?L5",
+			"https://sourcegraph.test/github.com/a/b/-/blob/main.go#symbolName=main",
+			"Before\n\n<!-- notebook-block -->\n\nAfter",
+			"Unclosed code block\n\n```go\nfunc main() {}",
+			"Nested fences\n\n````sourcegraph\n```\n````",
+		} {
+			blocks := NotebookBlocks{
+				{Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: text}},
+				{Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "Next block"}},
+				{Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "repo:a"}},
+			}
+			got, err := ImportMarkdown(ExportMarkdown(blocks, "https://sourcegraph.test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(blocks, got, ignoreIDs); diff != "" {
+				t.Errorf("unexpected blocks for %q (-want +got):\n%s", text, diff)
+			}
+		}
+	})
+
+	t.Run("notebook editor export", func(t *testing.T) {
+		markdown := "# Title\n\n```sourcegraph\nrepo:a b\n```\n\nhttps://other.test/github.com/a/b@main/-/blob/main.go?L5\n\nhttps://other.test/github.com/a/b/-/blob/main.go?L3:1-5:10#symbolName=main&symbolContainerName=&symbolKind=FUNCTION&lineContext=2\n\nSee https://other.test/github.com/a/b/-/blob/main.go\n"
+		got, err := ImportMarkdown(markdown)
+		if err != nil {
+			t.Fatal(err)
+		}
+		revision := "main"
+		want := NotebookBlocks{
+			{Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "# Title"}},
+			{Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "repo:a b"}},
+			{Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", Revision: &revision, LineRange: &LineRange{StartLine: 4, EndLine: 5}}},
+			{Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", LineContext: 2, SymbolName: "main", SymbolKind: "FUNCTION"}},
+			{Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "See https://other.test/github.com/a/b/-/blob/main.go"}},
+		}
+		if diff := cmp.Diff(want, got, ignoreIDs); diff != "" {
+			t.Fatalf("unexpected blocks (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("unclosed code block", func(t *testing.T) {
+		got, err := ImportMarkdown("text\n\n```sourcegraph\nrepo:a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := NotebookBlocks{
+			{Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "text"}},
+			{Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "repo:a"}},
+		}
+		if diff := cmp.Diff(want, got, ignoreIDs); diff != "" {
+			t.Fatalf("unexpected blocks (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("invalid blocks", func(t *testing.T) {
+		for _, markdown := range []string{
+			"```sourcegraph file\nhttps://sourcegraph.test/github.com/a/b\n```",
+			"```sourcegraph references\nhttps://sourcegraph.test/github.com/a/b/-/blob/main.go#symbolName=main\n```",
+		} {
+			if _, err := ImportMarkdown(markdown); err == nil {
+				t.Errorf("expected error importing %q", markdown)
+			}
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package notebooks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlockOutputs computes the output of the blocks that are computed on the server.
+type BlockOutputs interface {
+	// References returns the locations that reference the symbol of a references block.
+	References(ctx context.Context, input NotebookReferencesBlockInput) ([]ReferenceLocation, error)
+
+	// InsightSeries returns the series embedded in an insight block.
+	InsightSeries(ctx context.Context, input NotebookInsightBlockInput) (*InsightSeries, error)
+
+	// ComputeOutput returns the outputs of the compute expression of a compute block.
+	ComputeOutput(ctx context.Context, input NotebookComputeBlockInput) ([]string, error)
+}
+
+type ReferenceLocation struct {
+	RepositoryName string
+	Commit         string
+	FilePath       string
+
+	// Line is the 0-based line of the reference.
+	Line int32
+}
+
+type InsightSeries struct {
+	InsightTitle string
+	SeriesLabel  string
+	Points       []InsightSeriesPoint
+}
+
+type InsightSeriesPoint struct {
+	Time    time.Time
+	Value   float64
+	Capture *string
+}
+
+// RenderMarkdown renders the blocks of a notebook as a Markdown document for export. Markdown,
+// query, file and symbol blocks are rendered like the Markdown export of the notebook editor, with
+// links relative to the given external URL. The output of references, insight and compute blocks
+// is included if block outputs are given. A block whose output cannot be computed is replaced by a
+// note with the error, so that a single broken block does not prevent the export of the notebook.
+func RenderMarkdown(ctx context.Context, blocks NotebookBlocks, externalURL string, outputs BlockOutputs) string {
+	rendered := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		markdown, err := renderBlock(ctx, block, externalURL, outputs)
+		if err != nil {
+			markdown = fmt.Sprintf("_Could not render the %s block: %s_", block.Type, err)
+		}
+		if markdown = strings.TrimRight(markdown, "\n"); markdown != "" {
+			rendered = append(rendered, markdown)
+		}
+	}
+	return strings.Join(rendered, "\n\n") + "\n"
+}
+
+func renderBlock(ctx context.Context, block NotebookBlock, externalURL string, outputs BlockOutputs) (string, error) {
+	switch block.Type {
+	case NotebookMarkdownBlockType:
+		return block.MarkdownInput.Text, nil
+	case NotebookQueryBlockType:
+		return codeFence("sourcegraph", block.QueryInput.Text), nil
+	case NotebookFileBlockType:
+		input := block.FileInput
+		return blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, fileLineParameter(input.LineRange)), nil
+	case NotebookSymbolBlockType:
+		input := block.SymbolInput
+		symbolParameters := url.Values{}
+		symbolParameters.Set("symbolName", input.SymbolName)
+		symbolParameters.Set("symbolContainerName", input.SymbolContainerName)
+		symbolParameters.Set("symbolKind", input.SymbolKind)
+		symbolParameters.Set("lineContext", fmt.Sprint(input.LineContext))
+		return blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, "") + "#" + symbolParameters.Encode(), nil
+	case NotebookReferencesBlockType:
+		input := block.ReferencesInput
+		symbolURL := blobURL(externalURL, input.RepositoryName, input.Revision, input.FilePath, fmt.Sprintf("L%d:%d", input.Line+1, input.Character+1))
+		heading := fmt.Sprintf("**References of [`%s`](%s)**", input.SymbolName, symbolURL)
+		if outputs == nil {
+			return heading, nil
+		}
+		locations, err := outputs.References(ctx, *input)
+		if err != nil {
+			return "", err
+		}
+		return heading + "\n\n" + renderReferences(locations, externalURL), nil
+	case NotebookInsightBlockType:
+		if outputs == nil {
+			return "", nil
+		}
+		series, err := outputs.InsightSeries(ctx, *block.InsightInput)
+		if err != nil {
+			return "", err
+		}
+		return renderInsightSeries(series), nil
+	case NotebookComputeBlockType:
+		heading := codeFence("sourcegraph-compute", block.ComputeInput.Text)
+		if outputs == nil {
+			return heading, nil
+		}
+		computeOutput, err := outputs.ComputeOutput(ctx, *block.ComputeInput)
+		if err != nil {
+			return "", err
+		}
+		return heading + "\n\n" + renderComputeOutput(computeOutput), nil
+	}
+	return "", nil
+}
+
+func renderReferences(locations []ReferenceLocation, externalURL string) string {
+	if len(locations) == 0 {
+		return "_No references found._"
+	}
+	var b strings.Builder
+	for _, location := range locations {
+		commit := location.Commit
+		locationURL := blobURL(externalURL, location.RepositoryName, &commit, location.FilePath, fmt.Sprintf("L%d", location.Line+1))
+		fmt.Fprintf(&b, "- [%s › %s:%d](%s)\n", location.RepositoryName, location.FilePath, location.Line+1, locationURL)
+	}
+	return b.String()
+}
+
+func renderInsightSeries(series *InsightSeries) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s: %s**\n\n", series.InsightTitle, series.SeriesLabel)
+	if len(series.Points) == 0 {
+		b.WriteString("_No data has been recorded for this series yet._")
+		return b.String()
+	}
+
+	hasCaptures := false
+	for _, point := range series.Points {
+		hasCaptures = hasCaptures || point.Capture != nil
+	}
+	if hasCaptures {
+		b.WriteString("| Date | Capture | Value |\n| --- | --- | ---: |\n")
+	} else {
+		b.WriteString("| Date | Value |\n| --- | ---: |\n")
+	}
+	for _, point := range series.Points {
+		value := strconv.FormatFloat(point.Value, 'f', -1, 64)
+		if hasCaptures {
+			capture := ""
+			if point.Capture != nil {
+				capture = *point.Capture
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", point.Time.UTC().Format("2006-01-02"), escapeTableCell(capture), value)
+		} else {
+			fmt.Fprintf(&b, "| %s | %s |\n", point.Time.UTC().Format("2006-01-02"), value)
+		}
+	}
+	return b.String()
+}
+
+func renderComputeOutput(output []string) string {
+	if len(output) == 0 {
+		return "_No results._"
+	}
+	return codeFence("", strings.Join(output, "\n"))
+}
+
+// codeFence returns a fenced code block with the given info string. The fence is longer than any
+// sequence of backticks in the content, so that the content cannot close it.
+func codeFence(info, content string) string {
+	fence := "```"
+	for strings.Contains(content, fence) {
+		fence += "`"
+	}
+	return fence + info + "\n" + content + "\n" + fence
+}
+
+func escapeTableCell(value string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(value)
+}
+
+// blobURL returns the URL of a file on the instance, in the format of the web app.
+func blobURL(externalURL, repositoryName string, revision *string, filePath, lineParameter string) string {
+	repoRevision := escapePath(repositoryName)
+	if revision != nil && *revision != "" {
+		repoRevision += "@" + escapePath(*revision)
+	}
+	u := fmt.Sprintf("%s/%s/-/blob/%s", strings.TrimSuffix(externalURL, "/"), repoRevision, escapePath(filePath))
+	if lineParameter != "" {
+		u += "?" + lineParameter
+	}
+	return u
+}
+
+// fileLineParameter returns the URL parameter that selects the line range of a file block. Like the
+// notebook editor, it treats the start line of the range as 0-based and the end line as exclusive.
+func fileLineParameter(lineRange *LineRange) string {
+	if lineRange == nil {
+		return ""
+	}
+	if lineRange.StartLine+1 >= lineRange.EndLine {
+		return fmt.Sprintf("L%d", lineRange.StartLine+1)
+	}
+	return fmt.Sprintf("L%d-%d", lineRange.StartLine+1, lineRange.EndLine)
+}
+
+// escapePath escapes each segment of a slash-separated path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,99 @@
+package notebooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type fakeBlockOutputs struct{}
+
+func (fakeBlockOutputs) References(_ context.Context, input NotebookReferencesBlockInput) ([]ReferenceLocation, error) {
+	return []ReferenceLocation{{RepositoryName: input.RepositoryName, Commit: "deadbeef", FilePath: "util.go", Line: 9}}, nil
+}
+
+func (fakeBlockOutputs) InsightSeries(_ context.Context, input NotebookInsightBlockInput) (*InsightSeries, error) {
+	return nil, errors.Newf("insight %s not found", input.InsightViewID)
+}
+
+func (fakeBlockOutputs) ComputeOutput(_ context.Context, input NotebookComputeBlockInput) ([]string, error) {
+	return []string{"main", "init"}, nil
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	revision := "main"
+	blocks := NotebookBlocks{
+		{ID: "1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "# Title\n"}},
+		{ID: "2", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "repo:a b"}},
+		{ID: "3", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "github.com/a/b", FilePath: "dir/file name.go", Revision: &revision, LineRange: &LineRange{StartLine: 0, EndLine: 10}}},
+		{ID: "4", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", LineContext: 3, SymbolName: "main", SymbolKind: "FUNCTION"}},
+		{ID: "5", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{RepositoryName: "github.com/a/b", FilePath: "main.go", Line: 4, Character: 5, SymbolName: "main"}},
+		{ID: "6", Type: NotebookInsightBlockType, InsightInput: &NotebookInsightBlockInput{InsightViewID: "aW5zaWdodA==", SeriesID: "s"}},
+		{ID: "7", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{Text: "func (\\w+) -> $1"}},
+	}
+
+	t.Run("with block outputs", func(t *testing.T) {
+		got := RenderMarkdown(context.Background(), blocks, "https://sourcegraph.test/", fakeBlockOutputs{})
+		autogold.Expect("# Title\n\n```sourcegraph\nrepo:a b\n```\n\nhttps://sourcegraph.test/github.com/a/b@main/-/blob/dir/file%20name.go?L1-10\n\nhttps://sourcegraph.test/github.com/a/b/-/blob/main.go#lineContext=3&symbolContainerName=&symbolKind=FUNCTION&symbolName=main\n\n**References of [`main`](https://sourcegraph.test/github.com/a/b/-/blob/main.go?L5:6)**\n\n- [github.com/a/b › util.go:10](https://sourcegraph.test/github.com/a/b@deadbeef/-/blob/util.go?L10)\n\n_Could not render the insight block: insight aW5zaWdodA== not found_\n\n```sourcegraph-compute\nfunc (\\w+) -> $1\n```\n\n```\nmain\ninit\n```\n").Equal(t, got)
+	})
+
+	t.Run("without block outputs", func(t *testing.T) {
+		got := RenderMarkdown(context.Background(), blocks[4:], "https://sourcegraph.test", nil)
+		autogold.Expect("**References of [`main`](https://sourcegraph.test/github.com/a/b/-/blob/main.go?L5:6)**\n\n```sourcegraph-compute\nfunc (\\w+) -> $1\n```\n").Equal(t, got)
+	})
+}
+
+func TestRenderInsightSeries(t *testing.T) {
+	capture := "v1|v2"
+	tests := []struct {
+		name   string
+		series *InsightSeries
+		want   autogold.Value
+	}{
+		{
+			name:   "no points",
+			series: &InsightSeries{InsightTitle: "Migration", SeriesLabel: "Old API"},
+			want:   autogold.Expect("**Migration: Old API**\n\n_No data has been recorded for this series yet._"),
+		},
+		{
+			name: "points",
+			series: &InsightSeries{InsightTitle: "Migration", SeriesLabel: "Old API", Points: []InsightSeriesPoint{
+				{Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Value: 12},
+				{Time: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), Value: 7.5},
+			}},
+			want: autogold.Expect("**Migration: Old API**\n\n| Date | Value |\n| --- | ---: |\n| 2023-05-01 | 12 |\n| 2023-06-01 | 7.5 |\n"),
+		},
+		{
+			name: "captured values",
+			series: &InsightSeries{InsightTitle: "Versions", SeriesLabel: "Go", Points: []InsightSeriesPoint{
+				{Time: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), Value: 3, Capture: &capture},
+			}},
+			want: autogold.Expect("**Versions: Go**\n\n| Date | Capture | Value |\n| --- | --- | ---: |\n| 2023-05-01 | v1\\|v2 | 3 |\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Equal(t, renderInsightSeries(tt.series))
+		})
+	}
+}
+
+func TestFileLineParameter(t *testing.T) {
+	tests := []struct {
+		lineRange *LineRange
+		want      string
+	}{
+		{lineRange: nil, want: ""},
+		{lineRange: &LineRange{StartLine: 4, EndLine: 5}, want: "L5"},
+		{lineRange: &LineRange{StartLine: 4, EndLine: 10}, want: "L5-10"},
+	}
+	for _, tt := range tests {
+		if got := fileLineParameter(tt.lineRange); got != tt.want {
+			t.Errorf("fileLineParameter(%v) = %q, want %q", tt.lineRange, got, tt.want)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 var ErrNotebookNotFound = errors.New("notebook not found")
 var ErrNotebookStarNotFound = errors.New("notebook star not found")
+var ErrNotebookRevisionNotFound = errors.New("notebook revision not found")
 
 type NotebooksOrderByOption uint8
 
@@ -40,6 +41,11 @@ type ListNotebookStarsPageOptions struct {
 	After int64
 }
 
+type ListNotebookRevisionsPageOptions struct {
+	First int32
+	After int64
+}
+
 type ListNotebooksOptions struct {
 	Query             string
 	CreatorUserID     int32
@@ -81,6 +87,11 @@ type NotebooksStore interface {
 	DeleteNotebookStar(ctx context.Context, notebookID int64, userID int32) error
 	ListNotebookStars(ctx context.Context, pageOpts ListNotebookStarsPageOptions, notebookID int64) ([]*NotebookStar, error)
 	CountNotebookStars(ctx context.Context, notebookID int64) (int64, error)
+
+	GetNotebookRevision(ctx context.Context, revisionID int64) (*NotebookRevision, error)
+	GetPreviousNotebookRevision(ctx context.Context, revision *NotebookRevision) (*NotebookRevision, error)
+	ListNotebookRevisions(ctx context.Context, pageOpts ListNotebookRevisionsPageOptions, notebookID int64) ([]*NotebookRevision, error)
+	CountNotebookRevisions(ctx context.Context, notebookID int64) (int64, error)
 }
 
 type notebooksStore struct {
@@ -337,12 +348,18 @@ INSERT INTO notebooks (title, blocks, public, creator_user_id, updater_user_id,
 RETURNING %s
 `
 
-func (s *notebooksStore) CreateNotebook(ctx context.Context, n *Notebook) (*Notebook, error) {
-	err := validateNotebookBlocks(n.Blocks)
+func (s *notebooksStore) CreateNotebook(ctx context.Context, n *Notebook) (_ *Notebook, err error) {
+	err = validateNotebookBlocks(n.Blocks)
 	if err != nil {
 		return nil, err
 	}
-	row := s.QueryRow(
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	row := tx.QueryRow(
 		ctx,
 		sqlf.Sprintf(
 			insertNotebookFmtStr,
@@ -356,7 +373,14 @@ func (s *notebooksStore) CreateNotebook(ctx context.Context, n *Notebook) (*Note
 			sqlf.Join(notebookColumns, ","),
 		),
 	)
-	return scanNotebook(row)
+	created, err := scanNotebook(row)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.createNotebookRevision(ctx, created.ID); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 const deleteNotebookFmtStr = `DELETE FROM notebooks WHERE id = %d`
@@ -381,12 +405,18 @@ RETURNING %s
 `
 
 // 🚨 SECURITY: The caller must ensure that the actor has permission to update the notebook.
-func (s *notebooksStore) UpdateNotebook(ctx context.Context, n *Notebook) (*Notebook, error) {
-	err := validateNotebookBlocks(n.Blocks)
+func (s *notebooksStore) UpdateNotebook(ctx context.Context, n *Notebook) (_ *Notebook, err error) {
+	err = validateNotebookBlocks(n.Blocks)
 	if err != nil {
 		return nil, err
 	}
-	row := s.QueryRow(
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	row := tx.QueryRow(
 		ctx,
 		sqlf.Sprintf(
 			updateNotebookFmtStr,
@@ -400,7 +430,39 @@ func (s *notebooksStore) UpdateNotebook(ctx context.Context, n *Notebook) (*Note
 			sqlf.Join(notebookColumns, ","),
 		),
 	)
-	return scanNotebook(row)
+	updated, err := scanNotebook(row)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.createNotebookRevision(ctx, updated.ID); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// insertNotebookRevisionFmtStr snapshots the current title and blocks of a notebook, unless they
+// are unchanged since the latest revision (e.g. when only the visibility of the notebook changed).
+const insertNotebookRevisionFmtStr = `
+INSERT INTO notebook_revisions (notebook_id, title, blocks, author_user_id)
+SELECT n.id, n.title, n.blocks, n.updater_user_id
+FROM notebooks n
+WHERE
+	n.id = %d
+	AND NOT EXISTS (
+		SELECT 1
+		FROM (
+			SELECT title, blocks
+			FROM notebook_revisions
+			WHERE notebook_id = n.id
+			ORDER BY id DESC
+			LIMIT 1
+		) latest
+		WHERE latest.title = n.title AND latest.blocks = n.blocks
+	)
+`
+
+func (s *notebooksStore) createNotebookRevision(ctx context.Context, notebookID int64) error {
+	return s.Exec(ctx, sqlf.Sprintf(insertNotebookRevisionFmtStr, notebookID))
 }
 
 func scanNotebookStar(scanner dbutil.Scanner) (*NotebookStar, error) {
@@ -479,3 +541,88 @@ func (s *notebooksStore) CountNotebookStars(ctx context.Context, notebookID int6
 	}
 	return count, nil
 }
+
+const notebookRevisionColumnsFmtStr = `id, notebook_id, title, blocks, author_user_id, created_at`
+
+func scanNotebookRevision(scanner dbutil.Scanner) (*NotebookRevision, error) {
+	r := &NotebookRevision{}
+	err := scanner.Scan(&r.ID, &r.NotebookID, &r.Title, &r.Blocks, &dbutil.NullInt32{N: &r.AuthorUserID}, &r.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *notebooksStore) getNotebookRevision(ctx context.Context, query *sqlf.Query) (*NotebookRevision, error) {
+	revision, err := scanNotebookRevision(s.QueryRow(ctx, query))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotebookRevisionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+const getNotebookRevisionFmtStr = `SELECT ` + notebookRevisionColumnsFmtStr + ` FROM notebook_revisions WHERE id = %d`
+
+// 🚨 SECURITY: The caller must ensure that the actor has permission to access the notebook of the revision.
+func (s *notebooksStore) GetNotebookRevision(ctx context.Context, id int64) (*NotebookRevision, error) {
+	return s.getNotebookRevision(ctx, sqlf.Sprintf(getNotebookRevisionFmtStr, id))
+}
+
+const getPreviousNotebookRevisionFmtStr = `
+SELECT ` + notebookRevisionColumnsFmtStr + `
+FROM notebook_revisions
+WHERE notebook_id = %d AND id < %d
+ORDER BY id DESC
+LIMIT 1
+`
+
+// GetPreviousNotebookRevision returns the revision of the same notebook that precedes the given
+// revision, or ErrNotebookRevisionNotFound if it is the first revision.
+//
+// 🚨 SECURITY: The caller must ensure that the actor has permission to access the notebook of the revision.
+func (s *notebooksStore) GetPreviousNotebookRevision(ctx context.Context, revision *NotebookRevision) (*NotebookRevision, error) {
+	return s.getNotebookRevision(ctx, sqlf.Sprintf(getPreviousNotebookRevisionFmtStr, revision.NotebookID, revision.ID))
+}
+
+const listNotebookRevisionsFmtStr = `
+SELECT ` + notebookRevisionColumnsFmtStr + `
+FROM notebook_revisions
+WHERE notebook_id = %d
+ORDER BY id DESC
+LIMIT %d
+OFFSET %d
+`
+
+// ListNotebookRevisions returns the revisions of a notebook, latest first.
+//
+// 🚨 SECURITY: The caller must ensure that the actor has permission to access the notebook.
+func (s *notebooksStore) ListNotebookRevisions(ctx context.Context, pageOpts ListNotebookRevisionsPageOptions, notebookID int64) ([]*NotebookRevision, error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(listNotebookRevisionsFmtStr, notebookID, pageOpts.First, pageOpts.After))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var revisions []*NotebookRevision
+	for rows.Next() {
+		revision, err := scanNotebookRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, rows.Err()
+}
+
+const countNotebookRevisionsFmtStr = `SELECT COUNT(*) FROM notebook_revisions WHERE notebook_id = %d`
+
+// 🚨 SECURITY: The caller must ensure that the actor has permission to access the notebook.
+func (s *notebooksStore) CountNotebookRevisions(ctx context.Context, notebookID int64) (int64, error) {
+	var count int64
+	err := s.QueryRow(ctx, sqlf.Sprintf(countNotebookRevisionsFmtStr, notebookID)).Scan(&count)
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
+}
@@ -679,3 +679,94 @@ func TestCreatingAndDeletingNotebookStars(t *testing.T) {
 		t.Errorf("expected non-nil error, got nil")
 	}
 }
+
+func TestNotebookRevisions(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	internalCtx := actor.WithInternalActor(context.Background())
+	u := db.Users()
+	n := Notebooks(db)
+
+	user1, err := u.Create(internalCtx, database.NewUser{Username: "u1", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	user2, err := u.Create(internalCtx, database.NewUser{Username: "u2", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	blocks := NotebookBlocks{{ID: "1", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a b"}}}
+	notebook, err := n.CreateNotebook(internalCtx, notebookByUser(&Notebook{Title: "Notebook1", Blocks: blocks, Public: true}, user1.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Changing only the visibility of the notebook does not create a revision.
+	notebook.Public = false
+	notebook, err = n.UpdateNotebook(internalCtx, notebook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notebook.Blocks = NotebookBlocks{{ID: "2", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Title"}}}
+	notebook.UpdaterUserID = user2.ID
+	notebook, err = n.UpdateNotebook(internalCtx, notebook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, err := n.ListNotebookRevisions(internalCtx, ListNotebookRevisionsPageOptions{First: 10}, notebook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("wanted 2 revisions, got %d", len(revisions))
+	}
+	latest, first := revisions[0], revisions[1]
+	if latest.AuthorUserID != user2.ID || !reflect.DeepEqual(notebook.Blocks, latest.Blocks) {
+		t.Fatalf("unexpected latest revision %+v", latest)
+	}
+	if first.AuthorUserID != user1.ID || !reflect.DeepEqual(blocks, first.Blocks) || first.Title != "Notebook1" {
+		t.Fatalf("unexpected first revision %+v", first)
+	}
+
+	count, err := n.CountNotebookRevisions(internalCtx, notebook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("wanted 2 revisions count, got %d", count)
+	}
+
+	gotRevision, err := n.GetNotebookRevision(internalCtx, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, gotRevision) {
+		t.Fatalf("wanted %+v revision, got %+v", first, gotRevision)
+	}
+
+	previous, err := n.GetPreviousNotebookRevision(internalCtx, latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, previous) {
+		t.Fatalf("wanted %+v previous revision, got %+v", first, previous)
+	}
+	_, err = n.GetPreviousNotebookRevision(internalCtx, first)
+	if !errors.Is(err, ErrNotebookRevisionNotFound) {
+		t.Fatalf("expected ErrNotebookRevisionNotFound, got %v", err)
+	}
+
+	// Revisions are deleted with the notebook.
+	if err := n.DeleteNotebook(internalCtx, notebook.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = n.GetNotebookRevision(internalCtx, first.ID)
+	if !errors.Is(err, ErrNotebookRevisionNotFound) {
+		t.Fatalf("expected ErrNotebookRevisionNotFound, got %v", err)
+	}
+}
@@ -11,6 +11,10 @@ const (
 	NotebookMarkdownBlockType NotebookBlockType = "md"
 	NotebookFileBlockType     NotebookBlockType = "file"
 	NotebookSymbolBlockType   NotebookBlockType = "symbol"
+
+	NotebookReferencesBlockType NotebookBlockType = "references"
+	NotebookInsightBlockType    NotebookBlockType = "insight"
+	NotebookComputeBlockType    NotebookBlockType = "compute"
 )
 
 type NotebookQueryBlockInput struct {
@@ -46,6 +50,37 @@ type NotebookSymbolBlockInput struct {
 	SymbolKind          string  `json:"symbolKind"`
 }
 
+// NotebookReferencesBlockInput identifies the symbol at a position in a file, whose references
+// are listed by the block.
+type NotebookReferencesBlockInput struct {
+	RepositoryName string  `json:"repositoryName"`
+	FilePath       string  `json:"filePath"`
+	Revision       *string `json:"revision,omitempty"`
+
+	// Line is the 0-based line of the symbol.
+	Line int32 `json:"line"`
+
+	// Character is the 0-based character offset of the symbol within the line.
+	Character int32 `json:"character"`
+
+	// SymbolName is the name of the symbol, used to label the block.
+	SymbolName string `json:"symbolName"`
+}
+
+// NotebookInsightBlockInput identifies a series of a code insight that is embedded in the block.
+type NotebookInsightBlockInput struct {
+	// InsightViewID is the GraphQL ID of the insight view.
+	InsightViewID string `json:"insightViewId"`
+
+	// SeriesID is the series ID of the series within the insight view.
+	SeriesID string `json:"seriesId"`
+}
+
+type NotebookComputeBlockInput struct {
+	// Text is a compute expression, e.g. "content:output(...)".
+	Text string `json:"text"`
+}
+
 type NotebookBlock struct {
 	ID            string                      `json:"id"`
 	Type          NotebookBlockType           `json:"type"`
@@ -53,6 +88,10 @@ type NotebookBlock struct {
 	MarkdownInput *NotebookMarkdownBlockInput `json:"markdownInput,omitempty"`
 	FileInput     *NotebookFileBlockInput     `json:"fileInput,omitempty"`
 	SymbolInput   *NotebookSymbolBlockInput   `json:"symbolInput,omitempty"`
+
+	ReferencesInput *NotebookReferencesBlockInput `json:"referencesInput,omitempty"`
+	InsightInput    *NotebookInsightBlockInput    `json:"insightInput,omitempty"`
+	ComputeInput    *NotebookComputeBlockInput    `json:"computeInput,omitempty"`
 }
 
 type NotebookBlocks []NotebookBlock
@@ -75,3 +114,14 @@ type NotebookStar struct {
 	UserID     int32
 	CreatedAt  time.Time
 }
+
+// NotebookRevision is a snapshot of the title and blocks of a notebook, created when the notebook
+// is saved.
+type NotebookRevision struct {
+	ID           int64
+	NotebookID   int64
+	Title        string
+	Blocks       NotebookBlocks
+	AuthorUserID int32 // zero if the author was removed
+	CreatedAt    time.Time
+}
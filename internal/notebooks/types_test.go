@@ -12,6 +12,8 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 	markdownBlockInput := NotebookMarkdownBlockInput{Text: "# Title"}
 	revision := "main"
 	fileBlockInput := NotebookFileBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}}
+	referencesBlockInput := NotebookReferencesBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Line: 4, Character: 5, SymbolName: "f"}
+	insightBlockInput := NotebookInsightBlockInput{InsightViewID: "aW5zaWdodA==", SeriesID: "s"}
 
 	tests := []struct {
 		block NotebookBlock
@@ -29,6 +31,14 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 			block: NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &fileBlockInput},
 			want:  autogold.Expect(`{"id":"id1","type":"file","fileInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.ts","revision":"main","lineRange":{"startLine":1,"endLine":10}}}`),
 		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &referencesBlockInput},
+			want:  autogold.Expect(`{"id":"id1","type":"references","referencesInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","line":4,"character":5,"symbolName":"f"}}`),
+		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookInsightBlockType, InsightInput: &insightBlockInput},
+			want:  autogold.Expect(`{"id":"id1","type":"insight","insightInput":{"insightViewId":"aW5zaWdodA==","seriesId":"s"}}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1,12 +1,18 @@
 package notebooks
 
-import "github.com/sourcegraph/sourcegraph/lib/errors"
+import (
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
 
 func validateNotebookBlock(block NotebookBlock) error {
 	if block.Type != NotebookQueryBlockType &&
 		block.Type != NotebookMarkdownBlockType &&
 		block.Type != NotebookFileBlockType &&
-		block.Type != NotebookSymbolBlockType {
+		block.Type != NotebookSymbolBlockType &&
+		block.Type != NotebookReferencesBlockType &&
+		block.Type != NotebookInsightBlockType &&
+		block.Type != NotebookComputeBlockType {
 		return errors.Errorf("invalid block type: %s", string(block.Type))
 	}
 
@@ -18,12 +24,37 @@ func validateNotebookBlock(block NotebookBlock) error {
 		return errors.Errorf("invalid file block with id: %s", block.ID)
 	} else if block.Type == NotebookSymbolBlockType && block.SymbolInput == nil {
 		return errors.Errorf("invalid symbol block with id: %s", block.ID)
+	} else if block.Type == NotebookReferencesBlockType && block.ReferencesInput == nil {
+		return errors.Errorf("invalid references block with id: %s", block.ID)
+	} else if block.Type == NotebookInsightBlockType && block.InsightInput == nil {
+		return errors.Errorf("invalid insight block with id: %s", block.ID)
+	} else if block.Type == NotebookComputeBlockType && block.ComputeInput == nil {
+		return errors.Errorf("invalid compute block with id: %s", block.ID)
 	}
 
 	if block.Type == NotebookSymbolBlockType && block.SymbolInput != nil && block.SymbolInput.LineContext < 0 {
 		return errors.Errorf("symbol block line context cannot be negative, block id: %s", block.ID)
 	}
 
+	switch block.Type {
+	case NotebookReferencesBlockType:
+		input := block.ReferencesInput
+		if input.RepositoryName == "" || input.FilePath == "" {
+			return errors.Errorf("references block requires a repository and a file path, block id: %s", block.ID)
+		}
+		if input.Line < 0 || input.Character < 0 {
+			return errors.Errorf("references block position cannot be negative, block id: %s", block.ID)
+		}
+	case NotebookInsightBlockType:
+		if block.InsightInput.InsightViewID == "" || block.InsightInput.SeriesID == "" {
+			return errors.Errorf("insight block requires an insight view and a series, block id: %s", block.ID)
+		}
+	case NotebookComputeBlockType:
+		if _, err := compute.Parse(block.ComputeInput.Text); err != nil {
+			return errors.Wrapf(err, "invalid compute expression in block with id: %s", block.ID)
+		}
+	}
+
 	return nil
 }
 
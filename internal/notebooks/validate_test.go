@@ -22,6 +22,18 @@ func TestNotebookBlocksValidation(t *testing.T) {
 		{blocks: NotebookBlocks{
 			{ID: "id1", SymbolInput: &NotebookSymbolBlockInput{LineContext: -10}, Type: NotebookSymbolBlockType},
 		}, wantErr: "symbol block line context cannot be negative, block id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookReferencesBlockType}}, wantErr: "invalid references block with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookInsightBlockType}}, wantErr: "invalid insight block with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookComputeBlockType}}, wantErr: "invalid compute block with id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{FilePath: "a.go"}},
+		}, wantErr: "references block requires a repository and a file path, block id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{RepositoryName: "a", FilePath: "a.go", Line: -1}},
+		}, wantErr: "references block position cannot be negative, block id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookInsightBlockType, InsightInput: &NotebookInsightBlockInput{InsightViewID: "aW5zaWdodA=="}},
+		}, wantErr: "insight block requires an insight view and a series, block id: id1"},
 	}
 
 	for _, tt := range tests {
@@ -33,3 +45,14 @@ func TestNotebookBlocksValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestValidNotebookBlocks(t *testing.T) {
+	blocks := NotebookBlocks{
+		{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{RepositoryName: "a", FilePath: "a.go", Line: 1, Character: 2, SymbolName: "f"}},
+		{ID: "id2", Type: NotebookInsightBlockType, InsightInput: &NotebookInsightBlockInput{InsightViewID: "aW5zaWdodA==", SeriesID: "s"}},
+		{ID: "id3", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{Text: "content:output(func (\\w+) -> $1)"}},
+	}
+	if err := validateNotebookBlocks(blocks); err != nil {
+		t.Fatalf("expected no error, got '%s'", err.Error())
+	}
+}
@@ -191,6 +191,8 @@ func (h *handler) Handle(ctx context.Context, lgr log.Logger, record *Job) error
 	switch record.ConfigName {
 	case types.SignalRecentContributors:
 		delegate = handleRecentContributors
+	case types.SignalRecentReviewers:
+		delegate = handleRecentReviewers
 	case types.Analytics:
 		delegate = handleAnalytics
 	default:
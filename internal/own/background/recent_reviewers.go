@@ -0,0 +1,364 @@
+package background
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	ghauth "github.com/sourcegraph/sourcegraph/internal/extsvc/github/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// recentReviewsWindow is how far back merged changesets are considered
+// for the recent reviewers signal.
+const recentReviewsWindow = 90 * 24 * time.Hour
+
+// maxIndexedChangesets bounds the number of merged changesets fetched from the
+// code host for a single repository, in order to bound API usage for very
+// active repositories.
+const maxIndexedChangesets = 1000
+
+func handleRecentReviewers(ctx context.Context, lgr logger.Logger, repoId api.RepoID, db database.DB, subRepoPermsCache *rcache.Cache) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user, and needs
+	// to see all repos and files
+	internalCtx := actor.WithInternalActor(ctx)
+
+	indexer := newRecentReviewersIndexer(newCodeHostReviewSource, db, lgr, subRepoPermsCache)
+	return indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+}
+
+// approvedChangeset is a changeset (pull request, merge request) merged on the code host,
+// along with the reviewers that approved it.
+type approvedChangeset struct {
+	approvers    []reviewer
+	filesChanged []string
+}
+
+type reviewer struct {
+	handle string
+	email  string
+}
+
+// reviewSource lists the approvals given on changesets merged into a single repository.
+type reviewSource interface {
+	// approvedChangesets returns changesets merged since given time, along with their approvers.
+	approvedChangesets(ctx context.Context, since time.Time) ([]approvedChangeset, error)
+}
+
+var errUnsupportedCodeHost = errors.New("code host does not support recent reviewers")
+
+// reviewSourceFactory returns the review source for the code host the given repository
+// is synced from. If the code host is not supported, errUnsupportedCodeHost is returned.
+type reviewSourceFactory func(ctx context.Context, db database.DB, repo *types.Repo) (reviewSource, error)
+
+type recentReviewersIndexer struct {
+	newSource         reviewSourceFactory
+	db                database.DB
+	logger            logger.Logger
+	subRepoPermsCache rcache.Cache
+}
+
+func newRecentReviewersIndexer(newSource reviewSourceFactory, db database.DB, lgr logger.Logger, subRepoPermsCache *rcache.Cache) *recentReviewersIndexer {
+	return &recentReviewersIndexer{newSource: newSource, db: db, logger: lgr, subRepoPermsCache: *subRepoPermsCache}
+}
+
+var reviewCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_recent_reviewers_reviews_indexed_total",
+})
+
+func (r *recentReviewersIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing.
+	isSubRepoPermsRepo, err := isSubRepoPermsRepo(ctx, repoId, r.subRepoPermsCache, checker)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own reviewer signal due to the repo having subrepo perms enabled", logger.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+	source, err := r.newSource(ctx, r.db, repo)
+	if errors.Is(err, errUnsupportedCodeHost) {
+		r.logger.Debug("skipping own reviewer signal for unsupported code host", logger.Int32("repoID", int32(repoId)), logger.String("serviceType", repo.ExternalRepo.ServiceType))
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "newSource")
+	}
+	changesets, err := source.approvedChangesets(ctx, time.Now().Add(-recentReviewsWindow))
+	if err != nil {
+		return errors.Wrap(err, "approvedChangesets")
+	}
+
+	var reviewCount int
+	err = r.db.RecentReviewSignals().WithTransact(ctx, func(store database.RecentReviewSignalStore) error {
+		if err := store.ClearSignals(ctx, repoId); err != nil {
+			return errors.Wrap(err, "ClearSignals")
+		}
+		for _, cs := range changesets {
+			for _, approver := range cs.approvers {
+				err := store.AddReview(ctx, database.Review{
+					RepoID:         repoId,
+					ReviewerHandle: approver.handle,
+					ReviewerEmail:  approver.email,
+					FilesChanged:   cs.filesChanged,
+				})
+				if err != nil {
+					return errors.Wrapf(err, "AddReview %v", approver)
+				}
+				reviewCount++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.logger.Info("reviews inserted", logger.Int("count", reviewCount), logger.Int("repo_id", int(repoId)))
+	reviewCounter.Add(float64(reviewCount))
+	return nil
+}
+
+// newCodeHostReviewSource is a reviewSourceFactory that lists reviews through
+// the code host API clients, authenticated with the code host connection
+// the repository is synced from.
+func newCodeHostReviewSource(ctx context.Context, db database.DB, repo *types.Repo) (reviewSource, error) {
+	switch repo.ExternalRepo.ServiceType {
+	case extsvc.TypeGitHub:
+		meta, ok := repo.Metadata.(*github.Repository)
+		if !ok {
+			return nil, errors.Errorf("unexpected metadata %T for GitHub repository", repo.Metadata)
+		}
+		owner, name, err := github.SplitRepositoryNameWithOwner(meta.NameWithOwner)
+		if err != nil {
+			return nil, err
+		}
+
+		svc, cfg, err := repoExternalService(ctx, db, repo, extsvc.KindGitHub)
+		if err != nil {
+			return nil, err
+		}
+		conn := cfg.(*schema.GitHubConnection)
+		baseURL, err := url.Parse(conn.Url)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing GitHub URL")
+		}
+		apiURL, _ := github.APIRoot(baseURL)
+		auther, err := ghauth.FromConnection(ctx, conn, db.GitHubApps(), keyring.Default().GitHubAppKey)
+		if err != nil {
+			return nil, err
+		}
+
+		client := github.NewV4Client(svc.URN(), apiURL, auther, httpcli.ExternalDoer)
+		return &gitHubReviewSource{client: client, owner: owner, name: name}, nil
+
+	case extsvc.TypeGitLab:
+		project, ok := repo.Metadata.(*gitlab.Project)
+		if !ok {
+			return nil, errors.Errorf("unexpected metadata %T for GitLab repository", repo.Metadata)
+		}
+
+		svc, cfg, err := repoExternalService(ctx, db, repo, extsvc.KindGitLab)
+		if err != nil {
+			return nil, err
+		}
+		conn := cfg.(*schema.GitLabConnection)
+		baseURL, err := url.Parse(conn.Url)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing GitLab URL")
+		}
+
+		client := gitlab.NewClientProvider(svc.URN(), baseURL, httpcli.ExternalDoer).GetPATClient(conn.Token, "")
+		return &gitLabReviewSource{client: client, project: project}, nil
+
+	case extsvc.TypeBitbucketServer:
+		bbsRepo, ok := repo.Metadata.(*bitbucketserver.Repo)
+		if !ok {
+			return nil, errors.Errorf("unexpected metadata %T for Bitbucket Server repository", repo.Metadata)
+		}
+
+		svc, cfg, err := repoExternalService(ctx, db, repo, extsvc.KindBitbucketServer)
+		if err != nil {
+			return nil, err
+		}
+		client, err := bitbucketserver.NewClient(svc.URN(), cfg.(*schema.BitbucketServerConnection), httpcli.ExternalDoer)
+		if err != nil {
+			return nil, err
+		}
+		return &bitbucketServerReviewSource{client: client, repo: bbsRepo}, nil
+
+	default:
+		return nil, errUnsupportedCodeHost
+	}
+}
+
+// repoExternalService returns the first code host connection of the given kind that the
+// repository is synced from, along with its parsed configuration.
+func repoExternalService(ctx context.Context, db database.DB, repo *types.Repo, kind string) (*types.ExternalService, any, error) {
+	for _, id := range repo.ExternalServiceIDs() {
+		svc, err := db.ExternalServices().GetByID(ctx, id)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "getting code host connection")
+		}
+		if svc.Kind != kind {
+			continue
+		}
+		cfg, err := extsvc.ParseEncryptableConfig(ctx, svc.Kind, svc.Config)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "parsing code host connection config")
+		}
+		return svc, cfg, nil
+	}
+	return nil, nil, errors.Errorf("no %s code host connection found for repository %s", kind, repo.Name)
+}
+
+type gitHubReviewSource struct {
+	client      *github.V4Client
+	owner, name string
+}
+
+func (s *gitHubReviewSource) approvedChangesets(ctx context.Context, since time.Time) ([]approvedChangeset, error) {
+	var changesets []approvedChangeset
+	params := &github.MergedPullRequestsParams{Owner: s.owner, Name: s.name}
+	for seen := 0; seen < maxIndexedChangesets; {
+		results, err := s.client.MergedPullRequests(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range results.Nodes {
+			seen++
+			// Pull requests are ordered by last update, and a pull request
+			// is always updated when it is merged.
+			if pr.UpdatedAt.Before(since) {
+				return changesets, nil
+			}
+			if pr.MergedAt.Before(since) {
+				continue
+			}
+			var cs approvedChangeset
+			for _, review := range pr.Reviews.Nodes {
+				if review.Author.Login != "" {
+					cs.approvers = append(cs.approvers, reviewer{handle: review.Author.Login, email: review.Author.Email})
+				}
+			}
+			for _, file := range pr.Files.Nodes {
+				cs.filesChanged = append(cs.filesChanged, file.Path)
+			}
+			if len(cs.approvers) > 0 {
+				changesets = append(changesets, cs)
+			}
+		}
+		if !results.PageInfo.HasNextPage {
+			break
+		}
+		params.After = results.PageInfo.EndCursor
+	}
+	return changesets, nil
+}
+
+type gitLabReviewSource struct {
+	client  *gitlab.Client
+	project *gitlab.Project
+}
+
+func (s *gitLabReviewSource) approvedChangesets(ctx context.Context, since time.Time) ([]approvedChangeset, error) {
+	var changesets []approvedChangeset
+	next := s.client.ListMergedMergeRequests(ctx, s.project, since)
+	for seen := 0; seen < maxIndexedChangesets; {
+		page, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, mr := range page {
+			seen++
+			if mr.MergedAt == nil || mr.MergedAt.Before(since) {
+				continue
+			}
+			approvers, err := s.client.GetMergeRequestApprovers(ctx, s.project, mr.IID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "GetMergeRequestApprovers !%d", mr.IID)
+			}
+			if len(approvers) == 0 {
+				continue
+			}
+			files, err := s.client.GetMergeRequestChangedFiles(ctx, s.project, mr.IID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "GetMergeRequestChangedFiles !%d", mr.IID)
+			}
+			cs := approvedChangeset{filesChanged: files}
+			for _, a := range approvers {
+				cs.approvers = append(cs.approvers, reviewer{handle: a.Username, email: a.Email})
+			}
+			changesets = append(changesets, cs)
+		}
+	}
+	return changesets, nil
+}
+
+type bitbucketServerReviewSource struct {
+	client *bitbucketserver.Client
+	repo   *bitbucketserver.Repo
+}
+
+func (s *bitbucketServerReviewSource) approvedChangesets(ctx context.Context, since time.Time) ([]approvedChangeset, error) {
+	var changesets []approvedChangeset
+	sinceMillis := int(since.UnixMilli())
+	token := &bitbucketserver.PageToken{Limit: 100}
+	for seen := 0; seen < maxIndexedChangesets && token.HasMore(); {
+		var (
+			prs []*bitbucketserver.PullRequest
+			err error
+		)
+		prs, token, err = s.client.MergedPullRequests(ctx, s.repo, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			seen++
+			// Bitbucket Server does not report when a pull request was merged,
+			// but merging is the last update of a merged pull request.
+			if pr.UpdatedDate < sinceMillis {
+				return changesets, nil
+			}
+			var cs approvedChangeset
+			for _, r := range pr.Reviewers {
+				if r.Approved && r.User != nil {
+					cs.approvers = append(cs.approvers, reviewer{handle: r.User.Name, email: r.User.EmailAddress})
+				}
+			}
+			if len(cs.approvers) == 0 {
+				continue
+			}
+			if cs.filesChanged, err = s.client.PullRequestChangedFiles(ctx, pr); err != nil {
+				return nil, errors.Wrapf(err, "PullRequestChangedFiles #%d", pr.ID)
+			}
+			changesets = append(changesets, cs)
+		}
+	}
+	return changesets, nil
+}
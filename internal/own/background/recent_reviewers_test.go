@@ -0,0 +1,136 @@
+package background
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeReviewSource []approvedChangeset
+
+func (s fakeReviewSource) approvedChangesets(context.Context, time.Time) ([]approvedChangeset, error) {
+	return s, nil
+}
+
+func fakeReviewSourceFactory(source reviewSource, err error) reviewSourceFactory {
+	return func(context.Context, database.DB, *types.Repo) (reviewSource, error) {
+		return source, err
+	}
+}
+
+func Test_RecentReviewersIndex(t *testing.T) {
+	rcache.SetupForTest(t)
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	ctx := context.Background()
+
+	err := db.Repos().Create(ctx, &types.Repo{
+		ID:   1,
+		Name: "own/repo1",
+	})
+	require.NoError(t, err)
+
+	source := fakeReviewSource{
+		{
+			approvers:    []reviewer{{handle: "alice", email: "alice@example.com"}, {handle: "bob"}},
+			filesChanged: []string{"file1.txt", "dir/file2.txt"},
+		},
+		{
+			approvers:    []reviewer{{handle: "alice", email: "alice@example.com"}},
+			filesChanged: []string{"dir/file2.txt", "dir/subdir/file.txt"},
+		},
+	}
+	indexer := newRecentReviewersIndexer(fakeReviewSourceFactory(source, nil), db, logger, rcache.New("testing_own_signals"))
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	// Indexing twice makes sure previous signals are cleared.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, indexer.indexRepo(ctx, api.RepoID(1), checker))
+	}
+
+	for p, w := range map[string][]database.RecentReviewerSummary{
+		"dir": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 2},
+			{ReviewerHandle: "bob", ReviewCount: 1},
+		},
+		"file1.txt": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 1},
+			{ReviewerHandle: "bob", ReviewCount: 1},
+		},
+		"dir/subdir/file.txt": {
+			{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 1},
+		},
+	} {
+		path := p
+		want := w
+		t.Run(path, func(t *testing.T) {
+			got, err := db.RecentReviewSignals().FindRecentReviewers(ctx, 1, path)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func Test_RecentReviewersIndexSkipsUnsupportedCodeHosts(t *testing.T) {
+	rcache.SetupForTest(t)
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	ctx := context.Background()
+
+	err := db.Repos().Create(ctx, &types.Repo{
+		ID:   1,
+		Name: "own/repo1",
+	})
+	require.NoError(t, err)
+
+	indexer := newRecentReviewersIndexer(fakeReviewSourceFactory(nil, errUnsupportedCodeHost), db, logger, rcache.New("testing_own_signals"))
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	require.NoError(t, indexer.indexRepo(ctx, api.RepoID(1), checker))
+}
+
+func Test_RecentReviewersIndexSkipsSubrepoPermsRepos(t *testing.T) {
+	rcache.SetupForTest(t)
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	ctx := context.Background()
+
+	err := db.Repos().Create(ctx, &types.Repo{
+		ID:   1,
+		Name: "own/repo1",
+	})
+	require.NoError(t, err)
+
+	source := fakeReviewSource{
+		{
+			approvers:    []reviewer{{handle: "alice"}},
+			filesChanged: []string{"file1.txt"},
+		},
+	}
+	indexer := newRecentReviewersIndexer(fakeReviewSourceFactory(source, nil), db, logger, rcache.New("testing_own_signals"))
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(true, nil)
+	require.NoError(t, indexer.indexRepo(ctx, api.RepoID(1), checker))
+
+	got, err := db.RecentReviewSignals().FindRecentReviewers(ctx, 1, "")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -43,6 +43,7 @@ func TestOwnRepoIndexSchedulerJob_JobsAutoIndex(t *testing.T) {
 
 	wantJobCountByName := map[string]int{
 		types.SignalRecentContributors: 3,
+		types.SignalRecentReviewers:    0, // Repos are already queued for recent contributors
 		types.Analytics:                0, // Turned off by default
 	}
 
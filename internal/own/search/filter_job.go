@@ -7,6 +7,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/own"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -37,6 +38,12 @@ func (s *fileHasOwnersJob) Run(ctx context.Context, clients job.RuntimeClients,
 	var maxAlerter search.MaxAlerter
 
 	rules := NewRulesCache(clients.Gitserver, clients.DB)
+	// Reviewers that recently approved changes to a file are considered
+	// its owners as long as the recent reviewers signal is enabled.
+	rules.includeRecentReviewers, err = clients.DB.OwnSignalConfigurations().IsEnabled(ctx, owntypes.SignalRecentReviewers)
+	if err != nil {
+		return nil, errors.Wrap(err, "IsEnabled")
+	}
 
 	// Semantics of multiple values in includeOwners and excludeOwners is that all
 	// need to match for ownership. Therefore we create a single bag per entry.
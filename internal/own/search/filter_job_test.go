@@ -23,6 +23,8 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 		excludeOwners []string
 		matches       []result.Match
 		repoContent   map[string]string
+		// recentReviewers mimics the recent reviewers signal being enabled.
+		recentReviewers bool
 	}
 	tests := []struct {
 		name  string
@@ -532,6 +534,50 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "selects results recently reviewed by owner",
+			args: args{
+				includeOwners: []string{"@reviewer"},
+				excludeOwners: []string{},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "src/main.go",
+						},
+					},
+					&result.FileMatch{
+						File: result.File{
+							Path: "README.md",
+						},
+					},
+				},
+				recentReviewers: true,
+			},
+			setup: recentReviewerSetup("src/main.go", "reviewer"),
+			want: autogold.Expect([]result.Match{
+				&result.FileMatch{
+					File: result.File{
+						Path: "src/main.go",
+					},
+				},
+			}),
+		},
+		{
+			name: "ignores recent reviewers if the signal is disabled",
+			args: args{
+				includeOwners: []string{"@reviewer"},
+				excludeOwners: []string{},
+				matches: []result.Match{
+					&result.FileMatch{
+						File: result.File{
+							Path: "src/main.go",
+						},
+					},
+				},
+			},
+			setup: recentReviewerSetup("src/main.go", "reviewer"),
+			want:  autogold.Expect([]result.Match{}),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -576,6 +622,7 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 
 			// TODO(#52450): Invoke filterHasOwnersJob.Run rather than duplicate code here.
 			rules := NewRulesCache(gitserverClient, db)
+			rules.includeRecentReviewers = tt.args.recentReviewers
 
 			var includeBags []own.Bag
 			for _, o := range tt.args.includeOwners {
@@ -622,3 +669,18 @@ func assignedOwnerSetup(path string, user *types.User) func(*dbmocks.MockDB) {
 		db.AssignedOwnersFunc.SetDefaultReturn(assignedOwnersStore)
 	}
 }
+
+func recentReviewerSetup(path, handle string) func(*dbmocks.MockDB) {
+	return func(db *dbmocks.MockDB) {
+		recentReviewers := []*database.RecentReviewerSummary{
+			{
+				FilePath:       path,
+				ReviewerHandle: handle,
+				ReviewCount:    1,
+			},
+		}
+		recentReviewStore := dbmocks.NewMockRecentReviewSignalStore()
+		recentReviewStore.ListRecentReviewersForRepoFunc.SetDefaultReturn(recentReviewers, nil)
+		db.RecentReviewSignalsFunc.SetDefaultReturn(recentReviewStore)
+	}
+}
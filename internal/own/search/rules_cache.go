@@ -24,22 +24,29 @@ type AssignedKey struct {
 }
 
 type RulesCache struct {
-	rules         map[RulesKey]*codeowners.Ruleset
-	assigned      map[AssignedKey]own.AssignedOwners
-	assignedTeams map[AssignedKey]own.AssignedTeams
-	ownService    own.Service
+	rules           map[RulesKey]*codeowners.Ruleset
+	assigned        map[AssignedKey]own.AssignedOwners
+	assignedTeams   map[AssignedKey]own.AssignedTeams
+	recentReviewers map[AssignedKey]own.RecentReviewers
+	ownService      own.Service
 
-	rulesMu         sync.RWMutex
-	assignedMu      sync.RWMutex
-	assignedTeamsMu sync.RWMutex
+	// includeRecentReviewers makes recent reviewers part of the ownership
+	// data. It is only set when the recent reviewers signal is enabled.
+	includeRecentReviewers bool
+
+	rulesMu           sync.RWMutex
+	assignedMu        sync.RWMutex
+	assignedTeamsMu   sync.RWMutex
+	recentReviewersMu sync.RWMutex
 }
 
 func NewRulesCache(gs gitserver.Client, db database.DB) RulesCache {
 	return RulesCache{
-		rules:         make(map[RulesKey]*codeowners.Ruleset),
-		assigned:      make(map[AssignedKey]own.AssignedOwners),
-		assignedTeams: make(map[AssignedKey]own.AssignedTeams),
-		ownService:    own.NewService(gs, db),
+		rules:           make(map[RulesKey]*codeowners.Ruleset),
+		assigned:        make(map[AssignedKey]own.AssignedOwners),
+		assignedTeams:   make(map[AssignedKey]own.AssignedTeams),
+		recentReviewers: make(map[AssignedKey]own.RecentReviewers),
+		ownService:      own.NewService(gs, db),
 	}
 }
 
@@ -52,14 +59,22 @@ func (c *RulesCache) GetFromCacheOrFetch(ctx context.Context, repoName api.RepoN
 	if err != nil {
 		return repoOwnershipData{}, err
 	}
+	var recentReviewers own.RecentReviewers
+	if c.includeRecentReviewers {
+		recentReviewers, err = c.RecentReviewers(ctx, repoID)
+		if err != nil {
+			return repoOwnershipData{}, err
+		}
+	}
 	codeowners, err := c.Codeowners(ctx, repoName, repoID, commitID)
 	if err != nil {
 		return repoOwnershipData{}, err
 	}
 	return repoOwnershipData{
-		assigned:      assigned,
-		assignedTeams: assignedTeams,
-		codeowners:    codeowners,
+		assigned:        assigned,
+		assignedTeams:   assignedTeams,
+		recentReviewers: recentReviewers,
+		codeowners:      codeowners,
 	}, nil
 }
 
@@ -105,6 +120,27 @@ func (c *RulesCache) AssignedTeams(ctx context.Context, repoID api.RepoID, commi
 	return c.assignedTeams[key], nil
 }
 
+func (c *RulesCache) RecentReviewers(ctx context.Context, repoID api.RepoID) (own.RecentReviewers, error) {
+	c.recentReviewersMu.RLock()
+	key := AssignedKey{repoID}
+	if v, ok := c.recentReviewers[key]; ok {
+		defer c.recentReviewersMu.RUnlock()
+		return v, nil
+	}
+	c.recentReviewersMu.RUnlock()
+	c.recentReviewersMu.Lock()
+	defer c.recentReviewersMu.Unlock()
+	if _, ok := c.recentReviewers[key]; !ok {
+		reviewers, err := c.ownService.RecentReviewers(ctx, repoID)
+		if err != nil {
+			// Error is picked up on a call site and in most cases a search alert is created.
+			return nil, err
+		}
+		c.recentReviewers[key] = reviewers
+	}
+	return c.recentReviewers[key], nil
+}
+
 func (c *RulesCache) Codeowners(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
 	c.rulesMu.RLock()
 	key := RulesKey{repoName, commitID}
@@ -131,9 +167,10 @@ func (c *RulesCache) Codeowners(ctx context.Context, repoName api.RepoName, repo
 }
 
 type repoOwnershipData struct {
-	codeowners    *codeowners.Ruleset
-	assigned      own.AssignedOwners
-	assignedTeams own.AssignedTeams
+	codeowners      *codeowners.Ruleset
+	assigned        own.AssignedOwners
+	assignedTeams   own.AssignedTeams
+	recentReviewers own.RecentReviewers
 }
 
 func (o repoOwnershipData) Match(path string) fileOwnershipData {
@@ -142,16 +179,18 @@ func (o repoOwnershipData) Match(path string) fileOwnershipData {
 		rule = o.codeowners.Match(path)
 	}
 	return fileOwnershipData{
-		rule:           rule,
-		assignedOwners: o.assigned.Match(path),
-		assignedTeams:  o.assignedTeams.Match(path),
+		rule:            rule,
+		assignedOwners:  o.assigned.Match(path),
+		assignedTeams:   o.assignedTeams.Match(path),
+		recentReviewers: o.recentReviewers.Match(path),
 	}
 }
 
 type fileOwnershipData struct {
-	rule           *codeownerspb.Rule
-	assignedOwners []database.AssignedOwnerSummary
-	assignedTeams  []database.AssignedTeamSummary
+	rule            *codeownerspb.Rule
+	assignedOwners  []database.AssignedOwnerSummary
+	assignedTeams   []database.AssignedTeamSummary
+	recentReviewers []database.RecentReviewerSummary
 }
 
 func (d fileOwnershipData) References() []own.Reference {
@@ -165,6 +204,9 @@ func (d fileOwnershipData) References() []own.Reference {
 	for _, o := range d.assignedTeams {
 		rs = append(rs, own.Reference{TeamID: o.OwnerTeamID})
 	}
+	for _, o := range d.recentReviewers {
+		rs = append(rs, own.Reference{Handle: o.ReviewerHandle, Email: o.ReviewerEmail})
+	}
 	return rs
 }
 
@@ -182,6 +224,9 @@ func (d fileOwnershipData) NonEmpty() bool {
 	if len(d.assignedTeams) > 0 {
 		return true
 	}
+	if len(d.recentReviewers) > 0 {
+		return true
+	}
 	return false
 }
 
@@ -204,6 +249,14 @@ func (d fileOwnershipData) IsWithin(bag own.Bag) bool {
 			return true
 		}
 	}
+	for _, o := range d.recentReviewers {
+		if bag.Contains(own.Reference{
+			Handle: o.ReviewerHandle,
+			Email:  o.ReviewerEmail,
+		}) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -223,5 +276,8 @@ func (d fileOwnershipData) String() string {
 	for _, o := range d.assignedTeams {
 		references = append(references, fmt.Sprintf("#%d", o.OwnerTeamID))
 	}
+	for _, o := range d.recentReviewers {
+		references = append(references, o.ReviewerHandle)
+	}
 	return fmt.Sprintf("[%s]", strings.Join(references, ", "))
 }
@@ -37,6 +37,10 @@ type Service interface {
 	// team of 'src/test' in a given repo transitively owns all files within the
 	// directory tree at that root like 'src/test/com/sourcegraph/Test.java'.
 	AssignedTeams(context.Context, api.RepoID, api.CommitID) (AssignedTeams, error)
+
+	// RecentReviewers returns the reviewers that approved changesets recently
+	// merged into given repo, as computed by the recent reviewers signal.
+	RecentReviewers(context.Context, api.RepoID) (RecentReviewers, error)
 }
 
 type AssignedOwners map[string][]database.AssignedOwnerSummary
@@ -59,6 +63,15 @@ func (at AssignedTeams) Match(path string) []database.AssignedTeamSummary {
 	return match(at, path)
 }
 
+type RecentReviewers map[string][]database.RecentReviewerSummary
+
+// Match returns all the recent reviewer summaries for the given path.
+// Reviews are already aggregated for all the ancestor directories
+// of reviewed files when indexing, so no inheritance is needed here.
+func (rr RecentReviewers) Match(path string) []database.RecentReviewerSummary {
+	return rr[path]
+}
+
 func match[T any](assigned map[string][]T, path string) []T {
 	var summaries []T
 	for lastSlash := len(path); lastSlash != -1; lastSlash = strings.LastIndex(path, "/") {
@@ -170,3 +183,17 @@ func (s *service) AssignedTeams(ctx context.Context, repoID api.RepoID, _ api.Co
 	}
 	return assignedTeams, nil
 }
+
+func (s *service) RecentReviewers(ctx context.Context, repoID api.RepoID) (RecentReviewers, error) {
+	summaries, err := s.db.RecentReviewSignals().ListRecentReviewersForRepo(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	recentReviewers := RecentReviewers{}
+	for _, summary := range summaries {
+		byPath := recentReviewers[summary.FilePath]
+		byPath = append(byPath, *summary)
+		recentReviewers[summary.FilePath] = byPath
+	}
+	return recentReviewers, nil
+}
@@ -522,3 +522,54 @@ func createTeam(t *testing.T, ctx context.Context, db database.DB, teamName stri
 	require.NoError(t, err)
 	return team
 }
+
+func TestRecentReviewers(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+
+	var repoID api.RepoID = 1
+	require.NoError(t, db.Repos().Create(ctx, &itypes.Repo{
+		ID:   repoID,
+		Name: "github.com/sourcegraph/sourcegraph",
+	}))
+
+	store := db.RecentReviewSignals()
+	require.NoError(t, store.AddReview(ctx, database.Review{
+		RepoID:         repoID,
+		ReviewerHandle: "alice",
+		ReviewerEmail:  "alice@example.com",
+		FilesChanged:   []string{"src/main.go"},
+	}))
+	require.NoError(t, store.AddReview(ctx, database.Review{
+		RepoID:         repoID,
+		ReviewerHandle: "bob",
+		FilesChanged:   []string{"README.md"},
+	}))
+
+	s := NewService(nil, db)
+	got, err := s.RecentReviewers(ctx, repoID)
+	require.NoError(t, err)
+	alice := database.RecentReviewerSummary{ReviewerHandle: "alice", ReviewerEmail: "alice@example.com", ReviewCount: 1}
+	bob := database.RecentReviewerSummary{ReviewerHandle: "bob", ReviewCount: 1}
+	withPath := func(s database.RecentReviewerSummary, path string) database.RecentReviewerSummary {
+		s.FilePath = path
+		return s
+	}
+	want := RecentReviewers{
+		"":            {withPath(alice, ""), withPath(bob, "")},
+		"README.md":   {withPath(bob, "README.md")},
+		"src":         {withPath(alice, "src")},
+		"src/main.go": {withPath(alice, "src/main.go")},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("RecentReviewers -want+got: %s", diff)
+	}
+	assert.Equal(t, []database.RecentReviewerSummary{withPath(alice, "src")}, got.Match("src"))
+	assert.Empty(t, got.Match("src/test"))
+}
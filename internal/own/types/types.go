@@ -20,5 +20,6 @@ type CodeownersFile struct {
 const (
 	SignalRecentContributors = "recent-contributors"
 	SignalRecentViews        = "recent-views"
+	SignalRecentReviewers    = "recent-reviewers"
 	Analytics                = "analytics"
 )
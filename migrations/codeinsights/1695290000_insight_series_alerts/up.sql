@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS insight_series_alert_rules (
+    id SERIAL PRIMARY KEY,
+    series_id INT NOT NULL,
+    kind TEXT NOT NULL,
+    direction TEXT NOT NULL DEFAULT 'above',
+    threshold DOUBLE PRECISION NOT NULL,
+    intervals INT NOT NULL DEFAULT 1,
+    created_by INT NOT NULL,
+    email BOOLEAN NOT NULL DEFAULT FALSE,
+    slack_webhook_url TEXT,
+    webhook_url TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_triggered_at TIMESTAMP WITHOUT TIME ZONE,
+    CONSTRAINT insight_series_alert_rules_series_id_fk FOREIGN KEY (series_id) REFERENCES insight_series(id) ON DELETE CASCADE,
+    CONSTRAINT insight_series_alert_rules_kind_valid CHECK (kind IN ('threshold', 'percent_change', 'anomaly')),
+    CONSTRAINT insight_series_alert_rules_direction_valid CHECK (direction IN ('above', 'below', 'either')),
+    CONSTRAINT insight_series_alert_rules_intervals_positive CHECK (intervals > 0)
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alert_rules_series_id_idx ON insight_series_alert_rules (series_id);
+
+COMMENT ON TABLE insight_series_alert_rules IS 'Alert rules that are evaluated against the points of an insight series after each snapshot.';
+COMMENT ON COLUMN insight_series_alert_rules.threshold IS 'The absolute value for threshold rules, the percentage for percent_change rules and the number of standard deviations from the rolling baseline for anomaly rules.';
+COMMENT ON COLUMN insight_series_alert_rules.intervals IS 'The number of recordings to compare against for percent_change rules, and the size of the rolling baseline for anomaly rules.';
+COMMENT ON COLUMN insight_series_alert_rules.created_by IS 'The ID of the user that created the rule in the frontend database.';
+COMMENT ON COLUMN insight_series_alert_rules.email IS 'Whether to email the user that created the rule when it fires.';
+
+CREATE TABLE IF NOT EXISTS insight_series_alert_events (
+    id SERIAL PRIMARY KEY,
+    alert_rule_id INT NOT NULL,
+    series_id INT NOT NULL,
+    point_time TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    baseline DOUBLE PRECISION NOT NULL,
+    message TEXT NOT NULL,
+    delivery_error TEXT,
+    triggered_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT insight_series_alert_events_alert_rule_id_fk FOREIGN KEY (alert_rule_id) REFERENCES insight_series_alert_rules(id) ON DELETE CASCADE,
+    CONSTRAINT insight_series_alert_events_series_id_fk FOREIGN KEY (series_id) REFERENCES insight_series(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alert_events_alert_rule_id_idx ON insight_series_alert_events (alert_rule_id, triggered_at DESC);
+CREATE INDEX IF NOT EXISTS insight_series_alert_events_series_id_idx ON insight_series_alert_events (series_id, triggered_at DESC);
+
+COMMENT ON TABLE insight_series_alert_events IS 'The history of fired insight series alert rules.';
+COMMENT ON COLUMN insight_series_alert_events.baseline IS 'The value the series point was compared against: the threshold, the value N intervals ago or the mean of the rolling baseline.';
+COMMENT ON COLUMN insight_series_alert_events.delivery_error IS 'The error returned by the notification channels, if delivering the alert failed.';
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS batch_spec_templates (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    spec text NOT NULL,
+    inputs jsonb NOT NULL DEFAULT '[]'::jsonb,
+    namespace_user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    namespace_org_id integer REFERENCES orgs(id) ON DELETE CASCADE DEFERRABLE,
+    creator_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT batch_spec_templates_has_1_namespace CHECK ((namespace_user_id IS NULL) <> (namespace_org_id IS NULL)),
+    CONSTRAINT batch_spec_templates_name_not_blank CHECK (name <> ''::text)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_spec_templates_unique_user_id ON batch_spec_templates (name, namespace_user_id) WHERE namespace_user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS batch_spec_templates_unique_org_id ON batch_spec_templates (name, namespace_org_id) WHERE namespace_org_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS batch_spec_templates_namespace_org_id ON batch_spec_templates (namespace_org_id);
+CREATE INDEX IF NOT EXISTS batch_spec_templates_namespace_user_id ON batch_spec_templates (namespace_user_id);
+
+COMMENT ON TABLE batch_spec_templates IS 'Stores reusable batch spec templates with typed inputs that can be rendered into batch specs.';
+COMMENT ON COLUMN batch_spec_templates.inputs IS 'JSON array of input definitions that the template declares.';
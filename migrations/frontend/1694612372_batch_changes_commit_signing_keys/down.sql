@@ -0,0 +1 @@
+DROP TABLE IF EXISTS batch_changes_commit_signing_keys;
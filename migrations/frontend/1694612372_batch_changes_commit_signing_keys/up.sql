@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS batch_changes_commit_signing_keys (
+    id bigserial PRIMARY KEY,
+    user_id integer REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    format text NOT NULL,
+    public_key text NOT NULL,
+    fingerprint text NOT NULL,
+    credential bytea NOT NULL,
+    encryption_key_id text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT batch_changes_commit_signing_keys_format_check CHECK (format = ANY (ARRAY['gpg'::text, 'ssh'::text]))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_changes_commit_signing_keys_unique_user_id ON batch_changes_commit_signing_keys (user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS batch_changes_commit_signing_keys_unique_site ON batch_changes_commit_signing_keys ((user_id IS NULL)) WHERE user_id IS NULL;
+CREATE INDEX IF NOT EXISTS batch_changes_commit_signing_keys_credential_idx ON batch_changes_commit_signing_keys ((encryption_key_id = ANY (ARRAY[''::text, 'previously-migrated'::text])));
+
+COMMENT ON TABLE batch_changes_commit_signing_keys IS 'Stores the GPG and SSH keys used to sign commits created by batch changes on code hosts without native commit signing support. A key without a user_id is the site-wide default.';
+COMMENT ON COLUMN batch_changes_commit_signing_keys.credential IS 'Encrypted JSON object holding the private key and its passphrase.';
@@ -0,0 +1,9 @@
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachable boolean;
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachable_symbols text[] NOT NULL DEFAULT '{}';
+ALTER TABLE vulnerability_matches ADD COLUMN IF NOT EXISTS reachability_checked_at timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS vulnerability_matches_reachability_unchecked ON vulnerability_matches (id) WHERE reachability_checked_at IS NULL;
+
+COMMENT ON COLUMN vulnerability_matches.reachable IS 'Whether the upload references one of the affected symbols of the vulnerability. Null if reachability could not be determined, for example because the vulnerability lists no affected symbols.';
+COMMENT ON COLUMN vulnerability_matches.reachable_symbols IS 'The SCIP symbol names of the affected symbols referenced by the upload.';
+COMMENT ON COLUMN vulnerability_matches.reachability_checked_at IS 'When the reachability of the match was last determined. Null if it has not been determined yet.';
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS vulnerability_imports (
+    id SERIAL PRIMARY KEY,
+    source text NOT NULL,
+    origin text NOT NULL,
+    user_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    num_vulnerabilities integer NOT NULL DEFAULT 0,
+    num_vulnerabilities_inserted integer NOT NULL DEFAULT 0,
+    failure_message text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT vulnerability_imports_origin_check CHECK (origin = ANY (ARRAY['download'::text, 'upload'::text]))
+);
+
+CREATE INDEX IF NOT EXISTS vulnerability_imports_source_created_at ON vulnerability_imports (source, created_at DESC);
+
+COMMENT ON TABLE vulnerability_imports IS 'A history of imports of vulnerability databases, either downloaded by the sentinel downloader or uploaded by a site admin.';
+COMMENT ON COLUMN vulnerability_imports.source IS 'The vulnerability database that was imported, e.g. github, govulndb or osv.';
+COMMENT ON COLUMN vulnerability_imports.origin IS 'Whether the archive was downloaded from a (mirror) URL or uploaded by a site admin.';
+COMMENT ON COLUMN vulnerability_imports.failure_message IS 'The reason the import failed. Null if the import succeeded.';
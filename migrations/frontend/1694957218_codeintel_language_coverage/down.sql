@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS codeintel_language_coverage;
+DROP TABLE IF EXISTS codeintel_last_coverage_scan;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS codeintel_language_coverage (
+    id SERIAL PRIMARY KEY,
+    repository_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    language text NOT NULL,
+    commit text NOT NULL,
+    total_bytes bigint NOT NULL,
+    total_lines bigint NOT NULL,
+    state text NOT NULL,
+    indexers text[] NOT NULL DEFAULT '{}',
+    failure_reason text,
+    last_indexed_at timestamp with time zone,
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT codeintel_language_coverage_state_check CHECK (state = ANY (ARRAY['indexed'::text, 'stale'::text, 'failed'::text, 'unindexed'::text]))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS codeintel_language_coverage_repository_id_language ON codeintel_language_coverage (repository_id, language);
+CREATE INDEX IF NOT EXISTS codeintel_language_coverage_language_state ON codeintel_language_coverage (language, state);
+
+COMMENT ON TABLE codeintel_language_coverage IS 'The precise code intelligence coverage of each programming language of the default branch of a repository, computed periodically by the coverage aggregator.';
+COMMENT ON COLUMN codeintel_language_coverage.commit IS 'The default branch commit from which the language statistics were computed.';
+COMMENT ON COLUMN codeintel_language_coverage.state IS 'One of indexed (precise data is visible from the default branch), stale (precise data exists but is outdated or not visible from the default branch), failed (the most recent upload or auto-indexing job failed and no usable data exists), or unindexed.';
+COMMENT ON COLUMN codeintel_language_coverage.indexers IS 'The names of the indexers with upload or auto-indexing records for the language.';
+COMMENT ON COLUMN codeintel_language_coverage.failure_reason IS 'The failure message of the most recent failed upload or auto-indexing job for the language, if the most recent attempt failed.';
+COMMENT ON COLUMN codeintel_language_coverage.last_indexed_at IS 'When the most recent completed upload for the language finished processing.';
+
+CREATE TABLE IF NOT EXISTS codeintel_last_coverage_scan (
+    repository_id integer NOT NULL PRIMARY KEY,
+    last_coverage_scan_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE codeintel_last_coverage_scan IS 'Tracks the last time the precise code intelligence coverage of a repository was computed.';
+COMMENT ON COLUMN codeintel_last_coverage_scan.last_coverage_scan_at IS 'The last time the precise code intelligence coverage of this repository was computed.';
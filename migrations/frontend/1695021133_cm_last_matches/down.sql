@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cm_last_matches;
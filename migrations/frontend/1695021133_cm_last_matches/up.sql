@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS cm_last_matches (
+    monitor_id bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    fingerprints text[] NOT NULL,
+    PRIMARY KEY (monitor_id, repo_id)
+);
+
+COMMENT ON TABLE cm_last_matches IS 'The matches of the last successful search of a content code monitor, per searched repository';
+COMMENT ON COLUMN cm_last_matches.fingerprints IS 'The set of match fingerprints (file path and matched line or symbol) found by the previous search, compared against on the next run';
@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS cm_issue_actions (
+    id bigserial PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    tracker text NOT NULL,
+    title_template text NOT NULL DEFAULT '',
+    body_template text NOT NULL DEFAULT '',
+    labels text[] NOT NULL DEFAULT '{}',
+    jira_url text,
+    jira_project_key text,
+    jira_issue_type text,
+    jira_username text,
+    jira_token text,
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT cm_issue_actions_tracker_valid CHECK (tracker IN ('codehost', 'jira'))
+);
+
+CREATE INDEX IF NOT EXISTS cm_issue_actions_monitor ON cm_issue_actions USING btree (monitor);
+
+COMMENT ON TABLE cm_issue_actions IS 'Issue actions configured on code monitors, which open or comment on an issue for each repository with new results';
+COMMENT ON COLUMN cm_issue_actions.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_issue_actions.tracker IS 'Where issues are filed: the code host of the repository with results (codehost), or a Jira project (jira)';
+COMMENT ON COLUMN cm_issue_actions.title_template IS 'Go text/template for the issue title. The default title is used if empty';
+COMMENT ON COLUMN cm_issue_actions.body_template IS 'Go text/template for the issue body and follow-up comments. The default body is used if empty';
+COMMENT ON COLUMN cm_issue_actions.jira_token IS 'The API token used to authenticate against Jira. Only set if tracker is jira';
+
+CREATE TABLE IF NOT EXISTS cm_issue_action_issues (
+    issue_action bigint NOT NULL REFERENCES cm_issue_actions(id) ON DELETE CASCADE,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    external_id text NOT NULL,
+    url text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (issue_action, repo_id)
+);
+
+COMMENT ON TABLE cm_issue_action_issues IS 'The most recent issue opened by a code monitor issue action for a repository, which is commented on instead of opening a new issue while it is open';
+COMMENT ON COLUMN cm_issue_action_issues.external_id IS 'The issue number (GitHub), project-scoped issue ID (GitLab), or issue key (Jira)';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS issue bigint REFERENCES cm_issue_actions(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.issue IS 'The ID of the cm_issue_actions action to execute if this is an issue job. Mutually exclusive with email, webhook and slack_webhook';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN issue IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
@@ -0,0 +1,7 @@
+ALTER TABLE cm_action_jobs DROP COLUMN IF EXISTS digest_after_trigger_event;
+
+ALTER TABLE cm_monitors DROP CONSTRAINT IF EXISTS cm_monitors_delivery_schedule_valid;
+ALTER TABLE cm_monitors
+    DROP COLUMN IF EXISTS delivery_schedule,
+    DROP COLUMN IF EXISTS last_digest_at,
+    DROP COLUMN IF EXISTS last_digest_trigger_job;
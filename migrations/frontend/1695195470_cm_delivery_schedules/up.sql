@@ -0,0 +1,15 @@
+ALTER TABLE cm_monitors
+    ADD COLUMN IF NOT EXISTS delivery_schedule text NOT NULL DEFAULT 'immediate',
+    ADD COLUMN IF NOT EXISTS last_digest_at timestamp with time zone,
+    ADD COLUMN IF NOT EXISTS last_digest_trigger_job integer;
+
+ALTER TABLE cm_monitors DROP CONSTRAINT IF EXISTS cm_monitors_delivery_schedule_valid;
+ALTER TABLE cm_monitors ADD CONSTRAINT cm_monitors_delivery_schedule_valid CHECK (delivery_schedule IN ('immediate', 'hourly', 'daily', 'weekly'));
+
+COMMENT ON COLUMN cm_monitors.delivery_schedule IS 'How often the actions of the monitor run: immediately after every trigger run with results, or as an hourly, daily or weekly digest of the results of all trigger runs since the last digest';
+COMMENT ON COLUMN cm_monitors.last_digest_at IS 'When the last digest of the monitor was enqueued';
+COMMENT ON COLUMN cm_monitors.last_digest_trigger_job IS 'The last cm_trigger_jobs job whose results were included in a digest. Not a foreign key, because old trigger jobs are deleted';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS digest_after_trigger_event integer;
+
+COMMENT ON COLUMN cm_action_jobs.digest_after_trigger_event IS 'For digests, the action job covers the results of the trigger jobs of the monitor after this one, up to and including trigger_event. NULL if the action job only covers trigger_event';
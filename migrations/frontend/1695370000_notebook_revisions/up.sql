@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS notebook_revisions (
+    id bigserial PRIMARY KEY,
+    notebook_id bigint NOT NULL REFERENCES notebooks(id) ON DELETE CASCADE DEFERRABLE,
+    title text NOT NULL,
+    blocks jsonb NOT NULL DEFAULT '[]'::jsonb,
+    author_user_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT notebook_revisions_blocks_is_array CHECK (jsonb_typeof(blocks) = 'array')
+);
+
+CREATE INDEX IF NOT EXISTS notebook_revisions_notebook_id_idx ON notebook_revisions USING btree (notebook_id, id);
+
+COMMENT ON TABLE notebook_revisions IS 'Revisions of the title and blocks of notebooks. A revision is created every time a notebook is saved with a changed title or blocks';
+COMMENT ON COLUMN notebook_revisions.author_user_id IS 'The user that saved the revision, or NULL if the user was removed';
+
+-- Existing notebooks start with a revision of their current title and blocks.
+INSERT INTO notebook_revisions (notebook_id, title, blocks, author_user_id, created_at)
+SELECT n.id, n.title, n.blocks, COALESCE(n.updater_user_id, n.creator_user_id), n.updated_at
+FROM notebooks n
+WHERE NOT EXISTS (SELECT 1 FROM notebook_revisions r WHERE r.notebook_id = n.id);
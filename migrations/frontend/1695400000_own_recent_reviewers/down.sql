@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS own_aggregate_recent_review;
+
+DELETE FROM own_signal_configurations
+WHERE name = 'recent-reviewers';
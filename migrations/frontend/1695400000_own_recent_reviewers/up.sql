@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS own_aggregate_recent_review
+(
+    id                    SERIAL PRIMARY KEY,
+    reviewer_handle       TEXT    NOT NULL,
+    reviewer_email        TEXT    NOT NULL DEFAULT '',
+    reviewed_file_path_id INTEGER NOT NULL REFERENCES repo_paths (id),
+    reviews_count         INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS own_aggregate_recent_review_reviewer
+    ON own_aggregate_recent_review
+        USING btree (reviewed_file_path_id, reviewer_handle);
+
+COMMENT ON TABLE own_aggregate_recent_review
+    IS 'One entry contains a number of approved reviews of recently merged changesets touching a single file (or directory) by a given code host reviewer.';
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'recent-reviewers',
+        FALSE,
+        'Indexes reviewers that approved recently merged changesets on the code host.'
+    ) ON CONFLICT DO NOTHING;
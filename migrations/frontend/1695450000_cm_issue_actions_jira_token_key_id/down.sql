@@ -0,0 +1 @@
+ALTER TABLE cm_issue_actions DROP COLUMN IF EXISTS jira_token_key_id;
@@ -0,0 +1,5 @@
+ALTER TABLE cm_issue_actions ADD COLUMN IF NOT EXISTS jira_token_key_id text;
+
+UPDATE cm_issue_actions SET jira_token_key_id = '' WHERE jira_token IS NOT NULL AND jira_token_key_id IS NULL;
+
+COMMENT ON COLUMN cm_issue_actions.jira_token_key_id IS 'The ID of the key jira_token is encrypted with, or empty if jira_token is not encrypted. Only set if tracker is jira';
@@ -733,7 +733,8 @@ func (v *EncryptionKey) UnmarshalJSON(data []byte) error {
 type EncryptionKeys struct {
 	BatchChangesCredentialKey *EncryptionKey `json:"batchChangesCredentialKey,omitempty"`
 	// CacheSize description: number of values to keep in LRU cache
-	CacheSize int `json:"cacheSize,omitempty"`
+	CacheSize      int            `json:"cacheSize,omitempty"`
+	CodeMonitorKey *EncryptionKey `json:"codeMonitorKey,omitempty"`
 	// EnableCache description: enable LRU cache for decryption APIs
 	EnableCache            bool           `json:"enableCache,omitempty"`
 	ExecutorSecretKey      *EncryptionKey `json:"executorSecretKey,omitempty"`